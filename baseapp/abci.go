@@ -241,7 +241,7 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		panic(fmt.Sprintf("unknown RequestCheckTx type: %s", req.Type))
 	}
 
-	gInfo, result, anteEvents, err := app.runTx(mode, req.Tx)
+	gInfo, result, anteEvents, priority, err := app.runTx(mode, req.Tx)
 	if err != nil {
 		return sdkerrors.ResponseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, app.trace)
 	}
@@ -252,6 +252,7 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		Log:       result.Log,
 		Data:      result.Data,
 		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
+		Priority:  priority,
 	}
 }
 
@@ -260,9 +261,18 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 // Otherwise, the ResponseDeliverTx will contain releveant error information.
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
-func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliverTx) {
+func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
 	defer telemetry.MeasureSince(time.Now(), "abci", "deliver_tx")
 
+	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
+	return app.deliverTxResponse(req, gInfo, result, anteEvents, err)
+}
+
+// deliverTxResponse returns the response of a delivered tx, and reports it to
+// the telemetry and the streaming listeners.
+func (app *BaseApp) deliverTxResponse(
+	req abci.RequestDeliverTx, gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error,
+) (res abci.ResponseDeliverTx) {
 	defer func() {
 		for _, streamingListener := range app.abciListeners {
 			goCtx := sdk.WrapSDKContext(app.deliverState.ctx)
@@ -272,7 +282,6 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 		}
 	}()
 
-	gInfo := sdk.GasInfo{}
 	resultStr := "successful"
 
 	defer func() {
@@ -282,7 +291,6 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, anteEvents, err := app.runTx(runTxModeDeliver, req.Tx)
 	if err != nil {
 		resultStr = "failed"
 		return sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, sdk.MarkEventsToIndex(anteEvents, app.indexEvents), app.trace)
@@ -337,6 +345,15 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 	// Commit. Use the header from this latest block.
 	app.setCheckState(header)
 
+	// Evict the expired txs from the mempool. Tendermint drops txs on its own,
+	// e.g. once their TTL elapsed or when its cache is full, without notifying
+	// the app, hence the app mempool must expire them too.
+	if app.mempool != nil && app.mempoolTTL > 0 {
+		if evicted := app.mempool.EvictExpired(header.Height - app.mempoolTTL); evicted > 0 {
+			app.logger.Debug("evicted expired txs from mempool", "count", evicted)
+		}
+	}
+
 	// empty/reset the deliver state
 	app.deliverState = nil
 
@@ -359,7 +376,13 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 	}
 
 	if app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0 {
-		go app.snapshot(header.Height)
+		// keep the height from being pruned in the background until the
+		// snapshot is taken
+		unpin := app.snapshotManager.PinHeight(uint64(header.Height))
+		go func() {
+			defer unpin()
+			app.snapshot(header.Height)
+		}()
 	}
 
 	return res
@@ -665,6 +688,14 @@ func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, e
 	}
 
 	cacheMS, err := app.cms.CacheMultiStoreWithVersion(height)
+	if err != nil && app.historicalStores != nil {
+		// the height may be pruned but restored from a local snapshot
+		cacheMS, err = app.historicalCacheMultiStore(height)
+		if errors.Is(err, sdkerrors.ErrConflict) {
+			// the state is being restored, the query must be retried
+			return sdk.Context{}, err
+		}
+	}
 	if err != nil {
 		return sdk.Context{},
 			sdkerrors.Wrapf(
@@ -847,6 +878,15 @@ func handleQueryStore(app *BaseApp, path []string, req abci.RequestQuery) abci.R
 			), app.trace)
 	}
 
+	if app.historicalStores != nil && req.Height > 0 && req.Height < app.LastBlockHeight() && !app.versionExists(req.Height) {
+		// the height is pruned but may be restored from a local snapshot
+		store, err := app.historicalStore(req.Height)
+		if err != nil {
+			return sdkerrors.QueryResultWithDebug(err, app.trace)
+		}
+		queryable = store
+	}
+
 	resp := queryable.Query(req)
 	resp.Height = req.Height
 
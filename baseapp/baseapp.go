@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -17,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/multi"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
@@ -51,6 +53,9 @@ type BaseApp struct { //nolint: maligned
 	txDecoder         sdk.TxDecoder // unmarshal []byte into sdk.Tx
 
 	anteHandler sdk.AnteHandler // ante handler for fee and auth
+	mempool     sdk.Mempool     // application side mempool, may be nil
+	mempoolMtx  sync.Mutex      // guards the mempool during DeliverTxs
+	mempoolTTL  int64           // number of blocks a tx is kept in the mempool, unbounded if 0
 
 	appStore
 	baseappVersions
@@ -111,6 +116,14 @@ type BaseApp struct { //nolint: maligned
 	// abciListeners for hooking into the ABCI message processing of the BaseApp
 	// and exposing the requests and responses to external consumers
 	abciListeners []ABCIListener
+
+	// deliverTxWorkers is the number of transactions of a block executed
+	// concurrently by DeliverTxs, they are executed sequentially if lower than 2
+	deliverTxWorkers int
+
+	// historicalStores holds the multistores restored from the local snapshots
+	// to serve the queries at pruned heights, nil if disabled
+	historicalStores *historicalStores
 }
 
 type appStore struct {
@@ -212,6 +225,12 @@ func (app *BaseApp) Version() string {
 	return app.version
 }
 
+// Mempool returns the application side mempool of the BaseApp, nil if none
+// is set.
+func (app *BaseApp) Mempool() sdk.Mempool {
+	return app.mempool
+}
+
 // Logger returns the logger of the BaseApp.
 func (app *BaseApp) Logger() log.Logger {
 	return app.logger
@@ -345,11 +364,12 @@ func (app *BaseApp) init() error {
 
 	// make sure the snapshot interval is a multiple of the pruning KeepEvery interval
 	if app.snapshotManager != nil && app.snapshotInterval > 0 {
-		rms, ok := app.cms.(*rootmulti.Store)
-		if !ok {
+		switch app.cms.(type) {
+		case *rootmulti.Store, *multi.Store:
+		default:
 			return errors.New("state sync snapshots require a rootmulti store")
 		}
-		pruningOpts := rms.GetPruning()
+		pruningOpts := app.cms.GetPruning()
 		if pruningOpts.KeepEvery > 0 && app.snapshotInterval%pruningOpts.KeepEvery != 0 {
 			return fmt.Errorf(
 				"state sync snapshot interval %v must be a multiple of pruning keep every interval %v",
@@ -569,7 +589,13 @@ func (app *BaseApp) getState(mode runTxMode) *state {
 
 // retrieve the context for the tx w/ txBytes and other memoized values.
 func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) sdk.Context {
-	ctx := app.getState(mode).ctx.
+	return app.txContext(app.getState(mode).ctx, mode, txBytes)
+}
+
+// txContext returns the context of the tx w/ txBytes derived from the given
+// state context.
+func (app *BaseApp) txContext(ctx sdk.Context, mode runTxMode, txBytes []byte) sdk.Context {
+	ctx = ctx.
 		WithTxBytes(txBytes).
 		WithVoteInfos(app.voteInfos)
 
@@ -612,18 +638,23 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // Note, gas execution info is always returned. A reference to a Result is
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
-func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes)
+}
+
+// runTxWithContext processes a transaction like runTx, on the given context
+// of the transaction.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
 	if mode == runTxModeDeliver && ctx.BlockGasMeter().IsOutOfGas() {
-		return gInfo, nil, nil, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
+		return gInfo, nil, nil, 0, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
 	}
 
 	defer func() {
@@ -658,19 +689,27 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 
 	tx, err := app.txDecoder(txBytes)
 	if err != nil {
-		return sdk.GasInfo{}, nil, nil, err
+		return sdk.GasInfo{}, nil, nil, 0, err
+	}
+
+	// The tx is included in a block, whatever the outcome of its execution, so
+	// it must not be proposed again. It may not be in our mempool, e.g. if it
+	// was received by another validator, hence the error is ignored. The
+	// transactions of a block may be delivered concurrently, see DeliverTxs.
+	if mode == runTxModeDeliver && app.mempool != nil {
+		app.mempoolMtx.Lock()
+		_ = app.mempool.Remove(ctx, tx)
+		app.mempoolMtx.Unlock()
 	}
 
 	msgs := tx.GetMsgs()
 	if err := validateBasicTxMsgs(msgs); err != nil {
-		return sdk.GasInfo{}, nil, nil, err
+		return sdk.GasInfo{}, nil, nil, 0, err
 	}
 
+	var anteCache sdk.CacheMultiStore
 	if app.anteHandler != nil {
-		var (
-			anteCtx sdk.Context
-			msCache sdk.CacheMultiStore
-		)
+		var anteCtx sdk.Context
 
 		// Branch context before AnteHandler call in case it aborts.
 		// This is required for both CheckTx and DeliverTx.
@@ -679,7 +718,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		// NOTE: Alternatively, we could require that AnteHandler ensures that
 		// writes do not happen if aborted/failed.  This may have some
 		// performance benefits, but it'll be more difficult to get right.
-		anteCtx, msCache = app.cacheTxContext(ctx, txBytes)
+		anteCtx, anteCache = app.cacheTxContext(ctx, txBytes)
 		anteCtx = anteCtx.WithEventManager(sdk.NewEventManager())
 		newCtx, err := app.anteHandler(anteCtx, tx, mode == runTxModeSimulate)
 
@@ -699,13 +738,34 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		gasWanted = ctx.GasMeter().Limit()
 
 		if err != nil {
-			return gInfo, nil, nil, err
+			// a tx failing on recheck is no longer valid against the latest
+			// committed state. Tendermint rechecks its pending txs one by one
+			// after Commit, hence this is where the mempool is cleared of the
+			// txs invalidated by a block.
+			if mode == runTxModeReCheck && app.mempool != nil {
+				_ = app.mempool.Remove(ctx, tx)
+			}
+
+			return gInfo, nil, nil, 0, err
 		}
 
-		msCache.Write()
+		priority = ctx.Priority()
 		anteEvents = events.ToABCIEvents()
 	}
 
+	if mode == runTxModeCheck && app.mempool != nil {
+		if err := app.mempool.Insert(ctx, tx); err != nil {
+			return gInfo, nil, anteEvents, priority, err
+		}
+	}
+
+	// The AnteHandler state changes are only written once the tx is accepted
+	// in the mempool, e.g. a rejected tx must not increment the sequence of its
+	// signers in the check state.
+	if anteCache != nil {
+		anteCache.Write()
+	}
+
 	// Create a new Context based off of the existing Context with a MultiStore branch
 	// in case message processing fails. At this point, the MultiStore
 	// is a branch of a branch.
@@ -727,7 +787,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		}
 	}
 
-	return gInfo, result, anteEvents, err
+	return gInfo, result, anteEvents, priority, err
 }
 
 // runMsgs iterates through a list of messages and executes them with the provided
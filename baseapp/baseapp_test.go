@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -13,8 +14,13 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	store "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/multi"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
 )
 
@@ -105,6 +111,7 @@ func TestLoadVersionPruning(t *testing.T) {
 		res := app.Commit()
 		lastCommitID = sdk.CommitID{Version: i, Hash: res.Data}
 	}
+	app.cms.(*rootmulti.Store).WaitForPruning()
 
 	for _, v := range []int64{1, 2, 4} {
 		_, err = app.cms.CacheMultiStoreWithVersion(v)
@@ -125,6 +132,136 @@ func TestLoadVersionPruning(t *testing.T) {
 	testLoadVersionHelper(t, app, int64(7), lastCommitID)
 }
 
+func TestStateStorage(t *testing.T) {
+	logger := log.NewNopLogger()
+	db, ssDB := dbm.NewMemDB(), dbm.NewMemDB()
+	name := t.Name()
+	app := NewBaseApp(name, logger, db, nil, SetPruning(store.PruneEverything), SetStateStorage(ssDB))
+
+	capKey := sdk.NewKVStoreKey("key1")
+	app.MountStores(capKey)
+	require.NoError(t, app.LoadLatestVersion())
+
+	for i := int64(1); i <= 20; i++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: i}})
+		app.deliverState.ctx.KVStore(capKey).Set([]byte("key"), []byte{byte(i)})
+		app.Commit()
+	}
+
+	// the pruned heights are served by the state storage
+	app.cms.(*multi.Store).WaitForPruning()
+	_, err := app.cms.(*multi.Store).StateCommitment().CacheMultiStoreWithVersion(1)
+	require.Error(t, err)
+	for i := int64(1); i <= 20; i++ {
+		cms, err := app.cms.CacheMultiStoreWithVersion(i)
+		require.NoError(t, err)
+		require.Equal(t, []byte{byte(i)}, cms.GetKVStore(capKey).Get([]byte("key")))
+	}
+
+	res := app.Query(abci.RequestQuery{Path: "/store/key1/key", Data: []byte("key"), Height: 5})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte{5}, res.Value)
+
+	// reload the app with the state storage
+	app = NewBaseApp(name, logger, db, nil, SetPruning(store.PruneEverything), SetStateStorage(ssDB))
+	app.MountStores(capKey)
+	require.NoError(t, app.LoadLatestVersion())
+	require.Equal(t, int64(20), app.LastBlockHeight())
+
+	res = app.Query(abci.RequestQuery{Path: "/store/key1/key", Data: []byte("key"), Height: 7})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte{7}, res.Value)
+}
+
+func setupHistoricalQueriesApp(t *testing.T, maxSize uint64) (*BaseApp, *sdk.KVStoreKey) {
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	app := NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil,
+		SetPruning(store.PruneEverything), SetSnapshotStore(snapshotStore), SetHistoricalQueries(1, maxSize))
+
+	capKey := sdk.NewKVStoreKey("key1")
+	app.MountStores(capKey)
+	require.NoError(t, app.LoadLatestVersion())
+
+	for i := int64(1); i <= 20; i++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: i}})
+		app.deliverState.ctx.KVStore(capKey).Set([]byte("key"), []byte{byte(i)})
+		app.Commit()
+		if i == 5 || i == 10 {
+			_, err := app.snapshotManager.Create(uint64(i))
+			require.NoError(t, err)
+		}
+	}
+	app.cms.(*rootmulti.Store).WaitForPruning()
+
+	return app, capKey
+}
+
+// waitForHistoricalRestore waits until no historical state is being restored.
+func waitForHistoricalRestore(t *testing.T, app *BaseApp) {
+	require.Eventually(t, func() bool {
+		app.historicalStores.mtx.Lock()
+		defer app.historicalStores.mtx.Unlock()
+		return app.historicalStores.restoring == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestHistoricalQueries(t *testing.T) {
+	app, capKey := setupHistoricalQueriesApp(t, 0)
+
+	// the pruned heights of the snapshots are restored in the background on
+	// the first query, which fails until then
+	_, err := app.cms.CacheMultiStoreWithVersion(5)
+	require.Error(t, err)
+	require.False(t, app.versionExists(5))
+	_, err = app.createQueryContext(5, false)
+	require.ErrorIs(t, err, sdkerrors.ErrConflict)
+	waitForHistoricalRestore(t, app)
+	ctx, err := app.createQueryContext(5, false)
+	require.NoError(t, err)
+	require.Equal(t, []byte{5}, ctx.KVStore(capKey).Get([]byte("key")))
+	require.True(t, app.historicalStores.stores.Contains(int64(5)))
+
+	res := app.Query(abci.RequestQuery{Path: "/store/key1/key", Data: []byte("key"), Height: 10})
+	require.False(t, res.IsOK())
+	require.Equal(t, sdkerrors.ErrConflict.ABCICode(), res.Code)
+	waitForHistoricalRestore(t, app)
+	res = app.Query(abci.RequestQuery{Path: "/store/key1/key", Data: []byte("key"), Height: 10})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte{10}, res.Value)
+	require.Equal(t, int64(10), res.Height)
+
+	// the least recently used height is evicted
+	require.False(t, app.historicalStores.stores.Contains(int64(5)))
+	require.True(t, app.historicalStores.stores.Contains(int64(10)))
+
+	// the heights held by the multistore are not restored
+	require.True(t, app.versionExists(20))
+	res = app.Query(abci.RequestQuery{Path: "/store/key1/key", Data: []byte("key"), Height: 19})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte{19}, res.Value)
+
+	// the other pruned heights still fail
+	_, err = app.createQueryContext(7, false)
+	require.Error(t, err)
+	res = app.Query(abci.RequestQuery{Path: "/store/key1/key", Data: []byte("key"), Height: 7})
+	require.False(t, res.IsOK())
+	require.Nil(t, res.Value)
+}
+
+func TestHistoricalQueriesMaxSize(t *testing.T) {
+	app, _ := setupHistoricalQueriesApp(t, 16)
+
+	// the restoration fails beyond the maximum size, and is not retried
+	_, err := app.createQueryContext(5, false)
+	require.ErrorIs(t, err, sdkerrors.ErrConflict)
+	waitForHistoricalRestore(t, app)
+	_, err = app.createQueryContext(5, false)
+	require.ErrorContains(t, err, "maximum size")
+	require.False(t, app.historicalStores.stores.Contains(int64(5)))
+	require.Equal(t, int64(0), app.historicalStores.restoring)
+}
+
 func testLoadVersionHelper(t *testing.T, app *BaseApp, expectedHeight int64, expectedID sdk.CommitID) {
 	lastHeight := app.LastBlockHeight()
 	lastID := app.LastCommitID()
@@ -167,7 +304,7 @@ func TestListSnapshots(t *testing.T) {
 	app, _ := setupBaseAppWithSnapshots(t, 2, 5)
 
 	expected := abci.ResponseListSnapshots{Snapshots: []*abci.Snapshot{
-		{Height: 2, Format: 1, Chunks: 2},
+		{Height: 2, Format: snapshottypes.CurrentFormat, Chunks: 2},
 	}}
 
 	resp := app.ListSnapshots(abci.RequestListSnapshots{})
@@ -0,0 +1,208 @@
+package baseapp
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/multi"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// historicalStores is an LRU cache of the multistores restored from the local
+// snapshots, by height. The restorations run in the background, one at a time,
+// so that they never block the ABCI connections.
+type historicalStores struct {
+	mtx    sync.Mutex
+	stores *simplelru.LRU
+	// maxSize is the maximum size in bytes of a restored multistore, unbounded
+	// if zero.
+	maxSize uint64
+	// restoring is the height being restored, zero if none.
+	restoring int64
+	// failed holds the errors of the failed restorations, by height, so that
+	// they are not retried on every query.
+	failed map[int64]error
+}
+
+func newHistoricalStores(cacheSize int, maxSize uint64) *historicalStores {
+	stores, err := simplelru.NewLRU(cacheSize, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return &historicalStores{stores: stores, maxSize: maxSize, failed: make(map[int64]error)}
+}
+
+// versionExists returns whether the multistore holds the state at the height,
+// without loading it.
+func (app *BaseApp) versionExists(height int64) bool {
+	cms, ok := app.cms.(interface{ VersionExists(int64) bool })
+	return !ok || cms.VersionExists(height)
+}
+
+// historicalCacheMultiStore returns a branch of the state at the height restored
+// from a local snapshot.
+func (app *BaseApp) historicalCacheMultiStore(height int64) (sdk.CacheMultiStore, error) {
+	store, err := app.historicalStore(height)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.CacheMultiStoreWithVersion(height)
+}
+
+// historicalStore returns a multistore holding the state at the height restored
+// from a local snapshot. If it is not cached, its restoration is started in the
+// background and an error is returned, the query must be retried once it is
+// restored.
+func (app *BaseApp) historicalStore(height int64) (*rootmulti.Store, error) {
+	h := app.historicalStores
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if store, ok := h.stores.Get(height); ok {
+		return store.(*rootmulti.Store), nil
+	}
+	if err, ok := h.failed[height]; ok {
+		return nil, err
+	}
+	if h.restoring != 0 {
+		return nil, errHistoricalRestoring(h.restoring)
+	}
+
+	var rms *rootmulti.Store
+	switch cms := app.cms.(type) {
+	case *rootmulti.Store:
+		rms = cms
+	case *multi.Store:
+		rms = cms.StateCommitment()
+	default:
+		return nil, fmt.Errorf("historical queries require a rootmulti store, got %T", app.cms)
+	}
+
+	if app.snapshotManager == nil {
+		return nil, fmt.Errorf("no snapshot store configured")
+	}
+	snapshots, err := app.snapshotManager.List()
+	if err != nil {
+		return nil, err
+	}
+	var format uint32
+	found := false
+	for _, snapshot := range snapshots {
+		if snapshot.Height == uint64(height) {
+			format, found = snapshot.Format, true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("no local snapshot at height %d", height)
+	}
+
+	h.restoring = height
+	go app.restoreHistoricalStore(rms, height, format)
+
+	return nil, errHistoricalRestoring(height)
+}
+
+// errHistoricalRestoring returns the error of the queries made while the state
+// at the height is being restored.
+func errHistoricalRestoring(height int64) error {
+	return sdkerrors.Wrapf(sdkerrors.ErrConflict, "restoring the state at height %d from a local snapshot, retry later", height)
+}
+
+// restoreHistoricalStore restores the state at the height from the local
+// snapshot into a temporary in-memory multistore, and caches it.
+func (app *BaseApp) restoreHistoricalStore(rms *rootmulti.Store, height int64, format uint32) {
+	h := app.historicalStores
+
+	store := rms.EmptyCopy(newBoundedMemDB(h.maxSize))
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+
+		if err := store.LoadLatestVersion(); err != nil {
+			return err
+		}
+		return app.snapshotManager.RestoreLocalMultistore(store, uint64(height), format)
+	}()
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	h.restoring = 0
+	if err != nil {
+		app.logger.Error("failed to restore historical state from local snapshot", "height", height, "err", err)
+		h.failed[height] = fmt.Errorf("failed to restore the local snapshot at height %d: %w", height, err)
+		return
+	}
+
+	app.logger.Info("restored historical state from local snapshot", "height", height, "format", format)
+	h.stores.Add(height, store)
+}
+
+// boundedMemDB is a MemDB whose writes fail once the total size of the keys and
+// values written exceeds maxSize, unbounded if zero. The deletions are not
+// accounted for, which is fine for a restoration.
+type boundedMemDB struct {
+	*dbm.MemDB
+	size    uint64
+	maxSize uint64
+}
+
+func newBoundedMemDB(maxSize uint64) *boundedMemDB {
+	return &boundedMemDB{MemDB: dbm.NewMemDB(), maxSize: maxSize}
+}
+
+// grow accounts for a write of the key and value.
+func (db *boundedMemDB) grow(key, value []byte) error {
+	size := atomic.AddUint64(&db.size, uint64(len(key)+len(value)))
+	if db.maxSize > 0 && size > db.maxSize {
+		return fmt.Errorf("historical state exceeds the maximum size of %d bytes", db.maxSize)
+	}
+
+	return nil
+}
+
+// Set implements dbm.DB.
+func (db *boundedMemDB) Set(key, value []byte) error {
+	if err := db.grow(key, value); err != nil {
+		return err
+	}
+
+	return db.MemDB.Set(key, value)
+}
+
+// SetSync implements dbm.DB.
+func (db *boundedMemDB) SetSync(key, value []byte) error {
+	return db.Set(key, value)
+}
+
+// NewBatch implements dbm.DB.
+func (db *boundedMemDB) NewBatch() dbm.Batch {
+	return &boundedMemDBBatch{Batch: db.MemDB.NewBatch(), db: db}
+}
+
+// boundedMemDBBatch is a batch of a boundedMemDB.
+type boundedMemDBBatch struct {
+	dbm.Batch
+	db *boundedMemDB
+}
+
+// Set implements dbm.Batch.
+func (b *boundedMemDBBatch) Set(key, value []byte) error {
+	if err := b.db.grow(key, value); err != nil {
+		return err
+	}
+
+	return b.Batch.Set(key, value)
+}
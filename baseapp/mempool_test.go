@@ -0,0 +1,236 @@
+package baseapp
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// mockMempool is a mempool of txTest, keyed by tx bytes and selected by
+// counter.
+type mockMempool struct {
+	txs     map[string]txTest
+	heights map[string]int64
+	maxTx   int
+}
+
+func newMockMempool(maxTx int) *mockMempool {
+	return &mockMempool{txs: make(map[string]txTest), heights: make(map[string]int64), maxTx: maxTx}
+}
+
+func (mp *mockMempool) Insert(ctx sdk.Context, tx sdk.Tx) error {
+	if len(mp.txs) >= mp.maxTx {
+		return sdkerrors.ErrMempoolIsFull
+	}
+
+	txTest := tx.(txTest)
+	if ctx.Priority() != txTest.Counter {
+		return fmt.Errorf("expected priority %d, got %d", txTest.Counter, ctx.Priority())
+	}
+
+	mp.txs[string(ctx.TxBytes())] = txTest
+	mp.heights[string(ctx.TxBytes())] = ctx.BlockHeight()
+	return nil
+}
+
+func (mp *mockMempool) Select(_ sdk.Context, limit int) []sdk.Tx {
+	txs := make([]txTest, 0, len(mp.txs))
+	for _, tx := range mp.txs {
+		txs = append(txs, tx)
+	}
+	sort.Slice(txs, func(i, j int) bool { return txs[i].Counter < txs[j].Counter })
+
+	var selected []sdk.Tx
+	for _, tx := range txs {
+		if limit > 0 && len(selected) >= limit {
+			break
+		}
+		selected = append(selected, tx)
+	}
+
+	return selected
+}
+
+func (mp *mockMempool) CountTx() int {
+	return len(mp.txs)
+}
+
+func (mp *mockMempool) Remove(ctx sdk.Context, _ sdk.Tx) error {
+	if _, ok := mp.txs[string(ctx.TxBytes())]; !ok {
+		return errors.New("tx not found")
+	}
+
+	delete(mp.txs, string(ctx.TxBytes()))
+	delete(mp.heights, string(ctx.TxBytes()))
+	return nil
+}
+
+func (mp *mockMempool) EvictExpired(height int64) int {
+	evicted := 0
+	for key, h := range mp.heights {
+		if h <= height {
+			delete(mp.txs, key)
+			delete(mp.heights, key)
+			evicted++
+		}
+	}
+
+	return evicted
+}
+
+// anteHandlerNonce uses the counter of the first message of a tx as a nonce
+// which can only be used once, and sets the tx priority to the tx counter.
+func anteHandlerNonce(capKey sdk.StoreKey) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		txTest := tx.(txTest)
+		if txTest.FailOnAnte {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+		}
+
+		store := ctx.KVStore(capKey)
+		var nonce int64
+		switch msg := txTest.Msgs[0].(type) {
+		case msgCounter:
+			nonce = msg.Counter
+		case *msgCounter:
+			nonce = msg.Counter
+		}
+
+		nonceKey := []byte(fmt.Sprintf("nonce-%d", nonce))
+		if store.Has(nonceKey) {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrWrongSequence, "nonce already used")
+		}
+		store.Set(nonceKey, []byte{1})
+
+		return ctx.WithPriority(txTest.Counter), nil
+	}
+}
+
+func TestMempool(t *testing.T) {
+	mempool := newMockMempool(3)
+
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerNonce(capKey1)) }
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, SetMempool(mempool))
+	require.Equal(t, mempool, app.Mempool())
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+	encode := func(tx *txTest) []byte {
+		bz, err := cdc.Marshal(tx)
+		require.NoError(t, err)
+		return bz
+	}
+
+	// txs passing CheckTx are inserted with their priority
+	for _, tx := range []*txTest{newTxCounter(1, 10), newTxCounter(2, 20), newTxCounter(3, 30)} {
+		res := app.CheckTx(abci.RequestCheckTx{Tx: encode(tx)})
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+		require.Equal(t, tx.Counter, res.Priority)
+	}
+	require.Equal(t, 3, mempool.CountTx())
+
+	// txs failing CheckTx are not inserted
+	res := app.CheckTx(abci.RequestCheckTx{Tx: encode(newTxCounter(4, 10))})
+	require.False(t, res.IsOK())
+
+	// txs rejected by the mempool are rejected, and their AnteHandler state
+	// changes are discarded
+	res = app.CheckTx(abci.RequestCheckTx{Tx: encode(newTxCounter(5, 50))})
+	require.False(t, res.IsOK())
+	require.False(t, app.checkState.ctx.KVStore(capKey1).Has([]byte("nonce-50")))
+	require.Equal(t, 3, mempool.CountTx())
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+	// txs included in a block are removed
+	deliverRes := app.DeliverTx(abci.RequestDeliverTx{Tx: encode(newTxCounter(1, 10))})
+	require.True(t, deliverRes.IsOK(), fmt.Sprintf("%v", deliverRes))
+	require.Equal(t, 2, mempool.CountTx())
+
+	// a tx using the nonce of a tx in the mempool is included in the block
+	deliverRes = app.DeliverTx(abci.RequestDeliverTx{Tx: encode(newTxCounter(6, 20))})
+	require.True(t, deliverRes.IsOK(), fmt.Sprintf("%v", deliverRes))
+	require.Equal(t, 2, mempool.CountTx())
+
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	// Commit does not revalidate the mempool
+	require.Equal(t, 2, mempool.CountTx())
+
+	// the tx invalidated by the block is evicted on recheck
+	res = app.CheckTx(abci.RequestCheckTx{Tx: encode(newTxCounter(2, 20)), Type: abci.CheckTxType_Recheck})
+	require.False(t, res.IsOK())
+	require.Equal(t, 1, mempool.CountTx())
+	selected := mempool.Select(app.checkState.ctx, 0)
+	require.Len(t, selected, 1)
+	require.Equal(t, int64(3), selected[0].(txTest).Counter)
+
+	// the txs still valid are kept on recheck
+	res = app.CheckTx(abci.RequestCheckTx{Tx: encode(newTxCounter(3, 30)), Type: abci.CheckTxType_Recheck})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, 1, mempool.CountTx())
+
+	// txs failing on recheck are removed, a different tx using the nonce of a
+	// tx in the mempool does not remove it
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 2}})
+	deliverRes = app.DeliverTx(abci.RequestDeliverTx{Tx: encode(newTxCounter(7, 30))})
+	require.True(t, deliverRes.IsOK(), fmt.Sprintf("%v", deliverRes))
+	require.Equal(t, 1, mempool.CountTx())
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	res = app.CheckTx(abci.RequestCheckTx{Tx: encode(newTxCounter(3, 30)), Type: abci.CheckTxType_Recheck})
+	require.False(t, res.IsOK())
+	require.Equal(t, 0, mempool.CountTx())
+}
+
+func TestMempoolTTL(t *testing.T) {
+	mempool := newMockMempool(3)
+
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerNonce(capKey1)) }
+	app := setupBaseApp(t, anteOpt, SetMempool(mempool), SetMempoolTTL(2))
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+	checkTx := func(tx *txTest) {
+		bz, err := cdc.Marshal(tx)
+		require.NoError(t, err)
+		res := app.CheckTx(abci.RequestCheckTx{Tx: bz})
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	}
+	commit := func(height int64) {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	checkTx(newTxCounter(1, 10))
+	commit(1)
+	require.Equal(t, 1, mempool.CountTx())
+
+	checkTx(newTxCounter(2, 20))
+	commit(2)
+	require.Equal(t, 1, mempool.CountTx())
+	require.Equal(t, int64(2), mempool.Select(app.checkState.ctx, 0)[0].(txTest).Counter)
+
+	commit(3)
+	require.Equal(t, 0, mempool.CountTx())
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/multi"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -73,6 +75,25 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetHistoricalQueries enables the queries at the heights pruned from the
+// multistore for which the local snapshot store has a snapshot. The state at
+// such a height is restored in the background from the snapshot into a
+// temporary in-memory multistore of at most maxSize bytes (unbounded if zero)
+// on the first query, which fails until it is restored, and the last cacheSize
+// restored heights are kept in memory. The queries at pruned heights fail as
+// usual if cacheSize is lower than 1.
+func (app *BaseApp) SetHistoricalQueries(cacheSize int, maxSize uint64) {
+	if app.sealed {
+		panic("SetHistoricalQueries() on sealed BaseApp")
+	}
+	if cacheSize < 1 {
+		app.historicalStores = nil
+		return
+	}
+
+	app.historicalStores = newHistoricalStores(cacheSize, maxSize)
+}
+
 // SetSnapshotInterval sets the snapshot interval.
 func SetSnapshotInterval(interval uint64) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotInterval(interval) }
@@ -88,6 +109,35 @@ func SetSnapshotStore(snapshotStore *snapshots.Store) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotStore(snapshotStore) }
 }
 
+// SetStateStorage sets the database of the state storage, see SetStateStorage
+// on BaseApp.
+func SetStateStorage(db dbm.DB) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetStateStorage(db) }
+}
+
+// SetMempool sets the application side mempool.
+func SetMempool(mempool sdk.Mempool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetMempool(mempool) }
+}
+
+// SetMempoolTTL sets the number of blocks a tx is kept in the application side
+// mempool.
+func SetMempoolTTL(ttl int64) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetMempoolTTL(ttl) }
+}
+
+// SetDeliverTxWorkers sets the number of transactions executed concurrently by
+// DeliverTxs, see SetDeliverTxWorkers on BaseApp.
+func SetDeliverTxWorkers(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetDeliverTxWorkers(workers) }
+}
+
+// SetHistoricalQueries enables the queries at the heights of the local
+// snapshots pruned from the multistore, see SetHistoricalQueries on BaseApp.
+func SetHistoricalQueries(cacheSize int, maxSize uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetHistoricalQueries(cacheSize, maxSize) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -166,6 +216,31 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	app.anteHandler = ah
 }
 
+// SetMempool sets the application side mempool. Transactions passing CheckTx
+// are inserted into it, and removed once included in a block, failing on
+// recheck, which requires Tendermint's mempool recheck to be enabled, or
+// expired, see SetMempoolTTL.
+func (app *BaseApp) SetMempool(mempool sdk.Mempool) {
+	if app.sealed {
+		panic("SetMempool() on sealed BaseApp")
+	}
+
+	app.mempool = mempool
+}
+
+// SetMempoolTTL sets the number of blocks a transaction is kept in the
+// application side mempool, it is evicted on the Commit of the block ttl
+// blocks after the one it was inserted at. It should not be lower than the
+// ttl-num-blocks of the Tendermint mempool. Transactions never expire if ttl
+// is lower than 1.
+func (app *BaseApp) SetMempoolTTL(ttl int64) {
+	if app.sealed {
+		panic("SetMempoolTTL() on sealed BaseApp")
+	}
+
+	app.mempoolTTL = ttl
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -225,6 +300,40 @@ func (app *BaseApp) SetSnapshotStore(snapshotStore *snapshots.Store) {
 	app.snapshotManager = snapshots.NewManager(snapshotStore, app.cms)
 }
 
+// SetStateStorage wraps the multistore so that the history of the state is
+// written to a separate state storage database, from which the queries are
+// served, while the multistore only keeps the recent versions needed by the
+// state commitment. It must be set before the stores are mounted and before the
+// snapshot store.
+func (app *BaseApp) SetStateStorage(db dbm.DB) {
+	if app.sealed {
+		panic("SetStateStorage() on sealed BaseApp")
+	}
+	if app.snapshotManager != nil {
+		panic("SetStateStorage() must be called before SetSnapshotStore()")
+	}
+
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		panic(fmt.Sprintf("state storage requires a rootmulti store, got %T", app.cms))
+	}
+	app.cms = multi.NewStore(rms, db)
+}
+
+// SetDeliverTxWorkers enables the optimistic parallel execution of the
+// transactions of a block by DeliverTxs, with the given number of workers. The
+// execution stays sequential if workers is lower than 2.
+//
+// The transactions must only keep their state in the stores: handlers mutating
+// in-memory state, e.g. the capability keeper, are not safe to run in parallel.
+func (app *BaseApp) SetDeliverTxWorkers(workers int) {
+	if app.sealed {
+		panic("SetDeliverTxWorkers() on sealed BaseApp")
+	}
+
+	app.deliverTxWorkers = workers
+}
+
 // SetSnapshotInterval sets the snapshot interval.
 func (app *BaseApp) SetSnapshotInterval(snapshotInterval uint64) {
 	if app.sealed {
@@ -258,3 +367,9 @@ func (app *BaseApp) SetStreamingService(s StreamingService) {
 	// BaseApp will pass BeginBlock, DeliverTx, and EndBlock requests and responses to the streaming services to update their ABCI context
 	app.abciListeners = append(app.abciListeners, s)
 }
+
+// AddABCIListener registers a listener of the ABCI messages processed by the
+// BaseApp, without listening to the state changes as a StreamingService does.
+func (app *BaseApp) AddABCIListener(listener ABCIListener) {
+	app.abciListeners = append(app.abciListeners, listener)
+}
@@ -0,0 +1,209 @@
+package baseapp
+
+import (
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DeliverTxs executes the transactions of a block, with the same state changes
+// and responses as successive calls to DeliverTx.
+//
+// If enabled with SetDeliverTxWorkers, the transactions are first executed
+// concurrently, each on its own branch of the block state recording the keys
+// it reads. The branches are then written in the order of the block: a
+// transaction which read a key written by a transaction before it, or whose
+// gas does not fit in the block, is executed again on the current state.
+func (app *BaseApp) DeliverTxs(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx {
+	res := make([]abci.ResponseDeliverTx, len(reqs))
+
+	ms, ok := app.deliverState.ctx.MultiStore().(cachemulti.Store)
+	// the transactions need the AnteHandler to set their own gas meter, and the
+	// order of the traced operations would differ
+	if !ok || app.deliverTxWorkers < 2 || len(reqs) < 2 || app.anteHandler == nil || ms.TracingEnabled() {
+		for i, req := range reqs {
+			res[i] = app.DeliverTx(req)
+		}
+		return res
+	}
+
+	shared := make(map[storetypes.StoreKey]*cachekv.SharedStore)
+	execs := make([]*txExecution, len(reqs))
+	for i, req := range reqs {
+		execs[i] = newTxExecution(ms, shared, req)
+	}
+
+	app.runSpeculativeTxs(execs)
+
+	writes := make(map[storetypes.StoreKey]*cachekv.WriteSet)
+	for i, exec := range execs {
+		if !app.commitSpeculativeTx(exec, writes) {
+			exec = newTxExecution(ms, shared, exec.req)
+			ctx := app.deliverState.ctx.WithMultiStore(exec.ms)
+			exec.run(app, app.txContext(ctx, runTxModeDeliver, exec.req.Tx))
+		}
+
+		for key, store := range exec.stores {
+			if _, ok := writes[key]; !ok {
+				writes[key] = cachekv.NewWriteSet()
+			}
+			writes[key].Add(store.WrittenKeys())
+		}
+		exec.ms.Write()
+
+		res[i] = app.deliverTxResponse(exec.req, exec.gInfo, exec.result, exec.anteEvents, exec.err)
+	}
+
+	return res
+}
+
+// runSpeculativeTxs executes the transactions concurrently on their branches,
+// with their own gas meters.
+func (app *BaseApp) runSpeculativeTxs(execs []*txExecution) {
+	queue := make(chan *txExecution)
+	wg := sync.WaitGroup{}
+
+	workers := app.deliverTxWorkers
+	if workers > len(execs) {
+		workers = len(execs)
+	}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for exec := range queue {
+				exec.gasMeter = newObservedGasMeter(sdk.NewInfiniteGasMeter())
+				exec.blockGasMeter = newObservedGasMeter(newBlockGasMeter(app.deliverState.ctx.BlockGasMeter().Limit()))
+
+				ctx := app.deliverState.ctx.
+					WithMultiStore(exec.ms).
+					WithGasMeter(exec.gasMeter).
+					WithBlockGasMeter(exec.blockGasMeter).
+					WithEventManager(sdk.NewEventManager())
+				exec.run(app, app.txContext(ctx, runTxModeDeliver, exec.req.Tx))
+			}
+		}()
+	}
+
+	for _, exec := range execs {
+		queue <- exec
+	}
+	close(queue)
+	wg.Wait()
+}
+
+// commitSpeculativeTx returns true if the speculative execution of a
+// transaction has the same outcome as its execution on the current state, in
+// which case its gas is consumed from the block state gas meters.
+func (app *BaseApp) commitSpeculativeTx(exec *txExecution, writes map[storetypes.StoreKey]*cachekv.WriteSet) bool {
+	// the gas consumed before the transaction must not be observed, and the
+	// block gas limit must not be reached even by the end of the transaction
+	if exec.gasMeter.observed || exec.blockGasMeter.observed {
+		return false
+	}
+
+	blockGasMeter := app.deliverState.ctx.BlockGasMeter()
+	blockGas := exec.blockGasMeter.GasMeter.GasConsumed()
+	if limit := blockGasMeter.Limit(); limit > 0 {
+		if blockGasMeter.IsOutOfGas() || blockGas >= limit-blockGasMeter.GasConsumed() {
+			return false
+		}
+	}
+
+	for key, store := range exec.stores {
+		if ws, ok := writes[key]; ok && store.Conflicts(ws) {
+			return false
+		}
+	}
+
+	app.deliverState.ctx.GasMeter().ConsumeGas(exec.gasMeter.GasMeter.GasConsumed(), "tx context")
+	blockGasMeter.ConsumeGas(blockGas, "block gas meter")
+
+	return true
+}
+
+// txExecution is the execution of a transaction on a branch of the block
+// state, tracking the keys read and written by the transaction.
+type txExecution struct {
+	req    abci.RequestDeliverTx
+	ms     cachemulti.Store
+	stores map[storetypes.StoreKey]*cachekv.TrackingStore
+
+	// gas meters of the speculative execution
+	gasMeter      *observedGasMeter
+	blockGasMeter *observedGasMeter
+
+	gInfo      sdk.GasInfo
+	result     *sdk.Result
+	anteEvents []abci.Event
+	err        error
+}
+
+// newTxExecution returns a new execution of the request, on a branch of ms
+// reading through the shared stores.
+func newTxExecution(ms cachemulti.Store, shared map[storetypes.StoreKey]*cachekv.SharedStore, req abci.RequestDeliverTx) *txExecution {
+	exec := &txExecution{
+		req:    req,
+		stores: make(map[storetypes.StoreKey]*cachekv.TrackingStore),
+	}
+	exec.ms = ms.WrapStores(func(key storetypes.StoreKey, store storetypes.KVStore) storetypes.CacheWrap {
+		if _, ok := shared[key]; !ok {
+			shared[key] = cachekv.NewSharedStore(store)
+		}
+		exec.stores[key] = shared[key].NewTrackingStore()
+		return exec.stores[key]
+	})
+
+	return exec
+}
+
+func (exec *txExecution) run(app *BaseApp, ctx sdk.Context) {
+	exec.gInfo, exec.result, exec.anteEvents, _, exec.err = app.runTxWithContext(ctx, runTxModeDeliver, exec.req.Tx)
+}
+
+// newBlockGasMeter returns a block gas meter with the given limit, infinite if
+// zero.
+func newBlockGasMeter(limit sdk.Gas) sdk.GasMeter {
+	if limit > 0 {
+		return sdk.NewGasMeter(limit)
+	}
+	return sdk.NewInfiniteGasMeter()
+}
+
+// observedGasMeter is a gas meter of a speculative execution, starting from
+// zero instead of the gas consumed by the transactions before it. It records
+// whether the consumed gas was observed, e.g. returned as the gas used by the
+// transaction, in which case the execution must be repeated.
+type observedGasMeter struct {
+	sdk.GasMeter
+	observed bool
+}
+
+func newObservedGasMeter(meter sdk.GasMeter) *observedGasMeter {
+	return &observedGasMeter{GasMeter: meter}
+}
+
+func (g *observedGasMeter) GasConsumed() sdk.Gas {
+	g.observed = true
+	return g.GasMeter.GasConsumed()
+}
+
+func (g *observedGasMeter) GasConsumedToLimit() sdk.Gas {
+	g.observed = true
+	return g.GasMeter.GasConsumedToLimit()
+}
+
+func (g *observedGasMeter) RefundGas(amount sdk.Gas, descriptor string) {
+	g.observed = true
+	g.GasMeter.RefundGas(amount, descriptor)
+}
+
+func (g *observedGasMeter) String() string {
+	g.observed = true
+	return g.GasMeter.String()
+}
@@ -0,0 +1,118 @@
+package baseapp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func setupParallelBaseApp(t *testing.T, workers int) *BaseApp {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			txTest := tx.(txTest)
+			newCtx := ctx.WithGasMeter(sdk.NewGasMeter(100000))
+			if txTest.FailOnAnte {
+				return newCtx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+			}
+
+			// the txs increment the sequence of one of the signers
+			store := newCtx.KVStore(capKey1)
+			key := []byte(fmt.Sprintf("seq/%d", txTest.Counter%10))
+			setIntOnStore(store, key, getIntFromStore(store, key)+1)
+			newCtx.EventManager().EmitEvents(counterEvent("ante_handler", txTest.Counter))
+
+			return newCtx, nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgKeyValue, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			kv := msg.(*msgKeyValue)
+			store := ctx.KVStore(capKey2)
+			value := append(append([]byte{}, store.Get(kv.Key)...), kv.Value...)
+			store.Set(kv.Key, value)
+
+			// the empty values iterate over all the keys
+			count := 0
+			if len(kv.Value) == 0 {
+				iter := store.Iterator(nil, nil)
+				for ; iter.Valid(); iter.Next() {
+					count++
+				}
+				iter.Close()
+			}
+
+			return &sdk.Result{Data: value, Log: fmt.Sprintf("%d keys", count)}, nil
+		}))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, SetDeliverTxWorkers(workers))
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: &abci.ConsensusParams{
+			Block: &abci.BlockParams{
+				MaxGas: 80000,
+			},
+		},
+	})
+
+	return app
+}
+
+func TestDeliverTxsParallel(t *testing.T) {
+	sequential := setupParallelBaseApp(t, 0)
+	parallel := setupParallelBaseApp(t, 4)
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	for height := int64(1); height <= 3; height++ {
+		var reqs []abci.RequestDeliverTx
+		for i := int64(0); i < 20; i++ {
+			key := []byte(fmt.Sprintf("key/%d/%d", height, i))
+			if i%6 == 0 {
+				key = []byte("shared")
+			}
+			var value []byte
+			if i%8 != 7 {
+				value = []byte{byte(i)}
+			}
+
+			tx := &txTest{
+				Msgs:       []sdk.Msg{&msgKeyValue{Key: key, Value: value}},
+				Counter:    i,
+				FailOnAnte: i%7 == 3,
+			}
+			bz, err := cdc.Marshal(tx)
+			require.NoError(t, err)
+			reqs = append(reqs, abci.RequestDeliverTx{Tx: bz})
+		}
+		// an invalid tx
+		reqs = append(reqs, abci.RequestDeliverTx{Tx: []byte("invalid")})
+
+		header := tmproto.Header{Height: height}
+		sequential.BeginBlock(abci.RequestBeginBlock{Header: header})
+		parallel.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		expected := sequential.DeliverTxs(reqs)
+		require.Equal(t, expected, parallel.DeliverTxs(reqs))
+		require.Equal(t,
+			sequential.deliverState.ctx.BlockGasMeter().GasConsumed(),
+			parallel.deliverState.ctx.BlockGasMeter().GasConsumed(),
+		)
+
+		// the block gas limit is reached by the last txs
+		require.True(t, expected[0].IsOK())
+		require.True(t, expected[3].IsErr())
+		require.Equal(t, sdkerrors.ErrOutOfGas.ABCICode(), expected[19].Code)
+
+		sequential.EndBlock(abci.RequestEndBlock{})
+		parallel.EndBlock(abci.RequestEndBlock{})
+		require.Equal(t, sequential.Commit(), parallel.Commit())
+	}
+}
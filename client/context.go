@@ -45,6 +45,7 @@ type Context struct {
 	UseLedger         bool
 	Simulate          bool
 	GenerateOnly      bool
+	IsAux             bool
 	Offline           bool
 	SkipConfirm       bool
 	TxConfig          TxConfig
@@ -172,6 +173,12 @@ func (ctx Context) WithGenerateOnly(generateOnly bool) Context {
 	return ctx
 }
 
+// WithAux returns a copy of the context with an updated IsAux value.
+func (ctx Context) WithAux(isAux bool) Context {
+	ctx.IsAux = isAux
+	return ctx
+}
+
 // WithSimulation returns a copy of the context with updated Simulate value
 func (ctx Context) WithSimulation(simulate bool) Context {
 	ctx.Simulate = simulate
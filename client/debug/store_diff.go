@@ -0,0 +1,139 @@
+package debug
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	FlagStores   = "stores"
+	FlagPrefixes = "prefixes"
+)
+
+// simulationApp is implemented by the apps whose simulation manager registers
+// the store decoders of their modules.
+type simulationApp interface {
+	SimulationManager() *module.SimulationManager
+}
+
+// StoreDiffCmd creates a command printing the keys of the multistore changed
+// between two heights.
+func StoreDiffCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-diff [from-height] [to-height]",
+		Short: "Print the keys added, modified and deleted in the stores between two heights",
+		Long: fmt.Sprintf(`Print the keys added, modified and deleted in the IAVL stores of the
+application between two heights, optionally restricted to some stores and to
+some hex-encoded key prefixes. Both heights must not be pruned.
+
+The values are decoded with the store decoders of the modules registered in the
+simulation manager of the application, if any, which print the old and new
+values. The other values are printed in hex. The node must be stopped.
+
+Example:
+$ %s debug store-diff 100 200 --stores bank,staking --prefixes 02,21
+			`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from height %s: %w", args[0], err)
+			}
+			to, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid to height %s: %w", args[1], err)
+			}
+
+			vp := viper.New()
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+			storeNames := vp.GetStringSlice(FlagStores)
+			var prefixes [][]byte
+			for _, s := range vp.GetStringSlice(FlagPrefixes) {
+				prefix, err := hex.DecodeString(s)
+				if err != nil {
+					return fmt.Errorf("invalid prefix %s: %w", s, err)
+				}
+				prefixes = append(prefixes, prefix)
+			}
+
+			db, err := server.NewDB("application", server.GetAppDBBackend(vp), filepath.Join(vp.GetString(flags.FlagHome), "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			// the app must not prune the heights when it is loaded
+			vp.Set(server.FlagPruning, storetypes.PruningOptionNothing)
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stderr))
+			app := appCreator(logger, db, nil, vp)
+
+			rms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("currently only support the diff of rootmulti.Store type")
+			}
+
+			var decoders sdk.StoreDecoderRegistry
+			if simApp, ok := app.(simulationApp); ok && simApp.SimulationManager() != nil {
+				decoders = simApp.SimulationManager().StoreDecoders
+			}
+
+			return rms.Diff(from, to, storeNames, prefixes, func(change rootmulti.KVChange) error {
+				cmd.Printf("%s %s %X\n", change.StoreName, change.Type, change.Key)
+				for _, line := range strings.Split(decodeChange(decoders, change), "\n") {
+					cmd.Printf("  %s\n", line)
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().StringSlice(FlagStores, nil, "The names of the stores to compare, all if empty")
+	cmd.Flags().StringSlice(FlagPrefixes, nil, "The hex-encoded prefixes of the keys to compare, all if empty")
+	cmd.Flags().String(server.FlagAppDBBackend, "", "The database backend of the application database (default goleveldb)")
+
+	return cmd
+}
+
+// decodeChange returns the values of a change decoded by the decoder of its
+// store, in hex if there is none or if it fails.
+func decodeChange(decoders sdk.StoreDecoderRegistry, change rootmulti.KVChange) (res string) {
+	hexValues := fmt.Sprintf("old: %X\nnew: %X", change.OldValue, change.NewValue)
+
+	decoder, ok := decoders[change.StoreName]
+	if !ok {
+		return hexValues
+	}
+
+	// the decoders panic on the keys they do not know
+	defer func() {
+		if r := recover(); r != nil {
+			res = hexValues
+		}
+	}()
+
+	return decoder(
+		kv.Pair{Key: change.Key, Value: change.OldValue},
+		kv.Pair{Key: change.Key, Value: change.NewValue},
+	)
+}
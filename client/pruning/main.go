@@ -13,7 +13,6 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 )
@@ -37,7 +36,7 @@ func PruningCmd(appCreator servertypes.AppCreator) *cobra.Command {
 		custom: allow pruning options to be manually specified through 'pruning-keep-recent'.
 		besides pruning options, database home directory and database backend type should also be specified via flags
 		'--home' and '--app-db-backend'.
-		valid app-db-backend type includes 'goleveldb', 'cleveldb', 'rocksdb', 'boltdb', and 'badgerdb'.
+		valid app-db-backend type includes 'goleveldb', 'cleveldb', 'rocksdb', 'boltdb', 'badgerdb' and 'pebbledb'.
 		`,
 		Example: `prune --home './' --app-db-backend 'goleveldb' --pruning 'custom' --pruning-keep-recent 100 --
 		pruning-keep-every 10, --pruning-interval 10`,
@@ -57,7 +56,7 @@ func PruningCmd(appCreator servertypes.AppCreator) *cobra.Command {
 			)
 
 			home := vp.GetString(flags.FlagHome)
-			db, err := openDB(home)
+			db, err := openDB(home, server.GetAppDBBackend(vp))
 			if err != nil {
 				return err
 			}
@@ -102,7 +101,7 @@ func PruningCmd(appCreator servertypes.AppCreator) *cobra.Command {
 	}
 
 	cmd.Flags().String(flags.FlagHome, "", "The database home directory")
-	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for application and snapshots databases")
+	cmd.Flags().String(FlagAppDBBackend, "", "The database backend of the application database (default goleveldb)")
 	cmd.Flags().String(server.FlagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
 	cmd.Flags().Uint64(server.FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(server.FlagPruningKeepEvery, 0,
@@ -115,7 +114,7 @@ func PruningCmd(appCreator servertypes.AppCreator) *cobra.Command {
 	return cmd
 }
 
-func openDB(rootDir string) (dbm.DB, error) {
+func openDB(rootDir string, backend dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
-	return sdk.NewLevelDB("application", dataDir)
+	return server.NewDB("application", backend, dataDir)
 }
@@ -0,0 +1,96 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+)
+
+func TestAuxTxBuilder(t *testing.T) {
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	msg := testdata.NewTestMsg(addr)
+	tip := &txtypes.Tip{Amount: sdk.NewCoins(sdk.NewInt64Coin("tip-token", 10)), Tipper: addr.String()}
+
+	newAuxTxBuilder := func(t *testing.T) tx.AuxTxBuilder {
+		b := tx.NewAuxTxBuilder()
+		b.SetAddress(addr.String())
+		b.SetAccountNumber(1)
+		b.SetSequence(2)
+		b.SetChainID("test-chain")
+		b.SetMemo("memo")
+		b.SetTimeoutHeight(3)
+		b.SetTip(tip)
+		require.NoError(t, b.SetMsgs(msg))
+		require.NoError(t, b.SetPubKey(pubKey))
+		return b
+	}
+
+	t.Run("unsupported sign mode", func(t *testing.T) {
+		b := newAuxTxBuilder(t)
+		require.Error(t, b.SetSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT))
+	})
+
+	t.Run("empty builder", func(t *testing.T) {
+		b := tx.NewAuxTxBuilder()
+		_, err := b.GetSignBytes()
+		require.Error(t, err)
+		_, err = b.GetAuxSignerData()
+		require.Error(t, err)
+	})
+
+	t.Run("direct aux", func(t *testing.T) {
+		b := newAuxTxBuilder(t)
+		require.NoError(t, b.SetSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX))
+
+		signBz, err := b.GetSignBytes()
+		require.NoError(t, err)
+
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		body := txtypes.TxBody{Messages: []*codectypes.Any{msgAny}, Memo: "memo", TimeoutHeight: 3}
+		bodyBz, err := body.Marshal()
+		require.NoError(t, err)
+		pkAny, err := codectypes.NewAnyWithValue(pubKey)
+		require.NoError(t, err)
+		signDoc := txtypes.SignDocDirectAux{
+			BodyBytes:     bodyBz,
+			PublicKey:     pkAny,
+			ChainId:       "test-chain",
+			AccountNumber: 1,
+			Sequence:      2,
+			Tip:           tip,
+		}
+		expectedSignBz, err := signDoc.Marshal()
+		require.NoError(t, err)
+		require.Equal(t, expectedSignBz, signBz)
+
+		// the signature is required
+		_, err = b.GetAuxSignerData()
+		require.Error(t, err)
+
+		b.SetSignature([]byte("signature"))
+		auxSignerData, err := b.GetAuxSignerData()
+		require.NoError(t, err)
+		require.Equal(t, addr.String(), auxSignerData.Address)
+		require.Equal(t, signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, auxSignerData.Mode)
+		require.Equal(t, []byte("signature"), auxSignerData.Sig)
+		require.Equal(t, &signDoc, auxSignerData.SignDoc)
+	})
+
+	t.Run("legacy amino json", func(t *testing.T) {
+		b := newAuxTxBuilder(t)
+		require.NoError(t, b.SetSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON))
+
+		signBz, err := b.GetSignBytes()
+		require.NoError(t, err)
+		require.Equal(t, legacytx.StdSignBytes("test-chain", 1, 2, 3, legacytx.StdFee{}, []sdk.Msg{msg}, "memo", tip), signBz)
+	})
+}
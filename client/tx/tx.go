@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -43,6 +44,16 @@ func GenerateOrBroadcastTxWithFactory(clientCtx client.Context, txf Factory, msg
 		}
 	}
 
+	// If the --aux flag is set, we simply generate and print the AuxSignerData.
+	if clientCtx.IsAux {
+		auxSignerData, err := makeAuxSignerData(clientCtx, txf, msgs...)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(&auxSignerData)
+	}
+
 	if clientCtx.GenerateOnly {
 		return GenerateTx(clientCtx, txf, msgs...)
 	}
@@ -317,19 +328,41 @@ func SignWithPrivKey(
 	return sigV2, nil
 }
 
-func checkMultipleSigners(mode signing.SignMode, tx authsigning.Tx) error {
-	if mode == signing.SignMode_SIGN_MODE_DIRECT &&
-		len(tx.GetSigners()) > 1 {
-		return sdkerrors.Wrap(sdkerrors.ErrNotSupported, "Signing in DIRECT mode is only supported for transactions with one signer only")
+// checkMultipleSigners checks that DIRECT and TEXTUAL, which sign over the
+// signer infos of all signers, are only used on txs with several signers by
+// the last signer, once all other signers signed with DIRECT_AUX (e.g. tippers).
+func checkMultipleSigners(mode signing.SignMode, tx authsigning.Tx, overwriteSig bool) error {
+	if mode != signing.SignMode_SIGN_MODE_DIRECT && mode != signing.SignMode_SIGN_MODE_TEXTUAL {
+		return nil
+	}
+
+	nSigners := len(tx.GetSigners())
+	if nSigners <= 1 {
+		return nil
+	}
+
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+	if overwriteSig || len(sigs)+1 != nSigners {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "Signing in %s mode is only supported for the last signer of transactions with several signers", mode)
 	}
+	for _, sig := range sigs {
+		data, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok || data.SignMode != signing.SignMode_SIGN_MODE_DIRECT_AUX {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "Signing in %s mode is only supported after %s signers", mode, signing.SignMode_SIGN_MODE_DIRECT_AUX)
+		}
+	}
+
 	return nil
 }
 
 // Sign signs a given tx with a named key. The bytes signed over are canconical.
 // The resulting signature will be added to the transaction builder overwriting the previous
 // ones if overwrite=true (otherwise, the signature will be appended).
-// Signing a transaction with mutltiple signers in the DIRECT mode is not supprted and will
-// return an error.
+// Signing a transaction with mutltiple signers in the DIRECT or TEXTUAL mode is only supported for
+// the last signer, and will return an error otherwise.
 // An error is returned upon failure.
 func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig bool) error {
 	if txf.keybase == nil {
@@ -341,7 +374,7 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 		// use the SignModeHandler's default mode if unspecified
 		signMode = txf.txConfig.SignModeHandler().DefaultMode()
 	}
-	if err := checkMultipleSigners(signMode, txBuilder.GetTx()); err != nil {
+	if err := checkMultipleSigners(signMode, txBuilder.GetTx(), overwriteSig); err != nil {
 		return err
 	}
 
@@ -354,12 +387,14 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 		ChainID:       txf.chainID,
 		AccountNumber: txf.accountNumber,
 		Sequence:      txf.sequence,
+		Address:       key.GetAddress().String(),
+		PubKey:        pubKey,
 	}
 
 	// For SIGN_MODE_DIRECT, calling SetSignatures calls setSignerInfos on
 	// TxBuilder under the hood, and SignerInfos is needed to generated the
 	// sign bytes. This is the reason for setting SetSignatures here, with a
-	// nil signature.
+	// nil signature, after the signatures of the previous signers.
 	//
 	// Note: this line is not needed for SIGN_MODE_LEGACY_AMINO, but putting it
 	// also doesn't affect its generated sign bytes, so for code's simplicity
@@ -380,18 +415,28 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 			return err
 		}
 	}
-	if err := txBuilder.SetSignatures(sig); err != nil {
+	if err := txBuilder.SetSignatures(append(prevSignatures, sig)...); err != nil {
 		return err
 	}
 
 	// Generate the bytes to be signed.
-	bytesToSign, err := txf.txConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+	bytesToSign, err := authsigning.GetSignBytesWithContext(context.Background(), txf.txConfig.SignModeHandler(), signMode, signerData, txBuilder.GetTx())
 	if err != nil {
 		return err
 	}
 
-	// Sign those bytes
-	sigBytes, _, err := txf.keybase.Sign(name, bytesToSign)
+	// Sign those bytes. EIP-191 sign bytes are hashed with Keccak256 instead
+	// of SHA256, the same way Ethereum wallets do.
+	var sigBytes []byte
+	if signMode == signing.SignMode_SIGN_MODE_EIP_191 {
+		eip191Signer, ok := txf.keybase.(keyring.EIP191Signer)
+		if !ok {
+			return fmt.Errorf("keyring does not support %s signatures", signMode)
+		}
+		sigBytes, _, err = eip191Signer.SignEIP191(name, bytesToSign)
+	} else {
+		sigBytes, _, err = txf.keybase.Sign(name, bytesToSign)
+	}
 	if err != nil {
 		return err
 	}
@@ -414,6 +459,67 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 	return txBuilder.SetSignatures(prevSignatures...)
 }
 
+// makeAuxSignerData generates an AuxSignerData from the client inputs, signed
+// by the --from key in DIRECT_AUX mode, unless LEGACY_AMINO_JSON is set.
+func makeAuxSignerData(clientCtx client.Context, f Factory, msgs ...sdk.Msg) (tx.AuxSignerData, error) {
+	b := NewAuxTxBuilder()
+	fromAddress, name, _, err := client.GetFromFields(clientCtx, clientCtx.Keyring, clientCtx.From)
+	if err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	b.SetAddress(fromAddress.String())
+	if clientCtx.Offline {
+		b.SetAccountNumber(f.accountNumber)
+		b.SetSequence(f.sequence)
+	} else {
+		accNum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, fromAddress)
+		if err != nil {
+			return tx.AuxSignerData{}, err
+		}
+		b.SetAccountNumber(accNum)
+		b.SetSequence(seq)
+	}
+
+	if err := b.SetMsgs(msgs...); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	signMode := f.signMode
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		signMode = signing.SignMode_SIGN_MODE_DIRECT_AUX
+	}
+	if err := b.SetSignMode(signMode); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	key, err := clientCtx.Keyring.Key(name)
+	if err != nil {
+		return tx.AuxSignerData{}, err
+	}
+	if err := b.SetPubKey(key.GetPubKey()); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	b.SetChainID(clientCtx.ChainID)
+	b.SetTimeoutHeight(f.timeoutHeight)
+	b.SetMemo(f.memo)
+	b.SetTip(f.tip)
+
+	signBz, err := b.GetSignBytes()
+	if err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	sig, _, err := clientCtx.Keyring.Sign(name, signBz)
+	if err != nil {
+		return tx.AuxSignerData{}, err
+	}
+	b.SetSignature(sig)
+
+	return b.GetAuxSignerData()
+}
+
 // GasEstimateResponse defines a response definition for tx gas estimation.
 type GasEstimateResponse struct {
 	GasEstimate uint64 `json:"gas_estimate" yaml:"gas_estimate"`
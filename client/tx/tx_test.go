@@ -184,6 +184,10 @@ func TestSign(t *testing.T) {
 		WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT)
 	txfAmino := txfDirect.
 		WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	txfEIP191 := txfDirect.
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_EIP_191)
+	txfTextual := txfDirect.
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_TEXTUAL)
 	msg1 := banktypes.NewMsgSend(info1.GetAddress(), sdk.AccAddress("to"), nil)
 	msg2 := banktypes.NewMsgSend(info2.GetAddress(), sdk.AccAddress("to"), nil)
 
@@ -223,6 +227,18 @@ func TestSign(t *testing.T) {
 			[]cryptotypes.PubKey{pubKey1},
 			nil,
 		},
+		{
+			"eip191: should succeed with keyring",
+			txfEIP191, txbSimple, from1, true,
+			[]cryptotypes.PubKey{pubKey1},
+			nil,
+		},
+		{
+			"textual: should succeed with keyring",
+			txfTextual, txbSimple, from1, true,
+			[]cryptotypes.PubKey{pubKey1},
+			nil,
+		},
 
 		/**** test double sign Amino mode ****/
 		{
@@ -293,3 +309,58 @@ func testSigners(require *require.Assertions, tr signing.Tx, pks ...cryptotypes.
 	}
 	return sigs
 }
+
+func TestSignWithAuxSigner(t *testing.T) {
+	requireT := require.New(t)
+	path := hd.CreateHDPath(118, 0, 0).String()
+	kr, err := keyring.New(t.Name(), "test", t.TempDir(), nil)
+	requireT.NoError(err)
+
+	tipper, _, err := kr.NewMnemonic("tipper", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	requireT.NoError(err)
+	feePayer, _, err := kr.NewMnemonic("feepayer", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	requireT.NoError(err)
+
+	txConfig := NewTestTxConfig()
+	txf := tx.Factory{}.
+		WithTxConfig(txConfig).
+		WithKeybase(kr).
+		WithAccountNumber(50).
+		WithSequence(23).
+		WithFees("50stake").
+		WithChainID("test-chain").
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT)
+
+	// the tipper signs the msg and tip first
+	auxBuilder := tx.NewAuxTxBuilder()
+	auxBuilder.SetAddress(tipper.GetAddress().String())
+	auxBuilder.SetChainID("test-chain")
+	auxBuilder.SetTip(&txtypes.Tip{Amount: sdk.NewCoins(sdk.NewInt64Coin("tip-token", 10)), Tipper: tipper.GetAddress().String()})
+	requireT.NoError(auxBuilder.SetMsgs(banktypes.NewMsgSend(tipper.GetAddress(), sdk.AccAddress("to"), nil)))
+	requireT.NoError(auxBuilder.SetPubKey(tipper.GetPubKey()))
+	requireT.NoError(auxBuilder.SetSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX))
+	signBz, err := auxBuilder.GetSignBytes()
+	requireT.NoError(err)
+	sig, _, err := kr.Sign("tipper", signBz)
+	requireT.NoError(err)
+	auxBuilder.SetSignature(sig)
+	auxSignerData, err := auxBuilder.GetAuxSignerData()
+	requireT.NoError(err)
+
+	txb := txConfig.NewTxBuilder()
+	requireT.NoError(txb.AddAuxSignerData(auxSignerData))
+	txb.SetFeePayer(feePayer.GetAddress())
+
+	// the fee payer can only overwrite the signatures of single signer txs
+	requireT.Error(tx.Sign(txf, "feepayer", txb, true))
+
+	// the fee payer signs last, in DIRECT mode
+	requireT.NoError(tx.Sign(txf, "feepayer", txb, false))
+	sigs := testSigners(requireT, txb.GetTx(), tipper.GetPubKey(), feePayer.GetPubKey())
+	requireT.Equal(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, sigs[0].Data.(*signingtypes.SingleSignatureData).SignMode)
+	requireT.Equal(signingtypes.SignMode_SIGN_MODE_DIRECT, sigs[1].Data.(*signingtypes.SingleSignatureData).SignMode)
+
+	// the DIRECT signature covers the signer infos of both signers
+	signerData := signing.SignerData{ChainID: "test-chain", AccountNumber: 50, Sequence: 23}
+	requireT.NoError(signing.VerifySignature(feePayer.GetPubKey(), signerData, sigs[1].Data, txConfig.SignModeHandler(), txb.GetTx()))
+}
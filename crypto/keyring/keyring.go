@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/bcrypt"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -43,8 +44,9 @@ const (
 )
 
 var (
-	_                          Keyring = &keystore{}
-	maxPassphraseEntryAttempts         = 3
+	_                          Keyring      = &keystore{}
+	_                          EIP191Signer = &keystore{}
+	maxPassphraseEntryAttempts              = 3
 )
 
 // Keyring exposes operations over a backend supported by github.com/99designs/keyring.
@@ -106,6 +108,15 @@ type Signer interface {
 	SignByAddress(address sdk.Address, msg []byte) ([]byte, types.PubKey, error)
 }
 
+// EIP191Signer is implemented by key stores that can sign EIP-191 sign bytes.
+// It is kept out of Signer so that existing Keyring implementations do not
+// have to support it.
+type EIP191Signer interface {
+	// SignEIP191 signs EIP-191 sign bytes with a user key, hashing them with
+	// Keccak256 like Ethereum wallets do.
+	SignEIP191(uid string, msg []byte) ([]byte, types.PubKey, error)
+}
+
 // Importer is implemented by key stores that support import of public and private keys.
 type Importer interface {
 	// ImportPrivKey imports ASCII armored passphrase-encrypted private keys.
@@ -379,6 +390,38 @@ func (ks keystore) SignByAddress(address sdk.Address, msg []byte) ([]byte, types
 	return ks.Sign(key.GetName(), msg)
 }
 
+func (ks keystore) SignEIP191(uid string, msg []byte) ([]byte, types.PubKey, error) {
+	info, err := ks.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	i, ok := info.(localInfo)
+	if !ok {
+		return nil, info.GetPubKey(), fmt.Errorf("cannot sign EIP-191 messages with %s keys", info.GetType())
+	}
+	if i.PrivKeyArmor == "" {
+		return nil, nil, fmt.Errorf("private key not available")
+	}
+
+	priv, err := legacy.PrivKeyFromBytes([]byte(i.PrivKeyArmor))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secpPriv, ok := priv.(*secp256k1.PrivKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: cannot sign EIP-191 messages with %s keys", ErrUnsupportedSigningAlgo, priv.Type())
+	}
+
+	sig, err := secpPriv.SignKeccak256(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sig, priv.PubKey(), nil
+}
+
 func (ks keystore) SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coinType, account, index uint32) (Info, error) {
 	if !ks.options.SupportedAlgosLedger.Contains(algo) {
 		return nil, fmt.Errorf(
@@ -996,6 +996,34 @@ func TestAltKeyring_SignByAddress(t *testing.T) {
 	require.True(t, key.VerifySignature(msg, sign))
 }
 
+func TestAltKeyring_SignEIP191(t *testing.T) {
+	keyring, err := New(t.Name(), BackendTest, t.TempDir(), nil)
+	require.NoError(t, err)
+
+	uid := "jack"
+	_, _, err = keyring.NewMnemonic(uid, English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	msg := []byte("\x19Ethereum Signed Message:\n12some message")
+
+	eip191Signer, ok := keyring.(EIP191Signer)
+	require.True(t, ok)
+
+	sign, key, err := eip191Signer.SignEIP191(uid, msg)
+	require.NoError(t, err)
+
+	secpKey, ok := key.(*secp256k1.PubKey)
+	require.True(t, ok)
+	require.True(t, secpKey.VerifyKeccak256Signature(msg, sign))
+	require.False(t, key.VerifySignature(msg, sign))
+
+	// offline keys cannot sign
+	_, err = keyring.SavePubKey("offline", secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+	_, _, err = eip191Signer.SignEIP191("offline", msg)
+	require.Error(t, err)
+}
+
 func TestAltKeyring_ImportExportPrivKey(t *testing.T) {
 	keyring, err := New(t.Name(), BackendTest, t.TempDir(), nil)
 	require.NoError(t, err)
@@ -0,0 +1,45 @@
+package secp256k1
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// SignKeccak256 creates an ECDSA signature on curve Secp256k1, using Keccak256
+// on the msg. This is the hash Ethereum wallets apply to EIP-191 personal
+// messages, so the returned R || S signature can be verified against
+// signatures produced by such wallets.
+func (privKey *PrivKey) SignKeccak256(msg []byte) ([]byte, error) {
+	return privKey.signDigest(keccak256(msg))
+}
+
+// VerifyKeccak256Signature verifies a signature of the form R || S created on
+// the Keccak256 hash of msg. Signatures of Ethereum wallets, of the form
+// R || S || V, are rejected so that a signature has a single encoding, the
+// recovery byte V must be stripped by the client, see Keccak256SignatureFromRSV.
+func (pubKey *PubKey) VerifyKeccak256Signature(msg []byte, sigStr []byte) bool {
+	return pubKey.verifyDigest(keccak256(msg), sigStr)
+}
+
+// Keccak256SignatureFromRSV returns the R || S signature verified by
+// VerifyKeccak256Signature from a R || S || V signature of an Ethereum wallet,
+// whose recovery byte V must be 0, 1, 27 or 28.
+func Keccak256SignatureFromRSV(sig []byte) ([]byte, error) {
+	if len(sig) != 65 {
+		return nil, fmt.Errorf("invalid R || S || V signature length: expected 65, got %d", len(sig))
+	}
+	switch sig[64] {
+	case 0, 1, 27, 28:
+	default:
+		return nil, fmt.Errorf("invalid signature recovery byte: %d", sig[64])
+	}
+
+	return sig[:64], nil
+}
+
+func keccak256(msg []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(msg)
+	return hasher.Sum(nil)
+}
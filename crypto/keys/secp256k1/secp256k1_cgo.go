@@ -11,7 +11,13 @@ import (
 
 // Sign creates an ECDSA signature on curve Secp256k1, using SHA256 on the msg.
 func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
-	rsv, err := secp256k1.Sign(crypto.Sha256(msg), privKey.Key)
+	return privKey.signDigest(crypto.Sha256(msg))
+}
+
+// signDigest creates an ECDSA signature of the form R || S on an already
+// hashed message.
+func (privKey *PrivKey) signDigest(digest []byte) ([]byte, error) {
+	rsv, err := secp256k1.Sign(digest, privKey.Key)
 	if err != nil {
 		return nil, err
 	}
@@ -23,5 +29,10 @@ func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
 // VerifySignature validates the signature.
 // The msg will be hashed prior to signature verification.
 func (pubKey *PubKey) VerifySignature(msg []byte, sigStr []byte) bool {
-	return secp256k1.VerifySignature(pubKey.Bytes(), crypto.Sha256(msg), sigStr)
+	return pubKey.verifyDigest(crypto.Sha256(msg), sigStr)
+}
+
+// verifyDigest validates the signature of an already hashed message.
+func (pubKey *PubKey) verifyDigest(digest []byte, sigStr []byte) bool {
+	return secp256k1.VerifySignature(pubKey.Bytes(), digest, sigStr)
 }
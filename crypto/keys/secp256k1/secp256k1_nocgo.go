@@ -20,8 +20,14 @@ var secp256k1halfN = new(big.Int).Rsh(secp256k1.S256().N, 1)
 // Sign creates an ECDSA signature on curve Secp256k1, using SHA256 on the msg.
 // The returned signature will be of the form R || S (in lower-S form).
 func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	return privKey.signDigest(crypto.Sha256(msg))
+}
+
+// signDigest creates an ECDSA signature of the form R || S (in lower-S form)
+// on an already hashed message.
+func (privKey *PrivKey) signDigest(digest []byte) ([]byte, error) {
 	priv, _ := secp256k1.PrivKeyFromBytes(secp256k1.S256(), privKey.Key)
-	sig, err := priv.Sign(crypto.Sha256(msg))
+	sig, err := priv.Sign(digest)
 	if err != nil {
 		return nil, err
 	}
@@ -32,6 +38,12 @@ func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
 // VerifyBytes verifies a signature of the form R || S.
 // It rejects signatures which are not in lower-S form.
 func (pubKey *PubKey) VerifySignature(msg []byte, sigStr []byte) bool {
+	return pubKey.verifyDigest(crypto.Sha256(msg), sigStr)
+}
+
+// verifyDigest verifies a signature of the form R || S on an already hashed
+// message. It rejects signatures which are not in lower-S form.
+func (pubKey *PubKey) verifyDigest(digest []byte, sigStr []byte) bool {
 	if len(sigStr) != 64 {
 		return false
 	}
@@ -46,7 +58,7 @@ func (pubKey *PubKey) VerifySignature(msg []byte, sigStr []byte) bool {
 	if signature.S.Cmp(secp256k1halfN) > 0 {
 		return false
 	}
-	return signature.Verify(crypto.Sha256(msg), pub)
+	return signature.Verify(digest, pub)
 }
 
 // Read Signature struct from R || S. Caller needs to ensure
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto"
 	tmsecp256k1 "github.com/tendermint/tendermint/crypto/secp256k1"
+	"golang.org/x/crypto/sha3"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
@@ -87,6 +88,44 @@ func TestSignAndValidateSecp256k1(t *testing.T) {
 	assert.False(t, pubKey.VerifySignature(msg, sig))
 }
 
+func TestSignAndValidateSecp256k1Keccak256(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	pubKey := privKey.PubKey().(*secp256k1.PubKey)
+
+	msg := crypto.CRandBytes(1000)
+	sig, err := privKey.SignKeccak256(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, 64)
+	assert.True(t, pubKey.VerifyKeccak256Signature(msg, sig))
+	// a SHA256 verification must not accept a Keccak256 signature
+	assert.False(t, pubKey.VerifySignature(msg, sig))
+
+	// cross check the digest against a plain ecdsa verification
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(msg)
+	_, btcPubKey := btcSecp256k1.PrivKeyFromBytes(btcSecp256k1.S256(), privKey.Key)
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	require.True(t, ecdsa.Verify(btcPubKey.ToECDSA(), hasher.Sum(nil), r, s))
+
+	// the recovery byte appended by Ethereum wallets must be stripped, so that
+	// a signature has a single encoding
+	for _, v := range []byte{0, 1, 27, 28} {
+		rsv := append(append([]byte{}, sig...), v)
+		assert.False(t, pubKey.VerifyKeccak256Signature(msg, rsv))
+		rs, err := secp256k1.Keccak256SignatureFromRSV(rsv)
+		require.NoError(t, err)
+		assert.True(t, pubKey.VerifyKeccak256Signature(msg, rs))
+	}
+	_, err = secp256k1.Keccak256SignatureFromRSV(append(append([]byte{}, sig...), 2))
+	require.Error(t, err)
+	_, err = secp256k1.Keccak256SignatureFromRSV(sig)
+	require.Error(t, err)
+
+	sig[3] ^= byte(0x01)
+	assert.False(t, pubKey.VerifyKeccak256Signature(msg, sig))
+}
+
 // This test is intended to justify the removal of calls to the underlying library
 // in creating the privkey.
 func TestSecp256k1LoadPrivkeyAndSerializeIsIdentity(t *testing.T) {
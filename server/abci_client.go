@@ -0,0 +1,142 @@
+package server
+
+import (
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmsync "github.com/tendermint/tendermint/libs/sync"
+	"github.com/tendermint/tendermint/proxy"
+
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// deliverTxsClientCreator creates local ABCI clients which execute the
+// transactions of a block together, see deliverTxsClient.
+type deliverTxsClientCreator struct {
+	mtx        *tmsync.Mutex
+	app        abci.Application
+	deliverTxs types.ApplicationDeliverTxs
+}
+
+// newDeliverTxsClientCreator returns a ClientCreator for the given app, running
+// locally and executing the transactions of a block with DeliverTxs.
+func newDeliverTxsClientCreator(app abci.Application, deliverTxs types.ApplicationDeliverTxs) proxy.ClientCreator {
+	return &deliverTxsClientCreator{
+		mtx:        new(tmsync.Mutex),
+		app:        app,
+		deliverTxs: deliverTxs,
+	}
+}
+
+func (c *deliverTxsClientCreator) NewABCIClient() (abcicli.Client, error) {
+	return &deliverTxsClient{
+		Client: abcicli.NewLocalClient(c.mtx, c.app),
+		mtx:    c.mtx,
+		app:    c.deliverTxs,
+	}, nil
+}
+
+// deliverTxsClient is a local ABCI client which queues the asynchronous
+// DeliverTx requests of a block. They are executed together, and their
+// responses passed to the callbacks, before the next request of another type,
+// e.g. EndBlock, or a Flush.
+type deliverTxsClient struct {
+	abcicli.Client
+
+	mtx      *tmsync.Mutex
+	app      types.ApplicationDeliverTxs
+	callback abcicli.Callback
+	pending  []*abcicli.ReqRes
+}
+
+var _ abcicli.Client = (*deliverTxsClient)(nil)
+
+func (cli *deliverTxsClient) SetResponseCallback(cb abcicli.Callback) {
+	cli.mtx.Lock()
+	cli.callback = cb
+	cli.mtx.Unlock()
+
+	cli.Client.SetResponseCallback(cb)
+}
+
+func (cli *deliverTxsClient) DeliverTxAsync(req abci.RequestDeliverTx) *abcicli.ReqRes {
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+
+	reqRes := abcicli.NewReqRes(abci.ToRequestDeliverTx(req))
+	cli.pending = append(cli.pending, reqRes)
+
+	return reqRes
+}
+
+func (cli *deliverTxsClient) DeliverTxSync(req abci.RequestDeliverTx) (*abci.ResponseDeliverTx, error) {
+	cli.deliverPending()
+	return cli.Client.DeliverTxSync(req)
+}
+
+func (cli *deliverTxsClient) FlushAsync() *abcicli.ReqRes {
+	cli.deliverPending()
+	return cli.Client.FlushAsync()
+}
+
+func (cli *deliverTxsClient) FlushSync() error {
+	cli.deliverPending()
+	return cli.Client.FlushSync()
+}
+
+func (cli *deliverTxsClient) BeginBlockAsync(req abci.RequestBeginBlock) *abcicli.ReqRes {
+	cli.deliverPending()
+	return cli.Client.BeginBlockAsync(req)
+}
+
+func (cli *deliverTxsClient) BeginBlockSync(req abci.RequestBeginBlock) (*abci.ResponseBeginBlock, error) {
+	cli.deliverPending()
+	return cli.Client.BeginBlockSync(req)
+}
+
+func (cli *deliverTxsClient) EndBlockAsync(req abci.RequestEndBlock) *abcicli.ReqRes {
+	cli.deliverPending()
+	return cli.Client.EndBlockAsync(req)
+}
+
+func (cli *deliverTxsClient) EndBlockSync(req abci.RequestEndBlock) (*abci.ResponseEndBlock, error) {
+	cli.deliverPending()
+	return cli.Client.EndBlockSync(req)
+}
+
+func (cli *deliverTxsClient) CommitAsync() *abcicli.ReqRes {
+	cli.deliverPending()
+	return cli.Client.CommitAsync()
+}
+
+func (cli *deliverTxsClient) CommitSync() (*abci.ResponseCommit, error) {
+	cli.deliverPending()
+	return cli.Client.CommitSync()
+}
+
+// deliverPending executes the queued DeliverTx requests, and passes their
+// responses to the callbacks in order.
+func (cli *deliverTxsClient) deliverPending() {
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+
+	if len(cli.pending) == 0 {
+		return
+	}
+	pending := cli.pending
+	cli.pending = nil
+
+	reqs := make([]abci.RequestDeliverTx, len(pending))
+	for i, reqRes := range pending {
+		reqs[i] = *reqRes.Request.GetDeliverTx()
+	}
+
+	for i, res := range cli.app.DeliverTxs(reqs) {
+		reqRes := pending[i]
+		reqRes.Response = abci.ToResponseDeliverTx(res)
+		if cli.callback != nil {
+			cli.callback(reqRes.Request, reqRes.Response)
+		}
+		reqRes.InvokeCallback()
+		reqRes.Done()
+	}
+}
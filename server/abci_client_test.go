@@ -0,0 +1,58 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+type deliverTxsTestApp struct {
+	abci.BaseApplication
+	batches [][]abci.RequestDeliverTx
+}
+
+func (app *deliverTxsTestApp) DeliverTxs(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx {
+	app.batches = append(app.batches, reqs)
+
+	res := make([]abci.ResponseDeliverTx, len(reqs))
+	for i, req := range reqs {
+		res[i] = abci.ResponseDeliverTx{Data: req.Tx}
+	}
+	return res
+}
+
+func TestDeliverTxsClient(t *testing.T) {
+	app := &deliverTxsTestApp{}
+	cli, err := newDeliverTxsClientCreator(app, app).NewABCIClient()
+	require.NoError(t, err)
+
+	var delivered [][]byte
+	cli.SetResponseCallback(func(req *abci.Request, res *abci.Response) {
+		if res := res.GetDeliverTx(); res != nil {
+			delivered = append(delivered, res.Data)
+		}
+	})
+
+	// the requests are queued until the end of the block
+	reqRes1 := cli.DeliverTxAsync(abci.RequestDeliverTx{Tx: []byte("tx1")})
+	reqRes2 := cli.DeliverTxAsync(abci.RequestDeliverTx{Tx: []byte("tx2")})
+	require.Empty(t, app.batches)
+	require.Empty(t, delivered)
+
+	var res2 []byte
+	reqRes2.SetCallback(func(res *abci.Response) { res2 = res.GetDeliverTx().Data })
+
+	_, err = cli.EndBlockSync(abci.RequestEndBlock{})
+	require.NoError(t, err)
+	reqRes1.Wait()
+	reqRes2.Wait()
+	require.Len(t, app.batches, 1)
+	require.Len(t, app.batches[0], 2)
+	require.Equal(t, [][]byte{[]byte("tx1"), []byte("tx2")}, delivered)
+	require.Equal(t, []byte("tx2"), res2)
+
+	// nothing is pending anymore
+	require.NoError(t, cli.FlushSync())
+	require.Len(t, app.batches, 1)
+}
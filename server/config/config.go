@@ -20,6 +20,14 @@ const (
 
 	// DefaultGRPCWebAddress defines the default address to bind the gRPC-web server to.
 	DefaultGRPCWebAddress = "0.0.0.0:9091"
+
+	// DefaultMempoolMaxTxs defines the default maximum number of transactions in
+	// the application side mempool, which is disabled by default.
+	DefaultMempoolMaxTxs = -1
+
+	// DefaultMempoolTTLBlocks defines the default number of blocks a transaction
+	// is kept in the application side mempool.
+	DefaultMempoolTTLBlocks = 100
 )
 
 // BaseConfig defines the server's basic configuration
@@ -75,6 +83,29 @@ type BaseConfig struct {
 
 	// IAVLDisableFastNode enables or disables the fast sync node.
 	IAVLDisableFastNode bool `mapstructure:"iavl-disable-fastnode"`
+
+	// StateStorage enables the separate state storage database, which holds
+	// the history of the state while IAVL only keeps the recent versions.
+	StateStorage bool `mapstructure:"state-storage"`
+
+	// DeliverTxWorkers defines the number of transactions of a block executed
+	// in parallel. The transactions are executed sequentially if lower than 2.
+	DeliverTxWorkers int `mapstructure:"deliver-tx-workers"`
+
+	// AppDBBackend defines the database backend of the application database,
+	// independently of the one of Tendermint. The default backend is used if
+	// empty.
+	AppDBBackend string `mapstructure:"app-db-backend"`
+
+	// HistoricalQueries defines the number of heights restored from the local
+	// snapshots kept in memory to serve the queries at pruned heights. The
+	// queries at pruned heights are not served if lower than 1.
+	HistoricalQueries int `mapstructure:"historical-queries"`
+
+	// HistoricalQueriesMaxSize defines the maximum size in MB of the state
+	// restored at a pruned height, the restoration fails beyond it. It is
+	// unbounded if 0.
+	HistoricalQueriesMaxSize uint64 `mapstructure:"historical-queries-max-size"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -152,6 +183,17 @@ type GRPCWebConfig struct {
 	EnableUnsafeCORS bool `mapstructure:"enable-unsafe-cors"`
 }
 
+// MempoolConfig defines the application side mempool configuration.
+type MempoolConfig struct {
+	// MaxTxs defines the maximum number of transactions in the mempool. A
+	// negative value disables the mempool and 0 leaves it unbounded.
+	MaxTxs int `mapstructure:"max-txs"`
+
+	// TTLBlocks defines the number of blocks a transaction is kept in the
+	// mempool. The transactions never expire if lower than 1.
+	TTLBlocks int64 `mapstructure:"ttl-blocks"`
+}
+
 // StateSyncConfig defines the state sync snapshot configuration.
 type StateSyncConfig struct {
 	// SnapshotInterval sets the interval at which state sync snapshots are taken.
@@ -175,6 +217,7 @@ type (
 	// list defined by 'StoreConfig.Streamers'.
 	StreamersConfig struct {
 		File FileStreamerConfig `mapstructure:"file"`
+		ABCI ABCIStreamerConfig `mapstructure:"abci"`
 	}
 
 	// FileStreamerConfig defines the file streaming configuration options.
@@ -192,21 +235,45 @@ type (
 		// the commit, but don't lose data in face of system crash.
 		Fsync bool `mapstructure:"fsync"`
 	}
+
+	// ABCIStreamerConfig defines the plugin based streaming configuration options.
+	ABCIStreamerConfig struct {
+		Keys []string `mapstructure:"keys"`
+		// Plugin is the name of the plugin, its binary is looked up from the
+		// COSMOS_SDK_<PLUGIN> environment variable.
+		Plugin string `mapstructure:"plugin"`
+		// StopNodeOnError specifies if propagate the plugin errors to the consensus
+		// state machine, it's necessary for data integrity of the output.
+		StopNodeOnError bool `mapstructure:"stop-node-on-error"`
+	}
 )
 
+// EventStoreConfig defines the configuration of the local event store.
+type EventStoreConfig struct {
+	// Enable defines if the events of the committed blocks and transactions are
+	// stored and served by the cosmos.base.events gRPC service.
+	Enable bool `mapstructure:"enable"`
+
+	// MaxScan defines the maximum number of index entries and records scanned
+	// by a query. The queries scanning more fail.
+	MaxScan uint64 `mapstructure:"max-scan"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
 
 	// Telemetry defines the application telemetry configuration
-	Telemetry telemetry.Config `mapstructure:"telemetry"`
-	API       APIConfig        `mapstructure:"api"`
-	GRPC      GRPCConfig       `mapstructure:"grpc"`
-	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
-	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
-	StateSync StateSyncConfig  `mapstructure:"state-sync"`
-	Store     StoreConfig      `mapstructure:"store"`
-	Streamers StreamersConfig  `mapstructure:"streamers"`
+	Telemetry  telemetry.Config `mapstructure:"telemetry"`
+	API        APIConfig        `mapstructure:"api"`
+	GRPC       GRPCConfig       `mapstructure:"grpc"`
+	Rosetta    RosettaConfig    `mapstructure:"rosetta"`
+	GRPCWeb    GRPCWebConfig    `mapstructure:"grpc-web"`
+	Mempool    MempoolConfig    `mapstructure:"mempool"`
+	StateSync  StateSyncConfig  `mapstructure:"state-sync"`
+	Store      StoreConfig      `mapstructure:"store"`
+	Streamers  StreamersConfig  `mapstructure:"streamers"`
+	EventStore EventStoreConfig `mapstructure:"event-store"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -250,6 +317,8 @@ func DefaultConfig() *Config {
 			IndexEvents:         make([]string, 0),
 			IAVLCacheSize:       781250, // 50 MB
 			IAVLDisableFastNode: true,
+
+			HistoricalQueriesMaxSize: 1024,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -279,6 +348,10 @@ func DefaultConfig() *Config {
 			Enable:  true,
 			Address: DefaultGRPCWebAddress,
 		},
+		Mempool: MempoolConfig{
+			MaxTxs:    DefaultMempoolMaxTxs,
+			TTLBlocks: DefaultMempoolTTLBlocks,
+		},
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
@@ -286,6 +359,9 @@ func DefaultConfig() *Config {
 		Store: StoreConfig{
 			Streamers: []string{},
 		},
+		EventStore: EventStoreConfig{
+			MaxScan: 100_000,
+		},
 		Streamers: StreamersConfig{
 			File: FileStreamerConfig{
 				Keys:            []string{"*"},
@@ -296,6 +372,11 @@ func DefaultConfig() *Config {
 				// in face of system crash.
 				Fsync: false,
 			},
+			ABCI: ABCIStreamerConfig{
+				Keys:            []string{"*"},
+				Plugin:          "",
+				StopNodeOnError: true,
+			},
 		},
 	}
 }
@@ -78,6 +78,37 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Default is true.
 iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 
+# StateStorage enables the separate state storage database, which holds the
+# history of the state while IAVL only keeps the recent versions needed by the
+# state commitment. Queries are served from the state storage, proofs from IAVL.
+# Existing nodes must run the migrate-state-storage command before enabling it.
+state-storage = {{ .BaseConfig.StateStorage }}
+
+# DeliverTxWorkers defines the number of transactions of a block executed
+# optimistically in parallel, with the same results as a sequential execution.
+# The transactions are executed sequentially if lower than 2 (default).
+deliver-tx-workers = {{ .BaseConfig.DeliverTxWorkers }}
+
+# AppDBBackend defines the database backend of the application database,
+# independently of the db_backend of Tendermint: goleveldb, cleveldb, rocksdb,
+# boltdb, badgerdb or pebbledb. The backends other than goleveldb and pebbledb
+# require the node to be built with their build tag. The default backend is
+# used if empty. Existing nodes must run the migrate-db command before changing
+# it.
+app-db-backend = "{{ .BaseConfig.AppDBBackend }}"
+
+# HistoricalQueries enables the queries at the heights pruned from the state for
+# which the local snapshot store has a snapshot, e.g. loaded from an archive with
+# the snapshots load command. The state at such a height is restored in memory
+# from the snapshot in the background on the first query, which fails until the
+# state is restored and must be retried, and this number of restored heights is
+# kept in memory. The queries at pruned heights fail if lower than 1 (default).
+historical-queries = {{ .BaseConfig.HistoricalQueries }}
+
+# HistoricalQueriesMaxSize defines the maximum size in MB of the state restored
+# at a pruned height, the restoration fails beyond it (unbounded if 0).
+historical-queries-max-size = {{ .BaseConfig.HistoricalQueriesMaxSize }}
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -195,6 +226,24 @@ address = "{{ .GRPCWeb.Address }}"
 # EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk).
 enable-unsafe-cors = {{ .GRPCWeb.EnableUnsafeCORS }}
 
+###############################################################################
+###                          Mempool Configuration                          ###
+###############################################################################
+
+[mempool]
+
+# max-txs defines the maximum number of transactions in the application side
+# mempool, which prioritizes the transactions by gas price and evicts the ones
+# failing on recheck. A negative value disables the mempool and 0 leaves it
+# unbounded.
+max-txs = {{ .Mempool.MaxTxs }}
+
+# ttl-blocks defines the number of blocks a transaction is kept in the
+# application side mempool, as Tendermint drops transactions without notifying
+# the application. It should not be lower than the ttl-num-blocks of the
+# Tendermint mempool. The transactions never expire if lower than 1.
+ttl-blocks = {{ .Mempool.TTLBlocks }}
+
 ###############################################################################
 ###                        State Sync Configuration                         ###
 ###############################################################################
@@ -232,6 +281,32 @@ stop-node-on-error = "{{ .Streamers.File.StopNodeOnError }}"
 
 # fsync specifies if call fsync after writing the files.
 fsync = "{{ .Streamers.File.Fsync }}"
+
+[streamers.abci]
+keys = [{{ range .Streamers.ABCI.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# plugin is the name of the streaming plugin, the path of its binary is read from
+# the COSMOS_SDK_<PLUGIN> environment variable, e.g. COSMOS_SDK_FILE for "file".
+plugin = "{{ .Streamers.ABCI.Plugin }}"
+
+# stop-node-on-error specifies if propagate the plugin errors to consensus state machine.
+stop-node-on-error = "{{ .Streamers.ABCI.StopNodeOnError }}"
+
+###############################################################################
+###                          Event Store Configuration                      ###
+###############################################################################
+
+[event-store]
+
+# enable defines if the events of the committed blocks and transactions are stored in
+# the data directory, indexed by their indexed attributes, and served by the
+# cosmos.base.events gRPC service.
+enable = {{ .EventStore.Enable }}
+
+# max-scan defines the maximum number of index entries and records scanned by a query.
+# The queries scanning more fail, and should narrow their height range or use an
+# equality condition.
+max-scan = {{ .EventStore.MaxScan }}
 `
 
 var configTemplate *template.Template
@@ -5,12 +5,19 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/pebbledb"
 )
 
 func Test_openDB(t *testing.T) {
 	t.Parallel()
-	_, err := openDB(t.TempDir())
+	_, err := openDB(t.TempDir(), dbm.GoLevelDBBackend)
+	require.NoError(t, err)
+	_, err = openDB(t.TempDir(), pebbledb.BackendType)
 	require.NoError(t, err)
+	_, err = openDB(t.TempDir(), "unknown")
+	require.Error(t, err)
 }
 
 func Test_openTraceWriter(t *testing.T) {
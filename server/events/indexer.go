@@ -0,0 +1,184 @@
+package events
+
+import (
+	"context"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+const (
+	// OptEnable is the app option enabling the event store, see
+	// config.EventStoreConfig.
+	OptEnable = "event-store.enable"
+	// OptMaxScan is the app option setting the maximum number of entries
+	// scanned by a query, see config.EventStoreConfig.
+	OptMaxScan = "event-store.max-scan"
+
+	// DefaultMaxScan is the default maximum number of index entries and
+	// records scanned by a query.
+	DefaultMaxScan = 100_000
+)
+
+// Indexer stores the events of the committed blocks and of their transactions
+// in a database, indexed by the values of their indexed attributes, and
+// implements the Query service over them.
+//
+// It is fed by the ABCI listener hooks of the BaseApp, see
+// BaseApp.AddABCIListener. The events of a block are written at once on
+// Commit.
+type Indexer struct {
+	db     dbm.DB
+	logger log.Logger
+	// maximum number of index entries and records scanned by a query
+	maxScan uint64
+
+	// events of the block being executed, nil before BeginBlock
+	block *BlockEvents
+	txs   []TxEvents
+}
+
+var (
+	_ baseapp.ABCIListener = (*Indexer)(nil)
+	_ QueryServer          = (*Indexer)(nil)
+)
+
+// NewIndexer returns an event indexer storing the events in db.
+func NewIndexer(db dbm.DB, logger log.Logger) *Indexer {
+	return &Indexer{
+		db:      db,
+		logger:  logger.With("module", "events"),
+		maxScan: DefaultMaxScan,
+	}
+}
+
+// SetMaxScan sets the maximum number of index entries and records scanned by
+// a query. The queries scanning more fail, so that a query cannot read an
+// unbounded part of the store.
+func (idx *Indexer) SetMaxScan(maxScan uint64) {
+	idx.maxScan = maxScan
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener.
+func (idx *Indexer) ListenBeginBlock(_ context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	idx.block = &BlockEvents{
+		Height:           req.Header.Height,
+		BeginBlockEvents: res.Events,
+	}
+	idx.txs = nil
+
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener.
+func (idx *Indexer) ListenDeliverTx(_ context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if idx.block == nil {
+		return nil
+	}
+
+	idx.txs = append(idx.txs, TxEvents{
+		Height: idx.block.Height,
+		Index:  uint32(len(idx.txs)),
+		Hash:   tmhash.Sum(req.Tx),
+		Code:   res.Code,
+		Events: res.Events,
+	})
+
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener.
+func (idx *Indexer) ListenEndBlock(_ context.Context, _ abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	if idx.block == nil {
+		return nil
+	}
+
+	idx.block.EndBlockEvents = res.Events
+
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener. The indexing errors are only
+// logged, so that they do not halt the node.
+func (idx *Indexer) ListenCommit(_ context.Context, _ abci.ResponseCommit) error {
+	if idx.block == nil {
+		return nil
+	}
+
+	if err := idx.write(idx.block, idx.txs); err != nil {
+		idx.logger.Error("failed to index events", "height", idx.block.Height, "err", err)
+	}
+	idx.block = nil
+	idx.txs = nil
+
+	return nil
+}
+
+// write stores the events of a block and of its transactions.
+func (idx *Indexer) write(block *BlockEvents, txs []TxEvents) error {
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	position := heightKey(block.Height)
+	if err := blockIndex.set(batch, position, block); err != nil {
+		return err
+	}
+	for _, events := range [][]abci.Event{block.BeginBlockEvents, block.EndBlockEvents} {
+		if err := blockIndex.setAttributes(batch, position, events); err != nil {
+			return err
+		}
+	}
+
+	for i := range txs {
+		position := txPosition(txs[i].Height, txs[i].Index)
+		if err := txIndex.set(batch, position, &txs[i]); err != nil {
+			return err
+		}
+		if err := txIndex.setAttributes(batch, position, txs[i].Events); err != nil {
+			return err
+		}
+	}
+
+	return batch.Write()
+}
+
+// set stores the record at the given position.
+func (idx index) set(batch dbm.Batch, position []byte, record interface{ Marshal() ([]byte, error) }) error {
+	bz, err := record.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return batch.Set(idx.recordKey(position), bz)
+}
+
+// setAttributes indexes the values of the indexed attributes of the events of
+// the record at the given position.
+func (idx index) setAttributes(batch dbm.Batch, position []byte, events []abci.Event) error {
+	for _, event := range events {
+		for _, attr := range event.Attributes {
+			if !attr.Index {
+				continue
+			}
+
+			compositeKey := fmt.Sprintf("%s.%s", event.Type, attr.Key)
+			key, err := idx.attributeKey(compositeKey)
+			if err != nil {
+				// the attribute cannot be queried
+				continue
+			}
+
+			key = append(append(key, attr.Value...), position...)
+			if err := batch.Set(key, []byte{}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,337 @@
+package events_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/server/events"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func newEvent(typ string, attrs ...string) abci.Event {
+	event := abci.Event{Type: typ}
+	for i := 0; i < len(attrs); i += 2 {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{
+			Key:   []byte(attrs[i]),
+			Value: []byte(attrs[i+1]),
+			Index: true,
+		})
+	}
+	return event
+}
+
+// indexBlocks indexes blocks 1 to 5, whose txs transfer amount i*height to
+// recipient addr{i}.
+func indexBlocks(t *testing.T, indexer *events.Indexer) {
+	ctx := context.Background()
+	for height := int64(1); height <= 5; height++ {
+		require.NoError(t, indexer.ListenBeginBlock(ctx,
+			abci.RequestBeginBlock{Header: tmproto.Header{Height: height}},
+			abci.ResponseBeginBlock{Events: []abci.Event{newEvent("mint", "amount", fmt.Sprint(height*10))}},
+		))
+
+		for i := int64(0); i < 3; i++ {
+			require.NoError(t, indexer.ListenDeliverTx(ctx,
+				abci.RequestDeliverTx{Tx: []byte(fmt.Sprintf("tx/%d/%d", height, i))},
+				abci.ResponseDeliverTx{Code: uint32(i % 2), Events: []abci.Event{
+					newEvent("message", "action", "send"),
+					newEvent("transfer", "recipient", fmt.Sprintf("addr%d", i), "amount", fmt.Sprint(i*height)),
+				}},
+			))
+		}
+
+		var endEvents []abci.Event
+		if height%2 == 0 {
+			endEvents = append(endEvents, newEvent("upgrade", "name", fmt.Sprintf("v%d", height)))
+		}
+		require.NoError(t, indexer.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{Events: endEvents}))
+
+		require.NoError(t, indexer.ListenCommit(ctx, abci.ResponseCommit{}))
+	}
+}
+
+type txPosition struct {
+	height int64
+	index  uint32
+}
+
+func txPositions(txs []events.TxEvents) []txPosition {
+	var positions []txPosition
+	for _, tx := range txs {
+		positions = append(positions, txPosition{tx.Height, tx.Index})
+	}
+	return positions
+}
+
+func TestIndexerTxEvents(t *testing.T) {
+	indexer := events.NewIndexer(dbm.NewMemDB(), log.NewNopLogger())
+	indexBlocks(t, indexer)
+	ctx := context.Background()
+
+	cond := func(key string, op events.Operator, value string) events.Condition {
+		return events.Condition{Key: key, Operator: op, Value: value}
+	}
+
+	testCases := []struct {
+		name     string
+		req      *events.QueryTxEventsRequest
+		expected []txPosition
+		expErr   bool
+	}{
+		{
+			"equal",
+			&events.QueryTxEventsRequest{Filters: []events.Filter{
+				{Conditions: []events.Condition{cond("transfer.recipient", events.OperatorEqual, "addr1")}},
+			}},
+			[]txPosition{{1, 1}, {2, 1}, {3, 1}, {4, 1}, {5, 1}},
+			false,
+		},
+		{
+			"conditions are AND'ed, filters are OR'ed",
+			&events.QueryTxEventsRequest{Filters: []events.Filter{
+				{Conditions: []events.Condition{
+					cond("transfer.recipient", events.OperatorEqual, "addr2"),
+					cond("transfer.amount", events.OperatorGreaterEqual, "6"),
+				}},
+				{Conditions: []events.Condition{
+					cond("transfer.amount", events.OperatorLess, "1.5"),
+					cond("transfer.recipient", events.OperatorPrefix, "addr"),
+				}},
+			}},
+			[]txPosition{
+				{1, 0}, {1, 1}, {2, 0}, {3, 0}, {3, 2}, {4, 0}, {4, 2}, {5, 0}, {5, 2},
+			},
+			false,
+		},
+		{
+			"height range",
+			&events.QueryTxEventsRequest{
+				Filters: []events.Filter{
+					{Conditions: []events.Condition{cond("transfer.amount", events.OperatorGreater, "2")}},
+				},
+				MinHeight: 2,
+				MaxHeight: 4,
+			},
+			[]txPosition{{2, 2}, {3, 1}, {3, 2}, {4, 1}, {4, 2}},
+			false,
+		},
+		{
+			"no filters",
+			&events.QueryTxEventsRequest{MinHeight: 5},
+			[]txPosition{{5, 0}, {5, 1}, {5, 2}},
+			false,
+		},
+		{
+			"no match",
+			&events.QueryTxEventsRequest{Filters: []events.Filter{
+				{Conditions: []events.Condition{cond("transfer.recipient", events.OperatorEqual, "addr")}},
+			}},
+			nil,
+			false,
+		},
+		{
+			"filter without conditions",
+			&events.QueryTxEventsRequest{Filters: []events.Filter{{}}},
+			nil,
+			true,
+		},
+		{
+			"invalid operator",
+			&events.QueryTxEventsRequest{Filters: []events.Filter{
+				{Conditions: []events.Condition{cond("transfer.recipient", events.OperatorUnspecified, "addr1")}},
+			}},
+			nil,
+			true,
+		},
+		{
+			"invalid number",
+			&events.QueryTxEventsRequest{Filters: []events.Filter{
+				{Conditions: []events.Condition{cond("transfer.amount", events.OperatorLess, "addr1")}},
+			}},
+			nil,
+			true,
+		},
+		{
+			"invalid height range",
+			&events.QueryTxEventsRequest{MinHeight: 3, MaxHeight: 2},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := indexer.TxEvents(ctx, tc.req)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, txPositions(res.Txs))
+		})
+	}
+
+	res, err := indexer.TxEvents(ctx, &events.QueryTxEventsRequest{MinHeight: 2, MaxHeight: 2})
+	require.NoError(t, err)
+	require.Equal(t, events.TxEvents{
+		Height: 2,
+		Index:  1,
+		Hash:   tmhash.Sum([]byte("tx/2/1")),
+		Code:   1,
+		Events: []abci.Event{
+			newEvent("message", "action", "send"),
+			newEvent("transfer", "recipient", "addr1", "amount", "2"),
+		},
+	}, res.Txs[1])
+}
+
+func TestIndexerPagination(t *testing.T) {
+	indexer := events.NewIndexer(dbm.NewMemDB(), log.NewNopLogger())
+	indexBlocks(t, indexer)
+	ctx := context.Background()
+
+	filters := []events.Filter{
+		{Conditions: []events.Condition{{Key: "message.action", Operator: events.OperatorEqual, Value: "send"}}},
+	}
+	for _, reverse := range []bool{false, true} {
+		var positions []txPosition
+		pageReq := &query.PageRequest{Limit: 4, CountTotal: true, Reverse: reverse}
+		for {
+			res, err := indexer.TxEvents(ctx, &events.QueryTxEventsRequest{
+				Filters:    filters,
+				MinHeight:  2,
+				Pagination: pageReq,
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(res.Txs), 4)
+			if pageReq.Key == nil {
+				require.Equal(t, uint64(12), res.Pagination.Total)
+			}
+			positions = append(positions, txPositions(res.Txs)...)
+
+			if res.Pagination.NextKey == nil {
+				break
+			}
+			pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 4, Reverse: reverse}
+		}
+
+		require.Len(t, positions, 12)
+		first, last := positions[0], positions[11]
+		if reverse {
+			first, last = last, first
+		}
+		require.Equal(t, txPosition{2, 0}, first)
+		require.Equal(t, txPosition{5, 2}, last)
+	}
+}
+
+func TestIndexerPaginationFilters(t *testing.T) {
+	indexer := events.NewIndexer(dbm.NewMemDB(), log.NewNopLogger())
+	indexBlocks(t, indexer)
+	ctx := context.Background()
+
+	// txs 0 and 2 of each block
+	filters := []events.Filter{
+		{Conditions: []events.Condition{{Key: "transfer.recipient", Operator: events.OperatorEqual, Value: "addr0"}}},
+		{Conditions: []events.Condition{{Key: "transfer.recipient", Operator: events.OperatorEqual, Value: "addr2"}}},
+		{Conditions: []events.Condition{{Key: "transfer.amount", Operator: events.OperatorEqual, Value: "0"}}},
+	}
+
+	res, err := indexer.TxEvents(ctx, &events.QueryTxEventsRequest{
+		Filters:    filters,
+		Pagination: &query.PageRequest{Offset: 3, Limit: 4, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []txPosition{{2, 2}, {3, 0}, {3, 2}, {4, 0}}, txPositions(res.Txs))
+	require.Equal(t, uint64(10), res.Pagination.Total)
+
+	res, err = indexer.TxEvents(ctx, &events.QueryTxEventsRequest{
+		Filters:    filters,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []txPosition{{4, 2}, {4, 0}}, txPositions(res.Txs))
+
+	_, err = indexer.TxEvents(ctx, &events.QueryTxEventsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Offset: 1},
+	})
+	require.Error(t, err)
+}
+
+func TestIndexerMaxScan(t *testing.T) {
+	indexer := events.NewIndexer(dbm.NewMemDB(), log.NewNopLogger())
+	indexBlocks(t, indexer)
+	indexer.SetMaxScan(4)
+	ctx := context.Background()
+
+	// an equality condition only scans the entries of its value in the height range
+	res, err := indexer.TxEvents(ctx, &events.QueryTxEventsRequest{
+		Filters: []events.Filter{{Conditions: []events.Condition{
+			{Key: "transfer.recipient", Operator: events.OperatorEqual, Value: "addr1"},
+			{Key: "transfer.amount", Operator: events.OperatorGreater, Value: "2"},
+		}}},
+		MinHeight: 2,
+		MaxHeight: 4,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []txPosition{{3, 1}, {4, 1}}, txPositions(res.Txs))
+
+	// the pages are read lazily
+	res, err = indexer.TxEvents(ctx, &events.QueryTxEventsRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 2)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	// a range condition scans all the records of the height range
+	_, err = indexer.TxEvents(ctx, &events.QueryTxEventsRequest{
+		Filters: []events.Filter{{Conditions: []events.Condition{
+			{Key: "transfer.amount", Operator: events.OperatorGreater, Value: "12"},
+		}}},
+	})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// and so does counting the total
+	_, err = indexer.TxEvents(ctx, &events.QueryTxEventsRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestIndexerBlockEvents(t *testing.T) {
+	indexer := events.NewIndexer(dbm.NewMemDB(), log.NewNopLogger())
+	indexBlocks(t, indexer)
+	ctx := context.Background()
+
+	res, err := indexer.BlockEvents(ctx, &events.QueryBlockEventsRequest{
+		Filters: []events.Filter{
+			{Conditions: []events.Condition{{Key: "upgrade.name", Operator: events.OperatorPrefix, Value: "v"}}},
+			{Conditions: []events.Condition{{Key: "mint.amount", Operator: events.OperatorLessEqual, Value: "10"}}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Blocks, 3)
+	require.Equal(t, events.BlockEvents{
+		Height:           1,
+		BeginBlockEvents: []abci.Event{newEvent("mint", "amount", "10")},
+	}, res.Blocks[0])
+	require.Equal(t, int64(2), res.Blocks[1].Height)
+	require.Equal(t, []abci.Event{newEvent("upgrade", "name", "v4")}, res.Blocks[2].EndBlockEvents)
+
+	// the blocks are only indexed on commit
+	require.NoError(t, indexer.ListenBeginBlock(ctx,
+		abci.RequestBeginBlock{Header: tmproto.Header{Height: 6}},
+		abci.ResponseBeginBlock{},
+	))
+	res, err = indexer.BlockEvents(ctx, &events.QueryBlockEventsRequest{MinHeight: 5})
+	require.NoError(t, err)
+	require.Len(t, res.Blocks, 1)
+}
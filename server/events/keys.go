@@ -0,0 +1,89 @@
+package events
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// index defines the keys of the events of transactions or blocks: their
+// records, stored by position, and the index of their attribute values.
+//
+// The position of a transaction is the height of its block followed by its
+// index in the block, the position of a block is its height, so that the
+// positions are ordered by height.
+type index struct {
+	// records is the prefix of the records, stored under
+	// records | position.
+	records []byte
+	// attributes is the prefix of the attribute index, storing empty values
+	// under attributes | len(compositeKey) | compositeKey | value | position.
+	attributes []byte
+	// positionLen is the length of the positions.
+	positionLen int
+	// events returns the indexed events of a stored record.
+	events func(bz []byte) ([]abci.Event, error)
+}
+
+var (
+	txIndex = index{
+		records:     []byte{0x01},
+		attributes:  []byte{0x02},
+		positionLen: 12,
+		events: func(bz []byte) ([]abci.Event, error) {
+			var tx TxEvents
+			err := tx.Unmarshal(bz)
+			return tx.Events, err
+		},
+	}
+	blockIndex = index{
+		records:     []byte{0x03},
+		attributes:  []byte{0x04},
+		positionLen: 8,
+		events: func(bz []byte) ([]abci.Event, error) {
+			var block BlockEvents
+			err := block.Unmarshal(bz)
+			return append(block.BeginBlockEvents, block.EndBlockEvents...), err
+		},
+	}
+)
+
+// heightKey returns the first bytes of the positions at the given height.
+func heightKey(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return bz
+}
+
+// txPosition returns the position of the transaction of the given height and
+// index.
+func txPosition(height int64, index uint32) []byte {
+	bz := make([]byte, 12)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	binary.BigEndian.PutUint32(bz[8:], index)
+	return bz
+}
+
+// recordKey returns the key of the record at the given position.
+func (idx index) recordKey(position []byte) []byte {
+	return append(append([]byte{}, idx.records...), position...)
+}
+
+// attributeKey returns the key of the attribute index prefixing the values of
+// the given composite key.
+func (idx index) attributeKey(compositeKey string) ([]byte, error) {
+	if len(compositeKey) == 0 || len(compositeKey) > math.MaxUint8 {
+		return nil, fmt.Errorf("invalid attribute key length %d", len(compositeKey))
+	}
+
+	key := append(append([]byte{}, idx.attributes...), byte(len(compositeKey)))
+	return append(key, compositeKey...), nil
+}
+
+// splitAttributeKey returns the value and the position of a key of the
+// attribute index, without the prefix returned by attributeKey.
+func (idx index) splitAttributeKey(key []byte) (value, position []byte) {
+	return key[:len(key)-idx.positionLen], key[len(key)-idx.positionLen:]
+}
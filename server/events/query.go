@@ -0,0 +1,550 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// TxEvents implements QueryServer.TxEvents
+func (idx *Indexer) TxEvents(_ context.Context, req *QueryTxEventsRequest) (*QueryTxEventsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var txs []TxEvents
+	pageRes, err := idx.query(txIndex, req.Filters, req.MinHeight, req.MaxHeight, req.Pagination, func(bz []byte) error {
+		var tx TxEvents
+		if err := tx.Unmarshal(bz); err != nil {
+			return err
+		}
+		txs = append(txs, tx)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryTxEventsResponse{Txs: txs, Pagination: pageRes}, nil
+}
+
+// BlockEvents implements QueryServer.BlockEvents
+func (idx *Indexer) BlockEvents(_ context.Context, req *QueryBlockEventsRequest) (*QueryBlockEventsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var blocks []BlockEvents
+	pageRes, err := idx.query(blockIndex, req.Filters, req.MinHeight, req.MaxHeight, req.Pagination, func(bz []byte) error {
+		var block BlockEvents
+		if err := block.Unmarshal(bz); err != nil {
+			return err
+		}
+		blocks = append(blocks, block)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryBlockEventsResponse{Blocks: blocks, Pagination: pageRes}, nil
+}
+
+// query calls onResult with the records matching any of the filters between
+// the given heights, all the records if there is no filter, for the requested
+// page.
+//
+// The matching positions are streamed in order and the page is read lazily,
+// so that the work done is bounded by the position of the last record of the
+// page, and by the scan budget of the indexer.
+func (idx *Indexer) query(index index, filters []Filter, minHeight, maxHeight int64, pageReq *query.PageRequest, onResult func(record []byte) error) (*query.PageResponse, error) {
+	if minHeight < 0 || maxHeight < 0 || (maxHeight > 0 && minHeight > maxHeight) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height range [%d, %d]", minHeight, maxHeight)
+	}
+
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+	}
+
+	var start, end []byte
+	if minHeight > 0 {
+		start = heightKey(minHeight)
+	}
+	if maxHeight > 0 {
+		end = heightKey(maxHeight + 1)
+	}
+
+	// the page starts at its key, included
+	if key := pageReq.Key; key != nil {
+		if pageReq.Reverse {
+			keyEnd := append(append([]byte{}, key...), 0)
+			if end == nil || bytes.Compare(keyEnd, end) < 0 {
+				end = keyEnd
+			}
+		} else if start == nil || bytes.Compare(key, start) > 0 {
+			start = key
+		}
+	}
+
+	it := &iteration{
+		idx:     idx,
+		index:   index,
+		start:   start,
+		end:     end,
+		reverse: pageReq.Reverse,
+		budget:  idx.maxScan,
+	}
+
+	stream, err := it.filtersStream(filters)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.close()
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	// the total is only counted on request, as it requires a full scan
+	countTotal := pageReq.CountTotal && pageReq.Key == nil
+
+	var count uint64
+	var nextKey []byte
+	for {
+		position, record, err := stream.next()
+		if err != nil {
+			return nil, err
+		}
+		if position == nil {
+			break
+		}
+
+		count++
+		switch {
+		case count <= pageReq.Offset:
+			continue
+		case count <= pageReq.Offset+limit:
+			if record == nil {
+				if record, err = it.record(position); err != nil {
+					return nil, err
+				}
+			}
+			if err := onResult(record); err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			continue
+		case count == pageReq.Offset+limit+1:
+			nextKey = position
+		}
+		if !countTotal {
+			break
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+
+	return pageRes, nil
+}
+
+// iteration holds the state of a query over an index, whose positions are
+// restricted to [start, end) and iterated in reverse order if reverse is set.
+type iteration struct {
+	idx        *Indexer
+	index      index
+	start, end []byte
+	reverse    bool
+	// budget is the number of index entries and records which can still be
+	// scanned.
+	budget uint64
+}
+
+// scan consumes one unit of the scan budget.
+func (it *iteration) scan() error {
+	if it.budget == 0 {
+		return status.Errorf(codes.ResourceExhausted,
+			"query scans more than %d entries, narrow the height range or add an equality condition", it.idx.maxScan)
+	}
+	it.budget--
+	return nil
+}
+
+// iterator returns an iterator over the keys prefix | position for the
+// positions of the iteration.
+func (it *iteration) iterator(prefix []byte) (dbm.Iterator, error) {
+	start := append(append([]byte{}, prefix...), it.start...)
+	end := storetypes.PrefixEndBytes(prefix)
+	if it.end != nil {
+		end = append(append([]byte{}, prefix...), it.end...)
+	}
+
+	if it.reverse {
+		return it.idx.db.ReverseIterator(start, end)
+	}
+	return it.idx.db.Iterator(start, end)
+}
+
+// record loads the record at the given position.
+func (it *iteration) record(position []byte) ([]byte, error) {
+	bz, err := it.idx.db.Get(it.index.recordKey(position))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("no record at position %X", position)
+	}
+
+	return bz, nil
+}
+
+// before returns whether position a comes before position b in the iteration
+// order.
+func (it *iteration) before(a, b []byte) bool {
+	if it.reverse {
+		return bytes.Compare(a, b) > 0
+	}
+	return bytes.Compare(a, b) < 0
+}
+
+// stream iterates over positions in the iteration order.
+type stream interface {
+	// next returns the next position, nil at the end, and the record at this
+	// position if it was loaded.
+	next() (position, record []byte, err error)
+	close()
+}
+
+// filtersStream returns a stream of the positions of the records matching any
+// of the filters, all the records if there is no filter.
+func (it *iteration) filtersStream(filters []Filter) (stream, error) {
+	if len(filters) == 0 {
+		return it.recordStream()
+	}
+
+	streams := make([]stream, 0, len(filters))
+	for _, filter := range filters {
+		s, err := it.filterStream(filter)
+		if err != nil {
+			for _, s := range streams {
+				s.close()
+			}
+			return nil, err
+		}
+		streams = append(streams, s)
+	}
+
+	if len(streams) == 1 {
+		return streams[0], nil
+	}
+	return &unionStream{it: it, streams: streams, heads: make([]streamHead, len(streams))}, nil
+}
+
+// filterStream returns a stream of the positions of the records matching all
+// the conditions of the filter. The positions are read from the attribute
+// index if the filter has an equality condition, as the positions of a value
+// are ordered, and from the records otherwise. The other conditions are
+// checked against the events of the records.
+func (it *iteration) filterStream(filter Filter) (stream, error) {
+	if len(filter.Conditions) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "filter without conditions")
+	}
+
+	var driver stream
+	conds := make([]condition, 0, len(filter.Conditions))
+	for _, cond := range filter.Conditions {
+		c, err := it.index.compileCondition(cond)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if driver == nil && cond.Operator == OperatorEqual {
+			if driver, err = it.valueStream(append(append([]byte{}, c.attributeKey...), cond.Value...)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		conds = append(conds, c)
+	}
+
+	if driver == nil {
+		var err error
+		if driver, err = it.recordStream(); err != nil {
+			return nil, err
+		}
+	}
+	if len(conds) == 0 {
+		return driver, nil
+	}
+
+	return &conditionStream{it: it, driver: driver, conds: conds}, nil
+}
+
+// recordStream returns a stream of the positions of all the records.
+func (it *iteration) recordStream() (stream, error) {
+	iter, err := it.iterator(it.index.records)
+	if err != nil {
+		return nil, err
+	}
+
+	return &iteratorStream{it: it, iter: iter, prefixLen: len(it.index.records), keyLen: len(it.index.records) + it.index.positionLen, loaded: true}, nil
+}
+
+// valueStream returns a stream of the positions of the records with an
+// indexed attribute value, given the key of the attribute index prefixing its
+// positions.
+func (it *iteration) valueStream(valueKey []byte) (stream, error) {
+	iter, err := it.iterator(valueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &iteratorStream{it: it, iter: iter, prefixLen: len(valueKey), keyLen: len(valueKey) + it.index.positionLen}, nil
+}
+
+// iteratorStream streams the positions suffixing the keys of an iterator. The
+// keys of another length are the ones of longer attribute values sharing the
+// prefix, and are skipped.
+type iteratorStream struct {
+	it        *iteration
+	iter      dbm.Iterator
+	prefixLen int
+	keyLen    int
+	// loaded is set if the values of the iterator are the records.
+	loaded bool
+}
+
+func (s *iteratorStream) next() ([]byte, []byte, error) {
+	for ; s.iter.Valid(); s.iter.Next() {
+		if err := s.it.scan(); err != nil {
+			return nil, nil, err
+		}
+
+		key := s.iter.Key()
+		if len(key) != s.keyLen {
+			continue
+		}
+
+		position := append([]byte{}, key[s.prefixLen:]...)
+		var record []byte
+		if s.loaded {
+			record = append([]byte{}, s.iter.Value()...)
+		}
+		s.iter.Next()
+		return position, record, nil
+	}
+
+	return nil, nil, s.iter.Error()
+}
+
+func (s *iteratorStream) close() {
+	s.iter.Close()
+}
+
+// conditionStream streams the positions of a driver stream whose records
+// satisfy all the conditions.
+type conditionStream struct {
+	it     *iteration
+	driver stream
+	conds  []condition
+}
+
+func (s *conditionStream) next() ([]byte, []byte, error) {
+	for {
+		position, record, err := s.driver.next()
+		if err != nil || position == nil {
+			return nil, nil, err
+		}
+
+		if record == nil {
+			if record, err = s.it.record(position); err != nil {
+				return nil, nil, err
+			}
+		}
+		events, err := s.it.index.events(record)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		matches := true
+		for _, cond := range s.conds {
+			if !cond.matchEvents(events) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return position, record, nil
+		}
+	}
+}
+
+func (s *conditionStream) close() {
+	s.driver.close()
+}
+
+// unionStream merges streams, without duplicates.
+type unionStream struct {
+	it      *iteration
+	streams []stream
+	heads   []streamHead
+	started bool
+}
+
+// streamHead is the next position of a stream and its record.
+type streamHead struct {
+	position, record []byte
+}
+
+func (s *unionStream) next() ([]byte, []byte, error) {
+	if !s.started {
+		for i := range s.streams {
+			if err := s.advance(i); err != nil {
+				return nil, nil, err
+			}
+		}
+		s.started = true
+	}
+
+	var first *streamHead
+	for i := range s.heads {
+		head := &s.heads[i]
+		if head.position != nil && (first == nil || s.it.before(head.position, first.position)) {
+			first = head
+		}
+	}
+	if first == nil {
+		return nil, nil, nil
+	}
+
+	position, record := first.position, first.record
+	for i := range s.heads {
+		if bytes.Equal(s.heads[i].position, position) {
+			if record == nil {
+				record = s.heads[i].record
+			}
+			if err := s.advance(i); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	return position, record, nil
+}
+
+// advance reads the next position of the i-th stream.
+func (s *unionStream) advance(i int) error {
+	position, record, err := s.streams[i].next()
+	s.heads[i] = streamHead{position: position, record: record}
+	return err
+}
+
+func (s *unionStream) close() {
+	for _, stream := range s.streams {
+		stream.close()
+	}
+}
+
+// condition is a validated condition on the attribute values of an index.
+type condition struct {
+	// key is the composite key of the attribute.
+	key string
+	// attributeKey is the key of the attribute index prefixing the values of
+	// the attribute.
+	attributeKey []byte
+	match        func(value []byte) bool
+}
+
+// compileCondition validates a condition on the attribute values of the
+// index.
+func (idx index) compileCondition(cond Condition) (condition, error) {
+	attributeKey, err := idx.attributeKey(cond.Key)
+	if err != nil {
+		return condition{}, err
+	}
+
+	match, err := cond.matcher()
+	if err != nil {
+		return condition{}, err
+	}
+
+	return condition{key: cond.Key, attributeKey: attributeKey, match: match}, nil
+}
+
+// matchEvents returns true if an indexed attribute of the events satisfies
+// the condition.
+func (c condition) matchEvents(events []abci.Event) bool {
+	for _, event := range events {
+		for _, attr := range event.Attributes {
+			if attr.Index && event.Type+"."+string(attr.Key) == c.key && c.match(attr.Value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// matcher returns a function returning true if an attribute value satisfies
+// the condition.
+func (cond Condition) matcher() (func(value []byte) bool, error) {
+	switch cond.Operator {
+	case OperatorEqual:
+		return func(value []byte) bool {
+			return string(value) == cond.Value
+		}, nil
+
+	case OperatorPrefix:
+		return func(value []byte) bool {
+			return bytes.HasPrefix(value, []byte(cond.Value))
+		}, nil
+
+	case OperatorLess, OperatorLessEqual, OperatorGreater, OperatorGreaterEqual:
+		operand, err := sdk.NewDecFromStr(cond.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s for operator %s: %w", cond.Value, cond.Operator, err)
+		}
+
+		return func(value []byte) bool {
+			number, err := sdk.NewDecFromStr(string(value))
+			if err != nil {
+				return false
+			}
+
+			switch cond.Operator {
+			case OperatorLess:
+				return number.LT(operand)
+			case OperatorLessEqual:
+				return number.LTE(operand)
+			case OperatorGreater:
+				return number.GT(operand)
+			default:
+				return number.GTE(operand)
+			}
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("invalid operator %s", cond.Operator)
+	}
+}
+
+// RegisterGRPCGatewayRoutes mounts the event query service's GRPC-gateway
+// routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+}
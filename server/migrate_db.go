@@ -0,0 +1,128 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+)
+
+// migrateDBBatchSize is the number of keys written to the target database in
+// a single batch.
+const migrateDBBatchSize = 10000
+
+// NewMigrateDBCmd creates a command to copy the application database to
+// another database backend.
+func NewMigrateDBCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-db [target-backend]",
+		Short: "copy the application database to another database backend",
+		Long: `
+Copy all the keys of the application database, i.e. the IAVL trees of the stores
+and the metadata of the multistore, to a new database with the target backend in
+data/migrate-db under the home directory. The source backend is the one set by
+the app-db-backend option. The IAVL trees of the copy are then checked against
+the latest commit info. The node must be stopped.
+
+The target backend is goleveldb, cleveldb, rocksdb, boltdb, badgerdb or pebbledb,
+the backends other than goleveldb and pebbledb require the node to be built with
+their build tag. Once migrated, data/application.db must be replaced by the copy
+and the app-db-backend option set to the target backend.
+`,
+		Example: "migrate-db pebbledb",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			home := ctx.Config.RootDir
+
+			source, target := GetAppDBBackend(ctx.Viper), dbm.BackendType(args[0])
+			if source == target {
+				return fmt.Errorf("the application database already has the backend %s", target)
+			}
+
+			targetDir := filepath.Join(home, "data", "migrate-db")
+			if _, err := os.Stat(filepath.Join(targetDir, "application.db")); err == nil {
+				return fmt.Errorf("%s already exists", filepath.Join(targetDir, "application.db"))
+			}
+
+			sourceDB, err := openDB(home, source)
+			if err != nil {
+				return err
+			}
+			defer sourceDB.Close()
+
+			info, err := rootmulti.GetLatestCommitInfo(sourceDB)
+			if err != nil {
+				return err
+			}
+
+			targetDB, err := NewDB("application", target, targetDir)
+			if err != nil {
+				return err
+			}
+			defer targetDB.Close()
+
+			count, err := copyDB(sourceDB, targetDB)
+			if err != nil {
+				return fmt.Errorf("failed to copy the application database: %w", err)
+			}
+			if err := rootmulti.VerifyCommitInfo(targetDB, info); err != nil {
+				return fmt.Errorf("failed to verify the copy of the application database: %w", err)
+			}
+
+			cmd.Printf("Copied %d keys of the application database at height %d to %s\n", count, info.Version, targetDir)
+			cmd.Printf("Replace %s with the copy and set app-db-backend = \"%s\" in app.toml\n",
+				filepath.Join(home, "data", "application.db"), target)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// copyDB copies all the keys of the source database to the target database in
+// batches, and returns the number of keys copied.
+func copyDB(source, target dbm.DB) (int, error) {
+	iter, err := source.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	count := 0
+	batch := target.NewBatch()
+	defer func() {
+		batch.Close()
+	}()
+
+	for ; iter.Valid(); iter.Next() {
+		if err := batch.Set(iter.Key(), iter.Value()); err != nil {
+			return 0, err
+		}
+		count++
+
+		if count%migrateDBBatchSize == 0 {
+			if err := batch.Write(); err != nil {
+				return 0, err
+			}
+			batch.Close()
+			batch = target.NewBatch()
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return 0, err
+	}
+
+	// the last batch is synced to make sure that the copy is persisted
+	if err := batch.WriteSync(); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,76 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/pebbledb"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func runMigrateDBCmd(t *testing.T, home string, args ...string) (string, error) {
+	t.Helper()
+
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config.SetRoot(home)
+	serverCtx.Viper.Set(flags.FlagHome, home)
+	ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+
+	cmd := server.NewMigrateDBCmd(home)
+	output := &bytes.Buffer{}
+	cmd.SetOut(output)
+	cmd.SetArgs(args)
+	err := cmd.ExecuteContext(ctx)
+
+	return output.String(), err
+}
+
+func TestMigrateDBCmd(t *testing.T) {
+	home := t.TempDir()
+	key := sdk.NewKVStoreKey("test")
+
+	// commit some state in the goleveldb application database
+	db, err := sdk.NewLevelDB("application", filepath.Join(home, "data"))
+	require.NoError(t, err)
+	rs := rootmulti.NewStore(db, log.NewNopLogger())
+	rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 100; j++ {
+			rs.GetKVStore(key).Set([]byte(fmt.Sprintf("key/%d", j)), []byte(fmt.Sprintf("value/%d/%d", i, j)))
+		}
+		rs.Commit()
+	}
+	commitID := rs.LastCommitID()
+	require.NoError(t, db.Close())
+
+	_, err = runMigrateDBCmd(t, home, "goleveldb")
+	require.Error(t, err)
+
+	out, err := runMigrateDBCmd(t, home, "pebbledb")
+	require.NoError(t, err)
+	require.Contains(t, out, "of the application database at height 3")
+
+	// the copy is not overwritten
+	_, err = runMigrateDBCmd(t, home, "pebbledb")
+	require.Error(t, err)
+
+	db, err = pebbledb.NewDB("application", filepath.Join(home, "data", "migrate-db"))
+	require.NoError(t, err)
+	defer db.Close()
+	rs = rootmulti.NewStore(db, log.NewNopLogger())
+	rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+	require.Equal(t, commitID, rs.LastCommitID())
+	require.Equal(t, []byte("value/2/42"), rs.GetKVStore(key).Get([]byte("key/42")))
+}
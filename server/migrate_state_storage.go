@@ -0,0 +1,59 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/multi"
+)
+
+// NewMigrateStateStorageCmd creates a command to populate the state storage from the multistore.
+func NewMigrateStateStorageCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-state-storage",
+		Short: "populate the state storage from the latest state of the multistore",
+		Long: `
+Copy the latest state of the multistore to the state storage database, so that
+the state storage can be enabled on an existing node. The node must be stopped.
+The heights below the latest one are not migrated, the queries at those heights
+are served from IAVL until they are pruned.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			home := ctx.Config.RootDir
+
+			db, err := openDB(home, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			ssDB, err := OpenStateStorageDB(home)
+			if err != nil {
+				return err
+			}
+			defer ssDB.Close()
+
+			// the app must load the multistore without the state storage, which is
+			// not populated yet
+			ctx.Viper.Set(FlagStateStorage, false)
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+
+			rms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("state storage requires a rootmulti store, got %T", app.CommitMultiStore())
+			}
+			if err := multi.MigrateStateStorage(rms, ssDB); err != nil {
+				return fmt.Errorf("failed to migrate state storage: %w", err)
+			}
+
+			fmt.Printf("Migrated state at height %d to the state storage\n", rms.LastCommitID().Version)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
@@ -0,0 +1,371 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+// snapshotArchiveMetadata is the name of the snapshot metadata file in a snapshot archive. It is
+// followed by the chunk files, named by their index.
+const snapshotArchiveMetadata = "metadata"
+
+// NewSnapshotsCmd creates the commands to manage the local snapshots of the node.
+func NewSnapshotsCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage the local state sync snapshots",
+		Long: `
+Manage the state sync snapshots stored under data/snapshots, so that a snapshot
+can be moved between machines as a single archive and restored without peers.
+The node must be stopped.
+`,
+	}
+
+	cmd.AddCommand(
+		newListSnapshotsCmd(),
+		newExportSnapshotCmd(appCreator),
+		newDumpSnapshotCmd(),
+		newLoadSnapshotCmd(),
+		newRestoreSnapshotCmd(appCreator),
+	)
+	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+func newListSnapshotsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the local snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			snapshotStore, snapshotDB, err := openSnapshotStore(GetServerContextFromCmd(cmd).Config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer snapshotDB.Close()
+			snapshots, err := snapshotStore.List()
+			if err != nil {
+				return fmt.Errorf("failed to list snapshots: %w", err)
+			}
+
+			for _, snapshot := range snapshots {
+				cmd.Printf("height: %d, format: %d, chunks: %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			}
+			return nil
+		},
+	}
+}
+
+func newExportSnapshotCmd(appCreator types.AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "export <height>",
+		Short: "Take a snapshot of the state at the given height",
+		Long: `
+Take a snapshot of the application state at the given height and save it to the
+local snapshot store. The height must not be pruned.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %q: %w", args[0], err)
+			}
+
+			ctx := GetServerContextFromCmd(cmd)
+			db, err := openDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+
+			manager, err := snapshotManager(app)
+			if err != nil {
+				return err
+			}
+			snapshot, err := manager.Create(height)
+			if err != nil {
+				return fmt.Errorf("failed to create snapshot: %w", err)
+			}
+
+			cmd.Printf("Snapshot created at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+}
+
+func newRestoreSnapshotCmd(appCreator types.AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore <height> <format>",
+		Short: "Restore the application state from a local snapshot",
+		Long: `
+Restore the application state from a snapshot of the local snapshot store, e.g.
+one loaded from an archive. The application state must be empty. Tendermint
+state is not restored and must be bootstrapped to the same height.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+
+			ctx := GetServerContextFromCmd(cmd)
+			db, err := openDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+
+			manager, err := snapshotManager(app)
+			if err != nil {
+				return err
+			}
+			if err := manager.RestoreLocalSnapshot(height, format); err != nil {
+				return fmt.Errorf("failed to restore snapshot: %w", err)
+			}
+
+			cmd.Printf("Restored application state at height %d\n", app.CommitMultiStore().LastCommitID().Version)
+			return nil
+		},
+	}
+}
+
+func newDumpSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump <height> <format>",
+		Short: "Dump a local snapshot into a portable archive",
+		Long: `
+Write a snapshot of the local snapshot store to a gzipped tar archive, which can
+be loaded by another node with the load command.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+
+			output, err := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				output = fmt.Sprintf("%d-%d.tar.gz", height, format)
+			}
+
+			snapshotStore, snapshotDB, err := openSnapshotStore(GetServerContextFromCmd(cmd).Config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer snapshotDB.Close()
+			snapshot, chunks, err := snapshotStore.Load(height, format)
+			if err != nil {
+				return fmt.Errorf("failed to load snapshot: %w", err)
+			}
+			if snapshot == nil {
+				return fmt.Errorf("snapshot at height %d format %d not found", height, format)
+			}
+
+			file, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			if err := writeSnapshotArchive(file, snapshot, chunks); err != nil {
+				return fmt.Errorf("failed to write snapshot archive: %w", err)
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot at height %d dumped to %s\n", height, output)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The output file, <height>-<format>.tar.gz by default")
+	return cmd
+}
+
+func newLoadSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "load <archive-file>",
+		Short: "Load a snapshot archive into the local snapshot store",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			snapshotStore, snapshotDB, err := openSnapshotStore(GetServerContextFromCmd(cmd).Config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer snapshotDB.Close()
+
+			gzReader, err := gzip.NewReader(file)
+			if err != nil {
+				return fmt.Errorf("failed to read snapshot archive: %w", err)
+			}
+			defer gzReader.Close()
+			tarReader := tar.NewReader(gzReader)
+
+			snapshot, err := readSnapshotArchiveMetadata(tarReader)
+			if err != nil {
+				return err
+			}
+
+			chunks := make(chan io.ReadCloser)
+			go readSnapshotArchiveChunks(tarReader, snapshot.Chunks, chunks)
+
+			saved, err := snapshotStore.Save(snapshot.Height, snapshot.Format, chunks)
+			if err != nil {
+				return fmt.Errorf("failed to save snapshot: %w", err)
+			}
+
+			// the snapshot store recomputes the hashes of the chunks
+			if saved.Chunks != snapshot.Chunks || !bytes.Equal(saved.Hash, snapshot.Hash) {
+				_ = snapshotStore.Delete(saved.Height, saved.Format)
+				return errors.New("invalid snapshot archive, the chunks don't match the metadata")
+			}
+
+			cmd.Printf("Snapshot at height %d, format %d loaded\n", snapshot.Height, snapshot.Format)
+			return nil
+		},
+	}
+}
+
+// writeSnapshotArchive writes the metadata and the chunks of a snapshot as a gzipped tar archive.
+func writeSnapshotArchive(w io.Writer, snapshot *snapshottypes.Snapshot, chunks <-chan io.ReadCloser) error {
+	gzWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzWriter)
+
+	metadata, err := snapshot.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := writeArchiveFile(tarWriter, snapshotArchiveMetadata, metadata); err != nil {
+		return err
+	}
+
+	index := uint32(0)
+	for chunk := range chunks {
+		bz, err := io.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return err
+		}
+		if err := writeArchiveFile(tarWriter, strconv.FormatUint(uint64(index), 10), bz); err != nil {
+			return err
+		}
+		index++
+	}
+	if index != snapshot.Chunks {
+		return fmt.Errorf("expected %d chunks, got %d", snapshot.Chunks, index)
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzWriter.Close()
+}
+
+func writeArchiveFile(tarWriter *tar.Writer, name string, bz []byte) error {
+	err := tarWriter.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0o644,
+		Size: int64(len(bz)),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = tarWriter.Write(bz)
+	return err
+}
+
+// readSnapshotArchiveMetadata reads the snapshot metadata, which is the first file of the archive.
+func readSnapshotArchiveMetadata(tarReader *tar.Reader) (*snapshottypes.Snapshot, error) {
+	header, err := tarReader.Next()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot archive: %w", err)
+	}
+	if header.Name != snapshotArchiveMetadata {
+		return nil, fmt.Errorf("invalid snapshot archive, expected %s file, got %s", snapshotArchiveMetadata, header.Name)
+	}
+
+	bz, err := io.ReadAll(tarReader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot metadata: %w", err)
+	}
+	snapshot := &snapshottypes.Snapshot{}
+	if err := snapshot.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("invalid snapshot metadata: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// readSnapshotArchiveChunks passes the chunk files of the archive to the channel, in order.
+func readSnapshotArchiveChunks(tarReader *tar.Reader, count uint32, chunks chan<- io.ReadCloser) {
+	defer close(chunks)
+
+	for i := uint32(0); i < count; i++ {
+		pr, pw := io.Pipe()
+		chunks <- pr
+
+		header, err := tarReader.Next()
+		if err != nil {
+			pw.CloseWithError(fmt.Errorf("failed to read chunk %d: %w", i, err))
+			return
+		}
+		if header.Name != strconv.FormatUint(uint64(i), 10) {
+			pw.CloseWithError(fmt.Errorf("invalid snapshot archive, expected chunk %d, got %s", i, header.Name))
+			return
+		}
+		if _, err := io.Copy(pw, tarReader); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		pw.Close()
+	}
+}
+
+// snapshotManager returns the snapshot manager of the app.
+func snapshotManager(app types.Application) (*snapshots.Manager, error) {
+	snapshotApp, ok := app.(types.ApplicationSnapshotManager)
+	if !ok || snapshotApp.SnapshotManager() == nil {
+		return nil, errors.New("the application has no snapshot manager")
+	}
+	return snapshotApp.SnapshotManager(), nil
+}
+
+func parseSnapshotArgs(args []string) (height uint64, format uint32, err error) {
+	height, err = strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid height %q: %w", args[0], err)
+	}
+	f, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid format %q: %w", args[1], err)
+	}
+	return height, uint32(f), nil
+}
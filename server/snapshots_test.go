@@ -0,0 +1,148 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var snapshotTestKey = sdk.NewKVStoreKey("test")
+
+type snapshotTestApp struct {
+	*baseapp.BaseApp
+}
+
+func (snapshotTestApp) RegisterAPIRoutes(*api.Server, config.APIConfig) {}
+
+func (snapshotTestApp) RegisterTxService(client.Context) {}
+
+func (snapshotTestApp) RegisterTendermintService(client.Context) {}
+
+// snapshotTestAppCreator creates apps with a single store, whose snapshot
+// databases are closed once the test command returns.
+type snapshotTestAppCreator struct {
+	t   *testing.T
+	dbs []dbm.DB
+}
+
+func (c *snapshotTestAppCreator) create(logger log.Logger, db dbm.DB, _ io.Writer, appOpts types.AppOptions) types.Application {
+	snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
+	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
+	require.NoError(c.t, err)
+	c.dbs = append(c.dbs, snapshotDB)
+	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	require.NoError(c.t, err)
+
+	app := baseapp.NewBaseApp("test", logger, db, nil, baseapp.SetSnapshotStore(snapshotStore))
+	app.MountStores(snapshotTestKey)
+	require.NoError(c.t, app.LoadLatestVersion())
+
+	return snapshotTestApp{app}
+}
+
+func (c *snapshotTestAppCreator) closeDBs() {
+	for _, db := range c.dbs {
+		db.Close()
+	}
+	c.dbs = nil
+}
+
+func runSnapshotsCmd(t *testing.T, creator *snapshotTestAppCreator, home string, args ...string) (string, error) {
+	t.Helper()
+	defer creator.closeDBs()
+
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config.SetRoot(home)
+	serverCtx.Viper.Set(flags.FlagHome, home)
+	ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+
+	cmd := server.NewSnapshotsCmd(creator.create, home)
+	output := &bytes.Buffer{}
+	cmd.SetOut(output)
+	cmd.SetArgs(args)
+	err := cmd.ExecuteContext(ctx)
+
+	return output.String(), err
+}
+
+func TestSnapshotsCmd(t *testing.T) {
+	source, target := t.TempDir(), t.TempDir()
+	creator := &snapshotTestAppCreator{t: t}
+
+	// commit some state on the source node
+	db, err := sdk.NewLevelDB("application", filepath.Join(source, "data"))
+	require.NoError(t, err)
+	rs := rootmulti.NewStore(db, log.NewNopLogger())
+	rs.MountStoreWithDB(snapshotTestKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+	for i := byte(1); i <= 3; i++ {
+		rs.GetKVStore(snapshotTestKey).Set([]byte("key"), []byte{i})
+		rs.Commit()
+	}
+	commitID := rs.LastCommitID()
+	require.NoError(t, db.Close())
+
+	_, err = runSnapshotsCmd(t, creator, source, "export", "4")
+	require.Error(t, err)
+	out, err := runSnapshotsCmd(t, creator, source, "export", "3")
+	require.NoError(t, err)
+	require.Contains(t, out, "Snapshot created at height 3")
+
+	out, err = runSnapshotsCmd(t, creator, source, "list")
+	require.NoError(t, err)
+	require.Equal(t, "height: 3, format: 2, chunks: 1\n", out)
+
+	// the snapshot is moved to the target node as an archive
+	archive := filepath.Join(t.TempDir(), "snapshot.tar.gz")
+	_, err = runSnapshotsCmd(t, creator, source, "dump", "3", "1")
+	require.Error(t, err)
+	_, err = runSnapshotsCmd(t, creator, source, "dump", "3", "2", "--output-document", archive)
+	require.NoError(t, err)
+
+	_, err = runSnapshotsCmd(t, creator, target, "load", archive)
+	require.NoError(t, err)
+	out, err = runSnapshotsCmd(t, creator, target, "list")
+	require.NoError(t, err)
+	require.Equal(t, "height: 3, format: 2, chunks: 1\n", out)
+
+	// a corrupted archive is rejected
+	bz, err := os.ReadFile(archive)
+	require.NoError(t, err)
+	corrupted := filepath.Join(t.TempDir(), "corrupted.tar.gz")
+	require.NoError(t, os.WriteFile(corrupted, bz[:len(bz)/2], 0o600))
+	_, err = runSnapshotsCmd(t, creator, t.TempDir(), "load", corrupted)
+	require.Error(t, err)
+
+	out, err = runSnapshotsCmd(t, creator, target, "restore", "3", "2")
+	require.NoError(t, err)
+	require.Equal(t, "Restored application state at height 3\n", out)
+
+	db, err = sdk.NewLevelDB("application", filepath.Join(target, "data"))
+	require.NoError(t, err)
+	defer db.Close()
+	rs = rootmulti.NewStore(db, log.NewNopLogger())
+	rs.MountStoreWithDB(snapshotTestKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+	require.Equal(t, commitID, rs.LastCommitID())
+	require.Equal(t, []byte{3}, rs.GetKVStore(snapshotTestKey).Get([]byte("key")))
+}
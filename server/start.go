@@ -55,6 +55,16 @@ const (
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
+	FlagStateStorage        = "state-storage"
+	FlagDeliverTxWorkers    = "deliver-tx-workers"
+	FlagAppDBBackend        = "app-db-backend"
+	FlagHistoricalQueries   = "historical-queries"
+
+	FlagHistoricalQueriesMaxSize = "historical-queries-max-size"
+
+	// mempool-related flags
+	FlagMempoolMaxTxs    = "mempool.max-txs"
+	FlagMempoolTTLBlocks = "mempool.ttl-blocks"
 
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
@@ -162,10 +172,18 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Bool(flagGRPCWebEnable, true, "Define if the gRPC-Web server should be enabled. (Note: gRPC must also be enabled.)")
 	cmd.Flags().String(flagGRPCWebAddress, config.DefaultGRPCWebAddress, "The gRPC-Web server address to listen on")
 
+	cmd.Flags().Int(FlagMempoolMaxTxs, config.DefaultMempoolMaxTxs, "Maximum number of txs in the application side mempool (disabled if negative, unbounded if 0)")
+	cmd.Flags().Int64(FlagMempoolTTLBlocks, config.DefaultMempoolTTLBlocks, "Number of blocks a tx is kept in the application side mempool (never expires if lower than 1)")
+
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, true, "Disable fast node for IAVL tree")
+	cmd.Flags().Bool(FlagStateStorage, false, "Write the history of the state to a separate state storage database and only keep the recent versions in IAVL")
+	cmd.Flags().Int(FlagDeliverTxWorkers, 0, "Number of transactions of a block executed in parallel (sequential execution if lower than 2)")
+	cmd.Flags().String(FlagAppDBBackend, "", "The database backend of the application database (default goleveldb)")
+	cmd.Flags().Int(FlagHistoricalQueries, 0, "Number of heights restored from the local snapshots kept in memory to serve the queries at pruned heights (disabled if lower than 1)")
+	cmd.Flags().Uint64(FlagHistoricalQueriesMaxSize, 1024, "Maximum size in MB of the state restored at a pruned height for the historical queries (unbounded if 0)")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -177,7 +195,7 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 	transport := ctx.Viper.GetString(flagTransport)
 	home := ctx.Viper.GetString(flags.FlagHome)
 
-	db, err := openDB(home)
+	db, err := openDB(home, GetAppDBBackend(ctx.Viper))
 	if err != nil {
 		return err
 	}
@@ -236,7 +254,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	}
 
 	traceWriterFile := ctx.Viper.GetString(flagTraceStore)
-	db, err := openDB(home)
+	db, err := openDB(home, GetAppDBBackend(ctx.Viper))
 	if err != nil {
 		return err
 	}
@@ -277,11 +295,16 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	} else {
 		ctx.Logger.Info("starting node with ABCI Tendermint in-process")
 
+		clientCreator := proxy.NewLocalClientCreator(app)
+		if deliverTxsApp, ok := app.(types.ApplicationDeliverTxs); ok && config.DeliverTxWorkers > 1 {
+			clientCreator = newDeliverTxsClientCreator(app, deliverTxsApp)
+		}
+
 		tmNode, err = node.NewNode(
 			cfg,
 			pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
 			nodeKey,
-			proxy.NewLocalClientCreator(app),
+			clientCreator,
 			genDocProvider,
 			node.DefaultDBProvider,
 			node.DefaultMetricsProvider(cfg.Instrumentation),
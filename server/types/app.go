@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/snapshots"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -67,6 +68,19 @@ type (
 		RegisterNodeService(client.Context)
 	}
 
+	// ApplicationSnapshotManager defines an extension of the Application
+	// interface giving access to the snapshot manager, e.g. to take and
+	// restore local snapshots.
+	ApplicationSnapshotManager interface {
+		SnapshotManager() *snapshots.Manager
+	}
+
+	// ApplicationDeliverTxs defines an extension of the Application interface
+	// executing the transactions of a block together, e.g. in parallel.
+	ApplicationDeliverTxs interface {
+		DeliverTxs([]abci.RequestDeliverTx) []abci.ResponseDeliverTx
+	}
+
 	// AppCreator is a function that allows us to lazily initialize an
 	// application using various configurations.
 	AppCreator func(log.Logger, dbm.DB, io.Writer, AppOptions) Application
@@ -16,6 +16,7 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -27,6 +28,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/store/pebbledb"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 )
@@ -284,6 +287,9 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		ExportCmd(appExport, defaultNodeHome),
 		version.NewVersionCommand(),
 		NewRollbackCmd(appCreator, defaultNodeHome),
+		NewMigrateStateStorageCmd(appCreator, defaultNodeHome),
+		NewSnapshotsCmd(appCreator, defaultNodeHome),
+		NewMigrateDBCmd(defaultNodeHome),
 	)
 }
 
@@ -375,9 +381,71 @@ func addrToIP(addr net.Addr) net.IP {
 	return ip
 }
 
-func openDB(rootDir string) (dbm.DB, error) {
+// GetAppDBBackend returns the database backend of the application database,
+// set by the app-db-backend option. It defaults to the backend set at build
+// time in types.DBBackend, if any, and to goleveldb otherwise.
+func GetAppDBBackend(appOpts types.AppOptions) dbm.BackendType {
+	if backend := cast.ToString(appOpts.Get(FlagAppDBBackend)); backend != "" {
+		return dbm.BackendType(backend)
+	}
+	if sdk.DBBackend != "" {
+		return dbm.BackendType(sdk.DBBackend)
+	}
+
+	return dbm.GoLevelDBBackend
+}
+
+// NewDB opens the database name.db in dir with the given backend, which is
+// either pebbledb or one of the backends of tm-db available in this build.
+func NewDB(name string, backend dbm.BackendType, dir string) (db dbm.DB, err error) {
+	if backend == pebbledb.BackendType {
+		return pebbledb.NewDB(name, dir)
+	}
+
+	// tm-db panics on the backends which are not available in this build
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("couldn't create db: %v", r)
+		}
+	}()
+
+	return dbm.NewDB(name, backend, dir)
+}
+
+func openDB(rootDir string, backend dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
-	return sdk.NewLevelDB("application", dataDir)
+	return NewDB("application", backend, dataDir)
+}
+
+// OpenStateStorageDB opens the database of the state storage of the node.
+func OpenStateStorageDB(rootDir string) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return sdk.NewLevelDB("state_storage", dataDir)
+}
+
+// GetSnapshotStore opens the local snapshot store of the node, in data/snapshots
+// under the home directory.
+func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
+	snapshotStore, _, err := openSnapshotStore(cast.ToString(appOpts.Get(flags.FlagHome)))
+	return snapshotStore, err
+}
+
+// openSnapshotStore opens the local snapshot store and returns its metadata
+// database, which must be closed by the caller.
+func openSnapshotStore(rootDir string) (*snapshots.Store, dbm.DB, error) {
+	snapshotDir := filepath.Join(rootDir, "data", "snapshots")
+	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	if err != nil {
+		snapshotDB.Close()
+		return nil, nil, err
+	}
+
+	return snapshotStore, snapshotDB, nil
 }
 
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
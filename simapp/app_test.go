@@ -28,10 +28,13 @@ import (
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
+	groupmodule "github.com/cosmos/cosmos-sdk/x/group/module"
 	"github.com/cosmos/cosmos-sdk/x/mint"
+	nftmodule "github.com/cosmos/cosmos-sdk/x/nft/module"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
@@ -124,13 +127,13 @@ func TestRunMigrations(t *testing.T) {
 		},
 		{
 			"can register and run migration handler for x/bank",
-			"bank", 1,
+			"bank", 2,
 			false, "", false, "", 1,
 		},
 		{
 			"cannot register migration handler for same module & forVersion",
-			"bank", 1,
-			true, "another migration for module bank and version 1 already exists: internal logic error", false, "", 0,
+			"bank", 2,
+			true, "another migration for module bank and version 2 already exists: internal logic error", false, "", 0,
 		},
 	}
 
@@ -161,12 +164,12 @@ func TestRunMigrations(t *testing.T) {
 			require.NoError(t, err)
 
 			// Run migrations only for bank. That's why we put the initial
-			// version for bank as 1, and for all other modules, we put as
+			// version for bank as 2, and for all other modules, we put as
 			// their latest ConsensusVersion.
 			_, err = app.mm.RunMigrations(
 				app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()}), app.configurator,
 				module.VersionMap{
-					"bank":         1,
+					"bank":         2,
 					"auth":         auth.AppModule{}.ConsensusVersion(),
 					"authz":        authzmodule.AppModule{}.ConsensusVersion(),
 					"staking":      staking.AppModule{}.ConsensusVersion(),
@@ -182,6 +185,9 @@ func TestRunMigrations(t *testing.T) {
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
 					"capability":   capability.AppModule{}.ConsensusVersion(),
+					"group":        groupmodule.AppModule{}.ConsensusVersion(),
+					"nft":          nftmodule.AppModule{}.ConsensusVersion(),
+					"tokenfactory": tokenfactory.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -234,6 +240,9 @@ func TestInitGenesisOnMigration(t *testing.T) {
 			"crisis":       crisis.AppModule{}.ConsensusVersion(),
 			"genutil":      genutil.AppModule{}.ConsensusVersion(),
 			"capability":   capability.AppModule{}.ConsensusVersion(),
+			"group":        groupmodule.AppModule{}.ConsensusVersion(),
+			"nft":          nftmodule.AppModule{}.ConsensusVersion(),
+			"tokenfactory": tokenfactory.AppModule{}.ConsensusVersion(),
 		},
 	)
 	require.NoError(t, err)
@@ -6,8 +6,11 @@ import (
 	"compress/zlib"
 	"crypto/sha256"
 	"errors"
+	"fmt"
 	"io"
 	"os"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -135,6 +138,10 @@ func (m *mockSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) er
 	return nil
 }
 
+func (m *mockSnapshotter) SnapshotName() string {
+	return "mock"
+}
+
 func (m *mockSnapshotter) SnapshotFormat() uint32 {
 	return 1
 }
@@ -143,6 +150,76 @@ func (m *mockSnapshotter) SupportedFormats() []uint32 {
 	return []uint32{1}
 }
 
+// mockParallelSnapshotter is a multistore whose stores are lists of keys.
+type mockParallelSnapshotter struct {
+	mtx       sync.Mutex
+	stores    map[string][][]byte
+	committed bool
+}
+
+func (m *mockParallelSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	return errors.New("not implemented")
+}
+
+func (m *mockParallelSnapshotter) Restore(
+	height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	return snapshottypes.SnapshotItem{}, errors.New("not implemented")
+}
+
+func (m *mockParallelSnapshotter) SnapshotStoreNames(height uint64) ([]string, error) {
+	names := []string{}
+	for name := range m.stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (m *mockParallelSnapshotter) SnapshotStore(height uint64, name string, protoWriter protoio.Writer) error {
+	for _, key := range m.stores[name] {
+		err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_IAVL{
+				IAVL: &snapshottypes.SnapshotIAVLItem{Key: key},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockParallelSnapshotter) RestoreStore(height uint64, name string, protoReader protoio.Reader) error {
+	keys := [][]byte{}
+	for {
+		item := &snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(item)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		if item.GetIAVL() == nil {
+			return fmt.Errorf("unexpected item %T", item.Item)
+		}
+		keys = append(keys, item.GetIAVL().Key)
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.stores == nil {
+		m.stores = make(map[string][][]byte)
+	}
+	m.stores[name] = keys
+	return nil
+}
+
+func (m *mockParallelSnapshotter) CommitRestore(height uint64) error {
+	m.committed = true
+	return nil
+}
+
 // setupBusyManager creates a manager with an empty store that is busy creating a snapshot at height 1.
 // The snapshot will complete when the returned closer is called.
 func setupBusyManager(t *testing.T) *snapshots.Manager {
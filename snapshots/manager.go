@@ -9,6 +9,8 @@ import (
 	"sort"
 	"sync"
 
+	protoio "github.com/gogo/protobuf/io"
+
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -57,6 +59,9 @@ type Manager struct {
 	chRestoreDone      <-chan restoreDone
 	restoreChunkHashes [][]byte
 	restoreChunkIndex  uint32
+
+	// skipExtensions restores the multistore only, see RestoreLocalMultistore
+	skipExtensions bool
 }
 
 // NewManager creates a new manager.
@@ -141,6 +146,23 @@ func (m *Manager) sortedExtensionNames() []string {
 	return names
 }
 
+// PinHeight keeps the height from being pruned by the multistore until the
+// returned function is called. It must be called before the height can be
+// pruned, i.e. when the height is committed, and the height unpinned once the
+// snapshot is created.
+func (m *Manager) PinHeight(height uint64) (unpin func()) {
+	if m == nil {
+		return func() {}
+	}
+
+	pinner, ok := m.multistore.(types.HeightPinner)
+	if !ok {
+		return func() {}
+	}
+
+	return pinner.PinHeight(height)
+}
+
 // Create creates a snapshot and returns its metadata.
 func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 	if m == nil {
@@ -163,9 +185,13 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
+	if multistore, ok := m.multistore.(types.ParallelSnapshotter); ok {
+		go m.createParallelSnapshot(height, multistore, ch)
+		return m.store.Save(height, types.FormatParallel, ch)
+	}
 	go m.createSnapshot(height, ch)
 
-	return m.store.Save(height, types.CurrentFormat, ch)
+	return m.store.Save(height, types.FormatSequential, ch)
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
@@ -247,7 +273,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	if !m.isFormatSupported(snapshot.Format) {
 		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
@@ -283,8 +309,81 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	return nil
 }
 
+// isFormatSupported returns whether the multistore can be restored from the snapshot format.
+func (m *Manager) isFormatSupported(format uint32) bool {
+	switch format {
+	case types.FormatSequential:
+		return true
+	case types.FormatParallel:
+		_, ok := m.multistore.(types.ParallelSnapshotter)
+		return ok
+	default:
+		return false
+	}
+}
+
+// RestoreLocalSnapshot restores the app state from a snapshot of the local snapshot store,
+// e.g. one loaded from an archive, and blocks until the restoration is complete.
+func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	if m == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "no snapshot store configured")
+	}
+
+	snapshot, chunks, err := m.store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot at height %v format %v", height, format)
+	}
+	defer DrainChunks(chunks)
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if !m.isFormatSupported(snapshot.Format) {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+	if err := m.beginLocked(opRestore); err != nil {
+		return err
+	}
+	defer m.endLocked()
+
+	return m.restoreSnapshot(*snapshot, chunks)
+}
+
+// RestoreLocalMultistore restores a snapshot of the local snapshot store into the given
+// multistore instead of the one of the manager, e.g. a temporary multistore serving queries at
+// the height of the snapshot, and blocks until the restoration is complete. The extensions are
+// not restored. It runs concurrently with the other operations of the manager.
+func (m *Manager) RestoreLocalMultistore(multistore types.Snapshotter, height uint64, format uint32) error {
+	if m == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "no snapshot store configured")
+	}
+
+	snapshot, chunks, err := m.store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot at height %v format %v", height, format)
+	}
+	defer DrainChunks(chunks)
+
+	restorer := &Manager{store: m.store, multistore: multistore, skipExtensions: true}
+	if !restorer.isFormatSupported(snapshot.Format) {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+
+	return restorer.restoreSnapshot(*snapshot, chunks)
+}
+
 // restoreSnapshot do the heavy work of snapshot restoration after preliminary checks on request have passed.
 func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
+	if snapshot.Format == types.FormatParallel {
+		return m.restoreParallelSnapshot(snapshot, chChunks)
+	}
+
 	streamReader, err := NewStreamReader(chChunks)
 	if err != nil {
 		return err
@@ -295,6 +394,17 @@ func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.Re
 	if err != nil {
 		return sdkerrors.Wrap(err, "multistore restore")
 	}
+	return m.restoreExtensions(snapshot.Height, next, streamReader)
+}
+
+// restoreExtensions restores the extensions from the reader, starting with the next item read
+// after the multistore.
+func (m *Manager) restoreExtensions(height uint64, next types.SnapshotItem, protoReader protoio.Reader) error {
+	if m.skipExtensions {
+		return nil
+	}
+
+	var err error
 	for {
 		if next.Item == nil {
 			// end of stream
@@ -311,7 +421,7 @@ func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.Re
 		if !IsFormatSupported(extension, metadata.Format) {
 			return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v for extension %s", metadata.Format, metadata.Name)
 		}
-		next, err = extension.Restore(snapshot.Height, metadata.Format, streamReader)
+		next, err = extension.Restore(height, metadata.Format, protoReader)
 		if err != nil {
 			return sdkerrors.Wrapf(err, "extension %s restore", metadata.Name)
 		}
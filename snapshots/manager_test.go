@@ -2,6 +2,7 @@ package snapshots_test
 
 import (
 	"errors"
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestManager_List(t *testing.T) {
@@ -210,3 +212,58 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_ParallelSnapshot(t *testing.T) {
+	// the first store is large enough to be split into several chunks
+	large := make([][]byte, 25)
+	r := rand.New(rand.NewSource(1))
+	for i := range large {
+		large[i] = make([]byte, 1e6)
+		_, err := r.Read(large[i])
+		require.NoError(t, err)
+	}
+	source := &mockParallelSnapshotter{
+		stores: map[string][][]byte{
+			"a": large,
+			"b": {},
+			"c": {{1, 2, 3}, {4, 5, 6}},
+		},
+	}
+	sourceManager := snapshots.NewManager(setupStore(t), source)
+	require.NoError(t, sourceManager.RegisterExtensions(&mockSnapshotter{items: [][]byte{{7, 8, 9}}}))
+
+	snapshot, err := sourceManager.Create(5)
+	require.NoError(t, err)
+	require.Equal(t, types.FormatParallel, snapshot.Format)
+	require.Greater(t, snapshot.Chunks, uint32(4))
+
+	// the format can't be restored by a sequential multistore
+	err = snapshots.NewManager(setupStore(t), &mockSnapshotter{}).Restore(*snapshot)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+
+	target := &mockParallelSnapshotter{}
+	extension := &mockSnapshotter{}
+	manager := snapshots.NewManager(setupStore(t), target)
+	require.NoError(t, manager.RegisterExtensions(extension))
+	require.NoError(t, manager.Restore(*snapshot))
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := sourceManager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		done, err := manager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		require.Equal(t, i == snapshot.Chunks-1, done)
+	}
+
+	assert.Equal(t, source.stores, target.stores)
+	assert.True(t, target.committed)
+	assert.Equal(t, [][]byte{{7, 8, 9}}, extension.items)
+
+	// the local snapshot is restored into another multistore without the extensions
+	other := &mockParallelSnapshotter{}
+	require.NoError(t, sourceManager.RestoreLocalMultistore(other, snapshot.Height, snapshot.Format))
+	assert.Equal(t, source.stores, other.stores)
+	assert.True(t, other.committed)
+	err = sourceManager.RestoreLocalMultistore(&mockParallelSnapshotter{}, snapshot.Height+1, snapshot.Format)
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+}
@@ -0,0 +1,549 @@
+package snapshots
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// In the FormatParallel format, the snapshot is made of sections: one per store, sorted by
+// name, followed by one per extension, sorted by name. The items of a section are split into
+// chunks, each chunk being an independent zlib stream whose first item is the header of the
+// section, i.e. its SnapshotStoreItem or SnapshotExtensionMeta. The stores are exported
+// concurrently into temporary chunk files, which are then passed to the snapshot store in order,
+// and restored concurrently by routing every chunk to the importer of its store. The extensions
+// are restored sequentially once all the stores are restored.
+
+// SectionWriter set up a stream pipeline to serialize the items of a snapshot section into
+// independent chunks:
+// Exported Items -> delimited Protobuf -> zlib -> buffer -> chunk
+type SectionWriter struct {
+	header   *types.SnapshotItem
+	newChunk func() (io.WriteCloser, error)
+
+	chunk       io.WriteCloser
+	bufWriter   *bufio.Writer
+	counter     *countWriter
+	zWriter     *zlib.Writer
+	protoWriter protoio.Writer
+}
+
+// NewSectionWriter creates a SectionWriter for the section with the given header item. The
+// newChunk function is called to create the destination of every chunk.
+func NewSectionWriter(header *types.SnapshotItem, newChunk func() (io.WriteCloser, error)) *SectionWriter {
+	return &SectionWriter{
+		header:   header,
+		newChunk: newChunk,
+	}
+}
+
+// WriteMsg implements protoio.Write interface
+func (sw *SectionWriter) WriteMsg(msg proto.Message) error {
+	if sw.zWriter == nil || sw.counter.written >= snapshotChunkSize {
+		if err := sw.nextChunk(); err != nil {
+			return err
+		}
+	}
+	return sw.protoWriter.WriteMsg(msg)
+}
+
+// Close implements io.Closer interface. A section always has at least one chunk, so that empty
+// stores are restored.
+func (sw *SectionWriter) Close() error {
+	if sw.zWriter == nil {
+		if err := sw.nextChunk(); err != nil {
+			return err
+		}
+	}
+	return sw.finishChunk()
+}
+
+// CloseWithError closes the current chunk, passing the error to its reader if possible.
+func (sw *SectionWriter) CloseWithError(err error) {
+	if sw.zWriter == nil {
+		return
+	}
+	sw.zWriter = nil
+	if pw, ok := sw.chunk.(*io.PipeWriter); ok {
+		pw.CloseWithError(err)
+		return
+	}
+	sw.chunk.Close()
+}
+
+// nextChunk finishes the current chunk and starts a new one with the section header.
+func (sw *SectionWriter) nextChunk() error {
+	if err := sw.finishChunk(); err != nil {
+		return err
+	}
+
+	chunk, err := sw.newChunk()
+	if err != nil {
+		return err
+	}
+	sw.chunk = chunk
+	sw.bufWriter = bufio.NewWriter(chunk)
+	sw.counter = &countWriter{w: sw.bufWriter}
+	sw.zWriter, err = zlib.NewWriterLevel(sw.counter, snapshotCompressionLevel)
+	if err != nil {
+		chunk.Close()
+		return sdkerrors.Wrap(err, "zlib failure")
+	}
+	sw.protoWriter = protoio.NewDelimitedWriter(sw.zWriter)
+
+	return sw.protoWriter.WriteMsg(sw.header)
+}
+
+// finishChunk flushes and closes the current chunk, if any.
+func (sw *SectionWriter) finishChunk() error {
+	if sw.zWriter == nil {
+		return nil
+	}
+	if err := sw.zWriter.Close(); err != nil {
+		sw.CloseWithError(err)
+		return err
+	}
+	if err := sw.bufWriter.Flush(); err != nil {
+		sw.CloseWithError(err)
+		return err
+	}
+	sw.zWriter = nil
+	return sw.chunk.Close()
+}
+
+// countWriter counts the bytes written to the underlying writer.
+type countWriter struct {
+	w       io.Writer
+	written uint64
+}
+
+// Write implements io.Writer.
+func (cw *countWriter) Write(data []byte) (int, error) {
+	n, err := cw.w.Write(data)
+	cw.written += uint64(n)
+	return n, err
+}
+
+// SectionReader set up a restore stream pipeline for the chunks of snapshot sections:
+// chan io.ReadCloser -> zlib -> delimited Protobuf -> items
+// The header item of every chunk is passed to the keepHeader function, which tells whether it
+// is returned as an item or skipped.
+type SectionReader struct {
+	chunks     <-chan io.ReadCloser
+	keepHeader func(header *types.SnapshotItem) bool
+
+	chunk       io.ReadCloser
+	zReader     io.ReadCloser
+	protoReader protoio.ReadCloser
+	header      bool
+}
+
+// NewSectionReader creates a SectionReader.
+func NewSectionReader(chunks <-chan io.ReadCloser, keepHeader func(header *types.SnapshotItem) bool) *SectionReader {
+	return &SectionReader{
+		chunks:     chunks,
+		keepHeader: keepHeader,
+	}
+}
+
+// ReadMsg implements protoio.Reader interface. The messages must be SnapshotItems.
+func (sr *SectionReader) ReadMsg(msg proto.Message) error {
+	item, ok := msg.(*types.SnapshotItem)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot read snapshot item into %T", msg)
+	}
+
+	for {
+		if sr.protoReader == nil {
+			chunk, ok := <-sr.chunks
+			if !ok {
+				return io.EOF
+			}
+			if err := sr.open(chunk); err != nil {
+				return err
+			}
+		}
+
+		err := sr.protoReader.ReadMsg(item)
+		if err == io.EOF {
+			if sr.header {
+				return sdkerrors.Wrap(types.ErrInvalidMetadata, "snapshot chunk has no header item")
+			}
+			if err := sr.closeChunk(); err != nil {
+				return err
+			}
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if sr.header {
+			sr.header = false
+			if !sr.keepHeader(item) {
+				item.Reset()
+				continue
+			}
+		}
+		return nil
+	}
+}
+
+// Close implements io.Closer interface
+func (sr *SectionReader) Close() error {
+	return sr.closeChunk()
+}
+
+func (sr *SectionReader) open(chunk io.ReadCloser) error {
+	zReader, err := zlib.NewReader(chunk)
+	if err != nil {
+		chunk.Close()
+		return sdkerrors.Wrap(err, "zlib failure")
+	}
+	sr.chunk = chunk
+	sr.zReader = zReader
+	sr.protoReader = protoio.NewDelimitedReader(zReader, snapshotMaxItemSize)
+	sr.header = true
+	return nil
+}
+
+func (sr *SectionReader) closeChunk() error {
+	if sr.protoReader == nil {
+		return nil
+	}
+	sr.protoReader.Close()
+	sr.zReader.Close()
+	err := sr.chunk.Close()
+	sr.protoReader = nil
+	return err
+}
+
+// readChunkHeader returns the header item of a FormatParallel chunk.
+func readChunkHeader(chunk []byte) (types.SnapshotItem, error) {
+	header := types.SnapshotItem{}
+	zReader, err := zlib.NewReader(bytes.NewReader(chunk))
+	if err != nil {
+		return header, sdkerrors.Wrap(err, "zlib failure")
+	}
+	defer zReader.Close()
+
+	err = protoio.NewDelimitedReader(zReader, snapshotMaxItemSize).ReadMsg(&header)
+	if err != nil {
+		return header, sdkerrors.Wrap(err, "invalid snapshot chunk header")
+	}
+	return header, nil
+}
+
+// tempChunk is a temporary chunk file which is removed once closed.
+type tempChunk struct {
+	*os.File
+}
+
+// Close implements io.Closer.
+func (c tempChunk) Close() error {
+	err := c.File.Close()
+	os.Remove(c.Name())
+	return err
+}
+
+// sendError sends a chunk which fails with the error when read.
+func sendError(ch chan<- io.ReadCloser, err error) {
+	pr, pw := io.Pipe()
+	pw.CloseWithError(err)
+	ch <- pr
+}
+
+// storeSection is the result of the export of a store into temporary chunk files.
+type storeSection struct {
+	paths []string
+	err   error
+}
+
+// createParallelSnapshot writes a FormatParallel snapshot to the channel. The stores are
+// exported concurrently, at most one per CPU.
+func (m *Manager) createParallelSnapshot(height uint64, multistore types.ParallelSnapshotter, ch chan<- io.ReadCloser) {
+	defer close(ch)
+
+	names, err := multistore.SnapshotStoreNames(height)
+	if err != nil {
+		sendError(ch, err)
+		return
+	}
+
+	tempDir, err := os.MkdirTemp(m.store.dir, fmt.Sprintf("tmp-%v-", height))
+	if err != nil {
+		sendError(ch, sdkerrors.Wrap(err, "failed to create temporary snapshot directory"))
+		return
+	}
+	defer os.RemoveAll(tempDir)
+
+	sem := make(chan struct{}, runtime.NumCPU())
+	sections := make([]chan storeSection, len(names))
+	for i, name := range names {
+		sections[i] = make(chan storeSection, 1)
+		go func(i int, name string) {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			sections[i] <- m.exportStore(height, multistore, name, filepath.Join(tempDir, fmt.Sprintf("%v", i)))
+		}(i, name)
+	}
+
+	// The chunks are passed in store order, waiting for all the exports on errors so that the
+	// temporary directory is not removed while being written.
+	var failed bool
+	for i, name := range names {
+		section := <-sections[i]
+		if failed {
+			continue
+		}
+		if section.err != nil {
+			sendError(ch, sdkerrors.Wrapf(section.err, "failed to snapshot store %q", name))
+			failed = true
+			continue
+		}
+		for _, path := range section.paths {
+			file, err := os.Open(path)
+			if err != nil {
+				sendError(ch, err)
+				failed = true
+				break
+			}
+			ch <- tempChunk{file}
+		}
+	}
+	if failed {
+		return
+	}
+
+	for _, name := range m.sortedExtensionNames() {
+		extension := m.extensions[name]
+		sectionWriter := NewSectionWriter(&types.SnapshotItem{
+			Item: &types.SnapshotItem_Extension{
+				Extension: &types.SnapshotExtensionMeta{
+					Name:   name,
+					Format: extension.SnapshotFormat(),
+				},
+			},
+		}, func() (io.WriteCloser, error) {
+			pr, pw := io.Pipe()
+			ch <- pr
+			return pw, nil
+		})
+		if err := extension.Snapshot(height, sectionWriter); err != nil {
+			sectionWriter.CloseWithError(err)
+			sendError(ch, err)
+			return
+		}
+		if err := sectionWriter.Close(); err != nil {
+			sendError(ch, err)
+			return
+		}
+	}
+}
+
+// exportStore exports the named store into chunk files prefixed by the given path.
+func (m *Manager) exportStore(height uint64, multistore types.ParallelSnapshotter, name, prefix string) storeSection {
+	var paths []string
+	sectionWriter := NewSectionWriter(&types.SnapshotItem{
+		Item: &types.SnapshotItem_Store{
+			Store: &types.SnapshotStoreItem{
+				Name: name,
+			},
+		},
+	}, func() (io.WriteCloser, error) {
+		path := fmt.Sprintf("%v.%v", prefix, len(paths))
+		file, err := os.Create(path)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to create snapshot chunk file %q", path)
+		}
+		paths = append(paths, path)
+		return file, nil
+	})
+
+	if err := multistore.SnapshotStore(height, name, sectionWriter); err != nil {
+		sectionWriter.CloseWithError(err)
+		return storeSection{err: err}
+	}
+	if err := sectionWriter.Close(); err != nil {
+		return storeSection{err: err}
+	}
+	return storeSection{paths: paths}
+}
+
+// parallelRestore routes the chunks of a FormatParallel snapshot to the restoration of their
+// section.
+type parallelRestore struct {
+	manager    *Manager
+	multistore types.ParallelSnapshotter
+	height     uint64
+
+	stores   map[string]chan io.ReadCloser
+	storesWg sync.WaitGroup
+	mtx      sync.Mutex
+	storeErr error
+
+	extensions     chan io.ReadCloser
+	extensionsDone chan error
+}
+
+// restoreParallelSnapshot restores a FormatParallel snapshot. The stores are restored
+// concurrently, then the extensions sequentially.
+func (m *Manager) restoreParallelSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
+	multistore, ok := m.multistore.(types.ParallelSnapshotter)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+
+	r := &parallelRestore{
+		manager:    m,
+		multistore: multistore,
+		height:     snapshot.Height,
+		stores:     make(map[string]chan io.ReadCloser),
+	}
+	for chunk := range chChunks {
+		if err := r.route(chunk); err != nil {
+			r.abort()
+			return err
+		}
+	}
+
+	if r.extensions == nil {
+		return r.finishStores()
+	}
+	close(r.extensions)
+	return <-r.extensionsDone
+}
+
+// route passes the chunk to the restoration of its section.
+func (r *parallelRestore) route(chunk io.ReadCloser) error {
+	bz, err := io.ReadAll(chunk)
+	chunk.Close()
+	if err != nil {
+		return err
+	}
+	header, err := readChunkHeader(bz)
+	if err != nil {
+		return err
+	}
+
+	switch item := header.Item.(type) {
+	case *types.SnapshotItem_Store:
+		if r.extensions != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "received store %q after extensions", item.Store.Name)
+		}
+		ch, ok := r.stores[item.Store.Name]
+		if !ok {
+			ch = r.restoreStore(item.Store.Name)
+		}
+		ch <- io.NopCloser(bytes.NewReader(bz))
+
+	case *types.SnapshotItem_Extension:
+		if r.extensions == nil {
+			if err := r.finishStores(); err != nil {
+				return err
+			}
+			r.restoreExtensions()
+		}
+		r.extensions <- io.NopCloser(bytes.NewReader(bz))
+
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot chunk header %T", header.Item)
+	}
+	return nil
+}
+
+// restoreStore starts the restoration of a store and returns the channel of its chunks.
+func (r *parallelRestore) restoreStore(name string) chan io.ReadCloser {
+	ch := make(chan io.ReadCloser, chunkBufferSize)
+	r.stores[name] = ch
+	r.storesWg.Add(1)
+
+	go func() {
+		defer r.storesWg.Done()
+		defer DrainChunks(ch)
+
+		sectionReader := NewSectionReader(ch, func(*types.SnapshotItem) bool { return false })
+		defer sectionReader.Close()
+
+		if err := r.multistore.RestoreStore(r.height, name, sectionReader); err != nil {
+			r.mtx.Lock()
+			defer r.mtx.Unlock()
+			if r.storeErr == nil {
+				r.storeErr = sdkerrors.Wrapf(err, "multistore restore of store %q", name)
+			}
+		}
+	}()
+
+	return ch
+}
+
+// finishStores waits for the restoration of the stores and commits it.
+func (r *parallelRestore) finishStores() error {
+	for _, ch := range r.stores {
+		close(ch)
+	}
+	r.storesWg.Wait()
+	r.stores = nil
+
+	if r.storeErr != nil {
+		return r.storeErr
+	}
+	if err := r.multistore.CommitRestore(r.height); err != nil {
+		return sdkerrors.Wrap(err, "multistore restore")
+	}
+	return nil
+}
+
+// restoreExtensions starts the restoration of the extensions. Every extension reads the items
+// of its section, and receives the header of the next section as the next item.
+func (r *parallelRestore) restoreExtensions() {
+	r.extensions = make(chan io.ReadCloser, chunkBufferSize)
+	r.extensionsDone = make(chan error, 1)
+
+	go func() {
+		defer DrainChunks(r.extensions)
+
+		var current string
+		sectionReader := NewSectionReader(r.extensions, func(header *types.SnapshotItem) bool {
+			metadata := header.GetExtension()
+			if metadata == nil || metadata.Name != current {
+				if metadata != nil {
+					current = metadata.Name
+				}
+				return true
+			}
+			return false
+		})
+		defer sectionReader.Close()
+
+		next := types.SnapshotItem{}
+		if err := sectionReader.ReadMsg(&next); err != nil && err != io.EOF {
+			r.extensionsDone <- err
+			return
+		}
+		r.extensionsDone <- r.manager.restoreExtensions(r.height, next, sectionReader)
+	}()
+}
+
+// abort stops the restoration after an error.
+func (r *parallelRestore) abort() {
+	for _, ch := range r.stores {
+		close(ch)
+	}
+	r.storesWg.Wait()
+	if r.extensions != nil {
+		close(r.extensions)
+		<-r.extensionsDone
+	}
+}
@@ -1,6 +1,18 @@
 package types
 
+const (
+	// FormatSequential is the snapshot format where the multistore is written as a single stream
+	// of items, one store after another, followed by the extension payloads.
+	FormatSequential uint32 = 1
+
+	// FormatParallel is the snapshot format where every store and extension is written as an
+	// independent stream of chunks, so that stores can be snapshotted and restored concurrently.
+	// Every chunk is a separate zlib stream whose first item is the SnapshotStoreItem or
+	// SnapshotExtensionMeta of the stream it belongs to.
+	FormatParallel uint32 = 2
+)
+
 // CurrentFormat is the currently used format for snapshots. Snapshots using the same format
 // must be identical across all nodes for a given height, so this must be bumped when the binary
-// snapshot output changes.
-const CurrentFormat uint32 = 1
+// snapshot output changes. Multistores which are not a ParallelSnapshotter use FormatSequential.
+const CurrentFormat uint32 = FormatParallel
@@ -17,6 +17,34 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// ParallelSnapshotter is a multistore Snapshotter whose stores can be snapshotted and restored
+// independently of each other, which is required by the FormatParallel snapshot format.
+type ParallelSnapshotter interface {
+	Snapshotter
+
+	// SnapshotStoreNames returns the sorted names of the stores to snapshot at the height.
+	SnapshotStoreNames(height uint64) ([]string, error)
+
+	// SnapshotStore writes the snapshot items of the named store into the protobuf writer.
+	SnapshotStore(height uint64, name string, protoWriter protoio.Writer) error
+
+	// RestoreStore restores the named store from the protobuf items read from the reader. It
+	// may be called concurrently for different stores.
+	RestoreStore(height uint64, name string, protoReader protoio.Reader) error
+
+	// CommitRestore completes the restoration once all the stores are restored.
+	CommitRestore(height uint64) error
+}
+
+// HeightPinner is implemented by snapshotters whose heights are pruned in the
+// background. The snapshot manager pins the height of a snapshot so that it is
+// not pruned while the snapshot is taken.
+type HeightPinner interface {
+	// PinHeight keeps the height from being pruned until the returned function
+	// is called.
+	PinHeight(height uint64) (unpin func())
+}
+
 // ExtensionSnapshotter is an extension Snapshotter that is appended to the snapshot stream.
 // ExtensionSnapshotter has an unique name and manages it's own internal formats.
 type ExtensionSnapshotter interface {
@@ -18,17 +18,23 @@ type cacheMergeIterator struct {
 	parent    types.Iterator
 	cache     types.Iterator
 	ascending bool
+	// onSkip is called for each skipped entry, if not nil.
+	onSkip func()
 
 	valid bool
 }
 
 var _ types.Iterator = (*cacheMergeIterator)(nil)
 
-func NewCacheMergeIterator(parent, cache types.Iterator, ascending bool) *cacheMergeIterator {
+// NewCacheMergeIterator returns an iterator merging the parent and cache
+// iterators, calling onSkip, if not nil, for each entry it skips: the deleted
+// keys of the cache and the parent entries shadowed by the cache.
+func NewCacheMergeIterator(parent, cache types.Iterator, ascending bool, onSkip func()) *cacheMergeIterator {
 	iter := &cacheMergeIterator{
 		parent:    parent,
 		cache:     cache,
 		ascending: ascending,
+		onSkip:    onSkip,
 	}
 
 	iter.valid = iter.skipUntilExistsOrInvalid()
@@ -82,6 +88,7 @@ func (iter *cacheMergeIterator) Next() {
 		case 0: // parent == cache
 			iter.parent.Next()
 			iter.cache.Next()
+			iter.skipped()
 		case 1: // parent > cache
 			iter.cache.Next()
 		}
@@ -196,6 +203,14 @@ func (iter *cacheMergeIterator) skipCacheDeletes(until []byte) {
 		iter.cache.Value() == nil &&
 		(until == nil || iter.compare(iter.cache.Key(), until) < 0) {
 		iter.cache.Next()
+		iter.skipped()
+	}
+}
+
+// skipped calls onSkip, if any.
+func (iter *cacheMergeIterator) skipped() {
+	if iter.onSkip != nil {
+		iter.onSkip()
 	}
 }
 
@@ -230,6 +245,7 @@ func (iter *cacheMergeIterator) skipUntilExistsOrInvalid() bool {
 			if valueC == nil {
 				iter.parent.Next()
 				iter.cache.Next()
+				iter.skipped()
 
 				continue
 			}
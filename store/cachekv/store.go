@@ -35,7 +35,10 @@ type Store struct {
 	parent        types.KVStore
 }
 
-var _ types.CacheKVStore = (*Store)(nil)
+var (
+	_ types.CacheKVStore         = (*Store)(nil)
+	_ types.MeteredIteratorStore = (*Store)(nil)
+)
 
 // NewStore creates a new Store object
 func NewStore(parent types.KVStore) *Store {
@@ -170,18 +173,37 @@ func (store *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types
 
 // Iterator implements types.KVStore.
 func (store *Store) Iterator(start, end []byte) types.Iterator {
-	return store.iterator(start, end, true)
+	return store.iterator(start, end, true, nil)
 }
 
 // ReverseIterator implements types.KVStore.
 func (store *Store) ReverseIterator(start, end []byte) types.Iterator {
-	return store.iterator(start, end, false)
+	return store.iterator(start, end, false, nil)
+}
+
+// MeteredIterator implements types.MeteredIteratorStore. The meter is called
+// with the pending writes scanned and sorted to create the iterator, and with
+// each entry skipped by the iterator.
+func (store *Store) MeteredIterator(start, end []byte, ascending bool, meter func(types.IteratorWork)) types.Iterator {
+	return store.iterator(start, end, ascending, meter)
 }
 
-func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
+func (store *Store) iterator(start, end []byte, ascending bool, meter func(types.IteratorWork)) types.Iterator {
 	store.mtx.Lock()
 	defer store.mtx.Unlock()
 
+	// the work is metered before the iterators are created, so that they are
+	// not leaked if the meter panics
+	scanned, sorted := store.dirtyItems(start, end)
+
+	var onSkip func()
+	if meter != nil {
+		meter(types.IteratorWork{ScannedKeys: scanned, SortedKeys: sorted})
+		onSkip = func() {
+			meter(types.IteratorWork{SkippedKeys: 1})
+		}
+	}
+
 	var parent, cache types.Iterator
 
 	if ascending {
@@ -190,10 +212,9 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 		parent = store.parent.ReverseIterator(start, end)
 	}
 
-	store.dirtyItems(start, end)
 	cache = internal.NewMemIterator(start, end, store.sortedCache, store.deleted, ascending)
 
-	return internal.NewCacheMergeIterator(parent, cache, ascending)
+	return internal.NewCacheMergeIterator(parent, cache, ascending, onSkip)
 }
 
 func findStartIndex(strL []string, startQ string) int {
@@ -278,12 +299,13 @@ const (
 
 const minSortSize = 1024
 
-// Constructs a slice of dirty items, to use w/ memIterator.
-func (store *Store) dirtyItems(start, end []byte) {
+// Constructs a slice of dirty items, to use w/ memIterator. It returns the
+// number of unsorted dirty items scanned and sorted.
+func (store *Store) dirtyItems(start, end []byte) (scanned, sorted int) {
 	startStr, endStr := conv.UnsafeBytesToStr(start), conv.UnsafeBytesToStr(end)
 	if end != nil && startStr > endStr {
 		// Nothing to do here.
-		return
+		return 0, 0
 	}
 
 	n := len(store.unsortedCache)
@@ -303,7 +325,7 @@ func (store *Store) dirtyItems(start, end []byte) {
 			}
 		}
 		store.clearUnsortedCacheSubset(unsorted, stateUnsorted)
-		return
+		return n, len(unsorted)
 	}
 
 	// Otherwise it is large so perform a modified binary search to find
@@ -336,6 +358,7 @@ func (store *Store) dirtyItems(start, end []byte) {
 
 	// kvL was already sorted so pass it in as is.
 	store.clearUnsortedCacheSubset(kvL, stateAlreadySorted)
+	return n, n
 }
 
 func findStartEndIndex(strL []string, startStr, endStr string, end []byte) (int, int) {
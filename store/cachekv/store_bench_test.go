@@ -1,12 +1,15 @@
 package cachekv_test
 
 import (
+	"fmt"
 	"testing"
 
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/gaskv"
+	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
 var sink interface{}
@@ -127,6 +130,58 @@ func benchmarkIteratorOnParentWithManyDeletes(b *testing.B, numDeletes int) {
 	}
 }
 
+// Benchmark creating an iterator through a gas store over a cache store with
+// numWrites unsorted pending writes, every other one being a deletion, and
+// iterating over the first entry. The gas consumed per iterator is reported
+// with the time, to compare the metering of the cache work with its cost.
+func benchmarkGasIteratorOnDirtyCache(b *testing.B, numWrites int, start, end []byte) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	value := randSlice(32)
+	keys := generateRandomKeys(32, numWrites)
+	for _, k := range keys {
+		mem.Set(k, value)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var gas types.Gas
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		kvstore := cachekv.NewStore(mem)
+		for j, k := range keys {
+			if j%2 == 0 {
+				kvstore.Delete(k)
+			} else {
+				kvstore.Set(k, value)
+			}
+		}
+		meter := types.NewInfiniteGasMeter()
+		gasstore := gaskv.NewStore(kvstore, meter, types.KVGasConfig())
+		b.StartTimer()
+
+		iter := gasstore.Iterator(start, end)
+		if iter.Valid() {
+			sink = iter.Value()
+		}
+		iter.Close()
+		gas += meter.GasConsumed()
+	}
+
+	b.ReportMetric(float64(gas)/float64(b.N), "gas/op")
+}
+
+func BenchmarkGasIteratorOnDirtyCache(b *testing.B) {
+	for _, numWrites := range []int{100, 1000, 10000} {
+		b.Run(fmt.Sprintf("writes=%d/all", numWrites), func(b *testing.B) {
+			benchmarkGasIteratorOnDirtyCache(b, numWrites, nil, nil)
+		})
+		b.Run(fmt.Sprintf("writes=%d/range", numWrites), func(b *testing.B) {
+			benchmarkGasIteratorOnDirtyCache(b, numWrites, []byte{0x80}, []byte{0x81})
+		})
+	}
+}
+
 func BenchmarkBlankParentIteratorNextKeySize32(b *testing.B) {
 	benchmarkBlankParentIteratorNext(b, 32)
 }
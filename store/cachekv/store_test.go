@@ -447,6 +447,57 @@ func TestIteratorDeadlock(t *testing.T) {
 	defer it2.Close()
 }
 
+func TestMeteredIterator(t *testing.T) {
+	newStore := func() *cachekv.Store {
+		mem := dbadapter.Store{DB: dbm.NewMemDB()}
+		for i := 0; i < 10; i++ {
+			mem.Set(keyFmt(i), valFmt(i))
+		}
+		st := cachekv.NewStore(mem)
+		for i := 10; i < 15; i++ {
+			st.Set(keyFmt(i), valFmt(i))
+		}
+		st.Delete(keyFmt(0))
+		st.Delete(keyFmt(1))
+		st.Set(keyFmt(5), valFmt(50))
+		return st
+	}
+
+	iterate := func(st *cachekv.Store, start, end []byte, ascending bool) (types.IteratorWork, int) {
+		var work types.IteratorWork
+		it := st.MeteredIterator(start, end, ascending, func(w types.IteratorWork) {
+			work.ScannedKeys += w.ScannedKeys
+			work.SortedKeys += w.SortedKeys
+			work.SkippedKeys += w.SkippedKeys
+		})
+		defer it.Close()
+
+		n := 0
+		for ; it.Valid(); it.Next() {
+			n++
+		}
+		return work, n
+	}
+
+	// the pending writes are scanned and sorted once, the deleted and
+	// overwritten keys are skipped by each iterator
+	st := newStore()
+	work, n := iterate(st, nil, nil, true)
+	require.Equal(t, 13, n)
+	require.Equal(t, types.IteratorWork{ScannedKeys: 8, SortedKeys: 8, SkippedKeys: 3}, work)
+	work, n = iterate(st, nil, nil, false)
+	require.Equal(t, 13, n)
+	require.Equal(t, types.IteratorWork{SkippedKeys: 3}, work)
+
+	// only the pending writes in the domain are sorted
+	st = newStore()
+	work, n = iterate(st, keyFmt(3), keyFmt(8), true)
+	require.Equal(t, 5, n)
+	require.Equal(t, types.IteratorWork{ScannedKeys: 8, SortedKeys: 1, SkippedKeys: 1}, work)
+	work, _ = iterate(st, nil, nil, true)
+	require.Equal(t, types.IteratorWork{ScannedKeys: 7, SortedKeys: 7, SkippedKeys: 3}, work)
+}
+
 //-------------------------------------------------------------------------------------------
 // do some random ops
 
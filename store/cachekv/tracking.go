@@ -0,0 +1,264 @@
+package cachekv
+
+import (
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv/internal"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// SharedStore is a store read concurrently by the TrackingStores branched from
+// it. The accesses to the parent are serialized, and the parent must not be
+// written by anything else while it is shared.
+type SharedStore struct {
+	mtx    sync.Mutex
+	parent types.KVStore
+}
+
+// NewSharedStore creates a SharedStore reading from parent. If parent is a
+// cache store, its pending writes are sorted upfront, so that its iterators are
+// not invalidated by the iterators created concurrently.
+func NewSharedStore(parent types.KVStore) *SharedStore {
+	if store, ok := parent.(*Store); ok {
+		store.mtx.Lock()
+		store.dirtyItems(nil, nil)
+		store.mtx.Unlock()
+	}
+
+	return &SharedStore{parent: parent}
+}
+
+// NewTrackingStore returns a new branch of the shared store.
+func (s *SharedStore) NewTrackingStore() *TrackingStore {
+	reads := &readTracker{
+		shared: s,
+		keys:   make(map[string]struct{}),
+	}
+
+	return &TrackingStore{
+		Store: NewStore(reads),
+		reads: reads,
+	}
+}
+
+// TrackingStore is a cache store which records the keys and the key ranges it
+// reads from its parent. Together with the keys written to it, they allow to
+// detect the conflicts between executions run concurrently on separate
+// branches of the same state: an execution is only valid if it did not read
+// any key written by the executions ordered before it.
+type TrackingStore struct {
+	*Store
+	reads *readTracker
+}
+
+var _ types.CacheKVStore = (*TrackingStore)(nil)
+
+// Conflicts returns true if the store read a key of the write set, or iterated
+// over a range containing one.
+func (store *TrackingStore) Conflicts(writes *WriteSet) bool {
+	store.reads.mtx.Lock()
+	defer store.reads.mtx.Unlock()
+
+	for key := range store.reads.keys {
+		if writes.keys.Get([]byte(key)) != nil {
+			return true
+		}
+	}
+
+	for _, r := range store.reads.ranges {
+		iter := internal.NewMemIterator(r.start, r.end, writes.keys, nil, true)
+		found := iter.Valid()
+		iter.Close()
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WrittenKeys returns the sorted keys written to the store and not yet written
+// to its parent.
+func (store *TrackingStore) WrittenKeys() [][]byte {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+
+	keys := make([]string, 0, len(store.cache))
+	for key, value := range store.cache {
+		if value.dirty {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	res := make([][]byte, len(keys))
+	for i, key := range keys {
+		res[i] = []byte(key)
+	}
+
+	return res
+}
+
+// WriteSet is a set of written keys, against which the reads of the
+// TrackingStores are checked.
+type WriteSet struct {
+	keys *internal.BTree
+}
+
+// NewWriteSet returns an empty WriteSet.
+func NewWriteSet() *WriteSet {
+	return &WriteSet{keys: internal.NewBTree()}
+}
+
+// Add adds the given keys to the set.
+func (ws *WriteSet) Add(keys [][]byte) {
+	for _, key := range keys {
+		ws.keys.Set(key, []byte{})
+	}
+}
+
+// keyRange is an iterated domain of keys.
+type keyRange struct {
+	start, end []byte
+}
+
+// readTracker is the parent of a TrackingStore, it records the reads made
+// through it and serializes the accesses to the shared store.
+type readTracker struct {
+	shared *SharedStore
+
+	mtx    sync.Mutex
+	keys   map[string]struct{}
+	ranges []keyRange
+}
+
+func (rt *readTracker) recordKey(key []byte) {
+	rt.mtx.Lock()
+	rt.keys[string(key)] = struct{}{}
+	rt.mtx.Unlock()
+}
+
+func (rt *readTracker) recordRange(start, end []byte) {
+	rt.mtx.Lock()
+	rt.ranges = append(rt.ranges, keyRange{
+		start: append([]byte(nil), start...),
+		end:   append([]byte(nil), end...),
+	})
+	rt.mtx.Unlock()
+}
+
+// GetStoreType implements types.KVStore.
+func (rt *readTracker) GetStoreType() types.StoreType {
+	return rt.shared.parent.GetStoreType()
+}
+
+// CacheWrap implements types.KVStore.
+func (rt *readTracker) CacheWrap() types.CacheWrap {
+	panic("cannot CacheWrap a readTracker")
+}
+
+// CacheWrapWithTrace implements types.KVStore.
+func (rt *readTracker) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	panic("cannot CacheWrapWithTrace a readTracker")
+}
+
+// Get implements types.KVStore.
+func (rt *readTracker) Get(key []byte) []byte {
+	rt.recordKey(key)
+
+	rt.shared.mtx.Lock()
+	defer rt.shared.mtx.Unlock()
+
+	return rt.shared.parent.Get(key)
+}
+
+// Has implements types.KVStore.
+func (rt *readTracker) Has(key []byte) bool {
+	rt.recordKey(key)
+
+	rt.shared.mtx.Lock()
+	defer rt.shared.mtx.Unlock()
+
+	return rt.shared.parent.Has(key)
+}
+
+// Set implements types.KVStore.
+func (rt *readTracker) Set(key, value []byte) {
+	rt.shared.mtx.Lock()
+	defer rt.shared.mtx.Unlock()
+
+	rt.shared.parent.Set(key, value)
+}
+
+// Delete implements types.KVStore.
+func (rt *readTracker) Delete(key []byte) {
+	rt.shared.mtx.Lock()
+	defer rt.shared.mtx.Unlock()
+
+	rt.shared.parent.Delete(key)
+}
+
+// Iterator implements types.KVStore.
+func (rt *readTracker) Iterator(start, end []byte) types.Iterator {
+	rt.recordRange(start, end)
+
+	rt.shared.mtx.Lock()
+	defer rt.shared.mtx.Unlock()
+
+	return &sharedIterator{Iterator: rt.shared.parent.Iterator(start, end), mtx: &rt.shared.mtx}
+}
+
+// ReverseIterator implements types.KVStore.
+func (rt *readTracker) ReverseIterator(start, end []byte) types.Iterator {
+	rt.recordRange(start, end)
+
+	rt.shared.mtx.Lock()
+	defer rt.shared.mtx.Unlock()
+
+	return &sharedIterator{Iterator: rt.shared.parent.ReverseIterator(start, end), mtx: &rt.shared.mtx}
+}
+
+// sharedIterator is an iterator of the shared store, whose calls are
+// serialized with the other accesses to the store.
+type sharedIterator struct {
+	types.Iterator
+	mtx *sync.Mutex
+}
+
+func (it *sharedIterator) Valid() bool {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Valid()
+}
+
+func (it *sharedIterator) Next() {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	it.Iterator.Next()
+}
+
+func (it *sharedIterator) Key() []byte {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Key()
+}
+
+func (it *sharedIterator) Value() []byte {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Value()
+}
+
+func (it *sharedIterator) Error() error {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Error()
+}
+
+func (it *sharedIterator) Close() error {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Close()
+}
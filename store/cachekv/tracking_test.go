@@ -0,0 +1,82 @@
+package cachekv_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+)
+
+func TestTrackingStore(t *testing.T) {
+	parent := newCacheKVStore()
+	for i := 0; i < 10; i++ {
+		parent.Set(keyFmt(i), valFmt(i))
+	}
+	shared := cachekv.NewSharedStore(parent)
+
+	// a store reading a key and iterating over a range
+	reader := shared.NewTrackingStore()
+	require.Equal(t, valFmt(1), reader.Get(keyFmt(1)))
+	iter := reader.Iterator(keyFmt(5), keyFmt(8))
+	for ; iter.Valid(); iter.Next() {
+	}
+	require.NoError(t, iter.Close())
+	reader.Set(keyFmt(20), valFmt(20))
+
+	// the keys written by the store are not read from the parent
+	writer := shared.NewTrackingStore()
+	writer.Set(keyFmt(3), valFmt(30))
+	require.Equal(t, valFmt(30), writer.Get(keyFmt(3)))
+	writer.Delete(keyFmt(9))
+	require.Equal(t, [][]byte{keyFmt(3), keyFmt(9)}, writer.WrittenKeys())
+
+	writes := cachekv.NewWriteSet()
+	writes.Add(writer.WrittenKeys())
+	require.False(t, reader.Conflicts(writes))
+	require.False(t, writer.Conflicts(cachekv.NewWriteSet()))
+
+	// a written key read by the store
+	writes.Add([][]byte{keyFmt(1)})
+	require.True(t, reader.Conflicts(writes))
+
+	// a key inserted in an iterated range
+	writes = cachekv.NewWriteSet()
+	writes.Add([][]byte{keyFmt(8), bz("key00000006a")})
+	require.True(t, reader.Conflicts(writes))
+
+	// the writes are only applied to the parent on Write
+	require.Equal(t, valFmt(3), parent.Get(keyFmt(3)))
+	writer.Write()
+	require.Equal(t, valFmt(30), parent.Get(keyFmt(3)))
+	require.Nil(t, parent.Get(keyFmt(9)))
+	require.Empty(t, writer.WrittenKeys())
+}
+
+func TestTrackingStoreConcurrentReads(t *testing.T) {
+	parent := newCacheKVStore()
+	for i := 0; i < 100; i++ {
+		parent.Set(keyFmt(i), valFmt(i))
+	}
+	shared := cachekv.NewSharedStore(parent)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			store := shared.NewTrackingStore()
+			store.Set(keyFmt(i), valFmt(100+i))
+			iter := store.Iterator(nil, nil)
+			defer iter.Close()
+			for j := 0; iter.Valid(); iter.Next() {
+				require.Equal(t, keyFmt(j), iter.Key())
+				require.Equal(t, store.Get(keyFmt(j)), iter.Value())
+				j++
+			}
+		}(i)
+	}
+	wg.Wait()
+}
@@ -81,6 +81,22 @@ func newCacheMultiStoreFromCMS(cms Store) Store {
 	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext)
 }
 
+// WrapStores returns a branch of the multistore whose stores are the given
+// wrappers of its stores. Unlike CacheMultiStore, the stores are not branched
+// with a cache store, so wrap must return a store buffering its writes, e.g. a
+// store tracking the keys accessed through it.
+func (cms Store) WrapStores(wrap func(key types.StoreKey, store types.KVStore) types.CacheWrap) Store {
+	stores := make(map[types.StoreKey]types.CacheWrap, len(cms.stores))
+	for key, store := range cms.stores {
+		stores[key] = wrap(key, store.(types.KVStore))
+	}
+
+	cms.db = cachekv.NewStore(cms.db)
+	cms.stores = stores
+
+	return cms
+}
+
 // SetTracer sets the tracer for the MultiStore that the underlying
 // stores will utilize to trace operations. A MultiStore is returned.
 func (cms Store) SetTracer(w io.Writer) types.MultiStore {
@@ -73,7 +73,9 @@ func (gs *Store) Delete(key []byte) {
 
 // Iterator implements the KVStore interface. It returns an iterator which
 // incurs a flat gas cost for seeking to the first key/value pair and a variable
-// gas cost based on the current value's length if the iterator is valid.
+// gas cost based on the current value's length if the iterator is valid. If the
+// parent is a MeteredIteratorStore, e.g. a cache store, the work it does for the
+// iterator is charged too.
 func (gs *Store) Iterator(start, end []byte) types.Iterator {
 	return gs.iterator(start, end, true)
 }
@@ -98,7 +100,9 @@ func (gs *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.Cac
 
 func (gs *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 	var parent types.Iterator
-	if ascending {
+	if metered, ok := gs.parent.(types.MeteredIteratorStore); ok {
+		parent = metered.MeteredIterator(start, end, ascending, gs.consumeIteratorWorkGas)
+	} else if ascending {
 		parent = gs.parent.Iterator(start, end)
 	} else {
 		parent = gs.parent.ReverseIterator(start, end)
@@ -110,6 +114,20 @@ func (gs *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 	return gi
 }
 
+// consumeIteratorWorkGas consumes the gas of the work done by a cache store for
+// an iterator.
+func (gs *Store) consumeIteratorWorkGas(work types.IteratorWork) {
+	if work.ScannedKeys > 0 {
+		gs.gasMeter.ConsumeGas(gs.gasConfig.CacheScanCostPerKey*types.Gas(work.ScannedKeys), types.GasCacheScanDesc)
+	}
+	if work.SortedKeys > 0 {
+		gs.gasMeter.ConsumeGas(gs.gasConfig.CacheSortCostPerKey*types.Gas(work.SortedKeys), types.GasCacheSortDesc)
+	}
+	if work.SkippedKeys > 0 {
+		gs.gasMeter.ConsumeGas(gs.gasConfig.IterSkipCostFlat*types.Gas(work.SkippedKeys), types.GasIterSkipCostFlatDesc)
+	}
+}
+
 type gasIterator struct {
 	gasMeter  types.GasMeter
 	gasConfig types.GasConfig
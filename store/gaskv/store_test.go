@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/gaskv"
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -105,6 +106,41 @@ func TestGasKVStoreIterator(t *testing.T) {
 	require.Equal(t, types.Gas(15135), meter.GasConsumed())
 }
 
+func TestGasKVStoreCacheIterator(t *testing.T) {
+	iterate := func(parent types.KVStore) types.Gas {
+		meter := types.NewInfiniteGasMeter()
+		st := gaskv.NewStore(parent, meter, types.KVGasConfig())
+		iterator := st.Iterator(nil, nil)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			iterator.Value()
+		}
+		return meter.GasConsumed()
+	}
+
+	// the same keys in a cache store with 4 pending writes, including a deleted
+	// key and an overwritten one, and in a database store
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	for i := 0; i < 4; i++ {
+		mem.Set(keyFmt(i), valFmt(i))
+	}
+	cache := cachekv.NewStore(mem)
+	cache.Set(keyFmt(4), valFmt(4))
+	cache.Set(keyFmt(5), valFmt(5))
+	cache.Set(keyFmt(2), valFmt(2))
+	cache.Delete(keyFmt(0))
+
+	expected := dbadapter.Store{DB: dbm.NewMemDB()}
+	for i := 1; i < 6; i++ {
+		expected.Set(keyFmt(i), valFmt(i))
+	}
+
+	config := types.KVGasConfig()
+	require.Equal(t, iterate(expected)+4*config.CacheScanCostPerKey+4*config.CacheSortCostPerKey+2*config.IterSkipCostFlat, iterate(cache))
+	// the pending writes are only sorted by the first iterator
+	require.Equal(t, iterate(expected)+2*config.IterSkipCostFlat, iterate(cache))
+}
+
 func TestGasKVStoreOutOfGasSet(t *testing.T) {
 	mem := dbadapter.Store{DB: dbm.NewMemDB()}
 	meter := types.NewGasMeter(0)
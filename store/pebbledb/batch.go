@@ -0,0 +1,73 @@
+package pebbledb
+
+import (
+	"github.com/cockroachdb/pebble"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// batch is a dbm.Batch over a PebbleDB batch, which can no longer be used once
+// written or closed.
+type batch struct {
+	batch *pebble.Batch
+}
+
+var _ dbm.Batch = (*batch)(nil)
+
+// Set implements dbm.Batch.
+func (b *batch) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+	if value == nil {
+		return errValueNil
+	}
+	if b.batch == nil {
+		return errBatchClosed
+	}
+
+	return b.batch.Set(key, value, nil)
+}
+
+// Delete implements dbm.Batch.
+func (b *batch) Delete(key []byte) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+	if b.batch == nil {
+		return errBatchClosed
+	}
+
+	return b.batch.Delete(key, nil)
+}
+
+// Write implements dbm.Batch.
+func (b *batch) Write() error {
+	return b.write(pebble.NoSync)
+}
+
+// WriteSync implements dbm.Batch.
+func (b *batch) WriteSync() error {
+	return b.write(pebble.Sync)
+}
+
+func (b *batch) write(opts *pebble.WriteOptions) error {
+	if b.batch == nil {
+		return errBatchClosed
+	}
+	if err := b.batch.Commit(opts); err != nil {
+		return err
+	}
+
+	return b.Close()
+}
+
+// Close implements dbm.Batch.
+func (b *batch) Close() error {
+	if b.batch == nil {
+		return nil
+	}
+	err := b.batch.Close()
+	b.batch = nil
+
+	return err
+}
@@ -0,0 +1,156 @@
+package pebbledb
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/cockroachdb/pebble"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// BackendType is the backend type of the databases opened by NewDB, which
+// tm-db does not provide.
+const BackendType dbm.BackendType = "pebbledb"
+
+var (
+	errBatchClosed = errors.New("batch has been written or closed")
+	errKeyEmpty    = errors.New("key cannot be empty")
+	errValueNil    = errors.New("value cannot be nil")
+)
+
+// DB is a dbm.DB backed by a PebbleDB database.
+type DB struct {
+	db *pebble.DB
+}
+
+var _ dbm.DB = (*DB)(nil)
+
+// NewDB opens the PebbleDB database name.db in dir, creating it if needed.
+func NewDB(name, dir string) (*DB, error) {
+	db, err := pebble.Open(filepath.Join(dir, name+".db"), &pebble.Options{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &DB{db: db}, nil
+}
+
+// Get implements dbm.DB.
+func (db *DB) Get(key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, errKeyEmpty
+	}
+
+	value, closer, err := db.db.Get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer closer.Close()
+
+	// the value is only valid until the closer is closed
+	return append([]byte{}, value...), nil
+}
+
+// Has implements dbm.DB.
+func (db *DB) Has(key []byte) (bool, error) {
+	value, err := db.Get(key)
+	if err != nil {
+		return false, err
+	}
+
+	return value != nil, nil
+}
+
+// Set implements dbm.DB.
+func (db *DB) Set(key, value []byte) error {
+	return db.set(key, value, pebble.NoSync)
+}
+
+// SetSync implements dbm.DB.
+func (db *DB) SetSync(key, value []byte) error {
+	return db.set(key, value, pebble.Sync)
+}
+
+func (db *DB) set(key, value []byte, opts *pebble.WriteOptions) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+	if value == nil {
+		return errValueNil
+	}
+
+	return db.db.Set(key, value, opts)
+}
+
+// Delete implements dbm.DB.
+func (db *DB) Delete(key []byte) error {
+	return db.delete(key, pebble.NoSync)
+}
+
+// DeleteSync implements dbm.DB.
+func (db *DB) DeleteSync(key []byte) error {
+	return db.delete(key, pebble.Sync)
+}
+
+func (db *DB) delete(key []byte, opts *pebble.WriteOptions) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+
+	return db.db.Delete(key, opts)
+}
+
+// Iterator implements dbm.DB.
+func (db *DB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	return db.newIterator(start, end, false)
+}
+
+// ReverseIterator implements dbm.DB.
+func (db *DB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	return db.newIterator(start, end, true)
+}
+
+func (db *DB) newIterator(start, end []byte, reverse bool) (dbm.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, errKeyEmpty
+	}
+
+	iter := db.db.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	return newIterator(iter, start, end, reverse), nil
+}
+
+// NewBatch implements dbm.DB.
+func (db *DB) NewBatch() dbm.Batch {
+	return &batch{batch: db.db.NewBatch()}
+}
+
+// Close implements dbm.DB.
+func (db *DB) Close() error {
+	return db.db.Close()
+}
+
+// Print implements dbm.DB.
+func (db *DB) Print() error {
+	iter, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		fmt.Printf("[%X]:\t[%X]\n", iter.Key(), iter.Value())
+	}
+
+	return iter.Error()
+}
+
+// Stats implements dbm.DB.
+func (db *DB) Stats() map[string]string {
+	return map[string]string{
+		"pebbledb.metrics": db.db.Metrics().String(),
+	}
+}
@@ -0,0 +1,76 @@
+package pebbledb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/pebbledb"
+)
+
+func iterate(t *testing.T, iter dbm.Iterator) []string {
+	defer iter.Close()
+	var kvs []string
+	for ; iter.Valid(); iter.Next() {
+		kvs = append(kvs, string(iter.Key())+"="+string(iter.Value()))
+	}
+	require.NoError(t, iter.Error())
+	return kvs
+}
+
+func TestDB(t *testing.T) {
+	dir := t.TempDir()
+	db, err := pebbledb.NewDB("test", dir)
+	require.NoError(t, err)
+
+	require.NoError(t, db.Set([]byte("a"), []byte("1")))
+	require.NoError(t, db.SetSync([]byte("b"), []byte("2")))
+	require.NoError(t, db.Set([]byte("c"), []byte{}))
+	require.NoError(t, db.Set([]byte("d"), []byte("4")))
+	require.NoError(t, db.DeleteSync([]byte("d")))
+	require.Error(t, db.Set(nil, []byte("1")))
+	require.Error(t, db.Set([]byte("e"), nil))
+
+	value, err := db.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+	value, err = db.Get([]byte("d"))
+	require.NoError(t, err)
+	require.Nil(t, value)
+	ok, err := db.Has([]byte("c"))
+	require.NoError(t, err)
+	require.True(t, ok)
+	_, err = db.Get([]byte{})
+	require.Error(t, err)
+
+	iter, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a=1", "b=2", "c="}, iterate(t, iter))
+	iter, err = db.Iterator([]byte("b"), []byte("c"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"b=2"}, iterate(t, iter))
+	iter, err = db.ReverseIterator([]byte("a"), []byte("c"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"b=2", "a=1"}, iterate(t, iter))
+	_, err = db.Iterator([]byte{}, nil)
+	require.Error(t, err)
+
+	batch := db.NewBatch()
+	require.NoError(t, batch.Set([]byte("d"), []byte("4")))
+	require.NoError(t, batch.Delete([]byte("a")))
+	require.NoError(t, batch.Write())
+	require.Error(t, batch.Set([]byte("e"), []byte("5")))
+	require.Error(t, batch.Write())
+	require.NoError(t, batch.Close())
+
+	// the writes are persisted when the database is reopened
+	require.NoError(t, db.Close())
+	db, err = pebbledb.NewDB("test", dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	iter, err = db.Iterator(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"b=2", "c=", "d=4"}, iterate(t, iter))
+}
@@ -0,0 +1,74 @@
+package pebbledb
+
+import (
+	"github.com/cockroachdb/pebble"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// iterator is a dbm.Iterator over a PebbleDB iterator bounded by its domain.
+type iterator struct {
+	source     *pebble.Iterator
+	start, end []byte
+	reverse    bool
+}
+
+var _ dbm.Iterator = (*iterator)(nil)
+
+func newIterator(source *pebble.Iterator, start, end []byte, reverse bool) *iterator {
+	if reverse {
+		source.Last()
+	} else {
+		source.First()
+	}
+
+	return &iterator{source: source, start: start, end: end, reverse: reverse}
+}
+
+// Domain implements dbm.Iterator.
+func (it *iterator) Domain() ([]byte, []byte) {
+	return it.start, it.end
+}
+
+// Valid implements dbm.Iterator.
+func (it *iterator) Valid() bool {
+	return it.source.Valid()
+}
+
+// Next implements dbm.Iterator.
+func (it *iterator) Next() {
+	it.assertValid()
+	if it.reverse {
+		it.source.Prev()
+	} else {
+		it.source.Next()
+	}
+}
+
+// Key implements dbm.Iterator.
+func (it *iterator) Key() []byte {
+	it.assertValid()
+	// the key is only valid until the next move of the iterator
+	return append([]byte{}, it.source.Key()...)
+}
+
+// Value implements dbm.Iterator.
+func (it *iterator) Value() []byte {
+	it.assertValid()
+	return append([]byte{}, it.source.Value()...)
+}
+
+// Error implements dbm.Iterator.
+func (it *iterator) Error() error {
+	return it.source.Error()
+}
+
+// Close implements dbm.Iterator.
+func (it *iterator) Close() error {
+	return it.source.Close()
+}
+
+func (it *iterator) assertValid() {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+}
@@ -0,0 +1,209 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// ChangeType is the type of the change of a key between two versions.
+type ChangeType int
+
+const (
+	// ChangeAdded is a key absent at the first version.
+	ChangeAdded ChangeType = iota
+	// ChangeModified is a key whose value differs between the versions.
+	ChangeModified
+	// ChangeDeleted is a key absent at the second version.
+	ChangeDeleted
+)
+
+func (c ChangeType) String() string {
+	switch c {
+	case ChangeAdded:
+		return "added"
+	case ChangeModified:
+		return "modified"
+	case ChangeDeleted:
+		return "deleted"
+	default:
+		return fmt.Sprintf("ChangeType(%d)", int(c))
+	}
+}
+
+// KVChange is the change of a key of a store between two versions.
+type KVChange struct {
+	StoreName string
+	Type      ChangeType
+	Key       []byte
+	// OldValue is the value at the first version, nil if the key was added.
+	OldValue []byte
+	// NewValue is the value at the second version, nil if the key was deleted.
+	NewValue []byte
+}
+
+// Diff calls fn with the changes of the keys between the versions from and to,
+// ordered by store name and key. The changes are limited to the given stores
+// and to the keys with one of the given prefixes, if any. Both versions must
+// exist in the IAVL stores.
+//
+// The stores whose commit hash is the same at both versions are skipped, the
+// others are compared by iterating over both versions of their trees.
+func (rs *Store) Diff(from, to int64, storeNames []string, prefixes [][]byte, fn func(change KVChange) error) error {
+	fromInfo, err := getCommitInfo(rs.db, from)
+	if err != nil {
+		return err
+	}
+	toInfo, err := getCommitInfo(rs.db, to)
+	if err != nil {
+		return err
+	}
+
+	if len(storeNames) == 0 {
+		for name, key := range rs.keysByName {
+			if rs.stores[key].GetStoreType() == types.StoreTypeIAVL {
+				storeNames = append(storeNames, name)
+			}
+		}
+	}
+	storeNames = append([]string{}, storeNames...)
+	sort.Strings(storeNames)
+
+	for _, name := range storeNames {
+		key, ok := rs.keysByName[name]
+		if !ok {
+			return fmt.Errorf("unknown store %s", name)
+		}
+		store, ok := rs.GetCommitKVStore(key).(*iavl.Store)
+		if !ok {
+			return fmt.Errorf("store %s is not an IAVL store", name)
+		}
+
+		fromID, toID := commitID(fromInfo, name), commitID(toInfo, name)
+		if bytes.Equal(fromID.Hash, toID.Hash) {
+			continue
+		}
+
+		fromStore, err := immutableVersion(store, name, fromID, from)
+		if err != nil {
+			return err
+		}
+		toStore, err := immutableVersion(store, name, toID, to)
+		if err != nil {
+			return err
+		}
+
+		for _, prefix := range diffPrefixes(prefixes) {
+			if err := diffStores(name, fromStore, toStore, prefix, fn); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// commitID returns the commit ID of the named store in the commit info, empty
+// if the store is not found.
+func commitID(info *types.CommitInfo, name string) types.CommitID {
+	for _, storeInfo := range info.StoreInfos {
+		if storeInfo.Name == name {
+			return storeInfo.CommitId
+		}
+	}
+	return types.CommitID{}
+}
+
+// immutableVersion returns the given version of the store, empty if the store
+// was not committed at this version, e.g. added by a later upgrade.
+func immutableVersion(store *iavl.Store, name string, id types.CommitID, version int64) (types.KVStore, error) {
+	if id.Version == 0 {
+		return dbadapter.Store{DB: dbm.NewMemDB()}, nil
+	}
+
+	immutable, err := store.GetImmutable(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load store %s at version %d: %w", name, version, err)
+	}
+	return immutable, nil
+}
+
+// diffPrefixes returns the sorted prefixes without the ones starting with
+// another prefix, which are already iterated. A nil prefix iterates all the
+// keys.
+func diffPrefixes(prefixes [][]byte) [][]byte {
+	if len(prefixes) == 0 {
+		return [][]byte{nil}
+	}
+
+	sorted := append([][]byte{}, prefixes...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i], sorted[j]) < 0
+	})
+
+	var res [][]byte
+	for _, prefix := range sorted {
+		if len(res) > 0 && bytes.HasPrefix(prefix, res[len(res)-1]) {
+			continue
+		}
+		res = append(res, prefix)
+	}
+
+	return res
+}
+
+// diffStores calls fn with the changes between the keys with the given prefix
+// of two versions of a store, iterating over both versions in order.
+func diffStores(name string, fromStore, toStore types.KVStore, prefix []byte, fn func(change KVChange) error) error {
+	fromIter := types.KVStorePrefixIterator(fromStore, prefix)
+	defer fromIter.Close()
+	toIter := types.KVStorePrefixIterator(toStore, prefix)
+	defer toIter.Close()
+
+	for fromIter.Valid() || toIter.Valid() {
+		var change KVChange
+		cmp := 0
+		switch {
+		case !fromIter.Valid():
+			cmp = 1
+		case !toIter.Valid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(fromIter.Key(), toIter.Key())
+		}
+
+		switch {
+		case cmp < 0:
+			change = KVChange{Type: ChangeDeleted, Key: fromIter.Key(), OldValue: fromIter.Value()}
+			fromIter.Next()
+		case cmp > 0:
+			change = KVChange{Type: ChangeAdded, Key: toIter.Key(), NewValue: toIter.Value()}
+			toIter.Next()
+		default:
+			if bytes.Equal(fromIter.Value(), toIter.Value()) {
+				fromIter.Next()
+				toIter.Next()
+				continue
+			}
+			change = KVChange{Type: ChangeModified, Key: fromIter.Key(), OldValue: fromIter.Value(), NewValue: toIter.Value()}
+			fromIter.Next()
+			toIter.Next()
+		}
+
+		change.StoreName = name
+		if err := fn(change); err != nil {
+			return err
+		}
+	}
+
+	if err := fromIter.Error(); err != nil {
+		return err
+	}
+	return toIter.Error()
+}
@@ -0,0 +1,74 @@
+package rootmulti
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestMultistoreDiff(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	store1, store2 := ms.GetKVStore(testStoreKey1), ms.GetKVStore(testStoreKey2)
+	store1.Set([]byte("a/1"), []byte("1"))
+	store1.Set([]byte("a/2"), []byte("2"))
+	store1.Set([]byte("b/1"), []byte("1"))
+	store2.Set([]byte("a/1"), []byte("1"))
+	ms.Commit()
+
+	store1.Set([]byte("a/0"), []byte("0"))
+	store1.Set([]byte("a/1"), []byte("10"))
+	store1.Delete([]byte("a/2"))
+	store1.Set([]byte("b/1"), []byte("1"))
+	store1.Set([]byte("c/1"), []byte("1"))
+	ms.Commit()
+	ms.Commit()
+
+	diff := func(from, to int64, storeNames []string, prefixes ...[]byte) ([]KVChange, error) {
+		var changes []KVChange
+		err := ms.Diff(from, to, storeNames, prefixes, func(change KVChange) error {
+			changes = append(changes, change)
+			return nil
+		})
+		return changes, err
+	}
+
+	changes, err := diff(1, 3, nil)
+	require.NoError(t, err)
+	require.Equal(t, []KVChange{
+		{StoreName: "store1", Type: ChangeAdded, Key: []byte("a/0"), NewValue: []byte("0")},
+		{StoreName: "store1", Type: ChangeModified, Key: []byte("a/1"), OldValue: []byte("1"), NewValue: []byte("10")},
+		{StoreName: "store1", Type: ChangeDeleted, Key: []byte("a/2"), OldValue: []byte("2")},
+		{StoreName: "store1", Type: ChangeAdded, Key: []byte("c/1"), NewValue: []byte("1")},
+	}, changes)
+
+	// the changes are reversed between the versions in the other order
+	changes, err = diff(2, 1, []string{"store1", "store2"}, []byte("a/2"), []byte("c/"), []byte("a/"))
+	require.NoError(t, err)
+	require.Equal(t, []KVChange{
+		{StoreName: "store1", Type: ChangeDeleted, Key: []byte("a/0"), OldValue: []byte("0")},
+		{StoreName: "store1", Type: ChangeModified, Key: []byte("a/1"), OldValue: []byte("10"), NewValue: []byte("1")},
+		{StoreName: "store1", Type: ChangeAdded, Key: []byte("a/2"), NewValue: []byte("2")},
+		{StoreName: "store1", Type: ChangeDeleted, Key: []byte("c/1"), OldValue: []byte("1")},
+	}, changes)
+
+	changes, err = diff(2, 3, nil)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	_, err = diff(1, 4, nil)
+	require.Error(t, err)
+	_, err = diff(1, 2, []string{"unknown"})
+	require.Error(t, err)
+
+	// the errors of fn stop the diff
+	errStop := errors.New("stop")
+	err = ms.Diff(1, 2, nil, nil, func(KVChange) error { return errStop })
+	require.ErrorIs(t, err, errStop)
+}
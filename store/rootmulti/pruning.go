@@ -0,0 +1,253 @@
+package rootmulti
+
+import (
+	"sort"
+	"time"
+
+	iavltree "github.com/cosmos/iavl"
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// pruneQueueSize is the number of heights which can be queued for pruning
+// before Commit blocks on the pruning worker.
+const pruneQueueSize = 1000
+
+// Pruning is done by a background worker so that it does not block Commit.
+// At every pruning interval, Commit moves the heights to prune to a bounded
+// queue, from which the worker deletes them from the IAVL stores. The heights
+// which are not pruned yet, whether they are queued or not, are persisted on
+// every commit and after every pruning run, so that they are pruned after a
+// restart. The worker only holds the lock of the IAVL trees while pruning a
+// single store, so Commit never waits for more than the deletion of a batch of
+// heights in one store.
+
+// PinHeight keeps the given height from being pruned until the returned
+// function is called, e.g. while a snapshot of the height is taken. The pinned
+// heights which are due are pruned at the next pruning interval once unpinned.
+func (rs *Store) PinHeight(height uint64) (unpin func()) {
+	h := int64(height)
+
+	rs.pruneMtx.Lock()
+	rs.pinnedHeights[h]++
+	rs.pruneMtx.Unlock()
+
+	return func() {
+		rs.pruneMtx.Lock()
+		defer rs.pruneMtx.Unlock()
+
+		if rs.pinnedHeights[h]--; rs.pinnedHeights[h] <= 0 {
+			delete(rs.pinnedHeights, h)
+		}
+	}
+}
+
+// WaitForPruning blocks until the heights queued for pruning are pruned.
+func (rs *Store) WaitForPruning() {
+	rs.pruneWg.Wait()
+}
+
+// PruneStores will batch delete a list of heights from each mounted sub-store.
+// If clearStorePruningHeihgts is true, store's pruneHeights is appended to the
+// pruningHeights and reset after finishing pruning. Unlike the pruning done on
+// Commit, the heights are pruned synchronously.
+func (rs *Store) PruneStores(clearStorePruningHeihgts bool, pruningHeights []int64) {
+	if clearStorePruningHeihgts {
+		rs.pruneMtx.Lock()
+		pruningHeights = append(pruningHeights, rs.pruneHeights...)
+		rs.pruneMtx.Unlock()
+	}
+
+	if len(pruningHeights) == 0 {
+		return
+	}
+
+	if err := rs.deleteVersions(pruningHeights); err != nil {
+		panic(err)
+	}
+
+	if clearStorePruningHeihgts {
+		rs.pruneMtx.Lock()
+		rs.pruneHeights = removeHeights(rs.pruneHeights, pruningHeights)
+		rs.pruneMtx.Unlock()
+	}
+}
+
+// queuePruning queues the heights for the pruning worker, starting it if it is
+// not running. It blocks while the queue is full.
+func (rs *Store) queuePruning(heights []int64) {
+	if len(heights) == 0 {
+		return
+	}
+
+	rs.pruneWg.Add(len(heights))
+	for _, h := range heights {
+		rs.pruneQueue <- h
+	}
+
+	rs.pruneMtx.Lock()
+	defer rs.pruneMtx.Unlock()
+
+	if !rs.pruneRunning {
+		rs.pruneRunning = true
+		go rs.pruneWorker()
+	}
+}
+
+// pruneWorker prunes the queued heights until the queue is empty.
+func (rs *Store) pruneWorker() {
+	for {
+		rs.pruneMtx.Lock()
+		if len(rs.pruneQueue) == 0 {
+			rs.pruneRunning = false
+			rs.pruneMtx.Unlock()
+			return
+		}
+		rs.pruneMtx.Unlock()
+
+		heights := []int64{<-rs.pruneQueue}
+	drain:
+		for {
+			select {
+			case h := <-rs.pruneQueue:
+				heights = append(heights, h)
+			default:
+				break drain
+			}
+		}
+
+		rs.pruneHeightsBatch(heights)
+	}
+}
+
+// pruneHeightsBatch prunes a batch of queued heights. The pinned heights, and
+// the heights which fail to be pruned, are pruned at the next interval.
+func (rs *Store) pruneHeightsBatch(heights []int64) {
+	defer rs.pruneWg.Add(-len(heights))
+	defer telemetry.MeasureSince(time.Now(), "store", "pruning", "prune")
+
+	rs.pruneMtx.Lock()
+	var prune, retry []int64
+	for _, h := range heights {
+		if rs.pinnedHeights[h] > 0 {
+			retry = append(retry, h)
+		} else {
+			prune = append(prune, h)
+		}
+	}
+	rs.pruneMtx.Unlock()
+
+	err := rs.deleteVersions(prune)
+	if err != nil {
+		rs.logger.Error("failed to prune heights", "heights", prune, "err", err)
+		retry = append(retry, prune...)
+		prune = nil
+	}
+
+	rs.pruneMtx.Lock()
+	defer rs.pruneMtx.Unlock()
+
+	rs.prunePending = removeHeights(rs.prunePending, heights)
+	rs.pruneHeights = append(rs.pruneHeights, retry...)
+	sort.Slice(rs.pruneHeights, func(i, j int) bool { return rs.pruneHeights[i] < rs.pruneHeights[j] })
+
+	for _, h := range prune {
+		if h > rs.prunedHeight {
+			rs.prunedHeight = h
+		}
+	}
+
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+	setPruningHeights(batch, rs.heightsToPrune())
+	if err := batch.Write(); err != nil {
+		rs.logger.Error("failed to persist pruning heights", "err", err)
+	}
+
+	rs.emitPruningMetrics()
+}
+
+// deleteVersions deletes the heights from every IAVL store, holding the lock of
+// the IAVL trees for one store at a time.
+func (rs *Store) deleteVersions(heights []int64) error {
+	if len(heights) == 0 {
+		return nil
+	}
+
+	rs.treesMtx.Lock()
+	keys := make([]types.StoreKey, 0, len(rs.stores))
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeIAVL {
+			keys = append(keys, key)
+		}
+	}
+	rs.treesMtx.Unlock()
+
+	for _, key := range keys {
+		if err := rs.deleteStoreVersions(key, heights); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (rs *Store) deleteStoreVersions(key types.StoreKey, heights []int64) error {
+	rs.treesMtx.Lock()
+	defer rs.treesMtx.Unlock()
+
+	// If the store is wrapped with an inter-block cache, we must first unwrap
+	// it to get the underlying IAVL store.
+	store, ok := rs.GetCommitKVStore(key).(*iavl.Store)
+	if !ok {
+		return nil
+	}
+
+	// DeleteVersions sorts the heights in place
+	if err := store.DeleteVersions(append([]int64{}, heights...)...); err != nil {
+		if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// heightsToPrune returns the sorted heights which are not pruned yet, whether
+// they are queued or not. The caller must hold pruneMtx.
+func (rs *Store) heightsToPrune() []int64 {
+	heights := make([]int64, 0, len(rs.prunePending)+len(rs.pruneHeights))
+	heights = append(heights, rs.prunePending...)
+	heights = append(heights, rs.pruneHeights...)
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+
+	return heights
+}
+
+// emitPruningMetrics reports the lag between the committed and the pruned
+// heights, and the number of heights waiting to be pruned. The caller must hold
+// pruneMtx.
+func (rs *Store) emitPruningMetrics() {
+	telemetry.SetGauge(float32(rs.committedHeight-rs.prunedHeight), "store", "pruning", "lag")
+	telemetry.SetGauge(float32(len(rs.prunePending)+len(rs.pruneHeights)), "store", "pruning", "pending")
+}
+
+// removeHeights returns the heights which are not in the removed ones.
+func removeHeights(heights []int64, removed []int64) []int64 {
+	set := make(map[int64]bool, len(removed))
+	for _, h := range removed {
+		set[h] = true
+	}
+
+	res := make([]int64, 0, len(heights))
+	for _, h := range heights {
+		if !set[h] {
+			res = append(res, h)
+		}
+	}
+
+	return res
+}
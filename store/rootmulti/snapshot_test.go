@@ -206,7 +206,7 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 
 	streamReader, err := snapshots.NewStreamReader(chunks)
 	require.NoError(t, err)
-	nextItem, err := target.Restore(version, snapshottypes.CurrentFormat, streamReader)
+	nextItem, err := target.Restore(version, snapshottypes.FormatSequential, streamReader)
 	require.NoError(t, err)
 	require.Equal(t, *dummyExtensionItem.GetExtension(), *nextItem.GetExtension())
 
@@ -223,6 +223,41 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+func TestMultistoreParallelSnapshotRestore(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+
+	sourceStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	sourceManager := snapshots.NewManager(sourceStore, source)
+	snapshot, err := sourceManager.Create(version)
+	require.NoError(t, err)
+	require.Equal(t, snapshottypes.FormatParallel, snapshot.Format)
+
+	// every IAVL store has its own chunk
+	require.EqualValues(t, 3, snapshot.Chunks)
+
+	targetStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	targetManager := snapshots.NewManager(targetStore, target)
+	require.NoError(t, targetManager.Restore(*snapshot))
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := sourceManager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		_, err = targetManager.RestoreChunk(chunk)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for key, sourceStore := range source.GetStores() {
+		if sourceStore.GetStoreType() == types.StoreTypeIAVL {
+			targetStore := target.GetStoreByName(key.Name()).(types.CommitKVStore)
+			assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+		}
+	}
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
 
@@ -286,7 +321,7 @@ func benchmarkMultistoreSnapshotRestore(b *testing.B, stores uint8, storeKeys ui
 		}()
 		reader, err := snapshots.NewStreamReader(chunks)
 		require.NoError(b, err)
-		_, err = target.Restore(version, snapshottypes.CurrentFormat, reader)
+		_, err = target.Restore(version, snapshottypes.FormatSequential, reader)
 		require.NoError(b, err)
 		require.Equal(b, source.LastCommitID(), target.LastCommitID())
 	}
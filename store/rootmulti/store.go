@@ -64,6 +64,19 @@ type Store struct {
 	interBlockCache types.MultiStorePersistentCache
 
 	listeners map[types.StoreKey][]types.WriteListener
+
+	// treesMtx guards the IAVL trees against the pruning worker, see pruning.go
+	treesMtx sync.Mutex
+
+	// pruneMtx guards pruneHeights and the pruning state below
+	pruneMtx        sync.Mutex
+	pruneQueue      chan int64
+	pruneRunning    bool
+	pruneWg         sync.WaitGroup
+	prunePending    []int64
+	pinnedHeights   map[int64]int
+	committedHeight int64
+	prunedHeight    int64
 }
 
 var (
@@ -87,9 +100,24 @@ func NewStore(db dbm.DB, logger log.Logger) *Store {
 		keysByName:          make(map[string]types.StoreKey),
 		pruneHeights:        make([]int64, 0),
 		listeners:           make(map[types.StoreKey][]types.WriteListener),
+		pruneQueue:          make(chan int64, pruneQueueSize),
+		pinnedHeights:       make(map[int64]int),
 	}
 }
 
+// EmptyCopy returns a new store over db with the same stores mounted, which must
+// then be loaded or restored. The stores mounted with their own database are
+// mounted in db. The copy does not prune its heights.
+func (rs *Store) EmptyCopy(db dbm.DB) *Store {
+	store := NewStore(db, rs.logger)
+	store.iavlCacheSize = rs.iavlCacheSize
+	for key, params := range rs.storesParams {
+		store.MountStoreWithDB(key, params.typ, nil)
+	}
+
+	return store
+}
+
 // GetPruning fetches the pruning strategy from the root store.
 func (rs *Store) GetPruning() types.PruningOptions {
 	return rs.pruningOpts
@@ -188,6 +216,9 @@ func (rs *Store) LoadVersion(ver int64) error {
 }
 
 func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
+	rs.treesMtx.Lock()
+	defer rs.treesMtx.Unlock()
+
 	infos := make(map[string]types.StoreInfo)
 
 	cInfo := &types.CommitInfo{}
@@ -266,7 +297,9 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	// load any pruned heights we missed from disk to be pruned on the next run
 	ph, err := getPruningHeights(rs.db)
 	if err == nil && len(ph) > 0 {
-		rs.pruneHeights = ph
+		rs.pruneMtx.Lock()
+		rs.pruneHeights = removeHeights(ph, rs.prunePending)
+		rs.pruneMtx.Unlock()
 	}
 
 	return nil
@@ -402,7 +435,11 @@ func (rs *Store) Commit() types.CommitID {
 		version = previousHeight + 1
 	}
 
+	rs.treesMtx.Lock()
 	rs.lastCommitInfo = commitStores(version, rs.stores)
+	rs.treesMtx.Unlock()
+
+	rs.pruneMtx.Lock()
 
 	// Determine if pruneHeight height needs to be added to the list of heights to
 	// be pruned, where pruneHeight = (commitHeight - 1) - KeepRecent.
@@ -418,12 +455,22 @@ func (rs *Store) Commit() types.CommitID {
 		}
 	}
 
-	// batch prune if the current height is a pruning interval height
+	// queue the heights for the pruning worker if the current height is a
+	// pruning interval height
+	var pruneHeights []int64
 	if rs.pruningOpts.Interval > 0 && version%int64(rs.pruningOpts.Interval) == 0 {
-		rs.PruneStores(true, nil)
+		pruneHeights = rs.pruneHeights
+		rs.prunePending = append(rs.prunePending, pruneHeights...)
+		rs.pruneHeights = make([]int64, 0)
 	}
 
-	flushMetadata(rs.db, version, rs.lastCommitInfo, rs.pruneHeights)
+	flushMetadata(rs.db, version, rs.lastCommitInfo, rs.heightsToPrune())
+
+	rs.committedHeight = version
+	rs.emitPruningMetrics()
+	rs.pruneMtx.Unlock()
+
+	rs.queuePruning(pruneHeights)
 
 	return types.CommitID{
 		Version: version,
@@ -431,37 +478,6 @@ func (rs *Store) Commit() types.CommitID {
 	}
 }
 
-// PruneStores will batch delete a list of heights from each mounted sub-store.
-// If clearStorePruningHeihgts is true, store's pruneHeights is appended to the
-// pruningHeights and reset after finishing pruning.
-func (rs *Store) PruneStores(clearStorePruningHeihgts bool, pruningHeights []int64) {
-	if clearStorePruningHeihgts {
-		pruningHeights = append(pruningHeights, rs.pruneHeights...)
-	}
-
-	if len(rs.pruneHeights) == 0 {
-		return
-	}
-
-	for key, store := range rs.stores {
-		if store.GetStoreType() == types.StoreTypeIAVL {
-			// If the store is wrapped with an inter-block cache, we must first unwrap
-			// it to get the underlying IAVL store.
-			store = rs.GetCommitKVStore(key)
-
-			if err := store.(*iavl.Store).DeleteVersions(pruningHeights...); err != nil {
-				if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
-					panic(err)
-				}
-			}
-		}
-	}
-
-	if clearStorePruningHeihgts {
-		rs.pruneHeights = make([]int64, 0)
-	}
-}
-
 // CacheWrap implements CacheWrapper/Store/CommitStore.
 func (rs *Store) CacheWrap() types.CacheWrap {
 	return rs.CacheMultiStore().(types.CacheWrap)
@@ -488,6 +504,21 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.getTracingContext())
 }
 
+// VersionExists returns whether the given version of all the IAVL stores is
+// available, i.e. it was committed and not pruned, without loading it.
+func (rs *Store) VersionExists(version int64) bool {
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+		if !rs.GetCommitKVStore(key).(*iavl.Store).VersionExists(version) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
 // attempts to load stores at a given version (height). An error is returned if
 // any store cannot be loaded. This should only be used for querying and
@@ -680,18 +711,83 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 // given format changes (at the byte level), the snapshot format must be bumped - see
 // TestMultistoreSnapshot_Checksum test.
 func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	stores, err := rs.snapshotStores(height)
+	if err != nil {
+		return err
+	}
+
+	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
+	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
+	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
+	// are demarcated by new SnapshotStore items.
+	for _, store := range stores {
+		err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_Store{
+				Store: &snapshottypes.SnapshotStoreItem{
+					Name: store.name,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := exportStore(store.Store, height, protoWriter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SnapshotStoreNames implements snapshottypes.ParallelSnapshotter.
+func (rs *Store) SnapshotStoreNames(height uint64) ([]string, error) {
+	stores, err := rs.snapshotStores(height)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(stores))
+	for _, store := range stores {
+		names = append(names, store.name)
+	}
+
+	return names, nil
+}
+
+// SnapshotStore implements snapshottypes.ParallelSnapshotter. Unlike Snapshot,
+// only the SnapshotIAVLItem items of the store are written.
+func (rs *Store) SnapshotStore(height uint64, name string, protoWriter protoio.Writer) error {
+	stores, err := rs.snapshotStores(height)
+	if err != nil {
+		return err
+	}
+
+	for _, store := range stores {
+		if store.name == name {
+			return exportStore(store.Store, height, protoWriter)
+		}
+	}
+
+	return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot unknown store %q", name)
+}
+
+// namedStore is an IAVL store to snapshot.
+type namedStore struct {
+	*iavl.Store
+	name string
+}
+
+// snapshotStores returns the stores to snapshot at the height, sorted by name.
+func (rs *Store) snapshotStores(height uint64) ([]namedStore, error) {
 	if height == 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
 	}
 	if height > uint64(rs.LastCommitID().Version) {
-		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
 	}
 
 	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
-	}
 	stores := []namedStore{}
 	for key := range rs.stores {
 		switch store := rs.GetCommitKVStore(key).(type) {
@@ -701,7 +797,7 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 			// Non-persisted stores shouldn't be snapshotted
 			continue
 		default:
-			return sdkerrors.Wrapf(sdkerrors.ErrLogic,
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic,
 				"don't know how to snapshot store %q of type %T", key.Name(), store)
 		}
 	}
@@ -709,49 +805,37 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 		return strings.Compare(stores[i].name, stores[j].name) == -1
 	})
 
-	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
-	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
-	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
-	// are demarcated by new SnapshotStore items.
-	for _, store := range stores {
-		exporter, err := store.Export(int64(height))
-		if err != nil {
+	return stores, nil
+}
+
+// exportStore writes the nodes of the IAVL store at the height as SnapshotIAVLItem items.
+func exportStore(store *iavl.Store, height uint64, protoWriter protoio.Writer) error {
+	exporter, err := store.Export(int64(height))
+	if err != nil {
+		return err
+	}
+	defer exporter.Close()
+
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ExportDone {
+			break
+		} else if err != nil {
 			return err
 		}
-		defer exporter.Close()
 		err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-			Item: &snapshottypes.SnapshotItem_Store{
-				Store: &snapshottypes.SnapshotStoreItem{
-					Name: store.name,
+			Item: &snapshottypes.SnapshotItem_IAVL{
+				IAVL: &snapshottypes.SnapshotIAVLItem{
+					Key:     node.Key,
+					Value:   node.Value,
+					Height:  int32(node.Height),
+					Version: node.Version,
 				},
 			},
 		})
 		if err != nil {
 			return err
 		}
-
-		for {
-			node, err := exporter.Next()
-			if err == iavltree.ExportDone {
-				break
-			} else if err != nil {
-				return err
-			}
-			err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-				Item: &snapshottypes.SnapshotItem_IAVL{
-					IAVL: &snapshottypes.SnapshotIAVLItem{
-						Key:     node.Key,
-						Value:   node.Value,
-						Height:  int32(node.Height),
-						Version: node.Version,
-					},
-				},
-			})
-			if err != nil {
-				return err
-			}
-		}
-		exporter.Close()
 	}
 
 	return nil
@@ -786,13 +870,9 @@ loop:
 				}
 				importer.Close()
 			}
-			store, ok := rs.GetStoreByName(item.Store.Name).(*iavl.Store)
-			if !ok || store == nil {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name)
-			}
-			importer, err = store.Import(int64(height))
+			importer, err = rs.importStore(item.Store.Name, height)
 			if err != nil {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "import failed")
+				return snapshottypes.SnapshotItem{}, err
 			}
 			defer importer.Close()
 
@@ -800,27 +880,8 @@ loop:
 			if importer == nil {
 				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL node item before store item")
 			}
-			if item.IAVL.Height > math.MaxInt8 {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
-			}
-			node := &iavltree.ExportNode{
-				Key:     item.IAVL.Key,
-				Value:   item.IAVL.Value,
-				Height:  int8(item.IAVL.Height),
-				Version: item.IAVL.Version,
-			}
-			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 && node.Value == nil {
-				node.Value = []byte{}
-			}
-			err := importer.Add(node)
-			if err != nil {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "IAVL node import failed")
+			if err := importNode(importer, item.IAVL); err != nil {
+				return snapshottypes.SnapshotItem{}, err
 			}
 
 		default:
@@ -836,8 +897,90 @@ loop:
 		importer.Close()
 	}
 
+	return snapshotItem, rs.CommitRestore(height)
+}
+
+// RestoreStore implements snapshottypes.ParallelSnapshotter. The reader must
+// only contain SnapshotIAVLItem items. CommitRestore must be called once all
+// the stores are restored.
+func (rs *Store) RestoreStore(height uint64, name string, protoReader protoio.Reader) error {
+	importer, err := rs.importStore(name, height)
+	if err != nil {
+		return err
+	}
+	defer importer.Close()
+
+	for {
+		snapshotItem := snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		item, ok := snapshotItem.Item.(*snapshottypes.SnapshotItem_IAVL)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unexpected snapshot item %T in store %q", snapshotItem.Item, name)
+		}
+		if err := importNode(importer, item.IAVL); err != nil {
+			return err
+		}
+	}
+
+	if err := importer.Commit(); err != nil {
+		return sdkerrors.Wrap(err, "IAVL commit failed")
+	}
+
+	return nil
+}
+
+// CommitRestore implements snapshottypes.ParallelSnapshotter. It writes the
+// commit info of the restored height and loads it.
+func (rs *Store) CommitRestore(height uint64) error {
 	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{})
-	return snapshotItem, rs.LoadLatestVersion()
+	return rs.LoadLatestVersion()
+}
+
+// importStore returns an importer of the height into the named IAVL store.
+func (rs *Store) importStore(name string, height uint64) (*iavltree.Importer, error) {
+	store, ok := rs.GetStoreByName(name).(*iavl.Store)
+	if !ok || store == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", name)
+	}
+	importer, err := store.Import(int64(height))
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "import failed")
+	}
+
+	return importer, nil
+}
+
+// importNode adds the node of a SnapshotIAVLItem to the importer.
+func importNode(importer *iavltree.Importer, item *snapshottypes.SnapshotIAVLItem) error {
+	if item.Height > math.MaxInt8 {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
+			item.Height, math.MaxInt8)
+	}
+	node := &iavltree.ExportNode{
+		Key:     item.Key,
+		Value:   item.Value,
+		Height:  int8(item.Height),
+		Version: item.Version,
+	}
+	// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 && node.Value == nil {
+		node.Value = []byte{}
+	}
+	if err := importer.Add(node); err != nil {
+		return sdkerrors.Wrap(err, "IAVL node import failed")
+	}
+
+	return nil
 }
 
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
@@ -923,6 +1066,9 @@ func (rs *Store) RollbackToVersion(target int64) error {
 		return fmt.Errorf("invalid rollback height target: %d", target)
 	}
 
+	// the pruning worker must not prune the trees being rolled back
+	rs.WaitForPruning()
+
 	for key, store := range rs.stores {
 		if store.GetStoreType() == types.StoreTypeIAVL {
 			// If the store is wrapped with an inter-block cache, we must first unwrap
@@ -516,6 +516,7 @@ func TestMultiStore_Pruning(t *testing.T) {
 			for i := int64(0); i < tc.numVersions; i++ {
 				ms.Commit()
 			}
+			ms.WaitForPruning()
 
 			for _, v := range tc.saved {
 				_, err := ms.CacheMultiStoreWithVersion(v)
@@ -556,6 +557,7 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 
 	// commit one more block and ensure the heights have been pruned
 	ms.Commit()
+	ms.WaitForPruning()
 	require.Empty(t, ms.pruneHeights)
 
 	for _, v := range pruneHeights {
@@ -564,6 +566,44 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 	}
 }
 
+func TestMultiStore_PruningPinnedHeight(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(0, 0, 5))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ms.Commit()
+	ms.Commit()
+	unpin := ms.PinHeight(2)
+
+	for i := 0; i < 8; i++ {
+		ms.Commit()
+	}
+	ms.WaitForPruning()
+
+	// the pinned height is kept and persisted to be pruned at the next interval
+	_, err := ms.CacheMultiStoreWithVersion(2)
+	require.NoError(t, err)
+	for _, v := range []int64{1, 3, 4, 5, 6, 7, 8, 9} {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.Error(t, err, "expected error when loading height: %d", v)
+	}
+
+	ph, err := getPruningHeights(ms.db)
+	require.NoError(t, err)
+	require.Equal(t, []int64{2}, ph)
+
+	unpin()
+	for i := 0; i < 5; i++ {
+		ms.Commit()
+	}
+	ms.WaitForPruning()
+
+	_, err = ms.CacheMultiStoreWithVersion(2)
+	require.Error(t, err)
+	require.Empty(t, ms.pruneHeights)
+	require.Empty(t, ms.prunePending)
+}
+
 func TestSetInitialVersion(t *testing.T) {
 	db := dbm.NewMemDB()
 	multi := newMultiStoreWithMounts(db, types.PruneNothing)
@@ -0,0 +1,57 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// GetLatestCommitInfo returns the commit info of the latest version of the
+// multistore in db.
+func GetLatestCommitInfo(db dbm.DB) (*types.CommitInfo, error) {
+	version := GetLatestVersion(db)
+	if version == 0 {
+		return nil, fmt.Errorf("no version committed")
+	}
+
+	return getCommitInfo(db, version)
+}
+
+// VerifyCommitInfo checks that the IAVL trees of the stores in db have the
+// hashes of the commit info, and that the hash of the commit info recomputed
+// from them is the same. The stores without version, e.g. the memory stores,
+// have no tree in db and keep their empty hash.
+func VerifyCommitInfo(db dbm.DB, info *types.CommitInfo) error {
+	storeInfos := make([]types.StoreInfo, 0, len(info.StoreInfos))
+	for _, storeInfo := range info.StoreInfos {
+		if storeInfo.CommitId.Version == 0 {
+			storeInfos = append(storeInfos, storeInfo)
+			continue
+		}
+
+		key := types.NewKVStoreKey(storeInfo.Name)
+		storeDB := dbm.NewPrefixDB(db, []byte("s/k:"+storeInfo.Name+"/"))
+		store, err := iavl.LoadStore(storeDB, nil, key, storeInfo.CommitId, true, 0, iavlDisablefastNodeDefault)
+		if err != nil {
+			return fmt.Errorf("failed to load store %s at version %d: %w", storeInfo.Name, storeInfo.CommitId.Version, err)
+		}
+
+		commitID := store.LastCommitID()
+		if !bytes.Equal(commitID.Hash, storeInfo.CommitId.Hash) {
+			return fmt.Errorf("store %s has hash %X at version %d, expected %X",
+				storeInfo.Name, commitID.Hash, commitID.Version, storeInfo.CommitId.Hash)
+		}
+		storeInfos = append(storeInfos, types.StoreInfo{Name: storeInfo.Name, CommitId: commitID})
+	}
+
+	recomputed := types.CommitInfo{Version: info.Version, StoreInfos: storeInfos}
+	if !bytes.Equal(recomputed.Hash(), info.Hash()) {
+		return fmt.Errorf("commit info has hash %X at version %d, expected %X", recomputed.Hash(), info.Version, info.Hash())
+	}
+
+	return nil
+}
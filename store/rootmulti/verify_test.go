@@ -0,0 +1,44 @@
+package rootmulti
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestVerifyCommitInfo(t *testing.T) {
+	db := dbm.NewMemDB()
+	_, err := GetLatestCommitInfo(db)
+	require.Error(t, err)
+
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	ms.MountStoreWithDB(types.NewMemoryStoreKey("mem"), types.StoreTypeMemory, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.GetKVStore(testStoreKey1).Set([]byte("a"), []byte("1"))
+	ms.Commit()
+	ms.GetKVStore(testStoreKey2).Set([]byte("b"), []byte("2"))
+	commitID := ms.Commit()
+
+	info, err := GetLatestCommitInfo(db)
+	require.NoError(t, err)
+	require.Equal(t, commitID, info.CommitID())
+	require.NoError(t, VerifyCommitInfo(db, info))
+
+	// the hash of a store must match its tree
+	tampered := *info
+	tampered.StoreInfos = append([]types.StoreInfo{}, info.StoreInfos...)
+	for i, storeInfo := range tampered.StoreInfos {
+		if storeInfo.Name == testStoreKey1.Name() {
+			tampered.StoreInfos[i].CommitId.Hash = []byte("hash")
+		}
+	}
+	require.Error(t, VerifyCommitInfo(db, &tampered))
+
+	// the trees must be found at the version of their store
+	tampered.StoreInfos = append([]types.StoreInfo{}, info.StoreInfos...)
+	tampered.StoreInfos[0].CommitId.Version = 3
+	require.Error(t, VerifyCommitInfo(db, &tampered))
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	streamingabci "github.com/cosmos/cosmos-sdk/store/streaming/abci"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// FilePlugin is an example ABCIListener plugin which appends every message it
+// receives to a set of files in the directory pointed to by
+// COSMOS_SDK_ABCI_FILE_DIR, or the user's home directory if unset.
+type FilePlugin struct {
+	dir string
+}
+
+func (f *FilePlugin) writeToFile(file string, data fmt.Stringer) error {
+	fh, err := os.OpenFile(filepath.Join(f.dir, fmt.Sprintf("%s.txt", file)), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fh.WriteString(data.String() + "\n"); err != nil {
+		fh.Close()
+		return err
+	}
+
+	return fh.Close()
+}
+
+func (f *FilePlugin) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	if err := f.writeToFile("begin-block-req", &req); err != nil {
+		return err
+	}
+	return f.writeToFile("begin-block-res", &res)
+}
+
+func (f *FilePlugin) ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	if err := f.writeToFile("end-block-req", &req); err != nil {
+		return err
+	}
+	return f.writeToFile("end-block-res", &res)
+}
+
+func (f *FilePlugin) ListenDeliverTx(ctx context.Context, blockHeight int64, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if err := f.writeToFile("deliver-tx-req", &req); err != nil {
+		return err
+	}
+	return f.writeToFile("deliver-tx-res", &res)
+}
+
+func (f *FilePlugin) ListenCommit(ctx context.Context, blockHeight int64, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	if err := f.writeToFile("commit-res", &res); err != nil {
+		return err
+	}
+
+	for _, pair := range changeSet {
+		if err := f.writeToFile("state-change", pair); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func main() {
+	dir := os.Getenv("COSMOS_SDK_ABCI_FILE_DIR")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			panic(err)
+		}
+		dir = home
+	}
+
+	streamingabci.Serve(&FilePlugin{dir: dir})
+}
@@ -0,0 +1,121 @@
+package abci
+
+import (
+	"context"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var (
+	_ ABCIListener              = &GRPCClient{}
+	_ ABCIListenerServiceServer = &GRPCServer{}
+)
+
+// GRPCClient is the node side implementation of ABCIListener which forwards
+// every call to the plugin process over gRPC.
+type GRPCClient struct {
+	client ABCIListenerServiceClient
+}
+
+// ListenBeginBlock implements ABCIListener.
+func (m *GRPCClient) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	_, err := m.client.ListenBeginBlock(ctx, &ListenBeginBlockRequest{Req: &req, Res: &res})
+	return err
+}
+
+// ListenEndBlock implements ABCIListener.
+func (m *GRPCClient) ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	_, err := m.client.ListenEndBlock(ctx, &ListenEndBlockRequest{Req: &req, Res: &res})
+	return err
+}
+
+// ListenDeliverTx implements ABCIListener.
+func (m *GRPCClient) ListenDeliverTx(ctx context.Context, blockHeight int64, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	_, err := m.client.ListenDeliverTx(ctx, &ListenDeliverTxRequest{BlockHeight: blockHeight, Req: &req, Res: &res})
+	return err
+}
+
+// ListenCommit implements ABCIListener.
+func (m *GRPCClient) ListenCommit(ctx context.Context, blockHeight int64, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	_, err := m.client.ListenCommit(ctx, &ListenCommitRequest{BlockHeight: blockHeight, Res: &res, ChangeSet: changeSet})
+	return err
+}
+
+// GRPCServer is the plugin side gRPC server which dispatches the requests
+// received from the node to the concrete ABCIListener implementation.
+type GRPCServer struct {
+	// Impl is the concrete implementation of the ABCIListener
+	Impl ABCIListener
+}
+
+// ListenBeginBlock implements ABCIListenerServiceServer.
+func (m *GRPCServer) ListenBeginBlock(ctx context.Context, req *ListenBeginBlockRequest) (*ListenBeginBlockResponse, error) {
+	var (
+		abciReq abci.RequestBeginBlock
+		abciRes abci.ResponseBeginBlock
+	)
+	if req.Req != nil {
+		abciReq = *req.Req
+	}
+	if req.Res != nil {
+		abciRes = *req.Res
+	}
+
+	if err := m.Impl.ListenBeginBlock(ctx, abciReq, abciRes); err != nil {
+		return nil, err
+	}
+	return &ListenBeginBlockResponse{}, nil
+}
+
+// ListenEndBlock implements ABCIListenerServiceServer.
+func (m *GRPCServer) ListenEndBlock(ctx context.Context, req *ListenEndBlockRequest) (*ListenEndBlockResponse, error) {
+	var (
+		abciReq abci.RequestEndBlock
+		abciRes abci.ResponseEndBlock
+	)
+	if req.Req != nil {
+		abciReq = *req.Req
+	}
+	if req.Res != nil {
+		abciRes = *req.Res
+	}
+
+	if err := m.Impl.ListenEndBlock(ctx, abciReq, abciRes); err != nil {
+		return nil, err
+	}
+	return &ListenEndBlockResponse{}, nil
+}
+
+// ListenDeliverTx implements ABCIListenerServiceServer.
+func (m *GRPCServer) ListenDeliverTx(ctx context.Context, req *ListenDeliverTxRequest) (*ListenDeliverTxResponse, error) {
+	var (
+		abciReq abci.RequestDeliverTx
+		abciRes abci.ResponseDeliverTx
+	)
+	if req.Req != nil {
+		abciReq = *req.Req
+	}
+	if req.Res != nil {
+		abciRes = *req.Res
+	}
+
+	if err := m.Impl.ListenDeliverTx(ctx, req.BlockHeight, abciReq, abciRes); err != nil {
+		return nil, err
+	}
+	return &ListenDeliverTxResponse{}, nil
+}
+
+// ListenCommit implements ABCIListenerServiceServer.
+func (m *GRPCServer) ListenCommit(ctx context.Context, req *ListenCommitRequest) (*ListenCommitResponse, error) {
+	var abciRes abci.ResponseCommit
+	if req.Res != nil {
+		abciRes = *req.Res
+	}
+
+	if err := m.Impl.ListenCommit(ctx, req.BlockHeight, abciRes, req.ChangeSet); err != nil {
+		return nil, err
+	}
+	return &ListenCommitResponse{}, nil
+}
@@ -0,0 +1,70 @@
+package abci
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-plugin"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// PluginName is the name under which the ABCIListener plugin is registered in
+// the plugin map, both by the node and by the plugin process.
+const PluginName = "abci"
+
+// Handshake is a common handshake that is shared by the node and the plugin.
+// It acts as a UX feature rather than a security feature: if the magic cookie
+// does not match, the plugin binary prints a friendly message and exits
+// instead of blocking while it waits for a client that will never connect.
+var Handshake = plugin.HandshakeConfig{
+	ProtocolVersion:  1,
+	MagicCookieKey:   "ABCI_LISTENER_PLUGIN",
+	MagicCookieValue: "ef78114d-7bdf-411c-868f-347c99a78345",
+}
+
+// PluginMap is the map of plugins the node can dispense.
+var PluginMap = map[string]plugin.Plugin{
+	PluginName: &ListenerGRPCPlugin{},
+}
+
+// ABCIListener is the interface implemented by out-of-process streaming
+// plugins. It mirrors the baseapp ABCIListener, except that the block height
+// is passed explicitly where the ABCI types do not carry it, and the state
+// changes of a block are delivered together with the Commit response.
+type ABCIListener interface {
+	// ListenBeginBlock updates the plugin with the latest BeginBlock messages
+	ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	// ListenEndBlock updates the plugin with the latest EndBlock messages
+	ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+	// ListenDeliverTx updates the plugin with the latest DeliverTx messages
+	ListenDeliverTx(ctx context.Context, blockHeight int64, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenCommit updates the plugin with the latest Commit event and the
+	// state changes written during the block
+	ListenCommit(ctx context.Context, blockHeight int64, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error
+}
+
+var _ plugin.GRPCPlugin = &ListenerGRPCPlugin{}
+
+// ListenerGRPCPlugin is the go-plugin implementation of the ABCIListener
+// service. Impl is only set on the plugin side.
+type ListenerGRPCPlugin struct {
+	// GRPCPlugin must still implement the Plugin interface
+	plugin.Plugin
+	// Impl is the concrete implementation of the ABCIListener
+	Impl ABCIListener
+}
+
+// GRPCServer implements plugin.GRPCPlugin. It registers the ABCIListener
+// implementation with the plugin's gRPC server.
+func (p *ListenerGRPCPlugin) GRPCServer(_ *plugin.GRPCBroker, s *grpc.Server) error {
+	RegisterABCIListenerServiceServer(s, &GRPCServer{Impl: p.Impl})
+	return nil
+}
+
+// GRPCClient implements plugin.GRPCPlugin. It returns an ABCIListener backed
+// by the plugin's gRPC connection.
+func (p *ListenerGRPCPlugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return &GRPCClient{client: NewABCIListenerServiceClient(c)}, nil
+}
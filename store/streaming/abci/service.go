@@ -0,0 +1,179 @@
+package abci
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// StreamingService is a concrete implementation of StreamingService that
+// forwards the ABCI messages and state changes of every block to an
+// out-of-process ABCIListener plugin over gRPC.
+type StreamingService struct {
+	storeListeners []*types.MemoryListener // a series of KVStore listeners for each KVStore
+	listener       ABCIListener            // the plugin side listener the data is forwarded to
+	client         *plugin.Client          // the plugin client, nil when the listener is not backed by a process
+
+	currentBlockNumber int64
+
+	// stopNodeOnErr, if true, will propagate the errors returned by the plugin
+	// to the BaseApp, which stops the node; otherwise, any errors are ignored
+	// which could yield data loss in the streamed output.
+	stopNodeOnErr bool
+}
+
+// NewPluginClient returns a go-plugin client which launches the plugin binary
+// located at pluginPath and speaks to it over gRPC.
+func NewPluginClient(pluginPath string) *plugin.Client {
+	return plugin.NewClient(&plugin.ClientConfig{
+		HandshakeConfig:  Handshake,
+		Plugins:          PluginMap,
+		Cmd:              exec.Command(pluginPath), //nolint:gosec
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+		Logger: hclog.New(&hclog.LoggerOptions{
+			Output: os.Stderr,
+			Level:  hclog.Info,
+			Name:   fmt.Sprintf("plugin.%s", PluginName),
+		}),
+	})
+}
+
+// Serve is called by plugin binaries to serve the provided ABCIListener
+// implementation to the node. It blocks until the node kills the plugin.
+func Serve(impl ABCIListener) {
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: Handshake,
+		Plugins: map[string]plugin.Plugin{
+			PluginName: &ListenerGRPCPlugin{Impl: impl},
+		},
+		// a non-nil gRPC server is needed to enable gRPC serving
+		GRPCServer: plugin.DefaultGRPCServer,
+	})
+}
+
+// NewStreamingService launches the plugin using the provided client and
+// returns a StreamingService that streams the given store keys to it.
+func NewStreamingService(client *plugin.Client, storeKeys []types.StoreKey, stopNodeOnErr bool) (*StreamingService, error) {
+	rpcClient, err := client.Client()
+	if err != nil {
+		client.Kill()
+		return nil, err
+	}
+
+	raw, err := rpcClient.Dispense(PluginName)
+	if err != nil {
+		client.Kill()
+		return nil, err
+	}
+
+	listener, ok := raw.(ABCIListener)
+	if !ok {
+		client.Kill()
+		return nil, fmt.Errorf("unexpected plugin type %T, expected ABCIListener", raw)
+	}
+
+	ss := newStreamingService(listener, storeKeys, stopNodeOnErr)
+	ss.client = client
+
+	return ss, nil
+}
+
+func newStreamingService(listener ABCIListener, storeKeys []types.StoreKey, stopNodeOnErr bool) *StreamingService {
+	// sort storeKeys for deterministic output
+	sort.SliceStable(storeKeys, func(i, j int) bool {
+		return storeKeys[i].Name() < storeKeys[j].Name()
+	})
+
+	listeners := make([]*types.MemoryListener, len(storeKeys))
+	for i, key := range storeKeys {
+		listeners[i] = types.NewMemoryListener(key)
+	}
+
+	return &StreamingService{
+		storeListeners: listeners,
+		listener:       listener,
+		stopNodeOnErr:  stopNodeOnErr,
+	}
+}
+
+// Listeners satisfies the StreamingService interface. It returns the
+// StreamingService's underlying WriteListeners. Use for registering the
+// underlying WriteListeners with the BaseApp.
+func (s *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(s.storeListeners))
+	for _, listener := range s.storeListeners {
+		listeners[listener.StoreKey()] = []types.WriteListener{listener}
+	}
+
+	return listeners
+}
+
+// ListenBeginBlock satisfies the ABCIListener interface. It records the
+// current block number and forwards the BeginBlock request and response to
+// the plugin.
+func (s *StreamingService) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	s.currentBlockNumber = req.Header.Height
+	return s.handleErr(s.listener.ListenBeginBlock(ctx, req, res))
+}
+
+// ListenDeliverTx satisfies the ABCIListener interface. It forwards the
+// DeliverTx request and response to the plugin.
+func (s *StreamingService) ListenDeliverTx(ctx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	return s.handleErr(s.listener.ListenDeliverTx(ctx, s.currentBlockNumber, req, res))
+}
+
+// ListenEndBlock satisfies the ABCIListener interface. It forwards the
+// EndBlock request and response to the plugin.
+func (s *StreamingService) ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return s.handleErr(s.listener.ListenEndBlock(ctx, req, res))
+}
+
+// ListenCommit satisfies the ABCIListener interface. It forwards the Commit
+// response to the plugin together with all the state changes accumulated
+// since the previous commit.
+func (s *StreamingService) ListenCommit(ctx context.Context, res abci.ResponseCommit) error {
+	var changeSet []*types.StoreKVPair
+	for _, listener := range s.storeListeners {
+		cache := listener.PopStateCache()
+		for i := range cache {
+			changeSet = append(changeSet, &cache[i])
+		}
+	}
+
+	return s.handleErr(s.listener.ListenCommit(ctx, s.currentBlockNumber, res, changeSet))
+}
+
+// Stream satisfies the StreamingService interface. It performs a no-op.
+func (s *StreamingService) Stream(wg *sync.WaitGroup) error { return nil }
+
+// Close satisfies the StreamingService interface. It kills the plugin
+// process, if any.
+func (s *StreamingService) Close() error {
+	if s.client != nil {
+		s.client.Kill()
+	}
+
+	return nil
+}
+
+// handleErr only propagates errors returned by the plugin when stopNodeOnErr
+// is set.
+func (s *StreamingService) handleErr(err error) error {
+	if err != nil && s.stopNodeOnErr {
+		return err
+	}
+
+	return nil
+}
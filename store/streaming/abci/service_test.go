@@ -0,0 +1,129 @@
+package abci
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var (
+	mockStoreKey1 = types.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = types.NewKVStoreKey("mockStore2")
+)
+
+// mockListener records everything it receives from the node.
+type mockListener struct {
+	beginBlockReqs []abci.RequestBeginBlock
+	deliverTxReqs  []abci.RequestDeliverTx
+	endBlockReqs   []abci.RequestEndBlock
+	commitHeights  []int64
+	deliverHeights []int64
+	changeSets     [][]*types.StoreKVPair
+	err            error
+}
+
+func (m *mockListener) ListenBeginBlock(_ context.Context, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	m.beginBlockReqs = append(m.beginBlockReqs, req)
+	return m.err
+}
+
+func (m *mockListener) ListenEndBlock(_ context.Context, req abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	m.endBlockReqs = append(m.endBlockReqs, req)
+	return m.err
+}
+
+func (m *mockListener) ListenDeliverTx(_ context.Context, blockHeight int64, req abci.RequestDeliverTx, _ abci.ResponseDeliverTx) error {
+	m.deliverHeights = append(m.deliverHeights, blockHeight)
+	m.deliverTxReqs = append(m.deliverTxReqs, req)
+	return m.err
+}
+
+func (m *mockListener) ListenCommit(_ context.Context, blockHeight int64, _ abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	m.commitHeights = append(m.commitHeights, blockHeight)
+	m.changeSets = append(m.changeSets, changeSet)
+	return m.err
+}
+
+func setupStreamingService(t *testing.T, impl ABCIListener, stopNodeOnErr bool) *StreamingService {
+	client, server := plugin.TestPluginGRPCConn(t, map[string]plugin.Plugin{
+		PluginName: &ListenerGRPCPlugin{Impl: impl},
+	})
+	t.Cleanup(func() {
+		client.Close()
+		server.Stop()
+	})
+
+	raw, err := client.Dispense(PluginName)
+	require.NoError(t, err)
+
+	listener, ok := raw.(ABCIListener)
+	require.True(t, ok)
+
+	return newStreamingService(listener, []types.StoreKey{mockStoreKey2, mockStoreKey1}, stopNodeOnErr)
+}
+
+func TestStreamingService(t *testing.T) {
+	impl := &mockListener{}
+	ss := setupStreamingService(t, impl, true)
+
+	listeners := ss.Listeners()
+	require.Len(t, listeners, 2)
+	require.Contains(t, listeners, mockStoreKey1)
+	require.Contains(t, listeners, mockStoreKey2)
+
+	ctx := context.Background()
+	beginReq := abci.RequestBeginBlock{Header: tmproto.Header{Height: 7, ChainID: "test-chain"}}
+	require.NoError(t, ss.ListenBeginBlock(ctx, beginReq, abci.ResponseBeginBlock{}))
+
+	// write some state changes to the stores being listened to
+	require.NoError(t, listeners[mockStoreKey1][0].OnWrite(mockStoreKey1, []byte("key1"), []byte("value1"), false))
+	require.NoError(t, listeners[mockStoreKey2][0].OnWrite(mockStoreKey2, []byte("key2"), nil, true))
+
+	deliverReq := abci.RequestDeliverTx{Tx: []byte("tx")}
+	require.NoError(t, ss.ListenDeliverTx(ctx, deliverReq, abci.ResponseDeliverTx{Code: 1}))
+	require.NoError(t, ss.ListenEndBlock(ctx, abci.RequestEndBlock{Height: 7}, abci.ResponseEndBlock{}))
+	require.NoError(t, ss.ListenCommit(ctx, abci.ResponseCommit{Data: []byte("apphash")}))
+
+	require.Len(t, impl.beginBlockReqs, 1)
+	require.Equal(t, "test-chain", impl.beginBlockReqs[0].Header.ChainID)
+	require.Equal(t, []int64{7}, impl.deliverHeights)
+	require.Equal(t, deliverReq.Tx, impl.deliverTxReqs[0].Tx)
+	require.Equal(t, int64(7), impl.endBlockReqs[0].Height)
+	require.Equal(t, []int64{7}, impl.commitHeights)
+
+	// the change set is ordered by store key name
+	require.Len(t, impl.changeSets, 1)
+	require.Len(t, impl.changeSets[0], 2)
+	require.Equal(t, &types.StoreKVPair{StoreKey: mockStoreKey1.Name(), Key: []byte("key1"), Value: []byte("value1")}, impl.changeSets[0][0])
+	require.Equal(t, mockStoreKey2.Name(), impl.changeSets[0][1].StoreKey)
+	require.True(t, impl.changeSets[0][1].Delete)
+
+	// the state caches are flushed on commit
+	require.NoError(t, ss.ListenCommit(ctx, abci.ResponseCommit{}))
+	require.Len(t, impl.changeSets, 2)
+	require.Empty(t, impl.changeSets[1])
+
+	require.NoError(t, ss.Close())
+}
+
+func TestStreamingServiceStopNodeOnErr(t *testing.T) {
+	ctx := context.Background()
+
+	impl := &mockListener{err: errors.New("plugin failure")}
+	ss := setupStreamingService(t, impl, false)
+	require.NoError(t, ss.ListenBeginBlock(ctx, abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}))
+	require.NoError(t, ss.ListenCommit(ctx, abci.ResponseCommit{}))
+
+	ss = setupStreamingService(t, impl, true)
+	err := ss.ListenBeginBlock(ctx, abci.RequestBeginBlock{}, abci.ResponseBeginBlock{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "plugin failure")
+	require.Error(t, ss.ListenCommit(ctx, abci.ResponseCommit{}))
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/abci"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -27,6 +28,7 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	ABCI
 )
 
 // Streaming option keys
@@ -37,6 +39,9 @@ const (
 	OptStreamersFileStopNodeOnError = "streamers.file.stop-node-on-error"
 	OptStreamersFileFsync           = "streamers.file.fsync"
 
+	OptStreamersABCIPlugin          = "streamers.abci.plugin"
+	OptStreamersABCIStopNodeOnError = "streamers.abci.stop-node-on-error"
+
 	OptStoreStreamers = "store.streamers"
 )
 
@@ -47,6 +52,9 @@ func ServiceTypeFromString(name string) ServiceType {
 	case "file", "f":
 		return File
 
+	case "abci":
+		return ABCI
+
 	default:
 		return Unknown
 	}
@@ -58,6 +66,9 @@ func (sst ServiceType) String() string {
 	case File:
 		return "file"
 
+	case ABCI:
+		return "abci"
+
 	default:
 		return "unknown"
 	}
@@ -67,6 +78,7 @@ func (sst ServiceType) String() string {
 // streaming.ServiceConstructors types.
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
 	File: NewFileStreamingService,
+	ABCI: NewABCIStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding
@@ -113,6 +125,43 @@ func NewFileStreamingService(
 	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller, outputMetadata, stopNodeOnErr, fsync)
 }
 
+// NewABCIStreamingService is the streaming.ServiceConstructor function for
+// creating an ABCI StreamingService, which forwards the state changes and ABCI
+// messages to an out-of-process plugin over gRPC. The plugin binary is looked
+// up from the COSMOS_SDK_<PLUGIN> environment variable, where <PLUGIN> is the
+// upper-cased name configured under streamers.abci.plugin.
+func NewABCIStreamingService(
+	opts serverTypes.AppOptions,
+	keys []types.StoreKey,
+	_ codec.BinaryCodec,
+) (baseapp.StreamingService, error) {
+	pluginName := cast.ToString(opts.Get(OptStreamersABCIPlugin))
+	stopNodeOnErr := cast.ToBool(opts.Get(OptStreamersABCIStopNodeOnError))
+
+	if pluginName == "" {
+		return nil, fmt.Errorf("%s must be set when using the abci streaming service", OptStreamersABCIPlugin)
+	}
+
+	pluginPath, err := pluginPathFromEnv(pluginName)
+	if err != nil {
+		return nil, err
+	}
+
+	return abci.NewStreamingService(abci.NewPluginClient(pluginPath), keys, stopNodeOnErr)
+}
+
+// pluginPathFromEnv returns the path of the plugin binary registered under the
+// COSMOS_SDK_<NAME> environment variable for the given plugin name.
+func pluginPathFromEnv(name string) (string, error) {
+	envKey := fmt.Sprintf("COSMOS_SDK_%s", strings.ToUpper(name))
+	pluginPath := os.Getenv(envKey)
+	if pluginPath == "" {
+		return "", fmt.Errorf("environment variable %s not set for plugin %s", envKey, name)
+	}
+
+	return pluginPath, nil
+}
+
 // LoadStreamingServices is a function for loading StreamingServices onto the
 // BaseApp using the provided AppOptions, codec, and keys. It returns the
 // WaitGroup and quit channel used to synchronize with the streaming services
@@ -54,6 +54,23 @@ func TestStreamingServiceConstructor(t *testing.T) {
 	}
 }
 
+func TestABCIStreamingServiceConstructor(t *testing.T) {
+	require.Equal(t, streaming.ABCI, streaming.ServiceTypeFromString("abci"))
+	require.Equal(t, "abci", streaming.ABCI.String())
+
+	constructor, err := streaming.NewServiceConstructor("abci")
+	require.NoError(t, err)
+
+	// the plugin name is required
+	_, err = constructor(simapp.EmptyAppOptions{}, mockKeys, testMarshaller)
+	require.Error(t, err)
+
+	// the plugin binary must be registered in the environment
+	t.Setenv("COSMOS_SDK_NOTREGISTERED", "")
+	_, err = constructor(abciAppOptions{plugin: "notregistered"}, mockKeys, testMarshaller)
+	require.ErrorContains(t, err, "COSMOS_SDK_NOTREGISTERED")
+}
+
 func TestLoadStreamingServices(t *testing.T) {
 	db := dbm.NewMemDB()
 	encCdc := simapp.MakeTestEncodingConfig()
@@ -105,3 +122,14 @@ func (ao streamingAppOptions) Get(o string) interface{} {
 		return nil
 	}
 }
+
+type abciAppOptions struct {
+	plugin string
+}
+
+func (ao abciAppOptions) Get(o string) interface{} {
+	if o == streaming.OptStreamersABCIPlugin {
+		return ao.plugin
+	}
+	return nil
+}
@@ -15,6 +15,9 @@ const (
 	GasReadCostFlatDesc     = "ReadFlat"
 	GasHasDesc              = "Has"
 	GasDeleteDesc           = "Delete"
+	GasCacheScanDesc        = "CacheScan"
+	GasCacheSortDesc        = "CacheSort"
+	GasIterSkipCostFlatDesc = "IterSkipFlat"
 )
 
 // Gas measured by the SDK
@@ -193,30 +196,68 @@ type GasConfig struct {
 	WriteCostFlat    Gas
 	WriteCostPerByte Gas
 	IterNextCostFlat Gas
+	// CacheScanCostPerKey is charged for each pending write of a cache store
+	// scanned when an iterator is created over the store.
+	CacheScanCostPerKey Gas
+	// CacheSortCostPerKey is charged for each pending write of a cache store
+	// sorted when an iterator is created over the store.
+	CacheSortCostPerKey Gas
+	// IterSkipCostFlat is charged for each entry skipped by the iterators of a
+	// cache store, i.e. the deleted keys and the overwritten parent entries.
+	IterSkipCostFlat Gas
 }
 
 // KVGasConfig returns a default gas config for KVStores.
 func KVGasConfig() GasConfig {
 	return GasConfig{
-		HasCost:          1000,
-		DeleteCost:       1000,
-		ReadCostFlat:     1000,
-		ReadCostPerByte:  3,
-		WriteCostFlat:    2000,
-		WriteCostPerByte: 30,
-		IterNextCostFlat: 30,
+		HasCost:             1000,
+		DeleteCost:          1000,
+		ReadCostFlat:        1000,
+		ReadCostPerByte:     3,
+		WriteCostFlat:       2000,
+		WriteCostPerByte:    30,
+		IterNextCostFlat:    30,
+		CacheScanCostPerKey: 3,
+		CacheSortCostPerKey: 30,
+		IterSkipCostFlat:    30,
 	}
 }
 
 // TransientGasConfig returns a default gas config for TransientStores.
 func TransientGasConfig() GasConfig {
 	return GasConfig{
-		HasCost:          100,
-		DeleteCost:       100,
-		ReadCostFlat:     100,
-		ReadCostPerByte:  0,
-		WriteCostFlat:    200,
-		WriteCostPerByte: 3,
-		IterNextCostFlat: 3,
+		HasCost:             100,
+		DeleteCost:          100,
+		ReadCostFlat:        100,
+		ReadCostPerByte:     0,
+		WriteCostFlat:       200,
+		WriteCostPerByte:    3,
+		IterNextCostFlat:    3,
+		CacheScanCostPerKey: 1,
+		CacheSortCostPerKey: 3,
+		IterSkipCostFlat:    3,
 	}
 }
+
+// IteratorWork is the work done by a cache store for an iterator which is not
+// proportional to the number of entries it returns.
+type IteratorWork struct {
+	// ScannedKeys is the number of pending writes scanned for the keys in the
+	// domain of the iterator.
+	ScannedKeys int
+	// SortedKeys is the number of pending writes sorted.
+	SortedKeys int
+	// SkippedKeys is the number of entries skipped.
+	SkippedKeys int
+}
+
+// MeteredIteratorStore is implemented by the KVStores whose iterators do work
+// which is not proportional to the number of entries they return, e.g. the
+// cache stores sorting their pending writes, so that the gas stores wrapping
+// them can charge for it.
+type MeteredIteratorStore interface {
+	// MeteredIterator returns an iterator over the domain like Iterator or
+	// ReverseIterator, calling meter with the work done when it is created
+	// and advanced.
+	MeteredIterator(start, end []byte, ascending bool, meter func(IteratorWork)) Iterator
+}
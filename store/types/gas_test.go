@@ -101,12 +101,15 @@ func TestTransientGasConfig(t *testing.T) {
 	t.Parallel()
 	config := TransientGasConfig()
 	require.Equal(t, config, GasConfig{
-		HasCost:          100,
-		DeleteCost:       100,
-		ReadCostFlat:     100,
-		ReadCostPerByte:  0,
-		WriteCostFlat:    200,
-		WriteCostPerByte: 3,
-		IterNextCostFlat: 3,
+		HasCost:             100,
+		DeleteCost:          100,
+		ReadCostFlat:        100,
+		ReadCostPerByte:     0,
+		WriteCostFlat:       200,
+		WriteCostPerByte:    3,
+		IterNextCostFlat:    3,
+		CacheScanCostPerKey: 1,
+		CacheSortCostPerKey: 3,
+		IterSkipCostFlat:    3,
 	})
 }
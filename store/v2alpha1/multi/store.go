@@ -0,0 +1,572 @@
+// Package multi implements a multistore which separates the state storage (SS)
+// from the state commitment (SC).
+//
+// The state commitment is a rootmulti.Store whose IAVL trees only keep the
+// recent versions needed to compute the app hash, serve proofs and take state
+// sync snapshots. Every write committed to a persistent store is also written
+// as a flat, versioned key-value pair to the state storage (see
+// store/v2alpha1/storage), which serves the queries and the historical
+// branches of the multistore.
+package multi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	protoio "github.com/gogo/protobuf/io"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/storage"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+const (
+	proofsPath = "proofs"
+
+	// scKeepRecent and scInterval are the pruning options forced on the state
+	// commitment, which does not need to keep the history of the state.
+	scKeepRecent = 2
+	scInterval   = 10
+
+	// migrateBatchSize is the number of writes flushed at once when copying
+	// the state of the state commitment to the state storage.
+	migrateBatchSize = 10000
+)
+
+var (
+	_ types.CommitMultiStore = (*Store)(nil)
+	_ types.Queryable        = (*Store)(nil)
+)
+
+// Store is a CommitMultiStore which commits the state to a rootmulti.Store and
+// writes the history of the persistent stores to a separate state storage.
+//
+// NOTE: the store must be created before the stores are mounted, so that the
+// writes to them are recorded.
+type Store struct {
+	*rootmulti.Store
+
+	ss             *storage.Store
+	changes        *changeSet
+	listening      map[types.StoreKey]bool
+	pruningOpts    types.PruningOptions
+	initialVersion int64
+}
+
+// NewStore returns a multistore using the given rootmulti.Store as state
+// commitment and the given database as state storage.
+func NewStore(sc *rootmulti.Store, ssDB dbm.DB) *Store {
+	s := &Store{
+		Store:     sc,
+		ss:        storage.NewStore(ssDB),
+		changes:   newChangeSet(),
+		listening: make(map[types.StoreKey]bool),
+	}
+	s.SetPruning(sc.GetPruning())
+
+	return s
+}
+
+// StateStorage returns the state storage of the multistore.
+func (s *Store) StateStorage() *storage.Store {
+	return s.ss
+}
+
+// StateCommitment returns the state commitment of the multistore.
+func (s *Store) StateCommitment() *rootmulti.Store {
+	return s.Store
+}
+
+// SetPruning implements types.Committer. The state commitment only keeps the
+// recent versions, along with the KeepEvery versions needed by state sync
+// snapshots, unless all the versions must be kept.
+func (s *Store) SetPruning(opts types.PruningOptions) {
+	s.pruningOpts = opts
+
+	if opts.KeepEvery == 1 {
+		s.Store.SetPruning(opts)
+		return
+	}
+
+	s.Store.SetPruning(types.NewPruningOptions(scKeepRecent, opts.KeepEvery, scInterval))
+}
+
+// GetPruning implements types.Committer. It returns the pruning options set on
+// the multistore rather than the ones of the state commitment.
+func (s *Store) GetPruning() types.PruningOptions {
+	return s.pruningOpts
+}
+
+// MountStoreWithDB implements types.CommitMultiStore. The writes to persistent
+// stores are recorded to be written to the state storage on commit.
+func (s *Store) MountStoreWithDB(key types.StoreKey, typ types.StoreType, db dbm.DB) {
+	s.Store.MountStoreWithDB(key, typ, db)
+
+	if isPersistent(typ) {
+		s.Store.AddListeners(key, []types.WriteListener{s.changes})
+	}
+}
+
+// AddListeners implements types.CommitMultiStore.
+func (s *Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	s.Store.AddListeners(key, listeners)
+
+	if len(listeners) > 0 {
+		s.listening[key] = true
+	}
+}
+
+// ListeningEnabled implements types.CommitMultiStore. It ignores the listener
+// recording the writes to the state storage.
+func (s *Store) ListeningEnabled(key types.StoreKey) bool {
+	return s.listening[key]
+}
+
+// SetInitialVersion implements types.CommitMultiStore.
+func (s *Store) SetInitialVersion(version int64) error {
+	s.initialVersion = version
+	return s.Store.SetInitialVersion(version)
+}
+
+// LoadLatestVersion implements types.CommitMultiStore.
+func (s *Store) LoadLatestVersion() error {
+	if err := s.Store.LoadLatestVersion(); err != nil {
+		return err
+	}
+
+	return s.checkStateStorage()
+}
+
+// LoadVersion implements types.CommitMultiStore.
+func (s *Store) LoadVersion(ver int64) error {
+	if err := s.Store.LoadVersion(ver); err != nil {
+		return err
+	}
+
+	return s.checkStateStorage()
+}
+
+// LoadLatestVersionAndUpgrade implements types.CommitMultiStore.
+func (s *Store) LoadLatestVersionAndUpgrade(upgrades *types.StoreUpgrades) error {
+	if err := s.Store.LoadLatestVersionAndUpgrade(upgrades); err != nil {
+		return err
+	}
+
+	return s.upgradeStateStorage(upgrades)
+}
+
+// LoadVersionAndUpgrade implements types.CommitMultiStore.
+func (s *Store) LoadVersionAndUpgrade(ver int64, upgrades *types.StoreUpgrades) error {
+	if err := s.Store.LoadVersionAndUpgrade(ver, upgrades); err != nil {
+		return err
+	}
+
+	return s.upgradeStateStorage(upgrades)
+}
+
+// checkStateStorage returns an error if the state storage misses versions of
+// the state commitment, e.g. when it is enabled on an existing node.
+func (s *Store) checkStateStorage() error {
+	version := s.Store.LastCommitID().Version
+	if version == 0 {
+		return nil
+	}
+
+	if latest := s.ss.LatestVersion(); latest < version {
+		return fmt.Errorf(
+			"state storage is at version %d while the state commitment is at version %d, run the migrate-state-storage command",
+			latest, version,
+		)
+	}
+
+	return nil
+}
+
+// upgradeStateStorage applies the store renames and deletions to the state
+// storage. The changes are written with the next commit.
+func (s *Store) upgradeStateStorage(upgrades *types.StoreUpgrades) error {
+	if err := s.checkStateStorage(); err != nil {
+		return err
+	}
+	if upgrades == nil {
+		return nil
+	}
+
+	version := s.ss.LatestVersion()
+	for _, rename := range upgrades.Renamed {
+		s.changes.copyStore(s.ss.View(rename.OldKey, version), rename.NewKey)
+		s.changes.deleteStore(s.ss.View(rename.OldKey, version), rename.OldKey)
+	}
+	for _, name := range upgrades.Deleted {
+		s.changes.deleteStore(s.ss.View(name, version), name)
+	}
+
+	return nil
+}
+
+// Commit implements types.Committer. The writes of the block are written to the
+// state storage before the state commitment, so that a block interrupted
+// between the two is replayed over the same version of the state storage.
+func (s *Store) Commit() types.CommitID {
+	version := s.Store.LastCommitID().Version + 1
+	if version == 1 && s.initialVersion > 1 {
+		version = s.initialVersion
+	}
+
+	if err := s.writeChanges(version); err != nil {
+		panic(err)
+	}
+
+	return s.Store.Commit()
+}
+
+func (s *Store) writeChanges(version int64) error {
+	// versions above the committed one are left by an interrupted commit or
+	// by loading a past version, they are overwritten
+	if s.ss.LatestVersion() >= version {
+		if err := s.ss.DeleteVersionsFrom(version); err != nil {
+			return err
+		}
+	}
+
+	batch, err := s.ss.NewBatch(version)
+	if err != nil {
+		return err
+	}
+	defer batch.Close()
+
+	if err := s.changes.flush(batch); err != nil {
+		return err
+	}
+
+	return batch.Write()
+}
+
+// RollbackToVersion implements types.CommitMultiStore.
+func (s *Store) RollbackToVersion(target int64) error {
+	if err := s.Store.RollbackToVersion(target); err != nil {
+		return err
+	}
+
+	return s.ss.DeleteVersionsFrom(target + 1)
+}
+
+// Restore implements snapshottypes.Snapshotter. The restored state is copied to
+// the state storage.
+func (s *Store) Restore(
+	height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	item, err := s.Store.Restore(height, format, protoReader)
+	if err != nil {
+		return item, err
+	}
+
+	if err := s.ss.DeleteVersionsFrom(1); err != nil {
+		return item, err
+	}
+
+	return item, copyState(s.Store, s.ss, int64(height))
+}
+
+// CommitRestore implements snapshottypes.ParallelSnapshotter. The restored
+// state is copied to the state storage.
+func (s *Store) CommitRestore(height uint64) error {
+	if err := s.Store.CommitRestore(height); err != nil {
+		return err
+	}
+
+	if err := s.ss.DeleteVersionsFrom(1); err != nil {
+		return err
+	}
+
+	return copyState(s.Store, s.ss, int64(height))
+}
+
+// CacheMultiStoreWithVersion implements types.MultiStore. The past versions
+// held by the state storage are branched from it.
+func (s *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	if version >= s.Store.LastCommitID().Version || !s.ssHasVersion(version) {
+		return s.Store.CacheMultiStoreWithVersion(version)
+	}
+
+	stores := make(map[types.StoreKey]types.CacheWrapper)
+	keys := make(map[string]types.StoreKey)
+	for key, store := range s.Store.GetStores() {
+		keys[key.Name()] = key
+
+		if isPersistent(store.GetStoreType()) {
+			stores[key] = s.ss.View(key.Name(), version)
+		} else {
+			stores[key] = store
+		}
+	}
+
+	return cachemulti.NewStore(dbm.NewMemDB(), stores, keys, nil, nil), nil
+}
+
+// VersionExists returns whether the given version is held by the state storage
+// or the state commitment.
+func (s *Store) VersionExists(version int64) bool {
+	return s.ssHasVersion(version) || s.Store.VersionExists(version)
+}
+
+// Query implements types.Queryable. The proofs are served by the state
+// commitment, and the other queries of the persistent stores by the state
+// storage.
+func (s *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
+	storeName, subpath, err := parsePath(req.Path)
+	if err != nil {
+		return sdkerrors.QueryResult(err)
+	}
+
+	if storeName == proofsPath || req.Prove {
+		return s.Store.Query(req)
+	}
+
+	store := s.Store.GetStoreByName(storeName)
+	if store == nil || !isPersistent(store.GetStoreType()) {
+		return s.Store.Query(req)
+	}
+
+	// as for IAVL stores, the height 0 is the version before the latest one
+	// when it exists, so that the response can be proven with the next block
+	height := req.Height
+	if height == 0 {
+		height = s.Store.LastCommitID().Version
+		if s.ssHasVersion(height - 1) {
+			height--
+		}
+	}
+	if !s.ssHasVersion(height) {
+		return s.Store.Query(req)
+	}
+
+	return s.queryStateStorage(storeName, subpath, req.Data, height)
+}
+
+func (s *Store) queryStateStorage(storeName, subpath string, data []byte, height int64) (res abci.ResponseQuery) {
+	if len(data) == 0 {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, "query cannot be zero length"))
+	}
+
+	res.Height = height
+	res.Key = data
+
+	view := s.ss.View(storeName, height)
+
+	switch subpath {
+	case "/key":
+		res.Value = view.Get(data)
+
+	case "/subspace":
+		pairs := kv.Pairs{
+			Pairs: make([]kv.Pair, 0),
+		}
+
+		iterator := types.KVStorePrefixIterator(view, data)
+		for ; iterator.Valid(); iterator.Next() {
+			pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+		}
+		iterator.Close()
+
+		bz, err := pairs.Marshal()
+		if err != nil {
+			panic(fmt.Errorf("failed to marshal KV pairs: %w", err))
+		}
+
+		res.Value = bz
+
+	default:
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected query path: %v", subpath))
+	}
+
+	return res
+}
+
+// ssHasVersion returns whether the state storage holds the given version of
+// the state commitment.
+func (s *Store) ssHasVersion(version int64) bool {
+	earliest := s.ss.EarliestVersion()
+	return earliest > 0 && version >= earliest && version <= s.Store.LastCommitID().Version
+}
+
+// MigrateStateStorage copies the latest state of the state commitment to an
+// empty state storage, so that an existing node can use the multistore. The
+// versions below the latest one are not migrated, they are still served by the
+// state commitment until they are pruned.
+func MigrateStateStorage(sc *rootmulti.Store, ssDB dbm.DB) error {
+	ss := storage.NewStore(ssDB)
+	if latest := ss.LatestVersion(); latest != 0 {
+		return fmt.Errorf("state storage is not empty, it is at version %d", latest)
+	}
+
+	version := sc.LastCommitID().Version
+	if version == 0 {
+		return fmt.Errorf("state commitment is empty")
+	}
+
+	return copyState(sc, ss, version)
+}
+
+// copyState copies the persistent stores of the state commitment to the state
+// storage at the given version.
+func copyState(sc *rootmulti.Store, ss *storage.Store, version int64) error {
+	stores := sc.GetStores()
+	keys := make([]types.StoreKey, 0, len(stores))
+	for key, store := range stores {
+		if isPersistent(store.GetStoreType()) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
+
+	batch, err := ss.NewBatch(version)
+	if err != nil {
+		return err
+	}
+	defer func() { batch.Close() }()
+
+	size := 0
+	for _, key := range keys {
+		iter := sc.GetCommitKVStore(key).Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			if err = batch.Set(key.Name(), iter.Key(), iter.Value()); err != nil {
+				break
+			}
+
+			if size++; size%migrateBatchSize == 0 {
+				if err = batch.Write(); err != nil {
+					break
+				}
+				batch.Close()
+
+				if batch, err = ss.NewBatch(version); err != nil {
+					break
+				}
+			}
+		}
+		iter.Close()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return batch.Write()
+}
+
+func isPersistent(typ types.StoreType) bool {
+	return typ == types.StoreTypeIAVL || typ == types.StoreTypeDB
+}
+
+// parsePath expects a format like /<storeName>[/<subpath>], it mirrors the
+// path parsing of rootmulti.Store.Query.
+func parsePath(path string) (storeName string, subpath string, err error) {
+	if !strings.HasPrefix(path, "/") {
+		return storeName, subpath, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid path: %s", path)
+	}
+
+	paths := strings.SplitN(path[1:], "/", 2)
+	storeName = paths[0]
+
+	if len(paths) == 2 {
+		subpath = "/" + paths[1]
+	}
+
+	return storeName, subpath, nil
+}
+
+// changeSet is a WriteListener recording the writes to the persistent stores
+// until they are written to the state storage.
+type changeSet struct {
+	mtx     sync.Mutex
+	changes map[string]map[string]change
+}
+
+type change struct {
+	value   []byte
+	deleted bool
+}
+
+var _ types.WriteListener = (*changeSet)(nil)
+
+func newChangeSet() *changeSet {
+	return &changeSet{changes: make(map[string]map[string]change)}
+}
+
+// OnWrite implements types.WriteListener.
+func (cs *changeSet) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	cs.set(storeKey.Name(), key, value, delete)
+	return nil
+}
+
+func (cs *changeSet) set(storeName string, key, value []byte, deleted bool) {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+
+	changes, ok := cs.changes[storeName]
+	if !ok {
+		changes = make(map[string]change)
+		cs.changes[storeName] = changes
+	}
+
+	var c change
+	if deleted {
+		c.deleted = true
+	} else {
+		c.value = append([]byte{}, value...)
+	}
+	changes[string(key)] = c
+}
+
+// copyStore records the writes copying the given store to the named store.
+func (cs *changeSet) copyStore(store types.KVStore, storeName string) {
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		cs.set(storeName, iter.Key(), iter.Value(), false)
+	}
+}
+
+// deleteStore records the deletion of all the keys of the given store.
+func (cs *changeSet) deleteStore(store types.KVStore, storeName string) {
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		cs.set(storeName, iter.Key(), nil, true)
+	}
+}
+
+// flush adds the recorded writes to the batch and resets the change set.
+func (cs *changeSet) flush(batch *storage.Batch) error {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+
+	for storeName, changes := range cs.changes {
+		for key, c := range changes {
+			var err error
+			if c.deleted {
+				err = batch.Delete(storeName, []byte(key))
+			} else {
+				err = batch.Set(storeName, []byte(key), c.value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	cs.changes = make(map[string]map[string]change)
+
+	return nil
+}
@@ -0,0 +1,285 @@
+package multi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/multi"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+var (
+	key1  = types.NewKVStoreKey("store1")
+	key2  = types.NewKVStoreKey("store2")
+	tkey  = types.NewTransientStoreKey("transient")
+	store = map[types.StoreKey]types.StoreType{
+		key1: types.StoreTypeIAVL,
+		key2: types.StoreTypeIAVL,
+		tkey: types.StoreTypeTransient,
+	}
+)
+
+func newStore(t *testing.T, db, ssDB dbm.DB, keys ...types.StoreKey) *multi.Store {
+	t.Helper()
+
+	s := multi.NewStore(rootmulti.NewStore(db, log.NewNopLogger()), ssDB)
+	if len(keys) == 0 {
+		keys = []types.StoreKey{key1, key2, tkey}
+	}
+	for _, key := range keys {
+		s.MountStoreWithDB(key, store[key], nil)
+	}
+
+	return s
+}
+
+func TestStoreCommit(t *testing.T) {
+	db, ssDB := dbm.NewMemDB(), dbm.NewMemDB()
+	s := newStore(t, db, ssDB)
+	s.SetPruning(types.PruneDefault)
+	require.NoError(t, s.LoadLatestVersion())
+
+	// the state commitment only keeps the recent versions
+	require.Equal(t, types.PruneDefault, s.GetPruning())
+	require.Equal(t, types.NewPruningOptions(2, types.PruneDefault.KeepEvery, 10), s.StateCommitment().GetPruning())
+
+	for i := byte(1); i <= 30; i++ {
+		s.GetKVStore(key1).Set([]byte("key"), []byte{i})
+		s.GetKVStore(key1).Set([]byte{'k', i}, []byte{i})
+		s.GetKVStore(tkey).Set([]byte("key"), []byte{i})
+
+		cms := s.CacheMultiStore()
+		cms.GetKVStore(key2).Set([]byte("key"), []byte{i})
+		if i%2 == 0 {
+			cms.GetKVStore(key2).Delete([]byte("key"))
+		}
+		cms.Write()
+
+		require.Equal(t, int64(i), s.Commit().Version)
+	}
+
+	ss := s.StateStorage()
+	require.Equal(t, int64(30), ss.LatestVersion())
+	require.Equal(t, int64(1), ss.EarliestVersion())
+	require.Nil(t, ss.Get(tkey.Name(), []byte("key"), 30))
+
+	// versions pruned from the state commitment are served by the state storage
+	cms, err := s.CacheMultiStoreWithVersion(5)
+	require.NoError(t, err)
+	require.Equal(t, []byte{5}, cms.GetKVStore(key1).Get([]byte("key")))
+	require.Equal(t, []byte{5}, cms.GetKVStore(key2).Get([]byte("key")))
+	require.False(t, cms.GetKVStore(key1).Has([]byte{'k', 6}))
+
+	cms, err = s.CacheMultiStoreWithVersion(6)
+	require.NoError(t, err)
+	require.Nil(t, cms.GetKVStore(key2).Get([]byte("key")))
+
+	// queries are served by the state storage
+	res := s.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte("key"), Height: 7})
+	require.Equal(t, uint32(0), res.Code, res.Log)
+	require.Equal(t, int64(7), res.Height)
+	require.Equal(t, []byte{7}, res.Value)
+
+	res = s.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte("key")})
+	require.Equal(t, int64(29), res.Height)
+	require.Equal(t, []byte{29}, res.Value)
+
+	res = s.Query(abci.RequestQuery{Path: "/store1/subspace", Data: []byte("k"), Height: 2})
+	require.Equal(t, uint32(0), res.Code, res.Log)
+	var pairs kv.Pairs
+	require.NoError(t, pairs.Unmarshal(res.Value))
+	require.Len(t, pairs.Pairs, 3)
+	require.Equal(t, []byte{'k', 1}, pairs.Pairs[0].Key)
+	require.Equal(t, []byte{'k', 2}, pairs.Pairs[1].Key)
+	require.Equal(t, []byte("key"), pairs.Pairs[2].Key)
+	require.Equal(t, []byte{2}, pairs.Pairs[2].Value)
+
+	res = s.Query(abci.RequestQuery{Path: "/store1/unknown", Data: []byte("key")})
+	require.NotEqual(t, uint32(0), res.Code)
+
+	// proofs are served by the state commitment
+	res = s.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte("key"), Height: 30, Prove: true})
+	require.Equal(t, uint32(0), res.Code, res.Log)
+	require.Equal(t, []byte{30}, res.Value)
+	require.NotNil(t, res.ProofOps)
+
+	// the state is kept after reloading
+	s = newStore(t, db, ssDB)
+	require.NoError(t, s.LoadLatestVersion())
+	res = s.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte("key"), Height: 3})
+	require.Equal(t, []byte{3}, res.Value)
+}
+
+func TestStoreListeningEnabled(t *testing.T) {
+	s := newStore(t, dbm.NewMemDB(), dbm.NewMemDB())
+	require.False(t, s.ListeningEnabled(key1))
+
+	s.AddListeners(key1, []types.WriteListener{types.NewStoreKVPairWriteListener(nil, nil)})
+	require.True(t, s.ListeningEnabled(key1))
+	require.False(t, s.ListeningEnabled(key2))
+}
+
+func TestStoreRollback(t *testing.T) {
+	db, ssDB := dbm.NewMemDB(), dbm.NewMemDB()
+	s := newStore(t, db, ssDB)
+	s.SetPruning(types.PruneNothing)
+	require.NoError(t, s.LoadLatestVersion())
+
+	for i := byte(1); i <= 5; i++ {
+		s.GetKVStore(key1).Set([]byte("key"), []byte{i})
+		s.Commit()
+	}
+
+	require.NoError(t, s.RollbackToVersion(3))
+	require.Equal(t, int64(3), s.LastCommitID().Version)
+	require.Equal(t, int64(3), s.StateStorage().LatestVersion())
+	require.Equal(t, []byte{3}, s.StateStorage().Get(key1.Name(), []byte("key"), 5))
+
+	// the store is recreated as the rollback command does
+	s = newStore(t, db, ssDB)
+	require.NoError(t, s.LoadLatestVersion())
+	res := s.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte("key"), Height: 3})
+	require.Equal(t, uint32(0), res.Code, res.Log)
+	require.Equal(t, []byte{3}, res.Value)
+}
+
+func TestStoreInterruptedCommit(t *testing.T) {
+	db, ssDB := dbm.NewMemDB(), dbm.NewMemDB()
+	s := newStore(t, db, ssDB)
+	require.NoError(t, s.LoadLatestVersion())
+
+	s.GetKVStore(key1).Set([]byte("key"), []byte{1})
+	s.Commit()
+
+	// the state storage is written but the state commitment is not
+	s.GetKVStore(key1).Set([]byte("key"), []byte{2})
+	s.GetKVStore(key1).Set([]byte("other"), []byte{2})
+	batch, err := s.StateStorage().NewBatch(2)
+	require.NoError(t, err)
+	require.NoError(t, batch.Set(key1.Name(), []byte("key"), []byte{2}))
+	require.NoError(t, batch.Set(key1.Name(), []byte("other"), []byte{2}))
+	require.NoError(t, batch.Write())
+	require.NoError(t, batch.Close())
+
+	// the block is replayed with other writes
+	s = newStore(t, db, ssDB)
+	require.NoError(t, s.LoadLatestVersion())
+	s.GetKVStore(key1).Set([]byte("key"), []byte{3})
+	require.Equal(t, int64(2), s.Commit().Version)
+
+	require.Equal(t, []byte{3}, s.StateStorage().Get(key1.Name(), []byte("key"), 2))
+	require.Nil(t, s.StateStorage().Get(key1.Name(), []byte("other"), 2))
+}
+
+func TestMigrateStateStorage(t *testing.T) {
+	db, ssDB := dbm.NewMemDB(), dbm.NewMemDB()
+
+	rs := rootmulti.NewStore(db, log.NewNopLogger())
+	rs.MountStoreWithDB(key1, types.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(key2, types.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+	for i := byte(1); i <= 3; i++ {
+		rs.GetKVStore(key1).Set([]byte{i}, []byte{i})
+		rs.GetKVStore(key2).Set([]byte("key"), []byte{i})
+		rs.Commit()
+	}
+
+	// the state storage must be migrated before being enabled
+	s := newStore(t, db, ssDB, key1, key2)
+	require.Error(t, s.LoadLatestVersion())
+
+	rs = rootmulti.NewStore(db, log.NewNopLogger())
+	rs.MountStoreWithDB(key1, types.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(key2, types.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+	require.NoError(t, multi.MigrateStateStorage(rs, ssDB))
+	require.Error(t, multi.MigrateStateStorage(rs, ssDB))
+
+	s = newStore(t, db, ssDB, key1, key2)
+	require.NoError(t, s.LoadLatestVersion())
+
+	ss := s.StateStorage()
+	require.Equal(t, int64(3), ss.EarliestVersion())
+	require.Equal(t, []byte{1}, ss.Get(key1.Name(), []byte{1}, 3))
+	require.Equal(t, []byte{3}, ss.Get(key2.Name(), []byte("key"), 3))
+
+	// the versions below the migrated one are served by the state commitment
+	res := s.Query(abci.RequestQuery{Path: "/store2/key", Data: []byte("key"), Height: 2})
+	require.Equal(t, uint32(0), res.Code, res.Log)
+	require.Equal(t, []byte{2}, res.Value)
+}
+
+func TestStoreUpgrades(t *testing.T) {
+	db, ssDB := dbm.NewMemDB(), dbm.NewMemDB()
+	s := newStore(t, db, ssDB, key1, key2)
+	require.NoError(t, s.LoadLatestVersion())
+
+	s.GetKVStore(key1).Set([]byte("key"), []byte{1})
+	s.GetKVStore(key2).Set([]byte("key"), []byte{2})
+	s.Commit()
+
+	key3 := types.NewKVStoreKey("store3")
+	s = multi.NewStore(rootmulti.NewStore(db, log.NewNopLogger()), ssDB)
+	s.MountStoreWithDB(key3, types.StoreTypeIAVL, nil)
+	require.NoError(t, s.LoadLatestVersionAndUpgrade(&types.StoreUpgrades{
+		Renamed: []types.StoreRename{{OldKey: "store1", NewKey: "store3"}},
+		Deleted: []string{"store2"},
+	}))
+	s.Commit()
+
+	ss := s.StateStorage()
+	require.Equal(t, []byte{1}, ss.Get("store3", []byte("key"), 2))
+	require.Nil(t, ss.Get("store1", []byte("key"), 2))
+	require.Nil(t, ss.Get("store2", []byte("key"), 2))
+	require.Equal(t, []byte{2}, ss.Get("store2", []byte("key"), 1))
+}
+
+func TestStoreParallelSnapshotRestore(t *testing.T) {
+	source := newStore(t, dbm.NewMemDB(), dbm.NewMemDB())
+	require.NoError(t, source.LoadLatestVersion())
+	for i := byte(1); i <= 3; i++ {
+		source.GetKVStore(key1).Set([]byte("key"), []byte{i})
+		source.GetKVStore(key2).Set([]byte{i}, []byte{i})
+		source.Commit()
+	}
+
+	sourceManager := snapshots.NewManager(newSnapshotStore(t), source)
+	snapshot, err := sourceManager.Create(3)
+	require.NoError(t, err)
+	require.Equal(t, snapshottypes.FormatParallel, snapshot.Format)
+
+	target := newStore(t, dbm.NewMemDB(), dbm.NewMemDB())
+	require.NoError(t, target.LoadLatestVersion())
+	targetManager := snapshots.NewManager(newSnapshotStore(t), target)
+	require.NoError(t, targetManager.Restore(*snapshot))
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := sourceManager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		_, err = targetManager.RestoreChunk(chunk)
+		require.NoError(t, err)
+	}
+
+	// the restored state is copied to the state storage
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+	ss := target.StateStorage()
+	require.Equal(t, int64(3), ss.LatestVersion())
+	require.Equal(t, []byte{3}, ss.Get(key1.Name(), []byte("key"), 3))
+	require.Equal(t, []byte{1}, ss.Get(key2.Name(), []byte{1}, 3))
+}
+
+func newSnapshotStore(t *testing.T) *snapshots.Store {
+	t.Helper()
+
+	store, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+
+	return store
+}
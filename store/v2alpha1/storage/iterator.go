@@ -0,0 +1,151 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// iterator iterates over the keys of a store at a version. The underlying
+// database iterator yields every version of every key, grouped by key, and the
+// iterator picks the latest version of each key which is not greater than the
+// requested version, skipping the keys deleted at that version.
+type iterator struct {
+	source    dbm.Iterator
+	prefixLen int
+	start     []byte
+	end       []byte
+	version   int64
+
+	key   []byte
+	value []byte
+	valid bool
+	err   error
+}
+
+var _ types.Iterator = (*iterator)(nil)
+
+func newIterator(source dbm.Iterator, prefixLen int, start, end []byte, version int64) *iterator {
+	iter := &iterator{
+		source:    source,
+		prefixLen: prefixLen,
+		start:     start,
+		end:       end,
+		version:   version,
+	}
+	iter.next()
+
+	return iter
+}
+
+// Domain implements types.Iterator.
+func (iter *iterator) Domain() (start []byte, end []byte) {
+	return iter.start, iter.end
+}
+
+// Valid implements types.Iterator.
+func (iter *iterator) Valid() bool {
+	return iter.valid
+}
+
+// Next implements types.Iterator.
+func (iter *iterator) Next() {
+	if !iter.valid {
+		panic("iterator is invalid")
+	}
+
+	iter.next()
+}
+
+// Key implements types.Iterator.
+func (iter *iterator) Key() []byte {
+	if !iter.valid {
+		panic("iterator is invalid")
+	}
+
+	return iter.key
+}
+
+// Value implements types.Iterator.
+func (iter *iterator) Value() []byte {
+	if !iter.valid {
+		panic("iterator is invalid")
+	}
+
+	return iter.value
+}
+
+// Error implements types.Iterator.
+func (iter *iterator) Error() error {
+	if iter.err != nil {
+		return iter.err
+	}
+
+	return iter.source.Error()
+}
+
+// Close implements types.Iterator.
+func (iter *iterator) Close() error {
+	return iter.source.Close()
+}
+
+// next moves the iterator to the next key set at the iterator version.
+func (iter *iterator) next() {
+	for iter.source.Valid() {
+		key, version, err := iter.decode()
+		if err != nil {
+			iter.err = err
+			break
+		}
+
+		var (
+			value []byte
+			found bool
+			best  int64
+		)
+		for {
+			if version <= iter.version && (!found || version > best) {
+				value, found, best = iter.source.Value(), true, version
+			}
+
+			iter.source.Next()
+			if !iter.source.Valid() {
+				break
+			}
+
+			var next []byte
+			next, version, err = iter.decode()
+			if err != nil {
+				iter.err = err
+				iter.valid = false
+				return
+			}
+			if !bytes.Equal(next, key) {
+				break
+			}
+		}
+
+		if found && decodeValue(value) != nil {
+			iter.key, iter.value, iter.valid = key, decodeValue(value), true
+			return
+		}
+	}
+
+	iter.key, iter.value, iter.valid = nil, nil, false
+}
+
+func (iter *iterator) decode() ([]byte, int64, error) {
+	key, rest, err := decodeKey(iter.source.Key()[iter.prefixLen:])
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(rest) != 8 {
+		return nil, 0, fmt.Errorf("invalid version in key %X", iter.source.Key())
+	}
+
+	return key, int64(binary.BigEndian.Uint64(rest)), nil
+}
@@ -0,0 +1,307 @@
+// Package storage implements the state storage (SS) layer of the multistore in
+// store/v2alpha1/multi. Unlike the state commitment (SC) layer, which only
+// keeps the latest state in Merkle trees, the state storage keeps the history
+// of every store as flat key-value pairs indexed by version, so that queries at
+// past heights do not walk a Merkle tree.
+//
+// Every write is stored under the key
+//
+//	d/<store name>/<escaped key>0x0000<big endian version>
+//
+// where 0x00 bytes of the key are escaped as 0x00FF. The escaping keeps the
+// entries ordered by key then version, and makes the key boundaries
+// unambiguous. The value of an entry is prefixed with a byte telling whether
+// the key was set or deleted at that version.
+package storage
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	latestVersionKey   = "s/latest"
+	earliestVersionKey = "s/earliest"
+	dataKeyFmt         = "d/%s/" // d/<store name>/
+
+	valueDeleted byte = 0x00
+	valueSet     byte = 0x01
+)
+
+// Store is the versioned state storage of a multistore.
+type Store struct {
+	db dbm.DB
+}
+
+// NewStore returns a state storage persisted in the given database.
+func NewStore(db dbm.DB) *Store {
+	return &Store{db: db}
+}
+
+// LatestVersion returns the latest version written to the state storage, or 0
+// if nothing has been written.
+func (s *Store) LatestVersion() int64 {
+	bz, err := s.db.Get([]byte(latestVersionKey))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// EarliestVersion returns the first version written to the state storage, or
+// 0 if nothing has been written. The state storage does not hold the state of
+// the versions below it.
+func (s *Store) EarliestVersion() int64 {
+	bz, err := s.db.Get([]byte(earliestVersionKey))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// Get returns the value of the key in the named store at the given version, or
+// nil if the key was not set at that version.
+func (s *Store) Get(storeName string, key []byte, version int64) []byte {
+	types.AssertValidKey(key)
+
+	prefix := append(storePrefix(storeName), encodeKey(key)...)
+	iter, err := s.db.ReverseIterator(prefix, append(prefix, encodeVersion(version+1)...))
+	if err != nil {
+		panic(err)
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil
+	}
+
+	return decodeValue(iter.Value())
+}
+
+// Has returns whether the key is set in the named store at the given version.
+func (s *Store) Has(storeName string, key []byte, version int64) bool {
+	return s.Get(storeName, key, version) != nil
+}
+
+// Iterator returns an iterator over the keys of the named store at the given
+// version, in ascending order. End is exclusive.
+func (s *Store) Iterator(storeName string, start, end []byte, version int64) types.Iterator {
+	return s.newIterator(storeName, start, end, version, false)
+}
+
+// ReverseIterator returns an iterator over the keys of the named store at the
+// given version, in descending order. End is exclusive.
+func (s *Store) ReverseIterator(storeName string, start, end []byte, version int64) types.Iterator {
+	return s.newIterator(storeName, start, end, version, true)
+}
+
+// NewBatch returns a batch of writes to the state storage at the given
+// version. The version must not be lower than the latest version, a batch at
+// the latest version overwrites the writes of that version, e.g. when a block
+// is replayed after a crash.
+func (s *Store) NewBatch(version int64) (*Batch, error) {
+	if latest := s.LatestVersion(); version < latest {
+		return nil, fmt.Errorf("cannot write version %d, the latest version is %d", version, latest)
+	}
+
+	return &Batch{ss: s, batch: s.db.NewBatch(), version: version}, nil
+}
+
+// DeleteVersionsFrom deletes all the writes at the given version and above,
+// and sets the latest version to the previous one. It is used to roll back the
+// state storage along with the state commitment.
+func (s *Store) DeleteVersionsFrom(version int64) error {
+	iter, err := s.db.Iterator([]byte("d/"), types.PrefixEndBytes([]byte("d/")))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if int64(binary.BigEndian.Uint64(key[len(key)-8:])) >= version {
+			if err := batch.Delete(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := setLatestVersion(batch, version-1); err != nil {
+		return err
+	}
+	if version <= s.EarliestVersion() {
+		if err := batch.Delete([]byte(earliestVersionKey)); err != nil {
+			return err
+		}
+	}
+
+	return batch.WriteSync()
+}
+
+// View returns a read-only KVStore of the named store at the given version.
+func (s *Store) View(storeName string, version int64) *View {
+	return &View{ss: s, storeName: storeName, version: version}
+}
+
+func (s *Store) newIterator(storeName string, start, end []byte, version int64, reverse bool) types.Iterator {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		panic(errors.New("iterator keys cannot be empty"))
+	}
+
+	prefix := storePrefix(storeName)
+
+	dbStart := append(append([]byte{}, prefix...), escapeKey(start)...)
+	var dbEnd []byte
+	if end == nil {
+		dbEnd = types.PrefixEndBytes(prefix)
+	} else {
+		dbEnd = append(append([]byte{}, prefix...), escapeKey(end)...)
+	}
+
+	var (
+		source dbm.Iterator
+		err    error
+	)
+	if reverse {
+		source, err = s.db.ReverseIterator(dbStart, dbEnd)
+	} else {
+		source, err = s.db.Iterator(dbStart, dbEnd)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return newIterator(source, len(prefix), start, end, version)
+}
+
+// Batch is a batch of writes to the state storage at a single version.
+type Batch struct {
+	ss      *Store
+	batch   dbm.Batch
+	version int64
+}
+
+// Set sets the value of the key in the named store.
+func (b *Batch) Set(storeName string, key, value []byte) error {
+	return b.batch.Set(dataKey(storeName, key, b.version), append([]byte{valueSet}, value...))
+}
+
+// Delete deletes the key in the named store.
+func (b *Batch) Delete(storeName string, key []byte) error {
+	return b.batch.Set(dataKey(storeName, key, b.version), []byte{valueDeleted})
+}
+
+// Write writes the batch and sets the latest version of the state storage to
+// the version of the batch.
+func (b *Batch) Write() error {
+	if err := setLatestVersion(b.batch, b.version); err != nil {
+		return err
+	}
+	if b.ss.EarliestVersion() == 0 {
+		if err := b.batch.Set([]byte(earliestVersionKey), encodeVersion(b.version)); err != nil {
+			return err
+		}
+	}
+
+	return b.batch.WriteSync()
+}
+
+// Close releases the batch.
+func (b *Batch) Close() error {
+	return b.batch.Close()
+}
+
+func setLatestVersion(batch dbm.Batch, version int64) error {
+	if version <= 0 {
+		return batch.Delete([]byte(latestVersionKey))
+	}
+
+	return batch.Set([]byte(latestVersionKey), encodeVersion(version))
+}
+
+func storePrefix(storeName string) []byte {
+	return []byte(fmt.Sprintf(dataKeyFmt, storeName))
+}
+
+func dataKey(storeName string, key []byte, version int64) []byte {
+	types.AssertValidKey(key)
+
+	bz := append(storePrefix(storeName), encodeKey(key)...)
+	return append(bz, encodeVersion(version)...)
+}
+
+func encodeVersion(version int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(version))
+	return bz
+}
+
+// escapeKey escapes the 0x00 bytes of the key as 0x00FF.
+func escapeKey(key []byte) []byte {
+	bz := make([]byte, 0, len(key))
+	for _, b := range key {
+		bz = append(bz, b)
+		if b == 0x00 {
+			bz = append(bz, 0xFF)
+		}
+	}
+
+	return bz
+}
+
+// encodeKey escapes the key and terminates it with 0x0000.
+func encodeKey(key []byte) []byte {
+	return append(escapeKey(key), 0x00, 0x00)
+}
+
+// decodeKey decodes an encoded key and returns it along with the bytes
+// following it.
+func decodeKey(bz []byte) (key []byte, rest []byte, err error) {
+	key = make([]byte, 0, len(bz))
+	for i := 0; i < len(bz); i++ {
+		if bz[i] != 0x00 {
+			key = append(key, bz[i])
+			continue
+		}
+
+		if i+1 >= len(bz) {
+			break
+		}
+
+		switch bz[i+1] {
+		case 0x00:
+			return key, bz[i+2:], nil
+		case 0xFF:
+			key = append(key, 0x00)
+			i++
+		default:
+			return nil, nil, fmt.Errorf("invalid escape sequence in key %X", bz)
+		}
+	}
+
+	return nil, nil, fmt.Errorf("unterminated key %X", bz)
+}
+
+func decodeValue(bz []byte) []byte {
+	if len(bz) == 0 || bz[0] == valueDeleted {
+		return nil
+	}
+
+	return bz[1:]
+}
@@ -0,0 +1,134 @@
+package storage_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/storage"
+)
+
+func writeVersion(t *testing.T, ss *storage.Store, version int64, sets map[string]string, deletes ...string) {
+	t.Helper()
+
+	batch, err := ss.NewBatch(version)
+	require.NoError(t, err)
+	defer batch.Close()
+
+	for k, v := range sets {
+		require.NoError(t, batch.Set("store", []byte(k), []byte(v)))
+	}
+	for _, k := range deletes {
+		require.NoError(t, batch.Delete("store", []byte(k)))
+	}
+	require.NoError(t, batch.Write())
+}
+
+func collect(iter types.Iterator) []string {
+	defer iter.Close()
+
+	var kvs []string
+	for ; iter.Valid(); iter.Next() {
+		kvs = append(kvs, string(iter.Key())+"="+string(iter.Value()))
+	}
+
+	return kvs
+}
+
+func TestStoreVersions(t *testing.T) {
+	ss := storage.NewStore(dbm.NewMemDB())
+	require.Equal(t, int64(0), ss.LatestVersion())
+	require.Equal(t, int64(0), ss.EarliestVersion())
+
+	writeVersion(t, ss, 2, map[string]string{"a": "1", "b": "1"})
+	writeVersion(t, ss, 3, map[string]string{"a": "2"}, "b")
+	writeVersion(t, ss, 5, map[string]string{"b": "3"})
+
+	require.Equal(t, int64(5), ss.LatestVersion())
+	require.Equal(t, int64(2), ss.EarliestVersion())
+
+	testCases := []struct {
+		version int64
+		a, b    []byte
+	}{
+		{1, nil, nil},
+		{2, []byte("1"), []byte("1")},
+		{3, []byte("2"), nil},
+		{4, []byte("2"), nil},
+		{5, []byte("2"), []byte("3")},
+		{6, []byte("2"), []byte("3")},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.a, ss.Get("store", []byte("a"), tc.version), "version %d", tc.version)
+		require.Equal(t, tc.b, ss.Get("store", []byte("b"), tc.version), "version %d", tc.version)
+		require.Equal(t, tc.b != nil, ss.Has("store", []byte("b"), tc.version), "version %d", tc.version)
+	}
+
+	// stores are isolated
+	require.Nil(t, ss.Get("other", []byte("a"), 5))
+
+	// versions below the latest one cannot be written
+	_, err := ss.NewBatch(4)
+	require.Error(t, err)
+}
+
+func TestStoreIterator(t *testing.T) {
+	ss := storage.NewStore(dbm.NewMemDB())
+
+	writeVersion(t, ss, 1, map[string]string{"a": "1", "a\x00": "1", "a\x00b": "1", "ab": "1", "b": "1"})
+	writeVersion(t, ss, 2, map[string]string{"a\x00": "2", "c": "2"}, "ab")
+	writeVersion(t, ss, 3, nil, "a", "c")
+
+	require.Equal(t, []string{"a=1", "a\x00=1", "a\x00b=1", "ab=1", "b=1"}, collect(ss.Iterator("store", nil, nil, 1)))
+	require.Equal(t, []string{"a=1", "a\x00=2", "a\x00b=1", "b=1", "c=2"}, collect(ss.Iterator("store", nil, nil, 2)))
+	require.Equal(t, []string{"a\x00=2", "a\x00b=1", "b=1"}, collect(ss.Iterator("store", nil, nil, 3)))
+	require.Equal(t, []string{"b=1", "a\x00b=1", "a\x00=2"}, collect(ss.ReverseIterator("store", nil, nil, 3)))
+
+	// the domain is respected with keys containing 0x00 bytes
+	require.Equal(t, []string{"a\x00=2", "a\x00b=1"}, collect(ss.Iterator("store", []byte("a\x00"), []byte("ab"), 2)))
+	require.Equal(t, []string{"a\x00b=1", "a\x00=2", "a=1"}, collect(ss.ReverseIterator("store", []byte("a"), []byte("a\x01"), 2)))
+	require.Equal(t, []string{"a\x00=2", "a\x00b=1"}, collect(types.KVStorePrefixIterator(ss.View("store", 2), []byte("a\x00"))))
+
+	require.Panics(t, func() { ss.Iterator("store", []byte{}, nil, 1) })
+}
+
+func TestStoreDeleteVersionsFrom(t *testing.T) {
+	ss := storage.NewStore(dbm.NewMemDB())
+
+	writeVersion(t, ss, 1, map[string]string{"a": "1"})
+	writeVersion(t, ss, 2, map[string]string{"a": "2"})
+	writeVersion(t, ss, 3, map[string]string{"a": "3", "b": "3"})
+
+	require.NoError(t, ss.DeleteVersionsFrom(2))
+	require.Equal(t, int64(1), ss.LatestVersion())
+	require.Equal(t, []byte("1"), ss.Get("store", []byte("a"), 3))
+	require.Nil(t, ss.Get("store", []byte("b"), 3))
+
+	// the deleted versions can be written again
+	writeVersion(t, ss, 2, map[string]string{"b": "2"})
+	require.Equal(t, []string{"a=1", "b=2"}, collect(ss.Iterator("store", nil, nil, 2)))
+
+	require.NoError(t, ss.DeleteVersionsFrom(1))
+	require.Equal(t, int64(0), ss.LatestVersion())
+	require.Equal(t, int64(0), ss.EarliestVersion())
+	require.Empty(t, collect(ss.Iterator("store", nil, nil, 2)))
+}
+
+func TestView(t *testing.T) {
+	ss := storage.NewStore(dbm.NewMemDB())
+	writeVersion(t, ss, 1, map[string]string{"a": "1"})
+
+	view := ss.View("store", 1)
+	require.Equal(t, int64(1), view.Version())
+	require.Equal(t, []byte("1"), view.Get([]byte("a")))
+	require.Panics(t, func() { view.Set([]byte("a"), []byte("2")) })
+	require.Panics(t, func() { view.Delete([]byte("a")) })
+
+	// writes are allowed on a branch of the view
+	cache := view.CacheWrap().(types.KVStore)
+	cache.Set([]byte("a"), []byte("2"))
+	require.Equal(t, []byte("2"), cache.Get([]byte("a")))
+	require.Equal(t, []byte("1"), view.Get([]byte("a")))
+}
@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// View is a read-only KVStore of a store of the state storage at a version.
+type View struct {
+	ss        *Store
+	storeName string
+	version   int64
+}
+
+var _ types.KVStore = (*View)(nil)
+
+// Version returns the version of the view.
+func (v *View) Version() int64 {
+	return v.version
+}
+
+// Get implements types.KVStore.
+func (v *View) Get(key []byte) []byte {
+	return v.ss.Get(v.storeName, key, v.version)
+}
+
+// Has implements types.KVStore.
+func (v *View) Has(key []byte) bool {
+	return v.ss.Has(v.storeName, key, v.version)
+}
+
+// Set implements types.KVStore, it panics as the view is read-only.
+func (v *View) Set(key, value []byte) {
+	panic("cannot write to a state storage view")
+}
+
+// Delete implements types.KVStore, it panics as the view is read-only.
+func (v *View) Delete(key []byte) {
+	panic("cannot delete from a state storage view")
+}
+
+// Iterator implements types.KVStore.
+func (v *View) Iterator(start, end []byte) types.Iterator {
+	return v.ss.Iterator(v.storeName, start, end, v.version)
+}
+
+// ReverseIterator implements types.KVStore.
+func (v *View) ReverseIterator(start, end []byte) types.Iterator {
+	return v.ss.ReverseIterator(v.storeName, start, end, v.version)
+}
+
+// GetStoreType implements types.Store.
+func (v *View) GetStoreType() types.StoreType {
+	return types.StoreTypeDB
+}
+
+// CacheWrap implements types.KVStore.
+func (v *View) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(v)
+}
+
+// CacheWrapWithTrace implements types.KVStore.
+func (v *View) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(v, w, tc))
+}
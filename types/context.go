@@ -38,26 +38,33 @@ type Context struct {
 	minGasPrice   DecCoins
 	consParams    *abci.ConsensusParams
 	eventManager  *EventManager
+	priority      int64 // The tx priority, only relevant in CheckTx
+
+	kvGasConfig          GasConfig
+	transientKVGasConfig GasConfig
 }
 
 // Proposed rename, not done to avoid API breakage
 type Request = Context
 
 // Read-only accessors
-func (c Context) Context() context.Context    { return c.ctx }
-func (c Context) MultiStore() MultiStore      { return c.ms }
-func (c Context) BlockHeight() int64          { return c.header.Height }
-func (c Context) BlockTime() time.Time        { return c.header.Time }
-func (c Context) ChainID() string             { return c.chainID }
-func (c Context) TxBytes() []byte             { return c.txBytes }
-func (c Context) Logger() log.Logger          { return c.logger }
-func (c Context) VoteInfos() []abci.VoteInfo  { return c.voteInfo }
-func (c Context) GasMeter() GasMeter          { return c.gasMeter }
-func (c Context) BlockGasMeter() GasMeter     { return c.blockGasMeter }
-func (c Context) IsCheckTx() bool             { return c.checkTx }
-func (c Context) IsReCheckTx() bool           { return c.recheckTx }
-func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
-func (c Context) EventManager() *EventManager { return c.eventManager }
+func (c Context) Context() context.Context        { return c.ctx }
+func (c Context) MultiStore() MultiStore          { return c.ms }
+func (c Context) BlockHeight() int64              { return c.header.Height }
+func (c Context) BlockTime() time.Time            { return c.header.Time }
+func (c Context) ChainID() string                 { return c.chainID }
+func (c Context) TxBytes() []byte                 { return c.txBytes }
+func (c Context) Logger() log.Logger              { return c.logger }
+func (c Context) VoteInfos() []abci.VoteInfo      { return c.voteInfo }
+func (c Context) GasMeter() GasMeter              { return c.gasMeter }
+func (c Context) BlockGasMeter() GasMeter         { return c.blockGasMeter }
+func (c Context) IsCheckTx() bool                 { return c.checkTx }
+func (c Context) IsReCheckTx() bool               { return c.recheckTx }
+func (c Context) MinGasPrices() DecCoins          { return c.minGasPrice }
+func (c Context) EventManager() *EventManager     { return c.eventManager }
+func (c Context) Priority() int64                 { return c.priority }
+func (c Context) KVGasConfig() GasConfig          { return c.kvGasConfig }
+func (c Context) TransientKVGasConfig() GasConfig { return c.transientKVGasConfig }
 
 // clone the header before returning
 func (c Context) BlockHeader() tmproto.Header {
@@ -90,6 +97,9 @@ func NewContext(ms MultiStore, header tmproto.Header, isCheckTx bool, logger log
 		gasMeter:     stypes.NewInfiniteGasMeter(),
 		minGasPrice:  DecCoins{},
 		eventManager: NewEventManager(),
+
+		kvGasConfig:          stypes.KVGasConfig(),
+		transientKVGasConfig: stypes.TransientGasConfig(),
 	}
 }
 
@@ -214,6 +224,25 @@ func (c Context) WithEventManager(em *EventManager) Context {
 	return c
 }
 
+// WithPriority returns a Context with an updated tx priority
+func (c Context) WithPriority(p int64) Context {
+	c.priority = p
+	return c
+}
+
+// WithKVGasConfig returns a Context with an updated gas config of the KVStores.
+func (c Context) WithKVGasConfig(gasConfig GasConfig) Context {
+	c.kvGasConfig = gasConfig
+	return c
+}
+
+// WithTransientKVGasConfig returns a Context with an updated gas config of the
+// TransientStores.
+func (c Context) WithTransientKVGasConfig(gasConfig GasConfig) Context {
+	c.transientKVGasConfig = gasConfig
+	return c
+}
+
 // TODO: remove???
 func (c Context) IsZero() bool {
 	return c.ms == nil
@@ -250,12 +279,12 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.KVGasConfig())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), c.kvGasConfig)
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.TransientGasConfig())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), c.transientKVGasConfig)
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
@@ -0,0 +1,34 @@
+package types
+
+// Mempool defines an application side mempool, owned by BaseApp. Transactions
+// passing CheckTx are inserted into it, and transactions included in a block,
+// failing on recheck or expired are removed from it. A transaction is
+// identified by the hash of its bytes, i.e. of ctx.TxBytes().
+//
+// NOTE: Tendermint v0.34 has no ABCI method letting the application build the
+// block proposals, it always reaps them from its own mempool in the order of
+// the priority returned by CheckTx. Hence BaseApp never calls Select, and the
+// application side mempool only tracks and orders the pending transactions,
+// e.g. for inspection or a future Tendermint version.
+type Mempool interface {
+	// Insert attempts to insert a Tx into the mempool, returning an error on
+	// failure. The context is the CheckTx context of the transaction, e.g. its
+	// priority is given by ctx.Priority() and its height by ctx.BlockHeight().
+	Insert(ctx Context, tx Tx) error
+
+	// Select returns, in the order they should be included in a block, at most
+	// limit transactions from the mempool. If limit is zero or negative, all
+	// transactions are returned.
+	Select(ctx Context, limit int) []Tx
+
+	// CountTx returns the number of transactions currently in the mempool.
+	CountTx() int
+
+	// Remove attempts to remove the transaction of the context from the
+	// mempool, returning an error on failure.
+	Remove(ctx Context, tx Tx) error
+
+	// EvictExpired removes from the mempool the transactions inserted at a
+	// height lower than or equal to height, and returns their number.
+	EvictExpired(height int64) int
+}
@@ -0,0 +1,208 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var (
+	// ErrTxNotFound is returned when removing a transaction which is not in the
+	// mempool.
+	ErrTxNotFound = errors.New("tx not found in mempool")
+
+	// ErrTxAlreadyExists is returned when inserting a transaction which is
+	// already in the mempool.
+	ErrTxAlreadyExists = errors.New("tx already in mempool")
+)
+
+// txKey identifies the sender of a transaction, i.e. its first signer, and its
+// nonce, i.e. the sequence of its first signature.
+type txKey struct {
+	sender string
+	nonce  uint64
+}
+
+// getTxKey returns the sender and nonce of a transaction.
+func getTxKey(tx sdk.Tx) (txKey, error) {
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return txKey{}, fmt.Errorf("tx of type %T does not implement SigVerifiableTx", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return txKey{}, err
+	}
+	signers := sigTx.GetSigners()
+	if len(sigs) == 0 || len(signers) == 0 {
+		return txKey{}, errors.New("tx must have at least one signer")
+	}
+
+	return txKey{sender: signers[0].String(), nonce: sigs[0].Sequence}, nil
+}
+
+// getTxHash returns the hash identifying the transaction of the context, i.e.
+// the hash of its bytes.
+func getTxHash(ctx sdk.Context) (string, error) {
+	if len(ctx.TxBytes()) == 0 {
+		return "", errors.New("context has no tx bytes")
+	}
+
+	return string(tmhash.Sum(ctx.TxBytes())), nil
+}
+
+// entry is a transaction held in the mempool.
+type entry struct {
+	tx       sdk.Tx
+	sender   string
+	nonce    uint64
+	priority int64
+	// order is the insertion order of the transaction, used to break ties
+	// between transactions of equal priority or nonce.
+	order uint64
+	// height is the block height of the CheckTx context the transaction was
+	// inserted with.
+	height int64
+}
+
+// senderTxs holds the transactions of a sender, sorted by nonce then by
+// insertion order.
+type senderTxs []*entry
+
+// insert returns the list with e inserted after the entries of lower or equal
+// nonce.
+func (s senderTxs) insert(e *entry) senderTxs {
+	i := sort.Search(len(s), func(i int) bool { return s[i].nonce > e.nonce })
+	s = append(s, nil)
+	copy(s[i+1:], s[i:])
+	s[i] = e
+	return s
+}
+
+// remove returns the list without e.
+func (s senderTxs) remove(e *entry) senderTxs {
+	for i := range s {
+		if s[i] == e {
+			return append(s[:i], s[i+1:]...)
+		}
+	}
+
+	return s
+}
+
+// pool holds the transactions of a mempool by hash, and by sender in nonce
+// order. It implements the Mempool interface but Select, which depends on the
+// ordering of the mempool.
+type pool struct {
+	mtx     sync.RWMutex
+	maxTx   int
+	order   uint64
+	txs     map[string]*entry
+	senders map[string]senderTxs
+}
+
+func newPool(maxTx int) pool {
+	return pool{
+		maxTx:   maxTx,
+		txs:     make(map[string]*entry),
+		senders: make(map[string]senderTxs),
+	}
+}
+
+// Insert implements the Mempool interface. It returns an error if the
+// transaction is already in the mempool or if the mempool is full.
+func (p *pool) Insert(ctx sdk.Context, tx sdk.Tx) error {
+	hash, err := getTxHash(ctx)
+	if err != nil {
+		return err
+	}
+	key, err := getTxKey(tx)
+	if err != nil {
+		return err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if _, ok := p.txs[hash]; ok {
+		return ErrTxAlreadyExists
+	}
+	if p.maxTx > 0 && len(p.txs) >= p.maxTx {
+		return sdkerrors.ErrMempoolIsFull
+	}
+
+	e := &entry{
+		tx:       tx,
+		sender:   key.sender,
+		nonce:    key.nonce,
+		priority: ctx.Priority(),
+		order:    p.order,
+		height:   ctx.BlockHeight(),
+	}
+	p.txs[hash] = e
+	p.senders[key.sender] = p.senders[key.sender].insert(e)
+	p.order++
+
+	return nil
+}
+
+// CountTx implements the Mempool interface.
+func (p *pool) CountTx() int {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	return len(p.txs)
+}
+
+// Remove implements the Mempool interface.
+func (p *pool) Remove(ctx sdk.Context, _ sdk.Tx) error {
+	hash, err := getTxHash(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	e, ok := p.txs[hash]
+	if !ok {
+		return ErrTxNotFound
+	}
+	p.remove(hash, e)
+
+	return nil
+}
+
+// EvictExpired implements the Mempool interface.
+func (p *pool) EvictExpired(height int64) int {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	evicted := 0
+	for hash, e := range p.txs {
+		if e.height <= height {
+			p.remove(hash, e)
+			evicted++
+		}
+	}
+
+	return evicted
+}
+
+// remove removes the entry of the given hash. The caller must hold the lock.
+func (p *pool) remove(hash string, e *entry) {
+	delete(p.txs, hash)
+	if txs := p.senders[e.sender].remove(e); len(txs) == 0 {
+		delete(p.senders, e.sender)
+	} else {
+		p.senders[e.sender] = txs
+	}
+}
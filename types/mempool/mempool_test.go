@@ -0,0 +1,205 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+type testTx struct {
+	sdk.Tx
+	priority int64
+}
+
+type testSuite struct {
+	txConfig client.TxConfig
+	ctx      sdk.Context
+	privs    []cryptotypes.PrivKey
+}
+
+func newTestSuite(nAccounts int) testSuite {
+	s := testSuite{
+		txConfig: authtx.NewTxConfig(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), authtx.DefaultSignModes),
+		ctx:      sdk.NewContext(nil, tmproto.Header{}, true, log.NewNopLogger()),
+	}
+	for i := 0; i < nAccounts; i++ {
+		s.privs = append(s.privs, secp256k1.GenPrivKey())
+	}
+
+	return s
+}
+
+// newTx returns a tx signed by the account with the given index.
+func (s testSuite) newTx(t *testing.T, account int, nonce uint64, priority int64) testTx {
+	priv := s.privs[account]
+	bldr := s.txConfig.NewTxBuilder()
+	require.NoError(t, bldr.SetMsgs(testdata.NewTestMsg(sdk.AccAddress(priv.PubKey().Address()))))
+	require.NoError(t, bldr.SetSignatures(signing.SignatureV2{
+		PubKey:   priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: nonce,
+	}))
+
+	return testTx{bldr.GetTx(), priority}
+}
+
+// txCtx returns the context of the tx, holding its bytes.
+func (s testSuite) txCtx(t *testing.T, tx testTx) sdk.Context {
+	bz, err := s.txConfig.TxEncoder()(tx.Tx)
+	require.NoError(t, err)
+	return s.ctx.WithTxBytes(bz).WithPriority(tx.priority)
+}
+
+func (s testSuite) insert(t *testing.T, mp sdk.Mempool, tx testTx) error {
+	return mp.Insert(s.txCtx(t, tx), tx.Tx)
+}
+
+func (s testSuite) remove(t *testing.T, mp sdk.Mempool, tx testTx) error {
+	return mp.Remove(s.txCtx(t, tx), tx.Tx)
+}
+
+func requireSelected(t *testing.T, expected []testTx, selected []sdk.Tx) {
+	require.Len(t, selected, len(expected))
+	for i, tx := range expected {
+		require.Equal(t, tx.Tx, selected[i], "tx %d", i)
+	}
+}
+
+func TestPriorityMempool(t *testing.T) {
+	s := newTestSuite(3)
+	mp := mempool.NewPriorityMempool(0)
+
+	txs := []testTx{
+		s.newTx(t, 0, 0, 10),
+		s.newTx(t, 0, 1, 50),
+		s.newTx(t, 1, 0, 20),
+		s.newTx(t, 2, 5, 20),
+		s.newTx(t, 2, 6, 100),
+		s.newTx(t, 1, 1, 1),
+	}
+	for _, tx := range txs {
+		require.NoError(t, s.insert(t, mp, tx))
+	}
+	require.Equal(t, len(txs), mp.CountTx())
+
+	// txs are selected by priority, in nonce order for each sender, and by
+	// arrival for equal priorities
+	requireSelected(t, []testTx{txs[2], txs[3], txs[4], txs[0], txs[1], txs[5]}, mp.Select(s.ctx, 0))
+	requireSelected(t, []testTx{txs[2], txs[3]}, mp.Select(s.ctx, 2))
+
+	require.ErrorIs(t, s.insert(t, mp, txs[0]), mempool.ErrTxAlreadyExists)
+
+	require.NoError(t, s.remove(t, mp, txs[3]))
+	require.ErrorIs(t, s.remove(t, mp, txs[3]), mempool.ErrTxNotFound)
+	require.Equal(t, len(txs)-1, mp.CountTx())
+	requireSelected(t, []testTx{txs[4], txs[2], txs[0], txs[1], txs[5]}, mp.Select(s.ctx, 0))
+
+	// a different tx with the same sender and nonce does not remove it
+	bldr, err := s.txConfig.WrapTxBuilder(s.newTx(t, 1, 0, 20).Tx)
+	require.NoError(t, err)
+	bldr.SetMemo("other")
+	require.ErrorIs(t, s.remove(t, mp, testTx{Tx: bldr.GetTx()}), mempool.ErrTxNotFound)
+	require.Equal(t, len(txs)-1, mp.CountTx())
+}
+
+func TestPriorityMempool_MaxTx(t *testing.T) {
+	s := newTestSuite(1)
+	mp := mempool.NewPriorityMempool(1)
+
+	require.NoError(t, s.insert(t, mp, s.newTx(t, 0, 0, 10)))
+	require.ErrorIs(t, s.insert(t, mp, s.newTx(t, 0, 1, 10)), sdkerrors.ErrMempoolIsFull)
+	require.Equal(t, 1, mp.CountTx())
+}
+
+func TestSenderNonceMempool(t *testing.T) {
+	s := newTestSuite(3)
+	mp := mempool.NewSenderNonceMempool(0)
+
+	txs := []testTx{
+		s.newTx(t, 0, 2, 0),
+		s.newTx(t, 0, 1, 0),
+		s.newTx(t, 1, 0, 0),
+		s.newTx(t, 0, 3, 0),
+		s.newTx(t, 2, 7, 0),
+	}
+	for _, tx := range txs {
+		require.NoError(t, s.insert(t, mp, tx))
+	}
+	require.Equal(t, len(txs), mp.CountTx())
+	require.ErrorIs(t, s.insert(t, mp, txs[0]), mempool.ErrTxAlreadyExists)
+
+	// senders take turns, each one in nonce order
+	selected := mp.Select(s.ctx, 0)
+	require.Len(t, selected, len(txs))
+
+	var nonces [3][]uint64
+	for _, tx := range selected {
+		for account, priv := range s.privs {
+			sigs, err := tx.(authsigning.Tx).GetSignaturesV2()
+			require.NoError(t, err)
+			if sigs[0].PubKey.Equals(priv.PubKey()) {
+				nonces[account] = append(nonces[account], sigs[0].Sequence)
+			}
+		}
+	}
+	require.Equal(t, [3][]uint64{{1, 2, 3}, {0}, {7}}, nonces)
+
+	// the first round selects the lowest nonce tx of every sender
+	firstRound := mp.Select(s.ctx, 3)
+	require.Len(t, firstRound, 3)
+	require.ElementsMatch(t, []sdk.Tx{txs[1].Tx, txs[2].Tx, txs[4].Tx}, firstRound)
+
+	require.NoError(t, s.remove(t, mp, txs[1]))
+	require.ErrorIs(t, s.remove(t, mp, txs[1]), mempool.ErrTxNotFound)
+	require.Equal(t, len(txs)-1, mp.CountTx())
+}
+
+func TestSenderNonceMempool_MaxTx(t *testing.T) {
+	s := newTestSuite(1)
+	mp := mempool.NewSenderNonceMempool(1)
+
+	require.NoError(t, s.insert(t, mp, s.newTx(t, 0, 0, 0)))
+	require.ErrorIs(t, s.insert(t, mp, s.newTx(t, 0, 1, 0)), sdkerrors.ErrMempoolIsFull)
+}
+
+func TestMempool_InvalidTx(t *testing.T) {
+	s := newTestSuite(1)
+	unsigned := s.txConfig.NewTxBuilder().GetTx()
+
+	for _, mp := range []sdk.Mempool{mempool.NewPriorityMempool(0), mempool.NewSenderNonceMempool(0)} {
+		require.Error(t, s.insert(t, mp, testTx{Tx: unsigned}))
+		require.Error(t, mp.Remove(s.ctx, unsigned))
+	}
+}
+
+func TestMempool_EvictExpired(t *testing.T) {
+	s := newTestSuite(2)
+
+	for _, mp := range []sdk.Mempool{mempool.NewPriorityMempool(0), mempool.NewSenderNonceMempool(0)} {
+		txs := []testTx{s.newTx(t, 0, 0, 10), s.newTx(t, 0, 1, 10), s.newTx(t, 1, 0, 10)}
+		for i, tx := range txs {
+			require.NoError(t, mp.Insert(s.txCtx(t, tx).WithBlockHeight(int64(i+1)), tx.Tx))
+		}
+
+		require.Equal(t, 0, mp.EvictExpired(0))
+		require.Equal(t, 2, mp.EvictExpired(2))
+		require.Equal(t, 1, mp.CountTx())
+		requireSelected(t, txs[2:], mp.Select(s.ctx, 0))
+		require.ErrorIs(t, s.remove(t, mp, txs[0]), mempool.ErrTxNotFound)
+	}
+}
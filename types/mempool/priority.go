@@ -0,0 +1,82 @@
+package mempool
+
+import (
+	"container/heap"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Mempool = (*PriorityMempool)(nil)
+
+// PriorityMempool is a mempool ordering transactions by priority, i.e. by gas
+// price as set by the ante handler in the CheckTx context. Transactions of
+// equal priority are ordered by arrival.
+//
+// The transactions of a sender are always selected in nonce order, as a block
+// including them out of order would fail: the next selected transaction is the
+// one with the highest priority among the lowest nonce transactions of every
+// sender.
+type PriorityMempool struct {
+	pool
+}
+
+// NewPriorityMempool returns a new PriorityMempool holding at most maxTx
+// transactions. If maxTx is zero or negative, the mempool size is unbounded.
+func NewPriorityMempool(maxTx int) *PriorityMempool {
+	return &PriorityMempool{pool: newPool(maxTx)}
+}
+
+// Select implements the Mempool interface.
+func (mp *PriorityMempool) Select(_ sdk.Context, limit int) []sdk.Tx {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	// heads holds the next transaction to select of each sender.
+	heads := make(priorityHeap, 0, len(mp.senders))
+	for _, txs := range mp.senders {
+		heads = append(heads, txs)
+	}
+	heap.Init(&heads)
+
+	var selected []sdk.Tx
+	for heads.Len() > 0 && (limit <= 0 || len(selected) < limit) {
+		txs := heads[0]
+		selected = append(selected, txs[0].tx)
+
+		if len(txs) > 1 {
+			heads[0] = txs[1:]
+			heap.Fix(&heads, 0)
+		} else {
+			heap.Pop(&heads)
+		}
+	}
+
+	return selected
+}
+
+// priorityHeap is a max heap of the remaining transactions of senders, ordered
+// by the priority of their lowest nonce transaction.
+type priorityHeap []senderTxs
+
+func (h priorityHeap) Len() int { return len(h) }
+
+func (h priorityHeap) Less(i, j int) bool {
+	a, b := h[i][0], h[j][0]
+	if a.priority != b.priority {
+		return a.priority > b.priority
+	}
+
+	return a.order < b.order
+}
+
+func (h priorityHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *priorityHeap) Push(x interface{}) { *h = append(*h, x.(senderTxs)) }
+
+func (h *priorityHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
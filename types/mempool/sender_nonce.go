@@ -0,0 +1,54 @@
+package mempool
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Mempool = (*SenderNonceMempool)(nil)
+
+// SenderNonceMempool is a mempool ordering the transactions of each sender by
+// nonce, without any priority between senders. Select takes one transaction
+// from each sender in turn, senders being ordered by address, so that no
+// sender can crowd out the others.
+type SenderNonceMempool struct {
+	pool
+}
+
+// NewSenderNonceMempool returns a new SenderNonceMempool holding at most maxTx
+// transactions. If maxTx is zero or negative, the mempool size is unbounded.
+func NewSenderNonceMempool(maxTx int) *SenderNonceMempool {
+	return &SenderNonceMempool{pool: newPool(maxTx)}
+}
+
+// Select implements the Mempool interface.
+func (mp *SenderNonceMempool) Select(_ sdk.Context, limit int) []sdk.Tx {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	senders := make([]string, 0, len(mp.senders))
+	for sender := range mp.senders {
+		senders = append(senders, sender)
+	}
+	sort.Strings(senders)
+
+	var selected []sdk.Tx
+	for round := 0; len(senders) > 0; round++ {
+		remaining := senders[:0]
+		for _, sender := range senders {
+			if limit > 0 && len(selected) >= limit {
+				return selected
+			}
+
+			txs := mp.senders[sender]
+			selected = append(selected, txs[round].tx)
+			if round+1 < len(txs) {
+				remaining = append(remaining, sender)
+			}
+		}
+		senders = remaining
+	}
+
+	return selected
+}
@@ -0,0 +1,83 @@
+package tx
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _, _ codectypes.UnpackInterfacesMessage = &SignDocDirectAux{}, &AuxSignerData{}
+
+// ValidateBasic performs stateless validation of the sign doc.
+func (s *SignDocDirectAux) ValidateBasic() error {
+	if len(s.BodyBytes) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "body bytes cannot be empty")
+	}
+
+	if s.PublicKey == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "public key cannot be empty")
+	}
+
+	if s.Tip != nil {
+		if err := s.Tip.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (s *SignDocDirectAux) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(s.PublicKey, new(cryptotypes.PubKey))
+}
+
+// ValidateBasic performs stateless validation of the auxiliary signer data.
+func (a *AuxSignerData) ValidateBasic() error {
+	if a.Address == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "address cannot be empty")
+	}
+
+	if a.Mode != signing.SignMode_SIGN_MODE_DIRECT_AUX && a.Mode != signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "AuxSignerData mode must be DIRECT_AUX or LEGACY_AMINO_JSON, got %s", a.Mode)
+	}
+
+	if len(a.Sig) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrNoSignatures, "signature cannot be empty")
+	}
+
+	if a.SignDoc == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sign doc cannot be empty")
+	}
+
+	return a.SignDoc.ValidateBasic()
+}
+
+// GetSignatureV2 gets the SignatureV2 of the signer. It must only be called
+// on validated and unpacked AuxSignerData.
+func (a *AuxSignerData) GetSignatureV2() (signing.SignatureV2, error) {
+	pkAny := a.SignDoc.PublicKey.GetCachedValue()
+	pk, ok := pkAny.(cryptotypes.PubKey)
+	if !ok {
+		return signing.SignatureV2{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected PubKey, got %T", pkAny)
+	}
+
+	return signing.SignatureV2{
+		PubKey: pk,
+		Data: &signing.SingleSignatureData{
+			SignMode:  a.Mode,
+			Signature: a.Sig,
+		},
+		Sequence: a.SignDoc.Sequence,
+	}, nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (a *AuxSignerData) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if a.SignDoc == nil {
+		return nil
+	}
+
+	return a.SignDoc.UnpackInterfaces(unpacker)
+}
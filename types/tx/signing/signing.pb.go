@@ -38,6 +38,11 @@ const (
 	// human-readable textual representation on top of the binary representation
 	// from SIGN_MODE_DIRECT
 	SignMode_SIGN_MODE_TEXTUAL SignMode = 2
+	// SIGN_MODE_DIRECT_AUX specifies a signing mode which uses
+	// SignDocDirectAux. As opposed to SIGN_MODE_DIRECT, this sign mode does not
+	// require signers signing over other signers' `signer_info`. It also allows
+	// for adding Tips in transactions.
+	SignMode_SIGN_MODE_DIRECT_AUX SignMode = 3
 	// SIGN_MODE_LEGACY_AMINO_JSON is a backwards compatibility mode which uses
 	// Amino JSON and will be removed in the future
 	SignMode_SIGN_MODE_LEGACY_AMINO_JSON SignMode = 127
@@ -58,6 +63,7 @@ var SignMode_name = map[int32]string{
 	0:   "SIGN_MODE_UNSPECIFIED",
 	1:   "SIGN_MODE_DIRECT",
 	2:   "SIGN_MODE_TEXTUAL",
+	3:   "SIGN_MODE_DIRECT_AUX",
 	127: "SIGN_MODE_LEGACY_AMINO_JSON",
 	191: "SIGN_MODE_EIP_191",
 }
@@ -66,6 +72,7 @@ var SignMode_value = map[string]int32{
 	"SIGN_MODE_UNSPECIFIED":       0,
 	"SIGN_MODE_DIRECT":            1,
 	"SIGN_MODE_TEXTUAL":           2,
+	"SIGN_MODE_DIRECT_AUX":        3,
 	"SIGN_MODE_LEGACY_AMINO_JSON": 127,
 	"SIGN_MODE_EIP_191":           191,
 }
@@ -404,43 +411,43 @@ func init() {
 }
 
 var fileDescriptor_9a54958ff3d0b1b9 = []byte{
-	// 562 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x86, 0xed, 0xe6, 0x47, 0xe9, 0xf4, 0xd3, 0x27, 0x33, 0xa4, 0x28, 0x31, 0xc8, 0x44, 0x65,
-	0x41, 0x84, 0x94, 0xb1, 0x92, 0x2c, 0x50, 0xd9, 0xe5, 0xc7, 0xa4, 0xa6, 0x4d, 0x52, 0xec, 0x54,
-	0x02, 0x36, 0x96, 0xed, 0x4c, 0x8d, 0xd5, 0xd8, 0x63, 0x3c, 0x63, 0x54, 0xaf, 0xb8, 0x00, 0x36,
-	0xdc, 0x06, 0x57, 0xc1, 0x82, 0x0d, 0xcb, 0x2e, 0x59, 0xa2, 0xe4, 0x1a, 0xd8, 0xa3, 0xd8, 0x71,
-	0x12, 0xa4, 0x22, 0x44, 0x56, 0xd6, 0x9c, 0xf3, 0x9e, 0xe7, 0xbc, 0xa3, 0x73, 0xc6, 0xe0, 0xb1,
-	0x4d, 0xa8, 0x47, 0xa8, 0xcc, 0xae, 0x65, 0xea, 0x3a, 0xbe, 0xeb, 0x3b, 0xf2, 0xfb, 0xa6, 0x85,
-	0x99, 0xd9, 0xcc, 0xce, 0x28, 0x08, 0x09, 0x23, 0xb0, 0x9a, 0x0a, 0x11, 0xbb, 0x46, 0x59, 0x62,
-	0x25, 0x14, 0x1b, 0x2b, 0x86, 0x1d, 0xc6, 0x01, 0x23, 0xb2, 0x17, 0xcd, 0x98, 0x4b, 0xdd, 0x0d,
-	0x28, 0x0b, 0xa4, 0x24, 0xb1, 0xea, 0x10, 0xe2, 0xcc, 0xb0, 0x9c, 0x9c, 0xac, 0xe8, 0x52, 0x36,
-	0xfd, 0x38, 0x4d, 0x1d, 0x5d, 0x82, 0xb2, 0xee, 0x3a, 0xbe, 0xc9, 0xa2, 0x10, 0xf7, 0x31, 0xb5,
-	0x43, 0x37, 0x60, 0x24, 0xa4, 0x70, 0x04, 0x00, 0xcd, 0xe2, 0xb4, 0xc2, 0xd7, 0x72, 0xf5, 0x83,
-	0x16, 0x42, 0x7f, 0x74, 0x84, 0x6e, 0x81, 0x68, 0x5b, 0x84, 0xa3, 0x9f, 0x79, 0x70, 0xf7, 0x16,
-	0x0d, 0x6c, 0x03, 0x10, 0x44, 0xd6, 0xcc, 0xb5, 0x8d, 0x2b, 0x1c, 0x57, 0xf8, 0x1a, 0x5f, 0x3f,
-	0x68, 0x95, 0x51, 0xea, 0x17, 0x65, 0x7e, 0x51, 0xc7, 0x8f, 0xb5, 0xfd, 0x54, 0x77, 0x8a, 0x63,
-	0x38, 0x00, 0xf9, 0xa9, 0xc9, 0xcc, 0xca, 0x5e, 0x22, 0x6f, 0xff, 0x9b, 0x2d, 0xd4, 0x37, 0x99,
-	0xa9, 0x25, 0x00, 0x28, 0x82, 0x12, 0xc5, 0xef, 0x22, 0xec, 0xdb, 0xb8, 0x92, 0xab, 0xf1, 0xf5,
-	0xbc, 0xb6, 0x3e, 0x8b, 0x5f, 0x73, 0x20, 0xbf, 0x94, 0xc2, 0x09, 0x28, 0x52, 0xd7, 0x77, 0x66,
-	0x78, 0x65, 0xef, 0xd9, 0x0e, 0xfd, 0x90, 0x9e, 0x10, 0x4e, 0x38, 0x6d, 0xc5, 0x82, 0x2f, 0x41,
-	0x21, 0x99, 0xd2, 0xea, 0x12, 0xc7, 0xbb, 0x40, 0x87, 0x4b, 0xc0, 0x09, 0xa7, 0xa5, 0x24, 0xd1,
-	0x00, 0xc5, 0xb4, 0x0d, 0x7c, 0x0a, 0xf2, 0x1e, 0x99, 0xa6, 0x86, 0xff, 0x6f, 0x3d, 0xfa, 0x0b,
-	0x7b, 0x48, 0xa6, 0x58, 0x4b, 0x0a, 0xe0, 0x03, 0xb0, 0xbf, 0x1e, 0x5a, 0xe2, 0xec, 0x3f, 0x6d,
-	0x13, 0x10, 0x3f, 0xf3, 0xa0, 0x90, 0xf4, 0x84, 0xa7, 0xa0, 0x64, 0xb9, 0xcc, 0x0c, 0x43, 0x33,
-	0x1b, 0x9a, 0x9c, 0x35, 0x49, 0x77, 0x12, 0xad, 0x57, 0x30, 0xeb, 0xd4, 0x23, 0x5e, 0x60, 0xda,
-	0xac, 0xeb, 0xb2, 0xce, 0xb2, 0x4c, 0x5b, 0x03, 0xa0, 0xfe, 0xdb, 0xae, 0xed, 0x25, 0xbb, 0xb6,
-	0xd3, 0x50, 0xb7, 0x30, 0xdd, 0x02, 0xc8, 0xd1, 0xc8, 0x7b, 0xf2, 0x91, 0x07, 0xa5, 0xec, 0x8e,
-	0xb0, 0x0a, 0x0e, 0x75, 0x75, 0x30, 0x32, 0x86, 0xe3, 0xbe, 0x62, 0x5c, 0x8c, 0xf4, 0x73, 0xa5,
-	0xa7, 0x3e, 0x57, 0x95, 0xbe, 0xc0, 0xc1, 0x32, 0x10, 0x36, 0xa9, 0xbe, 0xaa, 0x29, 0xbd, 0x89,
-	0xc0, 0xc3, 0x43, 0x70, 0x67, 0x13, 0x9d, 0x28, 0xaf, 0x26, 0x17, 0x9d, 0x33, 0x61, 0x0f, 0x3e,
-	0x04, 0xf7, 0x37, 0xe1, 0x33, 0x65, 0xd0, 0xe9, 0xbd, 0x36, 0x3a, 0x43, 0x75, 0x34, 0x36, 0x5e,
-	0xe8, 0xe3, 0x91, 0xf0, 0x01, 0xde, 0xdb, 0xae, 0x53, 0xd4, 0x73, 0xa3, 0x79, 0xdc, 0x14, 0xbe,
-	0xf0, 0xdd, 0xc1, 0xb7, 0xb9, 0xc4, 0xdf, 0xcc, 0x25, 0xfe, 0xc7, 0x5c, 0xe2, 0x3f, 0x2d, 0x24,
-	0xee, 0x66, 0x21, 0x71, 0xdf, 0x17, 0x12, 0xf7, 0xa6, 0xe1, 0xb8, 0xec, 0x6d, 0x64, 0x21, 0x9b,
-	0x78, 0x72, 0xf6, 0xb8, 0x93, 0x4f, 0x83, 0x4e, 0xaf, 0x64, 0x16, 0x07, 0x78, 0xfb, 0x8f, 0x61,
-	0x15, 0x93, 0xa7, 0xd1, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x72, 0xd3, 0x58, 0x4d, 0x04,
-	0x00, 0x00,
+	// 569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xed, 0x3a, 0xad, 0xda, 0xe9, 0xa7, 0x4f, 0x66, 0x49, 0x51, 0x6a, 0x90, 0x89, 0xca,
+	0x81, 0x0a, 0xa9, 0x6b, 0xa5, 0x3d, 0xa0, 0x72, 0x73, 0x13, 0x93, 0x9a, 0x36, 0x69, 0xb1, 0x53,
+	0xa9, 0x70, 0xb1, 0x6c, 0x67, 0x6b, 0xac, 0xc6, 0x5e, 0xe3, 0x5d, 0xa3, 0xfa, 0xc4, 0x2b, 0xf0,
+	0x12, 0x1c, 0x78, 0x0a, 0x0e, 0x5c, 0x38, 0xf6, 0xc8, 0x11, 0xb5, 0xcf, 0xc0, 0x1d, 0xd5, 0x8e,
+	0x93, 0x80, 0x8a, 0x10, 0x39, 0x59, 0x33, 0xf3, 0xdf, 0xdf, 0xfc, 0x57, 0x33, 0x6b, 0x78, 0xec,
+	0x53, 0x16, 0x51, 0xa6, 0xf1, 0x0b, 0x8d, 0x85, 0x41, 0x1c, 0xc6, 0x81, 0xf6, 0xae, 0xe5, 0x11,
+	0xee, 0xb6, 0xaa, 0x18, 0x27, 0x29, 0xe5, 0x14, 0xad, 0x97, 0x42, 0xcc, 0x2f, 0x70, 0x55, 0x18,
+	0x0b, 0x95, 0xad, 0x31, 0xc3, 0x4f, 0xf3, 0x84, 0x53, 0x2d, 0xca, 0x46, 0x3c, 0x64, 0xe1, 0x14,
+	0x54, 0x25, 0x4a, 0x92, 0xb2, 0x1e, 0x50, 0x1a, 0x8c, 0x88, 0x56, 0x44, 0x5e, 0x76, 0xa6, 0xb9,
+	0x71, 0x5e, 0x96, 0x36, 0xce, 0xa0, 0x6e, 0x87, 0x41, 0xec, 0xf2, 0x2c, 0x25, 0x1d, 0xc2, 0xfc,
+	0x34, 0x4c, 0x38, 0x4d, 0x19, 0xea, 0x03, 0xb0, 0x2a, 0xcf, 0x1a, 0x62, 0x53, 0xda, 0x5c, 0xdd,
+	0xc6, 0xf8, 0x8f, 0x8e, 0xf0, 0x2d, 0x10, 0x6b, 0x86, 0xb0, 0xf1, 0xa3, 0x06, 0x77, 0x6f, 0xd1,
+	0xa0, 0x1d, 0x80, 0x24, 0xf3, 0x46, 0xa1, 0xef, 0x9c, 0x93, 0xbc, 0x21, 0x36, 0xc5, 0xcd, 0xd5,
+	0xed, 0x3a, 0x2e, 0xfd, 0xe2, 0xca, 0x2f, 0xd6, 0xe3, 0xdc, 0x5a, 0x29, 0x75, 0x07, 0x24, 0x47,
+	0x5d, 0xa8, 0x0d, 0x5d, 0xee, 0x36, 0x16, 0x0a, 0xf9, 0xce, 0xbf, 0xd9, 0xc2, 0x1d, 0x97, 0xbb,
+	0x56, 0x01, 0x40, 0x0a, 0x2c, 0x33, 0xf2, 0x36, 0x23, 0xb1, 0x4f, 0x1a, 0x52, 0x53, 0xdc, 0xac,
+	0x59, 0x93, 0x58, 0xf9, 0x22, 0x41, 0xed, 0x46, 0x8a, 0x06, 0xb0, 0xc4, 0xc2, 0x38, 0x18, 0x91,
+	0xb1, 0xbd, 0x67, 0x73, 0xf4, 0xc3, 0x76, 0x41, 0xd8, 0x17, 0xac, 0x31, 0x0b, 0xbd, 0x84, 0xc5,
+	0x62, 0x4a, 0xe3, 0x4b, 0xec, 0xce, 0x03, 0xed, 0xdd, 0x00, 0xf6, 0x05, 0xab, 0x24, 0x29, 0x0e,
+	0x2c, 0x95, 0x6d, 0xd0, 0x53, 0xa8, 0x45, 0x74, 0x58, 0x1a, 0xfe, 0x7f, 0xfb, 0xd1, 0x5f, 0xd8,
+	0x3d, 0x3a, 0x24, 0x56, 0x71, 0x00, 0x3d, 0x80, 0x95, 0xc9, 0xd0, 0x0a, 0x67, 0xff, 0x59, 0xd3,
+	0x84, 0xf2, 0x49, 0x84, 0xc5, 0xa2, 0x27, 0x3a, 0x80, 0x65, 0x2f, 0xe4, 0x6e, 0x9a, 0xba, 0xd5,
+	0xd0, 0xb4, 0xaa, 0x49, 0xb9, 0x93, 0x78, 0xb2, 0x82, 0x55, 0xa7, 0x36, 0x8d, 0x12, 0xd7, 0xe7,
+	0x7b, 0x21, 0xd7, 0x6f, 0x8e, 0x59, 0x13, 0x00, 0xb2, 0x7f, 0xd9, 0xb5, 0x85, 0xa6, 0x34, 0xef,
+	0x50, 0x67, 0x30, 0x7b, 0x8b, 0x20, 0xb1, 0x2c, 0x7a, 0xf2, 0x51, 0x84, 0xe5, 0xea, 0x8e, 0x68,
+	0x1d, 0xd6, 0x6c, 0xb3, 0xdb, 0x77, 0x7a, 0x47, 0x1d, 0xc3, 0x39, 0xe9, 0xdb, 0xc7, 0x46, 0xdb,
+	0x7c, 0x6e, 0x1a, 0x1d, 0x59, 0x40, 0x75, 0x90, 0xa7, 0xa5, 0x8e, 0x69, 0x19, 0xed, 0x81, 0x2c,
+	0xa2, 0x35, 0xb8, 0x33, 0xcd, 0x0e, 0x8c, 0xd3, 0xc1, 0x89, 0x7e, 0x28, 0x2f, 0xa0, 0x06, 0xd4,
+	0x7f, 0x17, 0x3b, 0xfa, 0xc9, 0xa9, 0x2c, 0xa1, 0x87, 0x70, 0x7f, 0x5a, 0x39, 0x34, 0xba, 0x7a,
+	0xfb, 0x95, 0xa3, 0xf7, 0xcc, 0xfe, 0x91, 0xf3, 0xc2, 0x3e, 0xea, 0xcb, 0xef, 0xd1, 0xbd, 0x59,
+	0xa2, 0x61, 0x1e, 0x3b, 0xad, 0xdd, 0x96, 0xfc, 0x59, 0xdc, 0xeb, 0x7e, 0xbd, 0x52, 0xc5, 0xcb,
+	0x2b, 0x55, 0xfc, 0x7e, 0xa5, 0x8a, 0x1f, 0xae, 0x55, 0xe1, 0xf2, 0x5a, 0x15, 0xbe, 0x5d, 0xab,
+	0xc2, 0xeb, 0xad, 0x20, 0xe4, 0x6f, 0x32, 0x0f, 0xfb, 0x34, 0xd2, 0xaa, 0x67, 0x5f, 0x7c, 0xb6,
+	0xd8, 0xf0, 0x5c, 0xe3, 0x79, 0x42, 0x66, 0xff, 0x25, 0xde, 0x52, 0xf1, 0x68, 0x76, 0x7e, 0x0e,
+	0x00, 0x02, 0x3d, 0xad, 0x03, 0x67, 0x04, 0x00, 0x00,
 }
 
 func (m *SignatureDescriptors) Marshal() (dAtA []byte, err error) {
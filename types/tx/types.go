@@ -85,6 +85,12 @@ func (t *Tx) ValidateBasic() error {
 		}
 	}
 
+	if tip := authInfo.Tip; tip != nil {
+		if err := tip.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	sigs := t.Signatures
 
 	if len(sigs) == 0 {
@@ -103,7 +109,8 @@ func (t *Tx) ValidateBasic() error {
 
 // GetSigners retrieves all the signers of a tx.
 // This includes all unique signers of the messages (in order),
-// as well as the FeePayer (if specified and not already included).
+// as well as the Tipper and the FeePayer (if specified and not already
+// included).
 func (t *Tx) GetSigners() []sdk.AccAddress {
 	var signers []sdk.AccAddress
 	seen := map[string]bool{}
@@ -117,6 +124,13 @@ func (t *Tx) GetSigners() []sdk.AccAddress {
 		}
 	}
 
+	// ensure any specified tipper is included in the required signers
+	if tip := t.AuthInfo.Tip; tip != nil && tip.Tipper != "" && !seen[tip.Tipper] {
+		tipperAddr := sdk.MustAccAddressFromBech32(tip.Tipper)
+		signers = append(signers, tipperAddr)
+		seen[tip.Tipper] = true
+	}
+
 	// ensure any specified fee payer is included in the required signers (at the end)
 	feePayer := t.AuthInfo.Fee.Payer
 	if feePayer != "" && !seen[feePayer] {
@@ -145,6 +159,11 @@ func (t *Tx) FeePayer() sdk.AccAddress {
 	return t.GetSigners()[0]
 }
 
+// GetTip returns the tip of the tx, if any.
+func (t *Tx) GetTip() *Tip {
+	return t.AuthInfo.Tip
+}
+
 func (t *Tx) FeeGranter() sdk.AccAddress {
 	feePayer := t.AuthInfo.Fee.Granter
 	if feePayer != "" {
@@ -197,6 +216,19 @@ func (m *SignerInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(m.PublicKey, new(cryptotypes.PubKey))
 }
 
+// ValidateBasic performs stateless validation of the tip.
+func (t *Tip) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(t.Tipper); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid tipper address (%s)", err)
+	}
+
+	if t.Amount.Empty() || !t.Amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid tip amount: %s", t.Amount)
+	}
+
+	return nil
+}
+
 // RegisterInterfaces registers the sdk.Tx interface.
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterInterface("cosmos.tx.v1beta1.Tx", (*sdk.Tx)(nil))
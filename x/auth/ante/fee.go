@@ -2,6 +2,7 @@ package ante
 
 import (
 	"fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -12,6 +13,8 @@ import (
 // as the local validator's minimum gasFee (defined in validator config).
 // If fee is too low, decorator returns error and tx is rejected from mempool.
 // Note this only applies when ctx.CheckTx = true
+// The priority of the transaction in the mempool is set to its gas price, see
+// GetTxPriority.
 // If fee is high enough or not CheckTx, then call next AnteHandler
 // CONTRACT: Tx must implement FeeTx to use MempoolFeeDecorator
 type MempoolFeeDecorator struct{}
@@ -49,11 +52,36 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
 			}
 		}
+
+		ctx = ctx.WithPriority(GetTxPriority(feeCoins, gas))
 	}
 
 	return next(ctx, tx, simulate)
 }
 
+// GetTxPriority returns the priority of a transaction, which is its gas price.
+// If the fee is paid in several denoms, the smallest gas price is used, as
+// gas prices in different denoms cannot be compared.
+func GetTxPriority(fee sdk.Coins, gas uint64) int64 {
+	if gas == 0 || fee.IsZero() {
+		return 0
+	}
+
+	var priority int64
+	for i, c := range fee {
+		p := int64(math.MaxInt64)
+		gasPrice := c.Amount.Quo(sdk.NewIntFromUint64(gas))
+		if gasPrice.IsInt64() {
+			p = gasPrice.Int64()
+		}
+		if i == 0 || p < priority {
+			priority = p
+		}
+	}
+
+	return priority
+}
+
 // DeductFeeDecorator deducts fees from the first signer of the tx
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
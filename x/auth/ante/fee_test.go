@@ -1,6 +1,11 @@
 package ante_test
 
 import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -56,8 +61,32 @@ func (suite *AnteTestSuite) TestEnsureMempoolFees() {
 	lowGasPrice := []sdk.DecCoin{atomPrice}
 	suite.ctx = suite.ctx.WithMinGasPrices(lowGasPrice)
 
-	_, err = antehandler(suite.ctx, tx, false)
+	newCtx, err := antehandler(suite.ctx, tx, false)
 	suite.Require().Nil(err, "Decorator should not have errored on fee higher than local gasPrice")
+	suite.Require().Equal(ante.GetTxPriority(feeAmount, gasLimit), newCtx.Priority())
+}
+
+func TestGetTxPriority(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fee      sdk.Coins
+		gas      uint64
+		expected int64
+	}{
+		{"no fee", sdk.NewCoins(), 100, 0},
+		{"no gas", sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), 0, 0},
+		{"single denom", sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)), 100, 10},
+		{"gas price rounded down", sdk.NewCoins(sdk.NewInt64Coin("atom", 199)), 100, 1},
+		{"smallest gas price of several denoms", sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 300)), 100, 3},
+		{"gas price overflowing int64", sdk.NewCoins(sdk.NewCoin("atom", sdk.NewIntFromUint64(math.MaxUint64))), 1, math.MaxInt64},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, ante.GetTxPriority(tc.fee, tc.gas))
+		})
+	}
 }
 
 func (suite *AnteTestSuite) TestDeductFees() {
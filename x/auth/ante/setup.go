@@ -72,3 +72,23 @@ func SetGasMeter(simulate bool, ctx sdk.Context, gasLimit uint64) sdk.Context {
 
 	return ctx.WithGasMeter(sdk.NewGasMeter(gasLimit))
 }
+
+// SetKVGasConfigDecorator sets the gas config of the KVStores in the Context
+// from the KVGasConfig param, so that the gas charged for the store operations
+// of the transactions can be changed by governance.
+// CONTRACT: Must be called after SetUpContextDecorator, so that reading the
+// params is charged to the transaction.
+type SetKVGasConfigDecorator struct {
+	ak AccountKeeper
+}
+
+func NewSetKVGasConfigDecorator(ak AccountKeeper) SetKVGasConfigDecorator {
+	return SetKVGasConfigDecorator{
+		ak: ak,
+	}
+}
+
+func (skd SetKVGasConfigDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	config := skd.ak.GetKVGasConfig(ctx)
+	return next(ctx.WithKVGasConfig(config.GasConfig()), tx, simulate)
+}
@@ -79,6 +79,31 @@ func (suite *AnteTestSuite) TestRecoverPanic() {
 	suite.Require().Panics(func() { antehandler(suite.ctx, tx, false) }, "Recovered from non-Out-of-Gas panic") // nolint:errcheck
 }
 
+func (suite *AnteTestSuite) TestSetKVGasConfig() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	params := suite.app.AccountKeeper.GetParams(suite.ctx)
+	params.KVGasConfig.WriteCostFlat = 5000
+	params.KVGasConfig.IterSkipCostFlat = 0
+	suite.app.AccountKeeper.SetParams(suite.ctx, params)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewSetUpContextDecorator(), ante.NewSetKVGasConfigDecorator(suite.app.AccountKeeper))
+	newCtx, err := antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.KVGasConfig.GasConfig(), newCtx.KVGasConfig())
+	suite.Require().Equal(suite.ctx.TransientKVGasConfig(), newCtx.TransientKVGasConfig())
+}
+
 type OutOfGasDecorator struct{}
 
 // AnteDecorator that will throw OutOfGas panic
@@ -208,14 +208,16 @@ func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler authsigning.S
 }
 
 // OnlyLegacyAminoSigners checks SignatureData to see if all
-// signers are using SIGN_MODE_LEGACY_AMINO_JSON. If this is the case
+// signers are using SIGN_MODE_LEGACY_AMINO_JSON, or SIGN_MODE_EIP_191 which
+// wraps the same sign bytes. If this is the case
 // then the corresponding SignatureV2 struct will not have account sequence
 // explicitly set, and we should skip the explicit verification of sig.Sequence
 // in the SigVerificationDecorator's AnteHandler function.
 func OnlyLegacyAminoSigners(sigData signing.SignatureData) bool {
 	switch v := sigData.(type) {
 	case *signing.SingleSignatureData:
-		return v.SignMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+		return v.SignMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON ||
+			v.SignMode == signing.SignMode_SIGN_MODE_EIP_191
 	case *signing.MultiSignatureData:
 		for _, s := range v.Signatures {
 			if !OnlyLegacyAminoSigners(s) {
@@ -279,11 +281,13 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			ChainID:       chainID,
 			AccountNumber: accNum,
 			Sequence:      acc.GetSequence(),
+			Address:       signerAddrs[i].String(),
+			PubKey:        pubKey,
 		}
 
 		// no need to verify signatures on recheck tx
 		if !simulate && !ctx.IsReCheckTx() {
-			err := authsigning.VerifySignature(pubKey, signerData, sig.Data, svd.signModeHandler, tx)
+			err := authsigning.VerifySignatureWithContext(sdk.WrapSDKContext(ctx), pubKey, signerData, sig.Data, svd.signModeHandler, tx)
 			if err != nil {
 				var errMsg string
 				if OnlyLegacyAminoSigners(sig.Data) {
@@ -0,0 +1,43 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TipDecorator transfers the tip of a transaction, if any, from the tipper to
+// the fee payer. The tip lets the tipper pay for the transaction in a denom
+// which is not accepted for fees, the fee payer being compensated for paying
+// the fees in an accepted denom. Txs which do not implement TipTx, such as
+// legacy amino txs, have no tip.
+type TipDecorator struct {
+	bankKeeper types.BankKeeper
+}
+
+func NewTipDecorator(bk types.BankKeeper) TipDecorator {
+	return TipDecorator{
+		bankKeeper: bk,
+	}
+}
+
+func (td TipDecorator) AnteHandle(ctx sdk.Context, sdkTx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	tipTx, ok := sdkTx.(tx.TipTx)
+	if !ok || tipTx.GetTip() == nil {
+		return next(ctx, sdkTx, simulate)
+	}
+
+	tip := tipTx.GetTip()
+
+	tipper, err := sdk.AccAddressFromBech32(tip.Tipper)
+	if err != nil {
+		return ctx, err
+	}
+
+	if err := td.bankKeeper.SendCoins(ctx, tipper, tipTx.FeePayer(), tip.Amount); err != nil {
+		return ctx, sdkerrors.Wrapf(err, "failed to transfer tip from %s to fee payer", tip.Tipper)
+	}
+
+	return next(ctx, sdkTx, simulate)
+}
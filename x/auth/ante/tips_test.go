@@ -0,0 +1,103 @@
+package ante_test
+
+import (
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// createTippedTx returns a tx whose message is signed by the tipper in
+// SIGN_MODE_DIRECT_AUX, and whose fee is paid by the fee payer.
+func (suite *AnteTestSuite) createTippedTx(tipper, feePayer TestAccount, tip sdk.Coins, fee sdk.Coins) xauthsigning.Tx {
+	tipperAddr := tipper.acc.GetAddress()
+	chainID := suite.ctx.ChainID()
+
+	auxBuilder := clienttx.NewAuxTxBuilder()
+	auxBuilder.SetAddress(tipperAddr.String())
+	auxBuilder.SetAccountNumber(tipper.acc.GetAccountNumber())
+	auxBuilder.SetSequence(tipper.acc.GetSequence())
+	auxBuilder.SetChainID(chainID)
+	auxBuilder.SetTip(&tx.Tip{Amount: tip, Tipper: tipperAddr.String()})
+	suite.Require().NoError(auxBuilder.SetMsgs(testdata.NewTestMsg(tipperAddr)))
+	suite.Require().NoError(auxBuilder.SetPubKey(tipper.priv.PubKey()))
+	suite.Require().NoError(auxBuilder.SetSignMode(signing.SignMode_SIGN_MODE_DIRECT_AUX))
+
+	signBz, err := auxBuilder.GetSignBytes()
+	suite.Require().NoError(err)
+	sig, err := tipper.priv.Sign(signBz)
+	suite.Require().NoError(err)
+	auxBuilder.SetSignature(sig)
+	auxSignerData, err := auxBuilder.GetAuxSignerData()
+	suite.Require().NoError(err)
+
+	txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.Require().NoError(txBuilder.AddAuxSignerData(auxSignerData))
+	txBuilder.SetFeePayer(feePayer.acc.GetAddress())
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	// The fee payer signs last, over the signer infos of all signers.
+	auxSig, err := auxSignerData.GetSignatureV2()
+	suite.Require().NoError(err)
+	suite.Require().NoError(txBuilder.SetSignatures(auxSig, signing.SignatureV2{
+		PubKey:   feePayer.priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: feePayer.acc.GetSequence(),
+	}))
+	feePayerSig, err := clienttx.SignWithPrivKey(
+		signing.SignMode_SIGN_MODE_DIRECT,
+		xauthsigning.SignerData{ChainID: chainID, AccountNumber: feePayer.acc.GetAccountNumber(), Sequence: feePayer.acc.GetSequence()},
+		txBuilder, feePayer.priv, suite.clientCtx.TxConfig, feePayer.acc.GetSequence(),
+	)
+	suite.Require().NoError(err)
+	suite.Require().NoError(txBuilder.SetSignatures(auxSig, feePayerSig))
+
+	return txBuilder.GetTx()
+}
+
+func (suite *AnteTestSuite) TestTips() {
+	suite.SetupTest(false)
+	accounts := suite.CreateTestAccounts(2)
+	tipper, feePayer := accounts[0], accounts[1]
+	tipperAddr, feePayerAddr := tipper.acc.GetAddress(), feePayer.acc.GetAddress()
+
+	tip := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 150))
+	tippedTx := suite.createTippedTx(tipper, feePayer, tip, fee)
+
+	tipperBalance := suite.app.BankKeeper.GetBalance(suite.ctx, tipperAddr, "atom")
+	feePayerBalance := suite.app.BankKeeper.GetBalance(suite.ctx, feePayerAddr, "atom")
+
+	_, err := suite.anteHandler(suite.ctx, tippedTx, false)
+	suite.Require().NoError(err)
+
+	// the tipper pays the tip to the fee payer, who pays the fee
+	suite.Require().Equal(tipperBalance.SubAmount(sdk.NewInt(1000)), suite.app.BankKeeper.GetBalance(suite.ctx, tipperAddr, "atom"))
+	suite.Require().Equal(feePayerBalance.AddAmount(sdk.NewInt(1000-150)), suite.app.BankKeeper.GetBalance(suite.ctx, feePayerAddr, "atom"))
+}
+
+func (suite *AnteTestSuite) TestTipDecorator() {
+	suite.SetupTest(false)
+	accounts := suite.CreateTestAccounts(2)
+	tipper, feePayer := accounts[0], accounts[1]
+	antehandler := sdk.ChainAnteDecorators(ante.NewTipDecorator(suite.app.BankKeeper))
+
+	// tips larger than the tipper balance cannot be paid
+	tippedTx := suite.createTippedTx(tipper, feePayer, sdk.NewCoins(sdk.NewInt64Coin("atom", 20000000)), nil)
+	_, err := antehandler(suite.ctx, tippedTx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// txs without tips are untouched
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(tipper.acc.GetAddress())))
+	untippedTx, err := suite.CreateTestTx([]cryptotypes.PrivKey{tipper.priv}, []uint64{0}, []uint64{0}, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx, untippedTx, false)
+	suite.Require().NoError(err)
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"strings"
 
@@ -45,6 +46,8 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountsCmd(),
 		QueryParamsCmd(),
 		QueryModuleAccountByNameCmd(),
+		QueryDerivedModuleAddressCmd(),
+		QueryDerivedModuleAccountCmd(),
 	)
 
 	return cmd
@@ -179,6 +182,76 @@ func QueryModuleAccountByNameCmd() *cobra.Command {
 	return cmd
 }
 
+// QueryDerivedModuleAddressCmd returns a command to query the address derived
+// from a module name and a hex encoded derivation key.
+func QueryDerivedModuleAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "derived-module-address [module-name] [derivation-key]",
+		Short:   "Query the address derived from a module name and a hex encoded derivation key",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s q auth derived-module-address gov 0102", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			derivationKey, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid derivation key: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DerivedModuleAddress(cmd.Context(), &types.QueryDerivedModuleAddressRequest{Module: args[0], DerivationKey: derivationKey})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryDerivedModuleAccountCmd returns a command to query the account derived
+// from a module name and a hex encoded derivation key.
+func QueryDerivedModuleAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "derived-module-account [module-name] [derivation-key]",
+		Short:   "Query the account derived from a module name and a hex encoded derivation key",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s q auth derived-module-account gov 0102", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			derivationKey, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid derivation key: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DerivedModuleAccount(cmd.Context(), &types.QueryDerivedModuleAccountRequest{Module: args[0], DerivationKey: derivationKey})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Account)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // QueryTxsByEventsCmd returns a command to search through transactions by events.
 func QueryTxsByEventsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+// GetAuxToFeeCommand returns the aux-to-fee command.
+func GetAuxToFeeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aux-to-fee [aux_signed_tx.json]",
+		Short: "Include the aux signer data in the tx, pay the fee and broadcast it",
+		Long: strings.TrimSpace(`Read the aux signer data generated with the --aux flag by an
+auxiliary signer, such as a tipper, from [aux_signed_tx.json]. The --from key
+becomes the fee payer of the transaction: it sets the fees, signs the
+transaction and broadcasts it, or prints it with --generate-only.
+
+$ <appd> tx aux-to-fee ./aux_signed_tx.json --from feepayer --fees 10stake
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var auxSignerData tx.AuxSignerData
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &auxSignerData); err != nil {
+				return err
+			}
+
+			if auxSignerData.SignDoc == nil || auxSignerData.SignDoc.ChainId != clientCtx.ChainID {
+				return fmt.Errorf("expected chain-id %s in the aux signer data", clientCtx.ChainID)
+			}
+
+			txf := clienttx.NewFactoryCLI(clientCtx, cmd.Flags())
+			txBuilder := clientCtx.TxConfig.NewTxBuilder()
+			if err := txBuilder.AddAuxSignerData(auxSignerData); err != nil {
+				return err
+			}
+
+			txBuilder.SetFeePayer(clientCtx.GetFromAddress())
+			txBuilder.SetFeeAmount(txf.Fees())
+			txBuilder.SetGasLimit(txf.Gas())
+			txBuilder.SetFeeGranter(clientCtx.GetFeeGranterAddress())
+
+			if clientCtx.GenerateOnly {
+				json, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+			}
+
+			if err := authclient.SignTx(txf, clientCtx, clientCtx.GetFromName(), txBuilder, clientCtx.Offline, false); err != nil {
+				return err
+			}
+
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+
+			res, err := clientCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
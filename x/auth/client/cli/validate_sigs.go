@@ -109,6 +109,8 @@ func printAndValidateSigs(
 				ChainID:       chainID,
 				AccountNumber: accNum,
 				Sequence:      accSeq,
+				Address:       sigAddr.String(),
+				PubKey:        pubKey,
 			}
 			err = authsigning.VerifySignature(pubKey, signingData, sig.Data, signModeHandler, sigTx)
 			if err != nil {
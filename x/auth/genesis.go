@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -20,6 +22,11 @@ func InitGenesis(ctx sdk.Context, ak keeper.AccountKeeper, data types.GenesisSta
 	accounts = types.SanitizeGenesisAccounts(accounts)
 
 	for _, a := range accounts {
+		// derived module accounts must be owned by a registered module account
+		if dmacc, ok := a.(types.DerivedModuleAccountI); ok && ak.GetModuleAddress(dmacc.GetModuleName()) == nil {
+			panic(fmt.Sprintf("derived module account %s is owned by unknown module %s", dmacc.GetAddress(), dmacc.GetModuleName()))
+		}
+
 		acc := ak.NewAccount(ctx, a)
 		ak.SetAccount(ctx, acc)
 	}
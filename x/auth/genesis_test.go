@@ -0,0 +1,35 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestDerivedModuleAccountGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	dmacc, err := app.AccountKeeper.CreateDerivedModuleAccount(ctx, types.FeeCollectorName, []byte("key"))
+	require.NoError(t, err)
+
+	genState := auth.ExportGenesis(ctx, app.AccountKeeper)
+	require.NoError(t, types.ValidateGenesis(*genState))
+
+	app2 := simapp.Setup(false)
+	ctx2 := app2.BaseApp.NewContext(false, tmproto.Header{})
+	auth.InitGenesis(ctx2, app2.AccountKeeper, *genState)
+
+	require.Equal(t, dmacc.GetAddress(), app2.AccountKeeper.GetDerivedModuleAccount(ctx2, types.FeeCollectorName, []byte("key")).GetAddress())
+
+	// derived module accounts of unknown modules are rejected
+	unknown, err := types.PackAccounts(types.GenesisAccounts{types.NewEmptyDerivedModuleAccount("unknown", []byte("key"))})
+	require.NoError(t, err)
+	genState.Accounts = unknown
+	require.Panics(t, func() { auth.InitGenesis(ctx2, app2.AccountKeeper, *genState) })
+}
@@ -106,3 +106,53 @@ func (ak AccountKeeper) ModuleAccountByName(c context.Context, req *types.QueryM
 
 	return &types.QueryModuleAccountByNameResponse{Account: any}, nil
 }
+
+// DerivedModuleAddress returns the address derived from a module name and a derivation key
+func (ak AccountKeeper) DerivedModuleAddress(c context.Context, req *types.QueryDerivedModuleAddressRequest) (*types.QueryDerivedModuleAddressResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Module) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "module name is empty")
+	}
+
+	if len(req.DerivationKey) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "derivation key is empty")
+	}
+
+	addr := ak.GetDerivedModuleAddress(req.Module, req.DerivationKey)
+	if addr == nil {
+		return nil, status.Errorf(codes.NotFound, "module account %s not found", req.Module)
+	}
+
+	return &types.QueryDerivedModuleAddressResponse{Address: addr.String()}, nil
+}
+
+// DerivedModuleAccount returns the account derived from a module name and a derivation key
+func (ak AccountKeeper) DerivedModuleAccount(c context.Context, req *types.QueryDerivedModuleAccountRequest) (*types.QueryDerivedModuleAccountResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Module) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "module name is empty")
+	}
+
+	if len(req.DerivationKey) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "derivation key is empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	account := ak.GetDerivedModuleAccount(ctx, req.Module, req.DerivationKey)
+	if account == nil {
+		return nil, status.Errorf(codes.NotFound, "derived account of module %s with key %X not found", req.Module, req.DerivationKey)
+	}
+
+	any, err := codectypes.NewAnyWithValue(account)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDerivedModuleAccountResponse{Account: any}, nil
+}
@@ -242,3 +242,124 @@ func (suite *KeeperTestSuite) TestGRPCQueryModuleAccountByName() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryDerivedModuleAddress() {
+	var req *types.QueryDerivedModuleAddressRequest
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expPass   bool
+		posttests func(res *types.QueryDerivedModuleAddressResponse)
+	}{
+		{
+			"success",
+			func() {
+				req = &types.QueryDerivedModuleAddressRequest{Module: "mint", DerivationKey: []byte("key")}
+			},
+			true,
+			func(res *types.QueryDerivedModuleAddressResponse) {
+				suite.Require().Equal(types.NewDerivedModuleAddress("mint", []byte("key")).String(), res.Address)
+			},
+		},
+		{
+			"empty derivation key",
+			func() {
+				req = &types.QueryDerivedModuleAddressRequest{Module: "mint"}
+			},
+			false,
+			func(res *types.QueryDerivedModuleAddressResponse) {},
+		},
+		{
+			"invalid module name",
+			func() {
+				req = &types.QueryDerivedModuleAddressRequest{Module: "gover", DerivationKey: []byte("key")}
+			},
+			false,
+			func(res *types.QueryDerivedModuleAddressResponse) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			res, err := suite.queryClient.DerivedModuleAddress(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+
+			tc.posttests(res)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryDerivedModuleAccount() {
+	var req *types.QueryDerivedModuleAccountRequest
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expPass   bool
+		posttests func(res *types.QueryDerivedModuleAccountResponse)
+	}{
+		{
+			"success",
+			func() {
+				_, err := suite.app.AccountKeeper.CreateDerivedModuleAccount(suite.ctx, "mint", []byte("key"))
+				suite.Require().NoError(err)
+				req = &types.QueryDerivedModuleAccountRequest{Module: "mint", DerivationKey: []byte("key")}
+			},
+			true,
+			func(res *types.QueryDerivedModuleAccountResponse) {
+				var account types.AccountI
+				err := suite.app.InterfaceRegistry().UnpackAny(res.Account, &account)
+				suite.Require().NoError(err)
+
+				dmacc, ok := account.(types.DerivedModuleAccountI)
+				suite.Require().True(ok)
+				suite.Require().Equal("mint", dmacc.GetModuleName())
+				suite.Require().Equal([]byte("key"), dmacc.GetDerivationKey())
+			},
+		},
+		{
+			"account not created",
+			func() {
+				req = &types.QueryDerivedModuleAccountRequest{Module: "mint", DerivationKey: []byte("key")}
+			},
+			false,
+			func(res *types.QueryDerivedModuleAccountResponse) {},
+		},
+		{
+			"empty module name",
+			func() {
+				req = &types.QueryDerivedModuleAccountRequest{DerivationKey: []byte("key")}
+			},
+			false,
+			func(res *types.QueryDerivedModuleAccountResponse) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			res, err := suite.queryClient.DerivedModuleAccount(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+
+			tc.posttests(res)
+		})
+	}
+}
@@ -212,6 +212,66 @@ func (ak AccountKeeper) SetModuleAccount(ctx sdk.Context, macc types.ModuleAccou
 	ak.SetAccount(ctx, macc)
 }
 
+// GetDerivedModuleAddress returns the address derived from the module name and
+// the derivation key, or nil if the module has no registered module account.
+func (ak AccountKeeper) GetDerivedModuleAddress(moduleName string, derivationKey []byte) sdk.AccAddress {
+	if _, ok := ak.permAddrs[moduleName]; !ok || len(derivationKey) == 0 {
+		return nil
+	}
+
+	return types.NewDerivedModuleAddress(moduleName, derivationKey)
+}
+
+// GetDerivedModuleAccount gets the account derived from the module name and the
+// derivation key from the auth account store, or nil if it has not been created.
+func (ak AccountKeeper) GetDerivedModuleAccount(ctx sdk.Context, moduleName string, derivationKey []byte) types.DerivedModuleAccountI {
+	addr := ak.GetDerivedModuleAddress(moduleName, derivationKey)
+	if addr == nil {
+		return nil
+	}
+
+	dmacc, ok := ak.GetAccount(ctx, addr).(types.DerivedModuleAccountI)
+	if !ok {
+		return nil
+	}
+
+	return dmacc
+}
+
+// CreateDerivedModuleAccount creates the account derived from the module name
+// and the derivation key and sets it to the auth account store. The module must
+// have a registered module account. A base account already stored at the
+// derived address, e.g. because it received coins, is converted to a derived
+// module account as long as it has never signed a transaction.
+func (ak AccountKeeper) CreateDerivedModuleAccount(ctx sdk.Context, moduleName string, derivationKey []byte) (types.DerivedModuleAccountI, error) {
+	if _, ok := ak.permAddrs[moduleName]; !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "module %s has no module account", moduleName)
+	}
+
+	if len(derivationKey) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "derivation key cannot be empty")
+	}
+
+	addr := types.NewDerivedModuleAddress(moduleName, derivationKey)
+
+	var dmacc types.DerivedModuleAccountI
+	switch acc := ak.GetAccount(ctx, addr).(type) {
+	case nil:
+		dmacc = ak.NewAccount(ctx, types.NewEmptyDerivedModuleAccount(moduleName, derivationKey)).(types.DerivedModuleAccountI) // set the account number
+	case *types.BaseAccount:
+		if acc.GetPubKey() != nil || acc.GetSequence() != 0 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", addr)
+		}
+		dmacc = types.NewDerivedModuleAccount(acc, moduleName, derivationKey)
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", addr)
+	}
+
+	ak.SetAccount(ctx, dmacc)
+
+	return dmacc, nil
+}
+
 func (ak AccountKeeper) decodeAccount(bz []byte) types.AccountI {
 	acc, err := ak.UnmarshalAccount(bz)
 	if err != nil {
@@ -118,6 +118,57 @@ func TestGetSetParams(t *testing.T) {
 	require.Equal(t, params, actualParams)
 }
 
+func TestDerivedModuleAccount(t *testing.T) {
+	app, ctx := createTestApp(true)
+	derivationKey := []byte("key")
+
+	// only modules with a module account own derived accounts
+	require.Nil(t, app.AccountKeeper.GetDerivedModuleAddress("unknown", derivationKey))
+	_, err := app.AccountKeeper.CreateDerivedModuleAccount(ctx, "unknown", derivationKey)
+	require.Error(t, err)
+	_, err = app.AccountKeeper.CreateDerivedModuleAccount(ctx, types.FeeCollectorName, nil)
+	require.Error(t, err)
+
+	addr := app.AccountKeeper.GetDerivedModuleAddress(types.FeeCollectorName, derivationKey)
+	require.Equal(t, types.NewDerivedModuleAddress(types.FeeCollectorName, derivationKey), addr)
+	require.Nil(t, app.AccountKeeper.GetDerivedModuleAccount(ctx, types.FeeCollectorName, derivationKey))
+
+	dmacc, err := app.AccountKeeper.CreateDerivedModuleAccount(ctx, types.FeeCollectorName, derivationKey)
+	require.NoError(t, err)
+	require.Equal(t, addr, dmacc.GetAddress())
+	require.Equal(t, types.FeeCollectorName, dmacc.GetModuleName())
+	require.Equal(t, derivationKey, dmacc.GetDerivationKey())
+	require.Equal(t, dmacc, app.AccountKeeper.GetDerivedModuleAccount(ctx, types.FeeCollectorName, derivationKey))
+
+	// the account can only be created once
+	_, err = app.AccountKeeper.CreateDerivedModuleAccount(ctx, types.FeeCollectorName, derivationKey)
+	require.Error(t, err)
+}
+
+func TestDerivedModuleAccountExistingAccount(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	// a base account created when the derived address receives coins is converted
+	addr := types.NewDerivedModuleAddress(types.FeeCollectorName, []byte("key"))
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	require.Nil(t, app.AccountKeeper.GetDerivedModuleAccount(ctx, types.FeeCollectorName, []byte("key")))
+
+	dmacc, err := app.AccountKeeper.CreateDerivedModuleAccount(ctx, types.FeeCollectorName, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, acc.GetAccountNumber(), dmacc.GetAccountNumber())
+	require.Equal(t, dmacc, app.AccountKeeper.GetDerivedModuleAccount(ctx, types.FeeCollectorName, []byte("key")))
+
+	// a base account which has signed a transaction is not converted
+	addr = types.NewDerivedModuleAddress(types.FeeCollectorName, []byte("other key"))
+	acc = app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	require.NoError(t, acc.SetSequence(1))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	_, err = app.AccountKeeper.CreateDerivedModuleAccount(ctx, types.FeeCollectorName, []byte("other key"))
+	require.Error(t, err)
+}
+
 func TestSupply_ValidatePermissions(t *testing.T) {
 	app, _ := createTestApp(true)
 
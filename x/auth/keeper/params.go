@@ -15,3 +15,9 @@ func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	ak.paramSubspace.GetParamSet(ctx, &params)
 	return
 }
+
+// GetKVGasConfig gets the KVGasConfig param, without reading the other params.
+func (ak AccountKeeper) GetKVGasConfig(ctx sdk.Context) (config types.KVGasConfig) {
+	ak.paramSubspace.Get(ctx, types.KeyKVGasConfig, &config)
+	return
+}
@@ -29,6 +29,12 @@ type StdFee struct {
 	Gas    uint64    `json:"gas" yaml:"gas"`
 }
 
+// StdTip is the tips used in a tipped transaction.
+type StdTip struct {
+	Amount sdk.Coins `json:"amount" yaml:"amount"`
+	Tipper string    `json:"tipper" yaml:"tipper"`
+}
+
 // Deprecated: NewStdFee returns a new instance of StdFee
 func NewStdFee(gas uint64, amount sdk.Coins) StdFee {
 	return StdFee{
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
@@ -69,6 +70,18 @@ func (s *StdTxBuilder) SetTimeoutHeight(height uint64) {
 // SetFeeGranter does nothing for stdtx
 func (s *StdTxBuilder) SetFeeGranter(_ sdk.AccAddress) {}
 
+// SetFeePayer does nothing for stdtx
+func (s *StdTxBuilder) SetFeePayer(_ sdk.AccAddress) {}
+
+// SetTip does nothing for stdtx
+func (s *StdTxBuilder) SetTip(_ *tx.Tip) {}
+
+// AddAuxSignerData returns an error for stdtx, which does not support
+// auxiliary signers.
+func (s *StdTxBuilder) AddAuxSignerData(_ tx.AuxSignerData) error {
+	return sdkerrors.Wrap(sdkerrors.ErrNotSupported, "stdtx does not support auxiliary signers")
+}
+
 // StdTxConfig is a context.TxConfig for StdTx
 type StdTxConfig struct {
 	Cdc *codec.LegacyAmino
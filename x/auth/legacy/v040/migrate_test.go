@@ -233,6 +233,18 @@ func TestMigrate(t *testing.T) {
     }
   ],
   "params": {
+    "kv_gas_config": {
+      "cache_scan_cost_per_key": "0",
+      "cache_sort_cost_per_key": "0",
+      "delete_cost": "0",
+      "has_cost": "0",
+      "iter_next_cost_flat": "0",
+      "iter_skip_cost_flat": "0",
+      "read_cost_flat": "0",
+      "read_cost_per_byte": "0",
+      "write_cost_flat": "0",
+      "write_cost_per_byte": "0"
+    },
     "max_memo_characters": "10",
     "sig_verify_cost_ed25519": "40",
     "sig_verify_cost_secp256k1": "50",
@@ -0,0 +1,16 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MigrateJSON accepts exported v0.43 x/auth genesis state and migrates it to
+// v0.46 x/auth genesis state. The migration includes:
+//
+// - Adding the KVGasConfig param, set to the default gas config of the KV
+// stores.
+func MigrateJSON(oldState *types.GenesisState) *types.GenesisState {
+	newState := *oldState
+	newState.Params.KVGasConfig = types.DefaultKVGasConfig()
+	return &newState
+}
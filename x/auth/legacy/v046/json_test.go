@@ -0,0 +1,23 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v046auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v046"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMigrateJSON(t *testing.T) {
+	oldState := types.DefaultGenesisState()
+	oldState.Params.MaxMemoCharacters = 10
+	oldState.Params.KVGasConfig = types.KVGasConfig{}
+	require.Error(t, oldState.Params.Validate())
+
+	newState := v046auth.MigrateJSON(oldState)
+	require.NoError(t, newState.Params.Validate())
+	require.Equal(t, uint64(10), newState.Params.MaxMemoCharacters)
+	require.Equal(t, types.DefaultKVGasConfig(), newState.Params.KVGasConfig)
+	require.Equal(t, types.KVGasConfig{}, oldState.Params.KVGasConfig)
+}
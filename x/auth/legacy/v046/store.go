@@ -0,0 +1,17 @@
+package v046
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.46. The
+// migration includes:
+//
+//   - Add the KVGasConfig param, set to the default gas config of the KV
+//     stores.
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	paramSpace.Set(ctx, types.KeyKVGasConfig, types.DefaultKVGasConfig())
+	return nil
+}
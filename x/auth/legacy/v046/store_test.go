@@ -0,0 +1,40 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v046"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	authKey := sdk.NewKVStoreKey("auth")
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(authKey, tKey)
+
+	// The params are stored under the auth store key, which is enough for
+	// testing the migration.
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, authKey, tKey, "auth").WithKeyTable(types.ParamKeyTable())
+	params := types.DefaultParams()
+	paramSpace.Set(ctx, types.KeyMaxMemoCharacters, params.MaxMemoCharacters)
+	paramSpace.Set(ctx, types.KeyTxSigLimit, params.TxSigLimit)
+	paramSpace.Set(ctx, types.KeyTxSizeCostPerByte, params.TxSizeCostPerByte)
+	paramSpace.Set(ctx, types.KeySigVerifyCostED25519, params.SigVerifyCostED25519)
+	paramSpace.Set(ctx, types.KeySigVerifyCostSecp256k1, params.SigVerifyCostSecp256k1)
+	require.False(t, paramSpace.Has(ctx, types.KeyKVGasConfig))
+
+	require.NoError(t, v046auth.MigrateStore(ctx, paramSpace))
+
+	var migrated types.Params
+	paramSpace.GetParamSet(ctx, &migrated)
+	require.Equal(t, params, migrated)
+	require.Equal(t, storetypes.KVGasConfig(), migrated.KVGasConfig.GasConfig())
+}
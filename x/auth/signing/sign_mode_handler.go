@@ -1,6 +1,9 @@
 package signing
 
 import (
+	"context"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
@@ -20,6 +23,26 @@ type SignModeHandler interface {
 	GetSignBytes(mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
 }
 
+// SignModeHandlerWithContext is a SignModeHandler whose sign bytes may depend on
+// a context, e.g. to query chain state such as bank denom metadata.
+type SignModeHandlerWithContext interface {
+	SignModeHandler
+
+	// GetSignBytesWithContext returns the sign bytes for the provided SignMode,
+	// SignerData and Tx, or an error
+	GetSignBytesWithContext(ctx context.Context, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
+}
+
+// GetSignBytesWithContext returns the sign bytes of the handler, using
+// SignModeHandlerWithContext.GetSignBytesWithContext if the handler implements it.
+func GetSignBytesWithContext(ctx context.Context, handler SignModeHandler, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	if handlerWithContext, ok := handler.(SignModeHandlerWithContext); ok {
+		return handlerWithContext.GetSignBytesWithContext(ctx, mode, data, tx)
+	}
+
+	return handler.GetSignBytes(mode, data, tx)
+}
+
 // SignerData is the specific information needed to sign a transaction that generally
 // isn't included in the transaction body itself
 type SignerData struct {
@@ -34,4 +57,12 @@ type SignerData struct {
 	// since in SIGN_MODE_DIRECT the account sequence is already in the signer
 	// info.
 	Sequence uint64
+
+	// Address is the bech32 address of the signer. It is only used by sign
+	// modes which display it to the signer, such as SIGN_MODE_TEXTUAL.
+	Address string
+
+	// PubKey is the public key of the signer. It is only used by sign modes
+	// which display it to the signer, such as SIGN_MODE_TEXTUAL.
+	PubKey cryptotypes.PubKey
 }
@@ -1,6 +1,7 @@
 package signing
 
 import (
+	"context"
 	"fmt"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -12,12 +13,21 @@ import (
 // VerifySignature verifies a transaction signature contained in SignatureData abstracting over different signing modes
 // and single vs multi-signatures.
 func VerifySignature(pubKey cryptotypes.PubKey, signerData SignerData, sigData signing.SignatureData, handler SignModeHandler, tx sdk.Tx) error {
+	return VerifySignatureWithContext(context.Background(), pubKey, signerData, sigData, handler, tx)
+}
+
+// VerifySignatureWithContext is like VerifySignature, the context is given to
+// handlers implementing SignModeHandlerWithContext.
+func VerifySignatureWithContext(ctx context.Context, pubKey cryptotypes.PubKey, signerData SignerData, sigData signing.SignatureData, handler SignModeHandler, tx sdk.Tx) error {
 	switch data := sigData.(type) {
 	case *signing.SingleSignatureData:
-		signBytes, err := handler.GetSignBytes(data.SignMode, signerData, tx)
+		signBytes, err := GetSignBytesWithContext(ctx, handler, data.SignMode, signerData, tx)
 		if err != nil {
 			return err
 		}
+		if data.SignMode == signing.SignMode_SIGN_MODE_EIP_191 {
+			return verifyEIP191Signature(pubKey, signBytes, data.Signature)
+		}
 		if !pubKey.VerifySignature(signBytes, data.Signature) {
 			return fmt.Errorf("unable to verify single signer signature")
 		}
@@ -28,8 +38,11 @@ func VerifySignature(pubKey cryptotypes.PubKey, signerData SignerData, sigData s
 		if !ok {
 			return fmt.Errorf("expected %T, got %T", (multisig.PubKey)(nil), pubKey)
 		}
+		if err := checkNoEIP191(data); err != nil {
+			return err
+		}
 		err := multiPK.VerifyMultisignature(func(mode signing.SignMode) ([]byte, error) {
-			return handler.GetSignBytes(mode, signerData, tx)
+			return GetSignBytesWithContext(ctx, handler, mode, signerData, tx)
 		}, data)
 		if err != nil {
 			return err
@@ -39,3 +52,42 @@ func VerifySignature(pubKey cryptotypes.PubKey, signerData SignerData, sigData s
 		return fmt.Errorf("unexpected SignatureData %T", sigData)
 	}
 }
+
+// checkNoEIP191 rejects multisignatures containing SIGN_MODE_EIP_191
+// signatures, which multisig public keys would verify over the SHA256 hash of
+// the sign bytes instead of the Keccak256 one.
+func checkNoEIP191(data *signing.MultiSignatureData) error {
+	for _, sig := range data.Signatures {
+		switch sig := sig.(type) {
+		case *signing.SingleSignatureData:
+			if sig.SignMode == signing.SignMode_SIGN_MODE_EIP_191 {
+				return fmt.Errorf("%s is not supported in multisignatures", signing.SignMode_SIGN_MODE_EIP_191)
+			}
+		case *signing.MultiSignatureData:
+			if err := checkNoEIP191(sig); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Keccak256PubKey is implemented by public keys which can verify signatures
+// created over the Keccak256 hash of a message, as Ethereum wallets do.
+type Keccak256PubKey interface {
+	VerifyKeccak256Signature(msg []byte, sig []byte) bool
+}
+
+// verifyEIP191Signature verifies a SIGN_MODE_EIP_191 signature. EIP-191 sign
+// bytes are hashed with Keccak256 by Ethereum wallets, so only public keys
+// implementing Keccak256PubKey can verify them.
+func verifyEIP191Signature(pubKey cryptotypes.PubKey, signBytes, sig []byte) error {
+	keccakPubKey, ok := pubKey.(Keccak256PubKey)
+	if !ok {
+		return fmt.Errorf("%s does not support %s signatures", pubKey.Type(), signing.SignMode_SIGN_MODE_EIP_191)
+	}
+	if !keccakPubKey.VerifyKeccak256Signature(signBytes, sig) {
+		return fmt.Errorf("unable to verify single signer signature")
+	}
+	return nil
+}
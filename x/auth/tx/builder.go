@@ -4,6 +4,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,6 +18,8 @@ import (
 // wrapper is a wrapper around the tx.Tx proto.Message which retain the raw
 // body and auth_info bytes.
 type wrapper struct {
+	cdc codec.Codec
+
 	tx *tx.Tx
 
 	// bodyBz represents the protobuf encoding of TxBody. This should be encoding
@@ -35,6 +38,7 @@ var (
 	_ client.TxBuilder           = &wrapper{}
 	_ ante.HasExtensionOptionsTx = &wrapper{}
 	_ ExtensionOptionsTxBuilder  = &wrapper{}
+	_ tx.TipTx                   = &wrapper{}
 )
 
 // ExtensionOptionsTxBuilder defines a TxBuilder that can also set extensions.
@@ -45,8 +49,9 @@ type ExtensionOptionsTxBuilder interface {
 	SetNonCriticalExtensionOptions(...*codectypes.Any)
 }
 
-func newBuilder() *wrapper {
+func newBuilder(cdc codec.Codec) *wrapper {
 	return &wrapper{
+		cdc: cdc,
 		tx: &tx.Tx{
 			Body: &tx.TxBody{},
 			AuthInfo: &tx.AuthInfo{
@@ -140,6 +145,11 @@ func (w *wrapper) FeePayer() sdk.AccAddress {
 	return w.GetSigners()[0]
 }
 
+// GetTip returns the transaction's tip (if set).
+func (w *wrapper) GetTip() *tx.Tip {
+	return w.tx.AuthInfo.Tip
+}
+
 func (w *wrapper) FeeGranter() sdk.AccAddress {
 	feePayer := w.tx.AuthInfo.Fee.Granter
 	if feePayer != "" {
@@ -269,6 +279,14 @@ func (w *wrapper) SetFeeGranter(feeGranter sdk.AccAddress) {
 	w.authInfoBz = nil
 }
 
+// SetTip sets the transaction's tip, paid by the tipper to the fee payer.
+func (w *wrapper) SetTip(tip *tx.Tip) {
+	w.tx.AuthInfo.Tip = tip
+
+	// set authInfoBz to nil because the cached authInfoBz no longer matches tx.AuthInfo
+	w.authInfoBz = nil
+}
+
 func (w *wrapper) SetSignatures(signatures ...signing.SignatureV2) error {
 	n := len(signatures)
 	signerInfos := make([]*tx.SignerInfo, n)
@@ -342,3 +360,65 @@ func (w *wrapper) SetNonCriticalExtensionOptions(extOpts ...*codectypes.Any) {
 	w.tx.Body.NonCriticalExtensionOptions = extOpts
 	w.bodyBz = nil
 }
+
+// AddAuxSignerData adds the signature of an auxiliary signer to the
+// transaction. The transaction body and tip are set to the ones signed over by
+// the auxiliary signer, and its signer info and signature are set at its index
+// in GetSigners.
+func (w *wrapper) AddAuxSignerData(data tx.AuxSignerData) error {
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+	if w.cdc == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "tx builder has no codec to decode the aux signer body")
+	}
+
+	var body tx.TxBody
+	if err := w.cdc.Unmarshal(data.SignDoc.BodyBytes, &body); err != nil {
+		return err
+	}
+	w.tx.Body = &body
+	w.bodyBz = data.SignDoc.BodyBytes
+
+	if data.SignDoc.Tip != nil {
+		w.SetTip(data.SignDoc.Tip)
+	}
+
+	signerIndex := -1
+	for i, signer := range w.GetSigners() {
+		if signer.String() == data.Address {
+			signerIndex = i
+			break
+		}
+	}
+	if signerIndex < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s is not a signer", data.Address)
+	}
+
+	sig, err := data.GetSignatureV2()
+	if err != nil {
+		return err
+	}
+	modeInfo, rawSig := SignatureDataToModeInfoAndSig(sig.Data)
+
+	// signers which did not sign yet get an empty signer info
+	signerInfos := w.tx.AuthInfo.SignerInfos
+	for len(signerInfos) <= signerIndex {
+		signerInfos = append(signerInfos, &tx.SignerInfo{})
+	}
+	rawSigs := w.tx.Signatures
+	for len(rawSigs) <= signerIndex {
+		rawSigs = append(rawSigs, nil)
+	}
+	signerInfos[signerIndex] = &tx.SignerInfo{
+		PublicKey: data.SignDoc.PublicKey,
+		ModeInfo:  modeInfo,
+		Sequence:  sig.Sequence,
+	}
+	rawSigs[signerIndex] = rawSig
+
+	w.setSignerInfos(signerInfos)
+	w.setSignatures(rawSigs)
+
+	return nil
+}
@@ -19,7 +19,7 @@ func TestTxBuilder(t *testing.T) {
 	_, pubkey, addr := testdata.KeyTestPubAddr()
 
 	marshaler := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
-	txBuilder := newBuilder()
+	txBuilder := newBuilder(nil)
 
 	memo := "sometestmemo"
 	msgs := []sdk.Msg{testdata.NewTestMsg(addr)}
@@ -136,7 +136,7 @@ func TestBuilderValidateBasic(t *testing.T) {
 	// require to fail validation upon invalid fee
 	badFeeAmount := testdata.NewTestFeeAmount()
 	badFeeAmount[0].Amount = sdk.NewInt(-5)
-	txBuilder := newBuilder()
+	txBuilder := newBuilder(nil)
 
 	var sig1, sig2 signing.SignatureV2
 	sig1 = signing.SignatureV2{
@@ -277,7 +277,7 @@ func TestBuilderFeePayer(t *testing.T) {
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			// setup basic tx
-			txBuilder := newBuilder()
+			txBuilder := newBuilder(nil)
 			err := txBuilder.SetMsgs(msgs...)
 			require.NoError(t, err)
 			txBuilder.SetGasLimit(200000)
@@ -301,7 +301,7 @@ func TestBuilderFeeGranter(t *testing.T) {
 	feeAmount := testdata.NewTestFeeAmount()
 	msgs := []sdk.Msg{msg1}
 
-	txBuilder := newBuilder()
+	txBuilder := newBuilder(nil)
 	err := txBuilder.SetMsgs(msgs...)
 	require.NoError(t, err)
 	txBuilder.SetGasLimit(200000)
@@ -313,3 +313,81 @@ func TestBuilderFeeGranter(t *testing.T) {
 	txBuilder.SetFeeGranter(addr1)
 	require.Equal(t, addr1, txBuilder.GetTx().FeeGranter())
 }
+
+func TestBuilderTip(t *testing.T) {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	msg1 := testdata.NewTestMsg(addr1)
+
+	txBuilder := newBuilder(nil)
+	require.NoError(t, txBuilder.SetMsgs(msg1))
+	require.Nil(t, txBuilder.GetTip())
+	authInfoBz := txBuilder.getAuthInfoBytes()
+
+	// the tipper is a signer of the tx, after the message signers
+	tip := &txtypes.Tip{Amount: sdk.NewCoins(sdk.NewInt64Coin("tip-token", 10)), Tipper: addr2.String()}
+	txBuilder.SetTip(tip)
+	require.Equal(t, tip, txBuilder.GetTip())
+	require.NotEqual(t, authInfoBz, txBuilder.getAuthInfoBytes())
+	require.Equal(t, []sdk.AccAddress{addr1, addr2}, txBuilder.GetSigners())
+}
+
+func TestBuilderAddAuxSignerData(t *testing.T) {
+	_, tipperPubKey, tipperAddr := testdata.KeyTestPubAddr()
+	_, _, feePayerAddr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	msg := testdata.NewTestMsg(tipperAddr)
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	bodyBz, err := marshaler.Marshal(&txtypes.TxBody{Messages: []*codectypes.Any{msgAny}, Memo: "memo"})
+	require.NoError(t, err)
+	pkAny, err := codectypes.NewAnyWithValue(tipperPubKey)
+	require.NoError(t, err)
+
+	tip := &txtypes.Tip{Amount: sdk.NewCoins(sdk.NewInt64Coin("tip-token", 10)), Tipper: tipperAddr.String()}
+	auxSignerData := txtypes.AuxSignerData{
+		Address: tipperAddr.String(),
+		SignDoc: &txtypes.SignDocDirectAux{
+			BodyBytes: bodyBz,
+			PublicKey: pkAny,
+			ChainId:   "test-chain",
+			Sequence:  3,
+			Tip:       tip,
+		},
+		Mode: signing.SignMode_SIGN_MODE_DIRECT_AUX,
+		Sig:  []byte("signature"),
+	}
+
+	// builders without codec cannot decode the aux signer body
+	require.Error(t, newBuilder(nil).AddAuxSignerData(auxSignerData))
+
+	txBuilder := newBuilder(marshaler)
+	invalid := auxSignerData
+	invalid.Sig = nil
+	require.Error(t, txBuilder.AddAuxSignerData(invalid))
+
+	notSigner := auxSignerData
+	notSigner.Address = feePayerAddr.String()
+	require.Error(t, txBuilder.AddAuxSignerData(notSigner))
+
+	require.NoError(t, txBuilder.AddAuxSignerData(auxSignerData))
+	txBuilder.SetFeePayer(feePayerAddr)
+
+	require.Equal(t, bodyBz, txBuilder.getBodyBytes())
+	require.Equal(t, "memo", txBuilder.GetMemo())
+	require.Equal(t, []sdk.Msg{msg}, txBuilder.GetMsgs())
+	require.Equal(t, tip, txBuilder.GetTip())
+	require.Equal(t, []sdk.AccAddress{tipperAddr, feePayerAddr}, txBuilder.GetSigners())
+
+	sigs, err := txBuilder.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.True(t, tipperPubKey.Equals(sigs[0].PubKey))
+	require.Equal(t, uint64(3), sigs[0].Sequence)
+	require.Equal(t, &signing.SingleSignatureData{
+		SignMode:  signing.SignMode_SIGN_MODE_DIRECT_AUX,
+		Signature: []byte("signature"),
+	}, sigs[0].Data)
+}
@@ -0,0 +1,122 @@
+package tx
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func TestDirectAuxHandler(t *testing.T) {
+	tipperPrivKey, tipperPubKey, tipperAddr := testdata.KeyTestPubAddr()
+	_, feePayerPubKey, feePayerAddr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	txConfig := NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT_AUX})
+	txBuilder := txConfig.NewTxBuilder()
+
+	chainID := "test-chain"
+	memo := "sometestmemo"
+	msgs := []sdk.Msg{testdata.NewTestMsg(tipperAddr)}
+	accNum, accSeq := uint64(1), uint64(2) // Arbitrary account number/sequence
+
+	any, err := codectypes.NewAnyWithValue(tipperPubKey)
+	require.NoError(t, err)
+
+	sigData := &signingtypes.SingleSignatureData{
+		SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT_AUX,
+	}
+
+	tip := &txtypes.Tip{Amount: sdk.NewCoins(sdk.NewInt64Coin("tip-token", 10)), Tipper: tipperAddr.String()}
+	fee := txtypes.Fee{Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 150)), GasLimit: 20000}
+
+	require.NoError(t, txBuilder.SetMsgs(msgs...))
+	txBuilder.SetMemo(memo)
+	txBuilder.SetFeeAmount(fee.Amount)
+	txBuilder.SetFeePayer(feePayerAddr)
+	txBuilder.SetGasLimit(fee.GasLimit)
+	txBuilder.SetTip(tip)
+
+	require.NoError(t, txBuilder.SetSignatures(
+		signingtypes.SignatureV2{PubKey: tipperPubKey, Data: sigData, Sequence: accSeq},
+		signingtypes.SignatureV2{PubKey: feePayerPubKey, Data: &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT}},
+	))
+
+	signingData := signing.SignerData{
+		Address:       tipperAddr.String(),
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      accSeq,
+		PubKey:        tipperPubKey,
+	}
+	feePayerSigningData := signing.SignerData{
+		Address:       feePayerAddr.String(),
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      accSeq,
+		PubKey:        feePayerPubKey,
+	}
+
+	modeHandler := txConfig.SignModeHandler()
+	t.Log("verify fee payer cannot use SIGN_MODE_DIRECT_AUX")
+	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, feePayerSigningData, txBuilder.GetTx())
+	require.EqualError(t, err, fmt.Sprintf("fee payer %s cannot sign with %s: unauthorized", feePayerAddr.String(), signingtypes.SignMode_SIGN_MODE_DIRECT_AUX))
+
+	t.Log("verify signer data without pubkey fails")
+	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signing.SignerData{Address: tipperAddr.String()}, txBuilder.GetTx())
+	require.Error(t, err)
+
+	t.Log("verify GetSignBytes with generating sign bytes by marshaling SignDocDirectAux")
+	signBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.NotNil(t, signBytes)
+
+	bodyBz := txBuilder.(*wrapper).getBodyBytes()
+	expectedSignDoc := txtypes.SignDocDirectAux{
+		ChainId:       chainID,
+		AccountNumber: accNum,
+		Sequence:      accSeq,
+		BodyBytes:     bodyBz,
+		PublicKey:     any,
+		Tip:           tip,
+	}
+	expectedSignBytes, err := expectedSignDoc.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, expectedSignBytes, signBytes)
+
+	t.Log("verify that the fee does not change the sign bytes")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 300)))
+	newSignBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, signBytes, newSignBytes)
+
+	t.Log("verify the signature")
+	sig, err := tipperPrivKey.Sign(signBytes)
+	require.NoError(t, err)
+	sigData.Signature = sig
+	require.NoError(t, signing.VerifySignature(tipperPubKey, signingData, sigData, modeHandler, txBuilder.GetTx()))
+
+	t.Log("verify GetSignBytes with a wrong mode")
+	_, err = signModeDirectAuxHandler{}.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, txBuilder.GetTx())
+	require.Error(t, err)
+}
+
+func TestDirectAuxHandler_DefaultMode(t *testing.T) {
+	handler := signModeDirectAuxHandler{}
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, handler.DefaultMode())
+}
+
+func TestDirectAuxHandler_Modes(t *testing.T) {
+	handler := signModeDirectAuxHandler{}
+	require.Equal(t, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT_AUX}, handler.Modes())
+}
@@ -0,0 +1,48 @@
+package tx
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// EIP191MessagePrefix is the prefix of every EIP-191 personal message, it is
+// followed by the decimal length of the message being signed.
+const EIP191MessagePrefix = "\x19Ethereum Signed Message:\n"
+
+var _ signing.SignModeHandler = signModeEIP191Handler{}
+
+// signModeEIP191Handler defines the SIGN_MODE_EIP_191 SignModeHandler. It
+// wraps the SIGN_MODE_LEGACY_AMINO_JSON sign bytes in the EIP-191 personal
+// message envelope so that they can be signed by Ethereum wallets.
+type signModeEIP191Handler struct{}
+
+func (s signModeEIP191Handler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_EIP_191
+}
+
+func (s signModeEIP191Handler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_191}
+}
+
+func (s signModeEIP191Handler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_EIP_191 {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_EIP_191, mode)
+	}
+
+	aminoJSONBz, err := signModeLegacyAminoJSONHandler{}.GetSignBytes(
+		signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		data, tx,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(
+		[]byte(EIP191MessagePrefix+strconv.Itoa(len(aminoJSONBz))),
+		aminoJSONBz...,
+	), nil
+}
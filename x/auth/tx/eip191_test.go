@@ -0,0 +1,135 @@
+package tx
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+)
+
+func TestEIP191Handler_GetSignBytes(t *testing.T) {
+	bldr := newBuilder(nil)
+	buildTx(t, bldr)
+	tx := bldr.GetTx()
+
+	var (
+		chainId        = "test-chain"
+		accNum  uint64 = 7
+		seqNum  uint64 = 7
+	)
+
+	handler := signModeEIP191Handler{}
+	signingData := signing.SignerData{
+		ChainID:       chainId,
+		AccountNumber: accNum,
+		Sequence:      seqNum,
+	}
+	signBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_191, signingData, tx)
+	require.NoError(t, err)
+
+	aminoJSONBz := legacytx.StdSignBytes(chainId, accNum, seqNum, timeout, legacytx.StdFee{
+		Amount: coins,
+		Gas:    gas,
+	}, []sdk.Msg{msg}, memo, nil)
+	expectedSignBz := append([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(aminoJSONBz))), aminoJSONBz...)
+
+	require.Equal(t, expectedSignBz, signBz)
+
+	// expect error with wrong sign mode
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, tx)
+	require.Error(t, err)
+}
+
+func TestEIP191Handler_VerifySignature(t *testing.T) {
+	bldr := newBuilder(nil)
+	buildTx(t, bldr)
+	tx := bldr.GetTx()
+
+	handler := makeSignModeHandler(DefaultSignModes, textual.NewTextual(nil))
+	signingData := signing.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+	}
+	signBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_191, signingData, tx)
+	require.NoError(t, err)
+
+	priv := secp256k1.GenPrivKey()
+	sig, err := priv.SignKeccak256(signBz)
+	require.NoError(t, err)
+
+	sigData := &signingtypes.SingleSignatureData{
+		SignMode:  signingtypes.SignMode_SIGN_MODE_EIP_191,
+		Signature: sig,
+	}
+	require.NoError(t, signing.VerifySignature(priv.PubKey(), signingData, sigData, handler, tx))
+
+	// a signature over the SHA256 hash of the sign bytes is rejected
+	sig, err = priv.Sign(signBz)
+	require.NoError(t, err)
+	sigData.Signature = sig
+	require.Error(t, signing.VerifySignature(priv.PubKey(), signingData, sigData, handler, tx))
+
+	// keys which cannot verify Keccak256 signatures are rejected
+	edPriv := ed25519.GenPrivKey()
+	sig, err = edPriv.Sign(signBz)
+	require.NoError(t, err)
+	sigData.Signature = sig
+	require.Error(t, signing.VerifySignature(edPriv.PubKey(), signingData, sigData, handler, tx))
+}
+
+func TestEIP191Handler_Multisig(t *testing.T) {
+	bldr := newBuilder(nil)
+	buildTx(t, bldr)
+	tx := bldr.GetTx()
+
+	handler := makeSignModeHandler(DefaultSignModes, textual.NewTextual(nil))
+	signingData := signing.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+	}
+	signBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_191, signingData, tx)
+	require.NoError(t, err)
+
+	privs := []*secp256k1.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	pubKeys := []cryptotypes.PubKey{privs[0].PubKey(), privs[1].PubKey()}
+	multisigKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+
+	// valid EIP-191 signatures are still rejected inside a multisignature
+	multisignature := multisig.NewMultisig(2)
+	for i, priv := range privs {
+		sig, err := priv.SignKeccak256(signBz)
+		require.NoError(t, err)
+		sigData := &signingtypes.SingleSignatureData{
+			SignMode:  signingtypes.SignMode_SIGN_MODE_EIP_191,
+			Signature: sig,
+		}
+		require.NoError(t, multisig.AddSignatureFromPubKey(multisignature, sigData, pubKeys[i], pubKeys))
+	}
+
+	err = signing.VerifySignature(multisigKey, signingData, multisignature, handler, tx)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not supported in multisignatures")
+}
+
+func TestEIP191Handler_DefaultMode(t *testing.T) {
+	handler := signModeEIP191Handler{}
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_EIP_191, handler.DefaultMode())
+}
+
+func TestEIP191Handler_Modes(t *testing.T) {
+	handler := signModeEIP191Handler{}
+	require.Equal(t, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_191}, handler.Modes())
+}
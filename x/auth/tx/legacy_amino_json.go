@@ -46,9 +46,16 @@ func (s signModeLegacyAminoJSONHandler) GetSignBytes(mode signingtypes.SignMode,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "SIGN_MODE_LEGACY_AMINO_JSON does not support protobuf extension options.")
 	}
 
+	// Auxiliary signers of a tipped transaction, such as the tipper, sign
+	// before the fee is known, so only the fee payer signs over it.
+	fee := legacytx.StdFee{Amount: protoTx.GetFee(), Gas: protoTx.GetGas()}
+	tip := protoTx.GetTip()
+	if tip != nil && data.Address != protoTx.FeePayer().String() {
+		fee = legacytx.StdFee{}
+	}
+
 	return legacytx.StdSignBytes(
 		data.ChainID, data.AccountNumber, data.Sequence, protoTx.GetTimeoutHeight(),
-		legacytx.StdFee{Amount: protoTx.GetFee(), Gas: protoTx.GetGas()},
-		tx.GetMsgs(), protoTx.GetMemo(),
+		fee, tx.GetMsgs(), protoTx.GetMemo(), tip,
 	), nil
 }
@@ -0,0 +1,128 @@
+package textual
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/gogo/protobuf/proto"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Screen is the abstract unit of information displayed to the user when
+// signing with SIGN_MODE_TEXTUAL. A device with a small display shows one
+// screen at a time.
+type Screen struct {
+	// Text is the content of the screen.
+	Text string `json:"text"`
+
+	// Indent is the nesting level of the screen, it is used to render nested
+	// objects, such as the fields of a message.
+	Indent int `json:"indent,omitempty"`
+
+	// Expert marks screens which are only shown to users who opted into an
+	// expert mode on their wallet.
+	Expert bool `json:"expert,omitempty"`
+}
+
+// CoinMetadataQueryFn returns the bank metadata of a denom. It returns a nil
+// metadata, and no error, if no metadata is registered for the denom.
+type CoinMetadataQueryFn func(ctx context.Context, denom string) (*banktypes.Metadata, error)
+
+// ValueRenderer formats a value into a list of screens.
+//
+// The first returned screen holds the value itself, or a header introducing
+// it, and is at indent 0. The following screens, if any, are indented relative
+// to the first one.
+type ValueRenderer interface {
+	Format(ctx context.Context, v interface{}) ([]Screen, error)
+}
+
+// ValueRendererFunc is an adapter to use a function as a ValueRenderer.
+type ValueRendererFunc func(ctx context.Context, v interface{}) ([]Screen, error)
+
+// Format implements ValueRenderer.Format.
+func (f ValueRendererFunc) Format(ctx context.Context, v interface{}) ([]Screen, error) {
+	return f(ctx, v)
+}
+
+// Textual renders values, messages and transactions into the screens signed
+// over in SIGN_MODE_TEXTUAL.
+type Textual struct {
+	coinMetadataQueryFn CoinMetadataQueryFn
+
+	// messageRenderers holds the renderers of message contents keyed by
+	// fully-qualified protobuf message name.
+	messageRenderers map[string]ValueRenderer
+}
+
+// NewTextual returns a new Textual. Coins are rendered with the display denom
+// of the metadata returned by coinMetadataQueryFn. If coinMetadataQueryFn is
+// nil, coins are rendered in their base denom.
+func NewTextual(coinMetadataQueryFn CoinMetadataQueryFn) *Textual {
+	return &Textual{
+		coinMetadataQueryFn: coinMetadataQueryFn,
+		messageRenderers:    make(map[string]ValueRenderer),
+	}
+}
+
+// RegisterMessageRenderer registers a custom renderer for the message with the
+// given fully-qualified protobuf name, replacing the default rendering of its
+// fields. The renderer is given the message and returns the screens of its
+// content, the header introducing the message is added by the caller.
+func (t *Textual) RegisterMessageRenderer(msgName string, vr ValueRenderer) {
+	if _, ok := t.messageRenderers[msgName]; ok {
+		panic(fmt.Errorf("renderer already registered for message %s", msgName))
+	}
+
+	t.messageRenderers[msgName] = vr
+}
+
+// Format renders any value supported by SIGN_MODE_TEXTUAL into screens.
+func (t *Textual) Format(ctx context.Context, v interface{}) ([]Screen, error) {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return nil, fmt.Errorf("cannot render nil value")
+	}
+
+	// make the value addressable so that messages embedded by value can be
+	// handled as proto.Message.
+	addressable := reflect.New(rv.Type()).Elem()
+	addressable.Set(rv)
+
+	return t.format(ctx, addressable)
+}
+
+// FormatMessage renders the content of a protobuf message, without any header.
+func (t *Textual) FormatMessage(ctx context.Context, msg proto.Message) ([]Screen, error) {
+	if vr, ok := t.messageRenderers[proto.MessageName(msg)]; ok {
+		return vr.Format(ctx, msg)
+	}
+
+	rv := reflect.ValueOf(msg)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return nil, fmt.Errorf("cannot render message %T", msg)
+	}
+
+	return t.formatFields(ctx, rv.Elem())
+}
+
+// EncodeScreens returns the deterministic encoding of screens.
+func EncodeScreens(screens []Screen) ([]byte, error) {
+	return json.Marshal(screens)
+}
+
+// HashScreens returns the SHA-256 hash of the encoding of screens, which is
+// used as sign bytes in SIGN_MODE_TEXTUAL.
+func HashScreens(screens []Screen) ([]byte, error) {
+	bz, err := EncodeScreens(screens)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
@@ -0,0 +1,177 @@
+package textual
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// TxData is the transaction data rendered in SIGN_MODE_TEXTUAL.
+type TxData struct {
+	Body     *txtypes.TxBody
+	AuthInfo *txtypes.AuthInfo
+
+	// BodyBytes and AuthInfoBytes are the raw bytes of Body and AuthInfo.
+	// Their hash is signed over to prevent malleability, as the rendering of
+	// a transaction is not guaranteed to be injective.
+	BodyBytes     []byte
+	AuthInfoBytes []byte
+}
+
+// GetScreens renders the screens signed over by a signer of a transaction in
+// SIGN_MODE_TEXTUAL. Screens only relevant to advanced users are marked as
+// expert.
+func (t *Textual) GetScreens(ctx context.Context, data signing.SignerData, txData TxData) ([]Screen, error) {
+	if txData.Body == nil || txData.AuthInfo == nil {
+		return nil, fmt.Errorf("cannot render a transaction without body or auth info")
+	}
+
+	screens := []Screen{
+		{Text: fmt.Sprintf("Chain id: %s", data.ChainID)},
+		{Text: fmt.Sprintf("Account number: %s", formatInteger(strconv.FormatUint(data.AccountNumber, 10)))},
+		{Text: fmt.Sprintf("Sequence: %s", formatInteger(strconv.FormatUint(data.Sequence, 10)))},
+	}
+
+	if data.Address != "" {
+		screens = append(screens, Screen{Text: fmt.Sprintf("Address: %s", data.Address)})
+	}
+
+	if data.PubKey != nil {
+		pkAny, err := codectypes.NewAnyWithValue(data.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		pkScreens, err := t.formatAny(ctx, pkAny)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, expert(withTitle("Public key", pkScreens))...)
+	}
+
+	msgs := txData.Body.Messages
+	msgsScreens, err := t.formatAnys(ctx, "Message", msgs)
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 1 {
+		screens = append(screens, Screen{Text: "This transaction has 1 Message"})
+	} else {
+		screens = append(screens, Screen{Text: fmt.Sprintf("This transaction has %d Messages", len(msgs))})
+	}
+	screens = append(screens, msgsScreens...)
+
+	if txData.Body.Memo != "" {
+		screens = append(screens, Screen{Text: fmt.Sprintf("Memo: %s", txData.Body.Memo)})
+	}
+
+	fee := txData.AuthInfo.Fee
+	if fee == nil {
+		fee = &txtypes.Fee{}
+	}
+	feeScreens, err := t.Format(ctx, fee.Amount)
+	if err != nil {
+		return nil, err
+	}
+	screens = append(screens, withTitle("Fee", feeScreens)...)
+	if fee.Payer != "" {
+		screens = append(screens, Screen{Text: fmt.Sprintf("Fee payer: %s", fee.Payer), Expert: true})
+	}
+	if fee.Granter != "" {
+		screens = append(screens, Screen{Text: fmt.Sprintf("Fee granter: %s", fee.Granter), Expert: true})
+	}
+	screens = append(screens, Screen{
+		Text:   fmt.Sprintf("Gas limit: %s", formatInteger(strconv.FormatUint(fee.GasLimit, 10))),
+		Expert: true,
+	})
+
+	if tip := txData.AuthInfo.Tip; tip != nil {
+		tipScreens, err := t.Format(ctx, tip.Amount)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, withTitle("Tip", tipScreens)...)
+		screens = append(screens, Screen{Text: fmt.Sprintf("Tipper: %s", tip.Tipper)})
+	}
+
+	if txData.Body.TimeoutHeight != 0 {
+		screens = append(screens, Screen{
+			Text:   fmt.Sprintf("Timeout height: %s", formatInteger(strconv.FormatUint(txData.Body.TimeoutHeight, 10))),
+			Expert: true,
+		})
+	}
+
+	if len(txData.AuthInfo.SignerInfos) > 0 {
+		signerInfosScreens, err := t.Format(ctx, txData.AuthInfo.SignerInfos)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, expert(withTitle("Signer infos", signerInfosScreens))...)
+	}
+
+	if len(txData.Body.ExtensionOptions) > 0 {
+		extScreens, err := t.Format(ctx, txData.Body.ExtensionOptions)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, expert(withTitle("Extension options", extScreens))...)
+	}
+
+	if len(txData.Body.NonCriticalExtensionOptions) > 0 {
+		extScreens, err := t.Format(ctx, txData.Body.NonCriticalExtensionOptions)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, expert(withTitle("Non critical extension options", extScreens))...)
+	}
+
+	hash := sha256.Sum256(append(append([]byte{}, txData.BodyBytes...), txData.AuthInfoBytes...))
+	screens = append(screens, Screen{Text: fmt.Sprintf("Hash of raw bytes: %X", hash[:]), Expert: true})
+
+	return screens, nil
+}
+
+// GetSignBytes returns the SIGN_MODE_TEXTUAL sign bytes of a transaction for a
+// signer, i.e. the hash of its screens.
+func (t *Textual) GetSignBytes(ctx context.Context, data signing.SignerData, txData TxData) ([]byte, error) {
+	screens, err := t.GetScreens(ctx, data, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	return HashScreens(screens)
+}
+
+// formatAnys renders a list of Anys, each one introduced by a numbered title,
+// followed by an end marker.
+func (t *Textual) formatAnys(ctx context.Context, title string, anys []*codectypes.Any) ([]Screen, error) {
+	var screens []Screen
+	for i, any := range anys {
+		anyScreens, err := t.formatAny(ctx, any)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, withTitle(fmt.Sprintf("%s (%d/%d)", title, i+1, len(anys)), anyScreens)...)
+	}
+
+	return append(screens, Screen{Text: fmt.Sprintf("End of %s", title)}), nil
+}
+
+// withTitle prefixes the first screen with title.
+func withTitle(title string, screens []Screen) []Screen {
+	screens[0].Text = fmt.Sprintf("%s: %s", title, screens[0].Text)
+	return screens
+}
+
+// expert marks screens as expert.
+func expert(screens []Screen) []Screen {
+	for i := range screens {
+		screens[i].Expert = true
+	}
+
+	return screens
+}
@@ -0,0 +1,498 @@
+package textual
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxRenderedBytes is the maximum length of a bytes value rendered as hex,
+// longer values are rendered as their SHA-256 hash.
+const maxRenderedBytes = 35
+
+var (
+	intType       = reflect.TypeOf(sdk.Int{})
+	decType       = reflect.TypeOf(sdk.Dec{})
+	coinType      = reflect.TypeOf(sdk.Coin{})
+	coinsType     = reflect.TypeOf(sdk.Coins{})
+	coinSliceType = reflect.TypeOf([]sdk.Coin{})
+	decCoinType   = reflect.TypeOf(sdk.DecCoin{})
+	decCoinsType  = reflect.TypeOf(sdk.DecCoins{})
+	decSliceType  = reflect.TypeOf([]sdk.DecCoin{})
+	timeType      = reflect.TypeOf(time.Time{})
+	durationType  = reflect.TypeOf(time.Duration(0))
+	timestampType = reflect.TypeOf(gogotypes.Timestamp{})
+	protoDurType  = reflect.TypeOf(gogotypes.Duration{})
+	anyType       = reflect.TypeOf(codectypes.Any{})
+	bytesType     = reflect.TypeOf([]byte{})
+
+	protoMessageType = reflect.TypeOf((*proto.Message)(nil)).Elem()
+	stringerType     = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// format renders an addressable value into screens.
+func (t *Textual) format(ctx context.Context, rv reflect.Value) ([]Screen, error) {
+	switch rv.Type() {
+	case intType:
+		return textScreen(formatInteger(rv.Interface().(sdk.Int).String())), nil
+	case decType:
+		return textScreen(formatDecimal(rv.Interface().(sdk.Dec).String())), nil
+	case coinType:
+		coin := rv.Interface().(sdk.Coin)
+		text, err := t.formatCoins(ctx, []displayCoin{{coin.Denom, coin.Amount.String()}})
+		return textScreen(text), err
+	case coinsType, coinSliceType:
+		var coins []displayCoin
+		for _, coin := range rv.Convert(coinSliceType).Interface().([]sdk.Coin) {
+			coins = append(coins, displayCoin{coin.Denom, coin.Amount.String()})
+		}
+		text, err := t.formatCoins(ctx, coins)
+		return textScreen(text), err
+	case decCoinType:
+		coin := rv.Interface().(sdk.DecCoin)
+		text, err := t.formatCoins(ctx, []displayCoin{{coin.Denom, coin.Amount.String()}})
+		return textScreen(text), err
+	case decCoinsType, decSliceType:
+		var coins []displayCoin
+		for _, coin := range rv.Convert(decSliceType).Interface().([]sdk.DecCoin) {
+			coins = append(coins, displayCoin{coin.Denom, coin.Amount.String()})
+		}
+		text, err := t.formatCoins(ctx, coins)
+		return textScreen(text), err
+	case timeType:
+		return textScreen(formatTimestamp(rv.Interface().(time.Time))), nil
+	case timestampType:
+		ts, err := gogotypes.TimestampFromProto(rv.Addr().Interface().(*gogotypes.Timestamp))
+		if err != nil {
+			return nil, err
+		}
+		return textScreen(formatTimestamp(ts)), nil
+	case durationType:
+		return textScreen(formatDuration(rv.Interface().(time.Duration))), nil
+	case protoDurType:
+		d, err := gogotypes.DurationFromProto(rv.Addr().Interface().(*gogotypes.Duration))
+		if err != nil {
+			return nil, err
+		}
+		return textScreen(formatDuration(d)), nil
+	case anyType:
+		return t.formatAny(ctx, rv.Addr().Interface().(*codectypes.Any))
+	case bytesType:
+		return textScreen(formatBytes(rv.Bytes())), nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return nil, fmt.Errorf("cannot render nil %s", rv.Type())
+		}
+		return t.format(ctx, rv.Elem())
+
+	case reflect.Interface:
+		if rv.IsNil() {
+			return nil, fmt.Errorf("cannot render nil %s", rv.Type())
+		}
+		elem := reflect.New(rv.Elem().Type()).Elem()
+		elem.Set(rv.Elem())
+		return t.format(ctx, elem)
+
+	case reflect.Struct:
+		msg, ok := rv.Addr().Interface().(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("cannot render non-protobuf struct %s", rv.Type())
+		}
+		content, err := t.FormatMessage(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		return append(textScreen(fmt.Sprintf("%s object", shortMessageName(msg))), indent(content, 1)...), nil
+
+	case reflect.String:
+		return textScreen(rv.String()), nil
+
+	case reflect.Bool:
+		if rv.Bool() {
+			return textScreen("True"), nil
+		}
+		return textScreen("False"), nil
+
+	case reflect.Int32:
+		// protobuf enums are generated as int32 implementing fmt.Stringer.
+		if rv.Type().Implements(stringerType) {
+			return textScreen(rv.Interface().(fmt.Stringer).String()), nil
+		}
+		return textScreen(formatInteger(strconv.FormatInt(rv.Int(), 10))), nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int64:
+		return textScreen(formatInteger(strconv.FormatInt(rv.Int(), 10))), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return textScreen(formatInteger(strconv.FormatUint(rv.Uint(), 10))), nil
+
+	case reflect.Slice:
+		return t.formatRepeated(ctx, rv)
+	}
+
+	return nil, fmt.Errorf("cannot render value of type %s", rv.Type())
+}
+
+// formatFields renders the non-default fields of a protobuf message struct, in
+// declaration order.
+func (t *Textual) formatFields(ctx context.Context, rv reflect.Value) ([]Screen, error) {
+	var screens []Screen
+
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Type().Field(i)
+		fv := rv.Field(i)
+
+		if field.Tag.Get("protobuf_oneof") != "" {
+			if fv.IsNil() {
+				continue
+			}
+			// oneofs are generated as an interface holding a pointer to a
+			// wrapper struct with a single field.
+			oneofScreens, err := t.formatFields(ctx, fv.Elem().Elem())
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, oneofScreens...)
+			continue
+		}
+
+		name := protoFieldName(field.Tag.Get("protobuf"))
+		if name == "" || fv.IsZero() {
+			continue
+		}
+
+		fieldScreens, err := t.format(ctx, fv)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", name, err)
+		}
+
+		fieldScreens[0].Text = fmt.Sprintf("%s: %s", fieldTitle(name), fieldScreens[0].Text)
+		screens = append(screens, fieldScreens...)
+	}
+
+	return screens, nil
+}
+
+// formatRepeated renders a list of values as a header announcing the number of
+// elements, followed by each element and an end marker.
+func (t *Textual) formatRepeated(ctx context.Context, rv reflect.Value) ([]Screen, error) {
+	kind := elemKindName(rv.Type().Elem())
+	screens := textScreen(fmt.Sprintf("%d %s", rv.Len(), kind))
+
+	for i := 0; i < rv.Len(); i++ {
+		elemScreens, err := t.format(ctx, rv.Index(i))
+		if err != nil {
+			return nil, err
+		}
+		elemScreens[0].Text = fmt.Sprintf("%s (%d/%d): %s", fieldTitle(kind), i+1, rv.Len(), elemScreens[0].Text)
+		screens = append(screens, indent(elemScreens, 1)...)
+	}
+
+	return append(screens, Screen{Text: fmt.Sprintf("End of %s", fieldTitle(kind))}), nil
+}
+
+// formatAny renders the type URL of an Any followed by the content of the
+// message it holds.
+func (t *Textual) formatAny(ctx context.Context, any *codectypes.Any) ([]Screen, error) {
+	msg, ok := any.GetCachedValue().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("cannot render unresolved Any of type %s", any.TypeUrl)
+	}
+
+	content, err := t.FormatMessage(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(textScreen(any.TypeUrl), indent(content, 1)...), nil
+}
+
+// displayCoin is a coin amount, integer or decimal, in its base denom.
+type displayCoin struct {
+	denom  string
+	amount string
+}
+
+// formatCoins renders coins in their display denom, sorted by display denom.
+func (t *Textual) formatCoins(ctx context.Context, coins []displayCoin) (string, error) {
+	if len(coins) == 0 {
+		return "zero", nil
+	}
+
+	rendered := make([]displayCoin, len(coins))
+	for i, coin := range coins {
+		c, err := t.toDisplayCoin(ctx, coin)
+		if err != nil {
+			return "", err
+		}
+		rendered[i] = c
+	}
+
+	sort.SliceStable(rendered, func(i, j int) bool {
+		return rendered[i].denom < rendered[j].denom
+	})
+
+	parts := make([]string, len(rendered))
+	for i, coin := range rendered {
+		parts[i] = fmt.Sprintf("%s %s", formatDecimal(coin.amount), coin.denom)
+	}
+
+	return strings.Join(parts, ", "), nil
+}
+
+// toDisplayCoin converts a coin from its base denom to the display denom of its
+// bank metadata, if any.
+func (t *Textual) toDisplayCoin(ctx context.Context, coin displayCoin) (displayCoin, error) {
+	if t.coinMetadataQueryFn == nil {
+		return coin, nil
+	}
+
+	metadata, err := t.coinMetadataQueryFn(ctx, coin.denom)
+	if err != nil {
+		return displayCoin{}, err
+	}
+	if metadata == nil || metadata.Display == "" || metadata.Display == coin.denom {
+		return coin, nil
+	}
+
+	var (
+		baseExp, dispExp uint32
+		foundBase        bool
+		foundDisp        bool
+	)
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == coin.denom {
+			baseExp, foundBase = unit.Exponent, true
+		}
+		if unit.Denom == metadata.Display {
+			dispExp, foundDisp = unit.Exponent, true
+		}
+	}
+	if !foundBase || !foundDisp || dispExp < baseExp {
+		return coin, nil
+	}
+
+	return displayCoin{
+		denom:  metadata.Display,
+		amount: shiftDecimal(coin.amount, int(dispExp-baseExp)),
+	}, nil
+}
+
+// shiftDecimal divides the decimal string amount by 10^exp, without loss of
+// precision.
+func shiftDecimal(amount string, exp int) string {
+	negative := strings.HasPrefix(amount, "-")
+	amount = strings.TrimPrefix(amount, "-")
+
+	intPart, fracPart := amount, ""
+	if i := strings.IndexByte(amount, '.'); i >= 0 {
+		intPart, fracPart = amount[:i], amount[i+1:]
+	}
+
+	if len(intPart) <= exp {
+		intPart = strings.Repeat("0", exp-len(intPart)+1) + intPart
+	}
+
+	split := len(intPart) - exp
+	result := intPart[:split] + "." + intPart[split:] + fracPart
+	if negative {
+		result = "-" + result
+	}
+
+	return result
+}
+
+// formatInteger renders an integer string with ' as thousands separator, e.g.
+// 1'000'000.
+func formatInteger(v string) string {
+	sign := ""
+	if strings.HasPrefix(v, "-") {
+		sign, v = "-", v[1:]
+	}
+
+	var sb strings.Builder
+	for i, c := range v {
+		if i > 0 && (len(v)-i)%3 == 0 {
+			sb.WriteByte('\'')
+		}
+		sb.WriteRune(c)
+	}
+
+	return sign + sb.String()
+}
+
+// formatDecimal renders a decimal string with ' as thousands separator and
+// without trailing zeros, e.g. 1'000.5.
+func formatDecimal(v string) string {
+	sign := ""
+	if strings.HasPrefix(v, "-") {
+		sign, v = "-", v[1:]
+	}
+
+	intPart, fracPart := v, ""
+	if i := strings.IndexByte(v, '.'); i >= 0 {
+		intPart, fracPart = v[:i], strings.TrimRight(v[i+1:], "0")
+	}
+
+	intPart = strings.TrimLeft(intPart, "0")
+	if intPart == "" {
+		intPart = "0"
+	}
+	if intPart == "0" && fracPart == "" {
+		sign = ""
+	}
+
+	if fracPart == "" {
+		return sign + formatInteger(intPart)
+	}
+
+	return sign + formatInteger(intPart) + "." + fracPart
+}
+
+// formatTimestamp renders a time in RFC 3339 format, in UTC.
+func formatTimestamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// formatDuration renders a duration as days, hours, minutes and seconds,
+// omitting zero components, e.g. "1 day, 2 hours, 30.5 seconds".
+func formatDuration(d time.Duration) string {
+	if d == 0 {
+		return "0 seconds"
+	}
+
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+
+	units := []struct {
+		name string
+		size time.Duration
+	}{
+		{"day", 24 * time.Hour},
+		{"hour", time.Hour},
+		{"minute", time.Minute},
+	}
+
+	var parts []string
+	for _, unit := range units {
+		n := d / unit.size
+		d -= n * unit.size
+		if n > 0 {
+			parts = append(parts, pluralize(strconv.FormatInt(int64(n), 10), unit.name, n == 1))
+		}
+	}
+
+	if d > 0 {
+		seconds := formatDecimal(fmt.Sprintf("%d.%09d", d/time.Second, d%time.Second))
+		parts = append(parts, pluralize(seconds, "second", seconds == "1"))
+	}
+
+	return sign + strings.Join(parts, ", ")
+}
+
+// formatBytes renders bytes as upper case hex, or as the hex of their SHA-256
+// hash if they are too long to be displayed.
+func formatBytes(bz []byte) string {
+	if len(bz) > maxRenderedBytes {
+		hash := sha256.Sum256(bz)
+		return fmt.Sprintf("SHA-256=%X", hash[:])
+	}
+
+	return fmt.Sprintf("%X", bz)
+}
+
+func pluralize(n, unit string, singular bool) string {
+	if singular {
+		return fmt.Sprintf("%s %s", n, unit)
+	}
+
+	return fmt.Sprintf("%s %ss", n, unit)
+}
+
+// protoFieldName returns the protobuf field name from a struct field tag
+// generated by protoc-gen-gogo.
+func protoFieldName(tag string) string {
+	for _, part := range strings.Split(tag, ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
+	}
+
+	return ""
+}
+
+// fieldTitle turns a snake case protobuf field name into a title, e.g.
+// from_address becomes "From address".
+func fieldTitle(name string) string {
+	title := strings.ReplaceAll(name, "_", " ")
+	if title == "" {
+		return title
+	}
+
+	return strings.ToUpper(title[:1]) + title[1:]
+}
+
+// shortMessageName returns the protobuf message name without its package.
+func shortMessageName(msg proto.Message) string {
+	name := proto.MessageName(msg)
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		return name[i+1:]
+	}
+
+	return name
+}
+
+// elemKindName returns the name used for the elements of a repeated field.
+func elemKindName(typ reflect.Type) string {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == anyType {
+		return "Any"
+	}
+	if reflect.PtrTo(typ).Implements(protoMessageType) {
+		return shortMessageName(reflect.New(typ).Interface().(proto.Message))
+	}
+
+	switch typ {
+	case intType, decType:
+		return "number"
+	case bytesType:
+		return "bytes"
+	case timeType:
+		return "timestamp"
+	case durationType:
+		return "duration"
+	}
+
+	return typ.Kind().String()
+}
+
+func textScreen(text string) []Screen {
+	return []Screen{{Text: text}}
+}
+
+// indent increases the indentation of screens by n.
+func indent(screens []Screen, n int) []Screen {
+	for i := range screens {
+		screens[i].Indent += n
+	}
+
+	return screens
+}
@@ -0,0 +1,154 @@
+package textual_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func atomMetadataQueryFn(_ context.Context, denom string) (*banktypes.Metadata, error) {
+	if denom != "uatom" {
+		return nil, nil
+	}
+
+	return &banktypes.Metadata{
+		Base:    "uatom",
+		Display: "atom",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "matom", Exponent: 3},
+			{Denom: "atom", Exponent: 6},
+		},
+	}, nil
+}
+
+func TestFormatScalars(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{"zero int", sdk.ZeroInt(), "0"},
+		{"int", sdk.NewInt(1234567), "1'234'567"},
+		{"negative int", sdk.NewInt(-1234), "-1'234"},
+		{"uint64", uint64(1000), "1'000"},
+		{"uint64 below thousand", uint64(999), "999"},
+		{"dec", sdk.MustNewDecFromStr("1234.5"), "1'234.5"},
+		{"zero dec", sdk.ZeroDec(), "0"},
+		{"small dec", sdk.MustNewDecFromStr("0.000001"), "0.000001"},
+		{"string", "hello", "hello"},
+		{"true", true, "True"},
+		{"false", false, "False"},
+		{"short bytes", []byte{0xde, 0xad, 0xbe, 0xef}, "DEADBEEF"},
+		{"long bytes", make([]byte, 36), "SHA-256=6DB65FD59FD356F6729140571B5BCD6BB3B83492A16E1BF0A3884442FC3C8A0E"},
+		{"time", time.Date(2022, 1, 2, 3, 4, 5, 600, time.FixedZone("CET", 3600)), "2022-01-02T02:04:05.0000006Z"},
+		{"timestamp", gogotypes.Timestamp{Seconds: 1, Nanos: 500000000}, "1970-01-01T00:00:01.5Z"},
+		{"zero duration", time.Duration(0), "0 seconds"},
+		{"duration", 26*time.Hour + time.Minute + 1500*time.Millisecond, "1 day, 2 hours, 1 minute, 1.5 seconds"},
+		{"one second", time.Second, "1 second"},
+		{"proto duration", gogotypes.Duration{Seconds: 120}, "2 minutes"},
+	}
+
+	tr := textual.NewTextual(nil)
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			screens, err := tr.Format(context.Background(), tc.value)
+			require.NoError(t, err)
+			require.Equal(t, []textual.Screen{{Text: tc.expected}}, screens)
+		})
+	}
+}
+
+func TestFormatCoins(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{"coin with metadata", sdk.NewInt64Coin("uatom", 1500000), "1.5 atom"},
+		{"small coin with metadata", sdk.NewInt64Coin("uatom", 1), "0.000001 atom"},
+		{"large coin with metadata", sdk.NewInt64Coin("uatom", 1234000000), "1'234 atom"},
+		{"coin without metadata", sdk.NewInt64Coin("stake", 1000), "1'000 stake"},
+		{"empty coins", sdk.Coins{}, "zero"},
+		{
+			"coins sorted by display denom",
+			sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000000), sdk.NewInt64Coin("bar", 10)),
+			"2 atom, 10 bar",
+		},
+		{"dec coin", sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("10.5")), "0.0000105 atom"},
+	}
+
+	tr := textual.NewTextual(atomMetadataQueryFn)
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			screens, err := tr.Format(context.Background(), tc.value)
+			require.NoError(t, err)
+			require.Equal(t, []textual.Screen{{Text: tc.expected}}, screens)
+		})
+	}
+}
+
+func TestFormatMessage(t *testing.T) {
+	from, to := sdk.AccAddress("from"), sdk.AccAddress("to")
+	msg := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000000)))
+	any, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+
+	tr := textual.NewTextual(atomMetadataQueryFn)
+	screens, err := tr.Format(context.Background(), any)
+	require.NoError(t, err)
+	require.Equal(t, []textual.Screen{
+		{Text: "/cosmos.bank.v1beta1.MsgSend"},
+		{Text: "From address: " + from.String(), Indent: 1},
+		{Text: "To address: " + to.String(), Indent: 1},
+		{Text: "Amount: 1 atom", Indent: 1},
+	}, screens)
+
+	// custom renderers replace the rendering of the message fields
+	tr.RegisterMessageRenderer("cosmos.bank.v1beta1.MsgSend", textual.ValueRendererFunc(
+		func(_ context.Context, v interface{}) ([]textual.Screen, error) {
+			msg := v.(*banktypes.MsgSend)
+			return []textual.Screen{{Text: "Send to " + msg.ToAddress}}, nil
+		},
+	))
+	screens, err = tr.Format(context.Background(), any)
+	require.NoError(t, err)
+	require.Equal(t, []textual.Screen{
+		{Text: "/cosmos.bank.v1beta1.MsgSend"},
+		{Text: "Send to " + to.String(), Indent: 1},
+	}, screens)
+
+	require.Panics(t, func() {
+		tr.RegisterMessageRenderer("cosmos.bank.v1beta1.MsgSend", textual.ValueRendererFunc(nil))
+	})
+}
+
+func TestFormatRepeated(t *testing.T) {
+	msg := &testdata.TestMsg{Signers: []string{"alice", "bob"}}
+
+	screens, err := textual.NewTextual(nil).Format(context.Background(), msg)
+	require.NoError(t, err)
+	require.Equal(t, []textual.Screen{
+		{Text: "TestMsg object"},
+		{Text: "Signers: 2 string", Indent: 1},
+		{Text: "String (1/2): alice", Indent: 2},
+		{Text: "String (2/2): bob", Indent: 2},
+		{Text: "End of String", Indent: 1},
+	}, screens)
+}
+
+func TestFormatUnresolvedAny(t *testing.T) {
+	_, err := textual.NewTextual(nil).Format(context.Background(), &codectypes.Any{TypeUrl: "/foo.Bar"})
+	require.Error(t, err)
+}
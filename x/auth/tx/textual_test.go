@@ -0,0 +1,115 @@
+package tx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestTextualHandler_GetSignBytes(t *testing.T) {
+	bldr := newBuilder(nil)
+	buildTx(t, bldr)
+	tx := bldr.GetTx()
+
+	handler := signModeTextualHandler{t: textual.NewTextual(nil)}
+	signingData := authsigning.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 7,
+		Sequence:      7,
+		Address:       addr1.String(),
+	}
+	signBz, err := handler.GetSignBytes(signing.SignMode_SIGN_MODE_TEXTUAL, signingData, tx)
+	require.NoError(t, err)
+
+	screens, err := textual.NewTextual(nil).GetScreens(context.Background(), signingData, textual.TxData{
+		Body:          bldr.tx.Body,
+		AuthInfo:      bldr.tx.AuthInfo,
+		BodyBytes:     bldr.getBodyBytes(),
+		AuthInfoBytes: bldr.getAuthInfoBytes(),
+	})
+	require.NoError(t, err)
+	expectedSignBz, err := textual.HashScreens(screens)
+	require.NoError(t, err)
+	require.Equal(t, expectedSignBz, signBz)
+
+	// sign bytes depend on the signer data
+	signingData.Sequence = 8
+	otherSignBz, err := handler.GetSignBytes(signing.SignMode_SIGN_MODE_TEXTUAL, signingData, tx)
+	require.NoError(t, err)
+	require.NotEqual(t, signBz, otherSignBz)
+
+	// expect error with wrong sign mode
+	_, err = handler.GetSignBytes(signing.SignMode_SIGN_MODE_DIRECT, signingData, tx)
+	require.Error(t, err)
+}
+
+func TestTextualHandler_CoinMetadata(t *testing.T) {
+	bldr := newBuilder(nil)
+	buildTx(t, bldr)
+	tx := bldr.GetTx()
+
+	signingData := authsigning.SignerData{ChainID: "test-chain"}
+	withoutMetadata := signModeTextualHandler{t: textual.NewTextual(nil)}
+	withMetadata := signModeTextualHandler{t: textual.NewTextual(func(_ context.Context, denom string) (*banktypes.Metadata, error) {
+		return &banktypes.Metadata{
+			Base:    denom,
+			Display: "coin",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: denom, Exponent: 0},
+				{Denom: "coin", Exponent: 6},
+			},
+		}, nil
+	})}
+
+	bz1, err := withoutMetadata.GetSignBytes(signing.SignMode_SIGN_MODE_TEXTUAL, signingData, tx)
+	require.NoError(t, err)
+	bz2, err := withMetadata.GetSignBytes(signing.SignMode_SIGN_MODE_TEXTUAL, signingData, tx)
+	require.NoError(t, err)
+	require.NotEqual(t, bz1, bz2)
+}
+
+func TestTextualHandler_VerifySignature(t *testing.T) {
+	bldr := newBuilder(nil)
+	buildTx(t, bldr)
+	tx := bldr.GetTx()
+
+	handler := makeSignModeHandler(DefaultSignModes, textual.NewTextual(nil))
+	priv := secp256k1.GenPrivKey()
+	signingData := authsigning.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+		PubKey:        priv.PubKey(),
+	}
+	signBz, err := handler.GetSignBytes(signing.SignMode_SIGN_MODE_TEXTUAL, signingData, tx)
+	require.NoError(t, err)
+
+	sig, err := priv.Sign(signBz)
+	require.NoError(t, err)
+
+	sigData := &signing.SingleSignatureData{
+		SignMode:  signing.SignMode_SIGN_MODE_TEXTUAL,
+		Signature: sig,
+	}
+	require.NoError(t, authsigning.VerifySignature(priv.PubKey(), signingData, sigData, handler, tx))
+
+	signingData.Sequence = 3
+	require.Error(t, authsigning.VerifySignature(priv.PubKey(), signingData, sigData, handler, tx))
+}
+
+func TestTextualHandler_DefaultMode(t *testing.T) {
+	handler := signModeTextualHandler{}
+	require.Equal(t, signing.SignMode_SIGN_MODE_TEXTUAL, handler.DefaultMode())
+}
+
+func TestTextualHandler_Modes(t *testing.T) {
+	handler := signModeTextualHandler{}
+	require.Equal(t, []signing.SignMode{signing.SignMode_SIGN_MODE_TEXTUAL}, handler.Modes())
+}
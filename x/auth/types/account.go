@@ -15,6 +15,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 var (
@@ -23,6 +24,8 @@ var (
 	_ codectypes.UnpackInterfacesMessage = (*BaseAccount)(nil)
 	_ GenesisAccount                     = (*ModuleAccount)(nil)
 	_ ModuleAccountI                     = (*ModuleAccount)(nil)
+	_ GenesisAccount                     = (*DerivedModuleAccount)(nil)
+	_ DerivedModuleAccountI              = (*DerivedModuleAccount)(nil)
 )
 
 // NewBaseAccount creates a new BaseAccount object
@@ -160,7 +163,9 @@ func (acc BaseAccount) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(acc.PubKey, &pubKey)
 }
 
-// NewModuleAddress creates an AccAddress from the hash of the module's name
+// NewModuleAddress creates an AccAddress from the hash of the module's name.
+// It uses the legacy truncated hash, and is kept for the existing module
+// accounts; see NewDerivedModuleAddress for ADR-028 module addresses.
 func NewModuleAddress(name string) sdk.AccAddress {
 	return sdk.AccAddress(crypto.AddressHash([]byte(name)))
 }
@@ -299,6 +304,128 @@ func (ma *ModuleAccount) UnmarshalJSON(bz []byte) error {
 	return nil
 }
 
+// NewDerivedModuleAddress creates an AccAddress derived from a module name and a
+// derivation key, as described in ADR-028.
+func NewDerivedModuleAddress(moduleName string, derivationKey []byte) sdk.AccAddress {
+	return sdk.AccAddress(address.Module(moduleName, derivationKey))
+}
+
+// NewEmptyDerivedModuleAccount creates an empty DerivedModuleAccount for the
+// given module name and derivation key.
+func NewEmptyDerivedModuleAccount(moduleName string, derivationKey []byte) *DerivedModuleAccount {
+	baseAcc := NewBaseAccountWithAddress(NewDerivedModuleAddress(moduleName, derivationKey))
+
+	return NewDerivedModuleAccount(baseAcc, moduleName, derivationKey)
+}
+
+// NewDerivedModuleAccount creates a new DerivedModuleAccount instance
+func NewDerivedModuleAccount(ba *BaseAccount, moduleName string, derivationKey []byte) *DerivedModuleAccount {
+	return &DerivedModuleAccount{
+		BaseAccount:   ba,
+		Module:        moduleName,
+		DerivationKey: derivationKey,
+	}
+}
+
+// GetModuleName returns the name of the module owning the account
+func (dma DerivedModuleAccount) GetModuleName() string {
+	return dma.Module
+}
+
+// GetDerivationKey returns the key the account address is derived from
+func (dma DerivedModuleAccount) GetDerivationKey() []byte {
+	return dma.DerivationKey
+}
+
+// SetPubKey - Implements AccountI
+func (dma DerivedModuleAccount) SetPubKey(pubKey cryptotypes.PubKey) error {
+	return fmt.Errorf("not supported for derived module accounts")
+}
+
+// Validate checks for errors on the account fields
+func (dma DerivedModuleAccount) Validate() error {
+	if strings.TrimSpace(dma.Module) == "" {
+		return errors.New("derived module account module name cannot be blank")
+	}
+
+	if len(dma.DerivationKey) == 0 {
+		return errors.New("derived module account derivation key cannot be empty")
+	}
+
+	if dma.Address != NewDerivedModuleAddress(dma.Module, dma.DerivationKey).String() {
+		return fmt.Errorf("address %s cannot be derived from the module name '%s' and derivation key %X", dma.Address, dma.Module, dma.DerivationKey)
+	}
+
+	return dma.BaseAccount.Validate()
+}
+
+type derivedModuleAccountPretty struct {
+	Address       sdk.AccAddress `json:"address" yaml:"address"`
+	PubKey        string         `json:"public_key" yaml:"public_key"`
+	AccountNumber uint64         `json:"account_number" yaml:"account_number"`
+	Sequence      uint64         `json:"sequence" yaml:"sequence"`
+	Module        string         `json:"module" yaml:"module"`
+	DerivationKey []byte         `json:"derivation_key" yaml:"derivation_key"`
+}
+
+func (dma DerivedModuleAccount) String() string {
+	out, _ := dma.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a DerivedModuleAccount.
+func (dma DerivedModuleAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(dma.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	bs, err := yaml.Marshal(derivedModuleAccountPretty{
+		Address:       accAddr,
+		PubKey:        "",
+		AccountNumber: dma.AccountNumber,
+		Sequence:      dma.Sequence,
+		Module:        dma.Module,
+		DerivationKey: dma.DerivationKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bs), nil
+}
+
+// MarshalJSON returns the JSON representation of a DerivedModuleAccount.
+func (dma DerivedModuleAccount) MarshalJSON() ([]byte, error) {
+	accAddr, err := sdk.AccAddressFromBech32(dma.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(derivedModuleAccountPretty{
+		Address:       accAddr,
+		PubKey:        "",
+		AccountNumber: dma.AccountNumber,
+		Sequence:      dma.Sequence,
+		Module:        dma.Module,
+		DerivationKey: dma.DerivationKey,
+	})
+}
+
+// UnmarshalJSON unmarshals raw JSON bytes into a DerivedModuleAccount.
+func (dma *DerivedModuleAccount) UnmarshalJSON(bz []byte) error {
+	var alias derivedModuleAccountPretty
+	if err := json.Unmarshal(bz, &alias); err != nil {
+		return err
+	}
+
+	dma.BaseAccount = NewBaseAccount(alias.Address, nil, alias.AccountNumber, alias.Sequence)
+	dma.Module = alias.Module
+	dma.DerivationKey = alias.DerivationKey
+
+	return nil
+}
+
 // AccountI is an interface used to store coins at a given address within state.
 // It presumes a notion of sequence numbers for replay protection,
 // a notion of account numbers for replay protection for previously pruned accounts,
@@ -334,6 +461,15 @@ type ModuleAccountI interface {
 	HasPermission(string) bool
 }
 
+// DerivedModuleAccountI defines an account interface for the accounts a module
+// derives from its name and a derivation key, as described in ADR-028.
+type DerivedModuleAccountI interface {
+	AccountI
+
+	GetModuleName() string
+	GetDerivationKey() []byte
+}
+
 // GenesisAccounts defines a slice of GenesisAccount objects
 type GenesisAccounts []GenesisAccount
 
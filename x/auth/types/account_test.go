@@ -196,6 +196,70 @@ func TestModuleAccountJSON(t *testing.T) {
 	require.Equal(t, acc.String(), a.String())
 }
 
+func TestDerivedModuleAccountValidate(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	baseAcc := types.NewBaseAccount(addr, nil, 0, 0)
+	tests := []struct {
+		name   string
+		acc    types.GenesisAccount
+		expErr error
+	}{
+		{
+			"valid derived module account",
+			types.NewEmptyDerivedModuleAccount("test", []byte{1}),
+			nil,
+		},
+		{
+			"invalid module, key and address",
+			types.NewDerivedModuleAccount(baseAcc, "test", []byte{1}),
+			fmt.Errorf("address %s cannot be derived from the module name 'test' and derivation key 01", addr),
+		},
+		{
+			"empty module name",
+			types.NewDerivedModuleAccount(baseAcc, "    ", []byte{1}),
+			errors.New("derived module account module name cannot be blank"),
+		},
+		{
+			"empty derivation key",
+			types.NewDerivedModuleAccount(baseAcc, "test", nil),
+			errors.New("derived module account derivation key cannot be empty"),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.acc.Validate()
+			require.Equal(t, tt.expErr, err)
+		})
+	}
+}
+
+func TestDerivedModuleAddress(t *testing.T) {
+	addr := types.NewDerivedModuleAddress("test", []byte{1})
+	require.Len(t, addr, 32)
+	require.Equal(t, addr, types.NewDerivedModuleAddress("test", []byte{1}))
+	require.NotEqual(t, addr, types.NewDerivedModuleAddress("test", []byte{2}))
+	require.NotEqual(t, addr, types.NewDerivedModuleAddress("test2", []byte{1}))
+	require.NotEqual(t, types.NewModuleAddress("test"), types.NewDerivedModuleAddress("test", nil))
+}
+
+func TestDerivedModuleAccountJSON(t *testing.T) {
+	acc := types.NewEmptyDerivedModuleAccount("test", []byte{1, 2})
+	require.NoError(t, acc.SetAccountNumber(10))
+
+	bz, err := json.Marshal(acc)
+	require.NoError(t, err)
+
+	bz1, err := acc.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, string(bz1), string(bz))
+
+	var a types.DerivedModuleAccount
+	require.NoError(t, json.Unmarshal(bz, &a))
+	require.Equal(t, acc.String(), a.String())
+	require.NoError(t, a.Validate())
+}
+
 func TestGenesisAccountsContains(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -106,6 +106,50 @@ func (m *ModuleAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleAccount proto.InternalMessageInfo
 
+// DerivedModuleAccount defines an account owned by a module, whose address is
+// derived from the module name and a derivation key as described in ADR-028.
+// A module can own any number of derived accounts, e.g. to escrow funds on
+// behalf of its users.
+type DerivedModuleAccount struct {
+	*BaseAccount `protobuf:"bytes,1,opt,name=base_account,json=baseAccount,proto3,embedded=base_account" json:"base_account,omitempty" yaml:"base_account"`
+	// module is the name of the module owning the account.
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// derivation_key is the key the account address is derived from.
+	DerivationKey []byte `protobuf:"bytes,3,opt,name=derivation_key,json=derivationKey,proto3" json:"derivation_key,omitempty" yaml:"derivation_key"`
+}
+
+func (m *DerivedModuleAccount) Reset()      { *m = DerivedModuleAccount{} }
+func (*DerivedModuleAccount) ProtoMessage() {}
+func (*DerivedModuleAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{2}
+}
+func (m *DerivedModuleAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DerivedModuleAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DerivedModuleAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DerivedModuleAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedModuleAccount.Merge(m, src)
+}
+func (m *DerivedModuleAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *DerivedModuleAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedModuleAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivedModuleAccount proto.InternalMessageInfo
+
 // Params defines the parameters for the auth module.
 type Params struct {
 	MaxMemoCharacters      uint64 `protobuf:"varint,1,opt,name=max_memo_characters,json=maxMemoCharacters,proto3" json:"max_memo_characters,omitempty" yaml:"max_memo_characters"`
@@ -113,12 +157,15 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
+	// kv_gas_config defines the gas costs of the operations on the KV stores
+	// during the execution of the transactions.
+	KVGasConfig KVGasConfig `protobuf:"bytes,6,opt,name=kv_gas_config,json=kvGasConfig,proto3" json:"kv_gas_config" yaml:"kv_gas_config"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7e1f7e915d020d2d, []int{2}
+	return fileDescriptor_7e1f7e915d020d2d, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -182,59 +229,213 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetKVGasConfig() KVGasConfig {
+	if m != nil {
+		return m.KVGasConfig
+	}
+	return KVGasConfig{}
+}
+
+// KVGasConfig defines the gas costs of the operations on the KV stores.
+type KVGasConfig struct {
+	HasCost          uint64 `protobuf:"varint,1,opt,name=has_cost,json=hasCost,proto3" json:"has_cost,omitempty" yaml:"has_cost"`
+	DeleteCost       uint64 `protobuf:"varint,2,opt,name=delete_cost,json=deleteCost,proto3" json:"delete_cost,omitempty" yaml:"delete_cost"`
+	ReadCostFlat     uint64 `protobuf:"varint,3,opt,name=read_cost_flat,json=readCostFlat,proto3" json:"read_cost_flat,omitempty" yaml:"read_cost_flat"`
+	ReadCostPerByte  uint64 `protobuf:"varint,4,opt,name=read_cost_per_byte,json=readCostPerByte,proto3" json:"read_cost_per_byte,omitempty" yaml:"read_cost_per_byte"`
+	WriteCostFlat    uint64 `protobuf:"varint,5,opt,name=write_cost_flat,json=writeCostFlat,proto3" json:"write_cost_flat,omitempty" yaml:"write_cost_flat"`
+	WriteCostPerByte uint64 `protobuf:"varint,6,opt,name=write_cost_per_byte,json=writeCostPerByte,proto3" json:"write_cost_per_byte,omitempty" yaml:"write_cost_per_byte"`
+	IterNextCostFlat uint64 `protobuf:"varint,7,opt,name=iter_next_cost_flat,json=iterNextCostFlat,proto3" json:"iter_next_cost_flat,omitempty" yaml:"iter_next_cost_flat"`
+	// cache_scan_cost_per_key is charged for each pending write scanned when an
+	// iterator is created over a cache store.
+	CacheScanCostPerKey uint64 `protobuf:"varint,8,opt,name=cache_scan_cost_per_key,json=cacheScanCostPerKey,proto3" json:"cache_scan_cost_per_key,omitempty" yaml:"cache_scan_cost_per_key"`
+	// cache_sort_cost_per_key is charged for each pending write sorted when an
+	// iterator is created over a cache store.
+	CacheSortCostPerKey uint64 `protobuf:"varint,9,opt,name=cache_sort_cost_per_key,json=cacheSortCostPerKey,proto3" json:"cache_sort_cost_per_key,omitempty" yaml:"cache_sort_cost_per_key"`
+	// iter_skip_cost_flat is charged for each deleted or overwritten entry
+	// skipped by the iterators of a cache store.
+	IterSkipCostFlat uint64 `protobuf:"varint,10,opt,name=iter_skip_cost_flat,json=iterSkipCostFlat,proto3" json:"iter_skip_cost_flat,omitempty" yaml:"iter_skip_cost_flat"`
+}
+
+func (m *KVGasConfig) Reset()         { *m = KVGasConfig{} }
+func (m *KVGasConfig) String() string { return proto.CompactTextString(m) }
+func (*KVGasConfig) ProtoMessage()    {}
+func (*KVGasConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
+}
+func (m *KVGasConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KVGasConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KVGasConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KVGasConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KVGasConfig.Merge(m, src)
+}
+func (m *KVGasConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *KVGasConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_KVGasConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KVGasConfig proto.InternalMessageInfo
+
+func (m *KVGasConfig) GetHasCost() uint64 {
+	if m != nil {
+		return m.HasCost
+	}
+	return 0
+}
+
+func (m *KVGasConfig) GetDeleteCost() uint64 {
+	if m != nil {
+		return m.DeleteCost
+	}
+	return 0
+}
+
+func (m *KVGasConfig) GetReadCostFlat() uint64 {
+	if m != nil {
+		return m.ReadCostFlat
+	}
+	return 0
+}
+
+func (m *KVGasConfig) GetReadCostPerByte() uint64 {
+	if m != nil {
+		return m.ReadCostPerByte
+	}
+	return 0
+}
+
+func (m *KVGasConfig) GetWriteCostFlat() uint64 {
+	if m != nil {
+		return m.WriteCostFlat
+	}
+	return 0
+}
+
+func (m *KVGasConfig) GetWriteCostPerByte() uint64 {
+	if m != nil {
+		return m.WriteCostPerByte
+	}
+	return 0
+}
+
+func (m *KVGasConfig) GetIterNextCostFlat() uint64 {
+	if m != nil {
+		return m.IterNextCostFlat
+	}
+	return 0
+}
+
+func (m *KVGasConfig) GetCacheScanCostPerKey() uint64 {
+	if m != nil {
+		return m.CacheScanCostPerKey
+	}
+	return 0
+}
+
+func (m *KVGasConfig) GetCacheSortCostPerKey() uint64 {
+	if m != nil {
+		return m.CacheSortCostPerKey
+	}
+	return 0
+}
+
+func (m *KVGasConfig) GetIterSkipCostFlat() uint64 {
+	if m != nil {
+		return m.IterSkipCostFlat
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
+	proto.RegisterType((*DerivedModuleAccount)(nil), "cosmos.auth.v1beta1.DerivedModuleAccount")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*KVGasConfig)(nil), "cosmos.auth.v1beta1.KVGasConfig")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 674 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4d, 0x4f, 0xdb, 0x4a,
-	0x14, 0x8d, 0x5f, 0xf2, 0xf8, 0x98, 0x00, 0x12, 0x26, 0x80, 0x93, 0xf7, 0x64, 0x5b, 0x5e, 0xe5,
-	0x49, 0x2f, 0x8e, 0x92, 0x8a, 0x4a, 0x64, 0x51, 0x15, 0xd3, 0x2e, 0x50, 0x0b, 0x42, 0x46, 0xea,
-	0xa2, 0xaa, 0xe4, 0x8e, 0x9d, 0xc1, 0x58, 0x64, 0x32, 0xc6, 0x33, 0x46, 0x31, 0xbf, 0xa0, 0xcb,
-	0x2e, 0xbb, 0xe4, 0x47, 0xf0, 0x0f, 0xba, 0xe9, 0x12, 0xb1, 0xea, 0xca, 0xad, 0xc2, 0xa6, 0xea,
-	0x32, 0xfb, 0x4a, 0x95, 0x67, 0x9c, 0x90, 0xa0, 0x74, 0x95, 0xb9, 0xe7, 0x9c, 0x7b, 0xee, 0x9d,
-	0x7b, 0xe3, 0x01, 0xaa, 0x47, 0x28, 0x26, 0xb4, 0x09, 0x63, 0x76, 0xd6, 0xbc, 0x6c, 0xb9, 0x88,
-	0xc1, 0x16, 0x0f, 0xcc, 0x30, 0x22, 0x8c, 0xc8, 0x1b, 0x82, 0x37, 0x39, 0x94, 0xf3, 0xb5, 0xaa,
-	0x00, 0x1d, 0x2e, 0x69, 0xe6, 0x0a, 0x1e, 0xd4, 0x2a, 0x3e, 0xf1, 0x89, 0xc0, 0xb3, 0x53, 0x8e,
-	0x56, 0x7d, 0x42, 0xfc, 0x1e, 0x6a, 0xf2, 0xc8, 0x8d, 0x4f, 0x9b, 0xb0, 0x9f, 0x08, 0xca, 0xf8,
-	0x25, 0x81, 0xb2, 0x05, 0x29, 0xda, 0xf3, 0x3c, 0x12, 0xf7, 0x99, 0xac, 0x80, 0x45, 0xd8, 0xed,
-	0x46, 0x88, 0x52, 0x45, 0xd2, 0xa5, 0xfa, 0xb2, 0x3d, 0x0e, 0xe5, 0x77, 0x60, 0x31, 0x8c, 0x5d,
-	0xe7, 0x1c, 0x25, 0xca, 0x5f, 0xba, 0x54, 0x2f, 0xb7, 0x2b, 0xa6, 0xb0, 0x35, 0xc7, 0xb6, 0xe6,
-	0x5e, 0x3f, 0xb1, 0x1a, 0x3f, 0x53, 0xad, 0x12, 0xc6, 0x6e, 0x2f, 0xf0, 0x32, 0xed, 0xff, 0x04,
-	0x07, 0x0c, 0xe1, 0x90, 0x25, 0xa3, 0x54, 0x5b, 0x4f, 0x20, 0xee, 0x75, 0x8c, 0x07, 0xd6, 0xb0,
-	0x17, 0xc2, 0xd8, 0x7d, 0x85, 0x12, 0xf9, 0x39, 0x58, 0x83, 0xa2, 0x05, 0xa7, 0x1f, 0x63, 0x17,
-	0x45, 0x4a, 0x51, 0x97, 0xea, 0x25, 0xab, 0x3a, 0x4a, 0xb5, 0x4d, 0x91, 0x36, 0xcb, 0x1b, 0xf6,
-	0x6a, 0x0e, 0x1c, 0xf1, 0x58, 0xae, 0x81, 0x25, 0x8a, 0x2e, 0x62, 0xd4, 0xf7, 0x90, 0x52, 0xca,
-	0x72, 0xed, 0x49, 0xdc, 0x51, 0x3e, 0x5c, 0x6b, 0x85, 0x4f, 0xd7, 0x5a, 0xe1, 0xc7, 0xb5, 0x56,
-	0xb8, 0xbb, 0x69, 0x2c, 0xe5, 0xd7, 0x3d, 0x30, 0x3e, 0x4b, 0x60, 0xf5, 0x90, 0x74, 0xe3, 0xde,
-	0x64, 0x02, 0xef, 0xc1, 0x8a, 0x0b, 0x29, 0x72, 0x72, 0x77, 0x3e, 0x86, 0x72, 0x5b, 0x37, 0xe7,
-	0x6c, 0xc2, 0x9c, 0x9a, 0x9c, 0xf5, 0xcf, 0x6d, 0xaa, 0x49, 0xa3, 0x54, 0xdb, 0x10, 0xdd, 0x4e,
-	0x7b, 0x18, 0x76, 0xd9, 0x9d, 0x9a, 0xb1, 0x0c, 0x4a, 0x7d, 0x88, 0x11, 0x1f, 0xe3, 0xb2, 0xcd,
-	0xcf, 0xb2, 0x0e, 0xca, 0x21, 0x8a, 0x70, 0x40, 0x69, 0x40, 0xfa, 0x54, 0x29, 0xea, 0xc5, 0xfa,
-	0xb2, 0x3d, 0x0d, 0x75, 0x6a, 0xe3, 0x3b, 0xdc, 0xdd, 0x34, 0xd6, 0x66, 0x5a, 0x3e, 0x30, 0xbe,
-	0x15, 0xc1, 0xc2, 0x31, 0x8c, 0x20, 0xa6, 0xf2, 0x11, 0xd8, 0xc0, 0x70, 0xe0, 0x60, 0x84, 0x89,
-	0xe3, 0x9d, 0xc1, 0x08, 0x7a, 0x0c, 0x45, 0x62, 0x99, 0x25, 0x4b, 0x1d, 0xa5, 0x5a, 0x4d, 0xf4,
-	0x37, 0x47, 0x64, 0xd8, 0xeb, 0x18, 0x0e, 0x0e, 0x11, 0x26, 0xfb, 0x13, 0x4c, 0xde, 0x05, 0x2b,
-	0x6c, 0xe0, 0xd0, 0xc0, 0x77, 0x7a, 0x01, 0x0e, 0x18, 0x6f, 0xba, 0x64, 0x6d, 0x3f, 0x5c, 0x74,
-	0x9a, 0x35, 0x6c, 0xc0, 0x06, 0x27, 0x81, 0xff, 0x3a, 0x0b, 0x64, 0x1b, 0x6c, 0x72, 0xf2, 0x0a,
-	0x39, 0x1e, 0xa1, 0xcc, 0x09, 0x51, 0xe4, 0xb8, 0x09, 0x43, 0xf9, 0x6a, 0xf5, 0x51, 0xaa, 0xfd,
-	0x3b, 0xe5, 0xf1, 0x58, 0x66, 0xd8, 0xeb, 0x99, 0xd9, 0x15, 0xda, 0x27, 0x94, 0x1d, 0xa3, 0xc8,
-	0x4a, 0x18, 0x92, 0x2f, 0xc0, 0x76, 0x56, 0xed, 0x12, 0x45, 0xc1, 0x69, 0x22, 0xf4, 0xa8, 0xdb,
-	0xde, 0xd9, 0x69, 0xed, 0x8a, 0xa5, 0x5b, 0x9d, 0x61, 0xaa, 0x55, 0x4e, 0x02, 0xff, 0x0d, 0x57,
-	0x64, 0xa9, 0x2f, 0x5f, 0x70, 0x7e, 0x94, 0x6a, 0xaa, 0xa8, 0xf6, 0x07, 0x03, 0xc3, 0xae, 0xd0,
-	0x99, 0x3c, 0x01, 0xcb, 0x09, 0xa8, 0x3e, 0xce, 0xa0, 0xc8, 0x0b, 0xdb, 0x3b, 0x4f, 0xcf, 0x5b,
-	0xca, 0xdf, 0xbc, 0xe8, 0xb3, 0x61, 0xaa, 0x6d, 0xcd, 0x14, 0x3d, 0x19, 0x2b, 0x46, 0xa9, 0xa6,
-	0xcf, 0x2f, 0x3b, 0x31, 0x31, 0xec, 0x2d, 0x3a, 0x37, 0xb7, 0xb3, 0x94, 0xff, 0x67, 0x25, 0x6b,
-	0xff, 0xcb, 0x50, 0x95, 0x6e, 0x87, 0xaa, 0xf4, 0x7d, 0xa8, 0x4a, 0x1f, 0xef, 0xd5, 0xc2, 0xed,
-	0xbd, 0x5a, 0xf8, 0x7a, 0xaf, 0x16, 0xde, 0xfe, 0xe7, 0x07, 0xec, 0x2c, 0x76, 0x4d, 0x8f, 0xe0,
-	0xfc, 0x2d, 0xc8, 0x7f, 0x1a, 0xb4, 0x7b, 0xde, 0x1c, 0x88, 0xa7, 0x85, 0x25, 0x21, 0xa2, 0xee,
-	0x02, 0xff, 0x52, 0x9f, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x49, 0x90, 0x16, 0xd9, 0x76, 0x04,
-	0x00, 0x00,
+	// 1030 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0xe3, 0xc4,
+	0x1b, 0x8e, 0x7f, 0x9b, 0x4d, 0xdb, 0x49, 0xff, 0x6c, 0x9d, 0xb4, 0x4d, 0xf3, 0x83, 0x38, 0xcc,
+	0xa9, 0x08, 0x9a, 0xa8, 0x45, 0x05, 0x6d, 0x0e, 0xc0, 0xba, 0x0b, 0xa8, 0x94, 0x56, 0xab, 0xa9,
+	0xb4, 0x42, 0x08, 0xc9, 0x8c, 0x9d, 0x69, 0x62, 0x25, 0xf6, 0xb8, 0x9e, 0x49, 0x89, 0xf7, 0x13,
+	0x70, 0xe4, 0xc8, 0xb1, 0x1f, 0x62, 0xbf, 0x01, 0x97, 0x3d, 0x56, 0x3d, 0x71, 0xb2, 0x50, 0x7a,
+	0x41, 0x1c, 0x38, 0x58, 0xe2, 0x88, 0x84, 0x3c, 0x63, 0x3b, 0x4e, 0x48, 0x39, 0x72, 0x8a, 0xdf,
+	0xf7, 0x7d, 0xde, 0xe7, 0xfd, 0x33, 0x8f, 0x9d, 0x01, 0x0d, 0x8b, 0x32, 0x87, 0xb2, 0x36, 0x1e,
+	0xf1, 0x7e, 0xfb, 0xfa, 0xc0, 0x24, 0x1c, 0x1f, 0x08, 0xa3, 0xe5, 0xf9, 0x94, 0x53, 0xb5, 0x22,
+	0xe3, 0x2d, 0xe1, 0x4a, 0xe2, 0xf5, 0x5d, 0xe9, 0x34, 0x04, 0xa4, 0x9d, 0x20, 0x84, 0x51, 0xaf,
+	0xf6, 0x68, 0x8f, 0x4a, 0x7f, 0xfc, 0x94, 0x78, 0x77, 0x7b, 0x94, 0xf6, 0x86, 0xa4, 0x2d, 0x2c,
+	0x73, 0x74, 0xd9, 0xc6, 0x6e, 0x20, 0x43, 0xf0, 0x2f, 0x05, 0x94, 0x75, 0xcc, 0xc8, 0x33, 0xcb,
+	0xa2, 0x23, 0x97, 0xab, 0x35, 0xb0, 0x84, 0xbb, 0x5d, 0x9f, 0x30, 0x56, 0x53, 0x9a, 0xca, 0xde,
+	0x0a, 0x4a, 0x4d, 0xf5, 0x5b, 0xb0, 0xe4, 0x8d, 0x4c, 0x63, 0x40, 0x82, 0xda, 0xff, 0x9a, 0xca,
+	0x5e, 0xf9, 0xb0, 0xda, 0x92, 0xb4, 0xad, 0x94, 0xb6, 0xf5, 0xcc, 0x0d, 0xf4, 0xfd, 0xdf, 0x43,
+	0xad, 0xea, 0x8d, 0xcc, 0xa1, 0x6d, 0xc5, 0xd8, 0xf7, 0xa9, 0x63, 0x73, 0xe2, 0x78, 0x3c, 0x88,
+	0x42, 0x6d, 0x33, 0xc0, 0xce, 0xb0, 0x03, 0xa7, 0x51, 0x88, 0x4a, 0xde, 0xc8, 0x3c, 0x25, 0x81,
+	0xfa, 0x29, 0x58, 0xc7, 0xb2, 0x05, 0xc3, 0x1d, 0x39, 0x26, 0xf1, 0x6b, 0x8f, 0x9a, 0xca, 0x5e,
+	0x51, 0xdf, 0x8d, 0x42, 0x6d, 0x4b, 0xa6, 0xcd, 0xc6, 0x21, 0x5a, 0x4b, 0x1c, 0xe7, 0xc2, 0x56,
+	0xeb, 0x60, 0x99, 0x91, 0xab, 0x11, 0x71, 0x2d, 0x52, 0x2b, 0xc6, 0xb9, 0x28, 0xb3, 0x3b, 0xb5,
+	0x1f, 0x6e, 0xb4, 0xc2, 0x4f, 0x37, 0x5a, 0xe1, 0xb7, 0x1b, 0xad, 0x70, 0xf7, 0x7a, 0x7f, 0x39,
+	0x19, 0xf7, 0x04, 0xfe, 0xac, 0x80, 0xb5, 0x33, 0xda, 0x1d, 0x0d, 0xb3, 0x0d, 0x7c, 0x07, 0x56,
+	0x4d, 0xcc, 0x88, 0x91, 0xb0, 0x8b, 0x35, 0x94, 0x0f, 0x9b, 0xad, 0x05, 0x27, 0xd1, 0xca, 0x6d,
+	0x4e, 0xff, 0xff, 0x6d, 0xa8, 0x29, 0x51, 0xa8, 0x55, 0x64, 0xb7, 0x79, 0x0e, 0x88, 0xca, 0x66,
+	0x6e, 0xc7, 0x2a, 0x28, 0xba, 0xd8, 0x21, 0x62, 0x8d, 0x2b, 0x48, 0x3c, 0xab, 0x4d, 0x50, 0xf6,
+	0x88, 0xef, 0xd8, 0x8c, 0xd9, 0xd4, 0x65, 0xb5, 0x47, 0xcd, 0x47, 0x7b, 0x2b, 0x28, 0xef, 0xea,
+	0xd4, 0xd3, 0x19, 0xee, 0x5e, 0xef, 0xaf, 0xcf, 0xb4, 0x7c, 0x02, 0xff, 0x54, 0x40, 0xf5, 0x39,
+	0xf1, 0xed, 0x6b, 0xd2, 0xfd, 0xaf, 0x87, 0xd9, 0x06, 0x25, 0x47, 0x94, 0x4c, 0xc6, 0x49, 0xac,
+	0xf8, 0x40, 0xbb, 0x71, 0x47, 0x98, 0xdb, 0xd4, 0x15, 0xaa, 0x89, 0x0f, 0x74, 0x35, 0x7f, 0xa0,
+	0xb3, 0x71, 0x88, 0xd6, 0xa6, 0x8e, 0x53, 0x12, 0x74, 0xde, 0xc9, 0x0d, 0xbc, 0xb5, 0x68, 0xba,
+	0x13, 0x78, 0x57, 0x04, 0xa5, 0x17, 0xd8, 0xc7, 0x0e, 0x53, 0xcf, 0x41, 0xc5, 0xc1, 0x63, 0xc3,
+	0x21, 0x0e, 0x35, 0xac, 0x3e, 0xf6, 0xb1, 0xc5, 0x89, 0x2f, 0x45, 0x5c, 0xd4, 0x1b, 0x51, 0xa8,
+	0xd5, 0x65, 0xd1, 0x05, 0x20, 0x88, 0x36, 0x1d, 0x3c, 0x3e, 0x23, 0x0e, 0x3d, 0xce, 0x7c, 0xea,
+	0x53, 0xb0, 0xca, 0xc7, 0x06, 0xb3, 0x7b, 0xc6, 0xd0, 0x76, 0x6c, 0x2e, 0xa6, 0x2b, 0xea, 0x3b,
+	0xd3, 0x9d, 0xe4, 0xa3, 0x10, 0x01, 0x3e, 0xbe, 0xb0, 0x7b, 0x5f, 0xc5, 0x86, 0x8a, 0xc0, 0x96,
+	0x08, 0xbe, 0x22, 0x86, 0x45, 0x19, 0x37, 0x3c, 0xe2, 0x1b, 0x66, 0xc0, 0x49, 0x22, 0xe9, 0x66,
+	0x14, 0x6a, 0x6f, 0xe5, 0x38, 0xe6, 0x61, 0x10, 0x6d, 0xc6, 0x64, 0xaf, 0xc8, 0x31, 0x65, 0xfc,
+	0x05, 0xf1, 0xf5, 0x80, 0x13, 0xf5, 0x0a, 0xec, 0xc4, 0xd5, 0xae, 0x89, 0x6f, 0x5f, 0x06, 0x12,
+	0x4f, 0xba, 0x87, 0x47, 0x47, 0x07, 0x4f, 0xa5, 0xd8, 0xf5, 0xce, 0x24, 0xd4, 0xaa, 0x17, 0x76,
+	0xef, 0xa5, 0x40, 0xc4, 0xa9, 0x9f, 0x3d, 0x17, 0xf1, 0x28, 0xd4, 0x1a, 0xb2, 0xda, 0x03, 0x04,
+	0x10, 0x55, 0xd9, 0x4c, 0x9e, 0x74, 0xab, 0x01, 0xd8, 0x9d, 0xcf, 0x60, 0xc4, 0xf2, 0x0e, 0x8f,
+	0x3e, 0x1c, 0x1c, 0xd4, 0x1e, 0x8b, 0xa2, 0x1f, 0x4f, 0x42, 0x6d, 0x7b, 0xa6, 0xe8, 0x45, 0x8a,
+	0x88, 0x42, 0xad, 0xb9, 0xb8, 0x6c, 0x46, 0x02, 0xd1, 0x36, 0x5b, 0x98, 0xab, 0x5e, 0x81, 0xb5,
+	0xc1, 0xb5, 0xd1, 0xc3, 0xcc, 0xb0, 0xa8, 0x7b, 0x69, 0xf7, 0x6a, 0xa5, 0x7f, 0xd1, 0xed, 0xe9,
+	0xcb, 0x2f, 0x30, 0x3b, 0x16, 0x38, 0xfd, 0xbd, 0x37, 0xa1, 0x56, 0x98, 0x84, 0x5a, 0x39, 0xe7,
+	0x8c, 0x42, 0xad, 0x2a, 0x3b, 0x99, 0xe1, 0x84, 0xa8, 0x3c, 0xb8, 0xce, 0x40, 0x9d, 0xe5, 0xe4,
+	0xf3, 0xa0, 0xc0, 0x3f, 0x1e, 0x83, 0x7c, 0xba, 0xda, 0x02, 0xcb, 0x7d, 0x91, 0xc5, 0x78, 0x22,
+	0xa7, 0x4a, 0x14, 0x6a, 0x1b, 0x92, 0x32, 0x8d, 0x40, 0xb4, 0xd4, 0x8f, 0x33, 0x18, 0x57, 0x3f,
+	0x02, 0xe5, 0x2e, 0x19, 0x12, 0x2e, 0x8f, 0x35, 0x11, 0xce, 0x76, 0x14, 0x6a, 0x6a, 0x2a, 0xfb,
+	0x2c, 0x08, 0x11, 0x90, 0x96, 0x48, 0xfc, 0x04, 0xac, 0xfb, 0x04, 0x77, 0xe5, 0x96, 0x2e, 0x87,
+	0x98, 0xff, 0xf3, 0x1b, 0x38, 0x1b, 0x87, 0x68, 0x35, 0x76, 0xc4, 0xc9, 0x9f, 0x0f, 0x31, 0x57,
+	0xbf, 0x04, 0xea, 0x14, 0x90, 0xa9, 0x4e, 0xea, 0xe3, 0xed, 0x28, 0xd4, 0x76, 0xe7, 0x49, 0xa6,
+	0x92, 0xdb, 0x48, 0x89, 0x52, 0xc1, 0xe9, 0x60, 0xe3, 0x7b, 0xdf, 0xe6, 0x64, 0x5a, 0x2d, 0x39,
+	0xf3, 0x7a, 0x14, 0x6a, 0xdb, 0x92, 0x68, 0x0e, 0x00, 0xd1, 0x9a, 0xf0, 0x64, 0xfd, 0x9c, 0x81,
+	0x4a, 0x0e, 0x92, 0x35, 0x54, 0x9a, 0x7f, 0x27, 0x17, 0x80, 0x20, 0x7a, 0x92, 0x71, 0xa5, 0x2d,
+	0x9d, 0x81, 0x8a, 0xcd, 0x89, 0x6f, 0xb8, 0x64, 0xcc, 0x73, 0x6d, 0x2d, 0xcd, 0xd3, 0x2d, 0x00,
+	0x41, 0xf4, 0x24, 0xf6, 0x9e, 0x93, 0x31, 0xcf, 0xba, 0xfb, 0x1a, 0xec, 0x58, 0xd8, 0xea, 0x13,
+	0x83, 0x59, 0xd8, 0x9d, 0x56, 0x8f, 0x3f, 0x55, 0xcb, 0x82, 0x12, 0x4e, 0x5f, 0x9d, 0x07, 0x80,
+	0x10, 0x55, 0x44, 0xe4, 0xc2, 0xc2, 0x6e, 0xd2, 0x69, 0xfc, 0x67, 0x36, 0x65, 0xa6, 0x3e, 0x9f,
+	0x65, 0x5e, 0x79, 0x80, 0x79, 0x1e, 0x98, 0x31, 0x53, 0x9f, 0xe7, 0x98, 0xd3, 0x15, 0xb0, 0x81,
+	0xed, 0xe5, 0x56, 0x00, 0x16, 0xae, 0x60, 0x16, 0x94, 0xac, 0xe0, 0x62, 0x60, 0x7b, 0xe9, 0x0a,
+	0x3a, 0xc5, 0x58, 0xf0, 0xfa, 0xf1, 0x9b, 0x49, 0x43, 0xb9, 0x9d, 0x34, 0x94, 0x5f, 0x27, 0x0d,
+	0xe5, 0xc7, 0xfb, 0x46, 0xe1, 0xf6, 0xbe, 0x51, 0xf8, 0xe5, 0xbe, 0x51, 0xf8, 0xe6, 0xdd, 0x9e,
+	0xcd, 0xfb, 0x23, 0xb3, 0x65, 0x51, 0x27, 0xb9, 0x67, 0x24, 0x3f, 0xfb, 0xac, 0x3b, 0x68, 0x8f,
+	0xe5, 0xb5, 0x85, 0x07, 0x1e, 0x61, 0x66, 0x49, 0xdc, 0x02, 0x3e, 0xf8, 0x7b, 0x00, 0x04, 0x9e,
+	0x8d, 0x3c, 0xd2, 0x08, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -271,6 +472,60 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if !this.KVGasConfig.Equal(&that1.KVGasConfig) {
+		return false
+	}
+	return true
+}
+func (this *KVGasConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*KVGasConfig)
+	if !ok {
+		that2, ok := that.(KVGasConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HasCost != that1.HasCost {
+		return false
+	}
+	if this.DeleteCost != that1.DeleteCost {
+		return false
+	}
+	if this.ReadCostFlat != that1.ReadCostFlat {
+		return false
+	}
+	if this.ReadCostPerByte != that1.ReadCostPerByte {
+		return false
+	}
+	if this.WriteCostFlat != that1.WriteCostFlat {
+		return false
+	}
+	if this.WriteCostPerByte != that1.WriteCostPerByte {
+		return false
+	}
+	if this.IterNextCostFlat != that1.IterNextCostFlat {
+		return false
+	}
+	if this.CacheScanCostPerKey != that1.CacheScanCostPerKey {
+		return false
+	}
+	if this.CacheSortCostPerKey != that1.CacheSortCostPerKey {
+		return false
+	}
+	if this.IterSkipCostFlat != that1.IterSkipCostFlat {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -376,6 +631,55 @@ func (m *ModuleAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DerivedModuleAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivedModuleAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivedModuleAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DerivationKey) > 0 {
+		i -= len(m.DerivationKey)
+		copy(dAtA[i:], m.DerivationKey)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.DerivationKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseAccount != nil {
+		{
+			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -396,6 +700,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.KVGasConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -424,6 +738,79 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KVGasConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KVGasConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KVGasConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IterSkipCostFlat != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.IterSkipCostFlat))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CacheSortCostPerKey != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.CacheSortCostPerKey))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CacheScanCostPerKey != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.CacheScanCostPerKey))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.IterNextCostFlat != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.IterNextCostFlat))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.WriteCostPerByte != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.WriteCostPerByte))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WriteCostFlat != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.WriteCostFlat))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReadCostPerByte != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.ReadCostPerByte))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadCostFlat != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.ReadCostFlat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DeleteCost != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.DeleteCost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HasCost != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.HasCost))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -481,6 +868,27 @@ func (m *ModuleAccount) Size() (n int) {
 	return n
 }
 
+func (m *DerivedModuleAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.DerivationKey)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -502,23 +910,64 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	l = m.KVGasConfig.Size()
+	n += 1 + l + sovAuth(uint64(l))
 	return n
 }
 
-func sovAuth(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAuth(x uint64) (n int) {
-	return sovAuth(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *BaseAccount) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+func (m *KVGasConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasCost != 0 {
+		n += 1 + sovAuth(uint64(m.HasCost))
+	}
+	if m.DeleteCost != 0 {
+		n += 1 + sovAuth(uint64(m.DeleteCost))
+	}
+	if m.ReadCostFlat != 0 {
+		n += 1 + sovAuth(uint64(m.ReadCostFlat))
+	}
+	if m.ReadCostPerByte != 0 {
+		n += 1 + sovAuth(uint64(m.ReadCostPerByte))
+	}
+	if m.WriteCostFlat != 0 {
+		n += 1 + sovAuth(uint64(m.WriteCostFlat))
+	}
+	if m.WriteCostPerByte != 0 {
+		n += 1 + sovAuth(uint64(m.WriteCostPerByte))
+	}
+	if m.IterNextCostFlat != 0 {
+		n += 1 + sovAuth(uint64(m.IterNextCostFlat))
+	}
+	if m.CacheScanCostPerKey != 0 {
+		n += 1 + sovAuth(uint64(m.CacheScanCostPerKey))
+	}
+	if m.CacheSortCostPerKey != 0 {
+		n += 1 + sovAuth(uint64(m.CacheSortCostPerKey))
+	}
+	if m.IterSkipCostFlat != 0 {
+		n += 1 + sovAuth(uint64(m.IterSkipCostFlat))
+	}
+	return n
+}
+
+func sovAuth(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuth(x uint64) (n int) {
+	return sovAuth(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BaseAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowAuth
 			}
 			if iNdEx >= l {
@@ -817,6 +1266,158 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DerivedModuleAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivedModuleAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivedModuleAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivationKey = append(m.DerivationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.DerivationKey == nil {
+				m.DerivationKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -941,6 +1542,279 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KVGasConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KVGasConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KVGasConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KVGasConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KVGasConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCost", wireType)
+			}
+			m.HasCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HasCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteCost", wireType)
+			}
+			m.DeleteCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadCostFlat", wireType)
+			}
+			m.ReadCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadCostPerByte", wireType)
+			}
+			m.ReadCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteCostFlat", wireType)
+			}
+			m.WriteCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteCostPerByte", wireType)
+			}
+			m.WriteCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IterNextCostFlat", wireType)
+			}
+			m.IterNextCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IterNextCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheScanCostPerKey", wireType)
+			}
+			m.CacheScanCostPerKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CacheScanCostPerKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheSortCostPerKey", wireType)
+			}
+			m.CacheSortCostPerKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CacheSortCostPerKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IterSkipCostFlat", wireType)
+			}
+			m.IterSkipCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IterSkipCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
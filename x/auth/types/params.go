@@ -5,6 +5,7 @@ import (
 
 	yaml "gopkg.in/yaml.v2"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -24,11 +25,12 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyKVGasConfig            = []byte("KVGasConfig")
 )
 
 var _ paramtypes.ParamSet = &Params{}
 
-// NewParams creates a new Params object
+// NewParams creates a new Params object with the default KV gas config.
 func NewParams(
 	maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64,
 ) Params {
@@ -38,6 +40,7 @@ func NewParams(
 		TxSizeCostPerByte:      txSizeCostPerByte,
 		SigVerifyCostED25519:   sigVerifyCostED25519,
 		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		KVGasConfig:            DefaultKVGasConfig(),
 	}
 }
 
@@ -55,6 +58,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyTxSizeCostPerByte, &p.TxSizeCostPerByte, validateTxSizeCostPerByte),
 		paramtypes.NewParamSetPair(KeySigVerifyCostED25519, &p.SigVerifyCostED25519, validateSigVerifyCostED25519),
 		paramtypes.NewParamSetPair(KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1, validateSigVerifyCostSecp256k1),
+		paramtypes.NewParamSetPair(KeyKVGasConfig, &p.KVGasConfig, validateKVGasConfig),
 	}
 }
 
@@ -66,6 +70,44 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		KVGasConfig:            DefaultKVGasConfig(),
+	}
+}
+
+// DefaultKVGasConfig returns the default gas config of the KV stores.
+func DefaultKVGasConfig() KVGasConfig {
+	return NewKVGasConfig(storetypes.KVGasConfig())
+}
+
+// NewKVGasConfig creates a new KVGasConfig object from a store gas config.
+func NewKVGasConfig(config storetypes.GasConfig) KVGasConfig {
+	return KVGasConfig{
+		HasCost:             config.HasCost,
+		DeleteCost:          config.DeleteCost,
+		ReadCostFlat:        config.ReadCostFlat,
+		ReadCostPerByte:     config.ReadCostPerByte,
+		WriteCostFlat:       config.WriteCostFlat,
+		WriteCostPerByte:    config.WriteCostPerByte,
+		IterNextCostFlat:    config.IterNextCostFlat,
+		CacheScanCostPerKey: config.CacheScanCostPerKey,
+		CacheSortCostPerKey: config.CacheSortCostPerKey,
+		IterSkipCostFlat:    config.IterSkipCostFlat,
+	}
+}
+
+// GasConfig returns the store gas config.
+func (c KVGasConfig) GasConfig() storetypes.GasConfig {
+	return storetypes.GasConfig{
+		HasCost:             c.HasCost,
+		DeleteCost:          c.DeleteCost,
+		ReadCostFlat:        c.ReadCostFlat,
+		ReadCostPerByte:     c.ReadCostPerByte,
+		WriteCostFlat:       c.WriteCostFlat,
+		WriteCostPerByte:    c.WriteCostPerByte,
+		IterNextCostFlat:    c.IterNextCostFlat,
+		CacheScanCostPerKey: c.CacheScanCostPerKey,
+		CacheSortCostPerKey: c.CacheSortCostPerKey,
+		IterSkipCostFlat:    c.IterSkipCostFlat,
 	}
 }
 
@@ -152,6 +194,21 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+func validateKVGasConfig(i interface{}) error {
+	v, ok := i.(KVGasConfig)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// the per byte and per key costs may be zero, but not the flat costs of
+	// the operations
+	if v.HasCost == 0 || v.DeleteCost == 0 || v.ReadCostFlat == 0 || v.WriteCostFlat == 0 || v.IterNextCostFlat == 0 {
+		return fmt.Errorf("invalid KV gas config, the flat costs must be positive: %+v", v)
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -169,6 +226,9 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := validateKVGasConfig(p.KVGasConfig); err != nil {
+		return err
+	}
 
 	return nil
 }
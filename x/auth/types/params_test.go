@@ -19,6 +19,13 @@ func TestParamsEqual(t *testing.T) {
 }
 
 func TestParams_Validate(t *testing.T) {
+	zeroWriteCost := types.DefaultParams()
+	zeroWriteCost.KVGasConfig.WriteCostFlat = 0
+	zeroCacheCosts := types.DefaultParams()
+	zeroCacheCosts.KVGasConfig.CacheScanCostPerKey = 0
+	zeroCacheCosts.KVGasConfig.CacheSortCostPerKey = 0
+	zeroCacheCosts.KVGasConfig.IterSkipCostFlat = 0
+
 	tests := []struct {
 		name    string
 		params  types.Params
@@ -35,6 +42,8 @@ func TestParams_Validate(t *testing.T) {
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx size cost per byte: 0")},
+		{"invalid kv gas config", zeroWriteCost, fmt.Errorf("invalid KV gas config, the flat costs must be positive: %+v", zeroWriteCost.KVGasConfig)},
+		{"kv gas config without cache costs", zeroCacheCosts, nil},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -7,4 +7,12 @@ func (m *QueryAccountResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 	return unpacker.UnpackAny(m.Account, &account)
 }
 
-var _ codectypes.UnpackInterfacesMessage = &QueryAccountResponse{}
+func (m *QueryDerivedModuleAccountResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var account AccountI
+	return unpacker.UnpackAny(m.Account, &account)
+}
+
+var (
+	_ codectypes.UnpackInterfacesMessage = &QueryAccountResponse{}
+	_ codectypes.UnpackInterfacesMessage = &QueryDerivedModuleAccountResponse{}
+)
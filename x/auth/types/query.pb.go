@@ -395,6 +395,206 @@ func (m *QueryModuleAccountByNameResponse) GetAccount() *types.Any {
 	return nil
 }
 
+// QueryDerivedModuleAddressRequest is the request type for the Query/DerivedModuleAddress RPC method.
+type QueryDerivedModuleAddressRequest struct {
+	// module is the name of the module owning the account.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// derivation_key is the key the account address is derived from.
+	DerivationKey []byte `protobuf:"bytes,2,opt,name=derivation_key,json=derivationKey,proto3" json:"derivation_key,omitempty"`
+}
+
+func (m *QueryDerivedModuleAddressRequest) Reset()         { *m = QueryDerivedModuleAddressRequest{} }
+func (m *QueryDerivedModuleAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivedModuleAddressRequest) ProtoMessage()    {}
+func (*QueryDerivedModuleAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{8}
+}
+func (m *QueryDerivedModuleAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivedModuleAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivedModuleAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDerivedModuleAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivedModuleAddressRequest.Merge(m, src)
+}
+func (m *QueryDerivedModuleAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivedModuleAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivedModuleAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDerivedModuleAddressRequest proto.InternalMessageInfo
+
+func (m *QueryDerivedModuleAddressRequest) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *QueryDerivedModuleAddressRequest) GetDerivationKey() []byte {
+	if m != nil {
+		return m.DerivationKey
+	}
+	return nil
+}
+
+// QueryDerivedModuleAddressResponse is the response type for the Query/DerivedModuleAddress RPC method.
+type QueryDerivedModuleAddressResponse struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryDerivedModuleAddressResponse) Reset()         { *m = QueryDerivedModuleAddressResponse{} }
+func (m *QueryDerivedModuleAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivedModuleAddressResponse) ProtoMessage()    {}
+func (*QueryDerivedModuleAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{9}
+}
+func (m *QueryDerivedModuleAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivedModuleAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivedModuleAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDerivedModuleAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivedModuleAddressResponse.Merge(m, src)
+}
+func (m *QueryDerivedModuleAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivedModuleAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivedModuleAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDerivedModuleAddressResponse proto.InternalMessageInfo
+
+func (m *QueryDerivedModuleAddressResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryDerivedModuleAccountRequest is the request type for the Query/DerivedModuleAccount RPC method.
+type QueryDerivedModuleAccountRequest struct {
+	// module is the name of the module owning the account.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// derivation_key is the key the account address is derived from.
+	DerivationKey []byte `protobuf:"bytes,2,opt,name=derivation_key,json=derivationKey,proto3" json:"derivation_key,omitempty"`
+}
+
+func (m *QueryDerivedModuleAccountRequest) Reset()         { *m = QueryDerivedModuleAccountRequest{} }
+func (m *QueryDerivedModuleAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivedModuleAccountRequest) ProtoMessage()    {}
+func (*QueryDerivedModuleAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{10}
+}
+func (m *QueryDerivedModuleAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivedModuleAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivedModuleAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDerivedModuleAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivedModuleAccountRequest.Merge(m, src)
+}
+func (m *QueryDerivedModuleAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivedModuleAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivedModuleAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDerivedModuleAccountRequest proto.InternalMessageInfo
+
+func (m *QueryDerivedModuleAccountRequest) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *QueryDerivedModuleAccountRequest) GetDerivationKey() []byte {
+	if m != nil {
+		return m.DerivationKey
+	}
+	return nil
+}
+
+// QueryDerivedModuleAccountResponse is the response type for the Query/DerivedModuleAccount RPC method.
+type QueryDerivedModuleAccountResponse struct {
+	Account *types.Any `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *QueryDerivedModuleAccountResponse) Reset()         { *m = QueryDerivedModuleAccountResponse{} }
+func (m *QueryDerivedModuleAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivedModuleAccountResponse) ProtoMessage()    {}
+func (*QueryDerivedModuleAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{11}
+}
+func (m *QueryDerivedModuleAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivedModuleAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivedModuleAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDerivedModuleAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivedModuleAccountResponse.Merge(m, src)
+}
+func (m *QueryDerivedModuleAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivedModuleAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivedModuleAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDerivedModuleAccountResponse proto.InternalMessageInfo
+
+func (m *QueryDerivedModuleAccountResponse) GetAccount() *types.Any {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -404,52 +604,64 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.auth.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryModuleAccountByNameRequest)(nil), "cosmos.auth.v1beta1.QueryModuleAccountByNameRequest")
 	proto.RegisterType((*QueryModuleAccountByNameResponse)(nil), "cosmos.auth.v1beta1.QueryModuleAccountByNameResponse")
+	proto.RegisterType((*QueryDerivedModuleAddressRequest)(nil), "cosmos.auth.v1beta1.QueryDerivedModuleAddressRequest")
+	proto.RegisterType((*QueryDerivedModuleAddressResponse)(nil), "cosmos.auth.v1beta1.QueryDerivedModuleAddressResponse")
+	proto.RegisterType((*QueryDerivedModuleAccountRequest)(nil), "cosmos.auth.v1beta1.QueryDerivedModuleAccountRequest")
+	proto.RegisterType((*QueryDerivedModuleAccountResponse)(nil), "cosmos.auth.v1beta1.QueryDerivedModuleAccountResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 625 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0x77, 0xfb, 0xeb, 0x2f, 0x89, 0x53, 0xf1, 0x30, 0x89, 0x10, 0xb7, 0x76, 0x37, 0xac,
-	0x68, 0x92, 0x4a, 0x66, 0x69, 0x6a, 0x0f, 0x15, 0x11, 0x1a, 0x41, 0xf1, 0xa0, 0xc4, 0xe0, 0xc9,
-	0x83, 0x65, 0x92, 0x4c, 0xb7, 0xc1, 0xee, 0xce, 0x36, 0xb3, 0x2b, 0x06, 0x29, 0x88, 0xa7, 0xde,
-	0x14, 0x7c, 0x03, 0x79, 0x11, 0x05, 0xdf, 0x42, 0xe9, 0xa9, 0xe0, 0xc5, 0x93, 0x68, 0xe2, 0xc1,
-	0x97, 0x21, 0x99, 0x79, 0x36, 0x69, 0x64, 0x63, 0xe2, 0x69, 0xe7, 0xcf, 0xf3, 0x7d, 0xbe, 0x9f,
-	0xe7, 0x99, 0x67, 0x91, 0xd5, 0xe2, 0xc2, 0xe3, 0xc2, 0xa1, 0x51, 0xb8, 0xef, 0xbc, 0xde, 0x68,
-	0xb2, 0x90, 0x6e, 0x38, 0x87, 0x11, 0xeb, 0xf6, 0x48, 0xd0, 0xe5, 0x21, 0xc7, 0x59, 0x15, 0x40,
-	0x46, 0x01, 0x04, 0x02, 0x8c, 0x75, 0x50, 0x35, 0xa9, 0x60, 0x2a, 0x7a, 0xac, 0x0d, 0xa8, 0xdb,
-	0xf1, 0x69, 0xd8, 0xe1, 0xbe, 0x4a, 0x60, 0xe4, 0x5c, 0xee, 0x72, 0xb9, 0x74, 0x46, 0x2b, 0x38,
-	0xbd, 0xe6, 0x72, 0xee, 0x1e, 0x30, 0x47, 0xee, 0x9a, 0xd1, 0x9e, 0x43, 0x7d, 0x70, 0x34, 0xae,
-	0xc3, 0x15, 0x0d, 0x3a, 0x0e, 0xf5, 0x7d, 0x1e, 0xca, 0x6c, 0x02, 0x6e, 0xcd, 0x24, 0x60, 0x09,
-	0x07, 0x89, 0xd5, 0xfd, 0xae, 0x72, 0x04, 0x78, 0xb9, 0xb1, 0x5f, 0xa2, 0xdc, 0xb3, 0x11, 0xeb,
-	0x4e, 0xab, 0xc5, 0x23, 0x3f, 0x14, 0x0d, 0x76, 0x18, 0x31, 0x11, 0xe2, 0x87, 0x08, 0x4d, 0xa8,
-	0xf3, 0x7a, 0x41, 0x2f, 0xad, 0x54, 0x6f, 0x11, 0x90, 0x8e, 0x4a, 0x24, 0xaa, 0x21, 0xe0, 0x46,
-	0xea, 0xd4, 0x65, 0xa0, 0x6d, 0x5c, 0x50, 0xda, 0x7d, 0x1d, 0x5d, 0xfd, 0xc3, 0x40, 0x04, 0xdc,
-	0x17, 0x0c, 0xdf, 0x47, 0x19, 0x0a, 0x67, 0x79, 0xbd, 0xf0, 0x5f, 0x69, 0xa5, 0x9a, 0x23, 0xaa,
-	0x4a, 0x12, 0x37, 0x80, 0xec, 0xf8, 0xbd, 0xda, 0xe5, 0xb3, 0x93, 0x4a, 0x06, 0xd4, 0x8f, 0x1b,
-	0x63, 0x0d, 0x7e, 0x34, 0x45, 0xb8, 0x24, 0x09, 0x8b, 0x73, 0x09, 0x95, 0xf9, 0x14, 0xe2, 0x36,
-	0xca, 0x5e, 0x24, 0x8c, 0x3b, 0x90, 0x47, 0x69, 0xda, 0x6e, 0x77, 0x99, 0x10, 0xb2, 0xfc, 0x4b,
-	0x8d, 0x78, 0x7b, 0x37, 0x73, 0xdc, 0xb7, 0xb4, 0x5f, 0x7d, 0x4b, 0xb3, 0x9f, 0x4f, 0x77, 0x6f,
-	0x5c, 0xdb, 0x3d, 0x94, 0x06, 0x4e, 0x68, 0xdd, 0x22, 0xa5, 0xc5, 0x12, 0x3b, 0x87, 0xb0, 0xcc,
-	0x5a, 0xa7, 0x5d, 0xea, 0xc5, 0x2f, 0x62, 0xd7, 0x01, 0x33, 0x3e, 0x05, 0xab, 0x6d, 0x94, 0x0a,
-	0xe4, 0x09, 0x38, 0xad, 0x92, 0x84, 0xe1, 0x24, 0x4a, 0x54, 0x5b, 0x3e, 0xfd, 0x66, 0x69, 0x0d,
-	0x10, 0xd8, 0x5b, 0xc8, 0x92, 0x19, 0x9f, 0xf0, 0x76, 0x74, 0xc0, 0x80, 0xa3, 0xd6, 0x7b, 0x4a,
-	0xbd, 0xf8, 0x29, 0x31, 0x46, 0xcb, 0x3e, 0xf5, 0x18, 0x74, 0x40, 0xae, 0xed, 0x3d, 0x54, 0x98,
-	0x2d, 0x03, 0xaa, 0xda, 0x62, 0x0d, 0xc0, 0x67, 0x27, 0x95, 0x2b, 0x53, 0x79, 0x26, 0x6d, 0xa8,
-	0xfe, 0x58, 0x46, 0xff, 0x4b, 0x23, 0x7c, 0xac, 0xa3, 0xb8, 0x4d, 0x02, 0x97, 0x13, 0x0b, 0x4c,
-	0x1a, 0x62, 0x63, 0x7d, 0x91, 0x50, 0x45, 0x6c, 0xdf, 0x7c, 0xff, 0xe5, 0xe7, 0xa7, 0x25, 0x0b,
-	0xaf, 0x39, 0x89, 0x3f, 0x53, 0xec, 0xfe, 0x41, 0x47, 0x69, 0xd0, 0xe2, 0xd2, 0xdc, 0xf4, 0x31,
-	0x48, 0x79, 0x81, 0x48, 0xe0, 0x70, 0x24, 0x47, 0x19, 0x17, 0xff, 0xca, 0xe1, 0xbc, 0x85, 0x61,
-	0x3c, 0xc2, 0xef, 0x74, 0x94, 0x52, 0xcf, 0x8b, 0x8b, 0xb3, 0x6d, 0xa6, 0x66, 0xc9, 0x28, 0xcd,
-	0x0f, 0x04, 0x9c, 0x1b, 0x12, 0x67, 0x0d, 0xaf, 0x26, 0xe2, 0xa8, 0x41, 0xc2, 0x9f, 0x75, 0x94,
-	0x4d, 0x98, 0x06, 0x7c, 0x67, 0xb6, 0xcd, 0xec, 0x99, 0x33, 0xb6, 0xfe, 0x51, 0x05, 0xa4, 0x9b,
-	0x92, 0xb4, 0x82, 0x6f, 0x27, 0x92, 0x7a, 0x52, 0xb9, 0x3b, 0xe9, 0xdf, 0x68, 0x94, 0x8f, 0x6a,
-	0x0f, 0x4e, 0x07, 0xa6, 0x7e, 0x3e, 0x30, 0xf5, 0xef, 0x03, 0x53, 0xff, 0x38, 0x34, 0xb5, 0xf3,
-	0xa1, 0xa9, 0x7d, 0x1d, 0x9a, 0xda, 0x8b, 0xb2, 0xdb, 0x09, 0xf7, 0xa3, 0x26, 0x69, 0x71, 0x2f,
-	0x4e, 0xa8, 0x3e, 0x15, 0xd1, 0x7e, 0xe5, 0xbc, 0x51, 0xd9, 0xc3, 0x5e, 0xc0, 0x44, 0x33, 0x25,
-	0x67, 0x7a, 0xf3, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xde, 0x85, 0xd2, 0xba, 0x38, 0x06, 0x00,
-	0x00,
+	// 758 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0xbb, 0x88, 0x2d, 0x0e, 0xc8, 0x61, 0x5a, 0x0c, 0x2c, 0xb2, 0x85, 0x35, 0x48, 0x8b,
+	0xe9, 0x6e, 0x00, 0x21, 0xc1, 0x88, 0x86, 0xfa, 0x2b, 0xc4, 0x68, 0xb0, 0xf1, 0xe4, 0xc1, 0x66,
+	0xda, 0x1d, 0x96, 0x06, 0xba, 0x53, 0x3a, 0xbb, 0xc4, 0xc6, 0x90, 0x18, 0x4f, 0xdc, 0x34, 0xf1,
+	0x1f, 0xe0, 0x8f, 0x20, 0xf1, 0x5f, 0x20, 0xc4, 0x03, 0x89, 0x17, 0x4f, 0xc6, 0x80, 0x07, 0xff,
+	0x0b, 0x4d, 0x67, 0xde, 0x52, 0xd6, 0xec, 0x96, 0x25, 0x7a, 0x6a, 0x67, 0xf6, 0x7d, 0xdf, 0xf7,
+	0xf3, 0xde, 0xbc, 0x19, 0x94, 0xad, 0x32, 0x5e, 0x67, 0xdc, 0x24, 0x9e, 0xbb, 0x6e, 0x6e, 0xcf,
+	0x54, 0xa8, 0x4b, 0x66, 0xcc, 0x2d, 0x8f, 0x36, 0x5b, 0x46, 0xa3, 0xc9, 0x5c, 0x86, 0xd3, 0x32,
+	0xc0, 0x68, 0x07, 0x18, 0x10, 0xa0, 0x4e, 0x83, 0xaa, 0x42, 0x38, 0x95, 0xd1, 0xa7, 0xda, 0x06,
+	0xb1, 0x6b, 0x0e, 0x71, 0x6b, 0xcc, 0x91, 0x09, 0xd4, 0x8c, 0xcd, 0x6c, 0x26, 0xfe, 0x9a, 0xed,
+	0x7f, 0xb0, 0x3b, 0x62, 0x33, 0x66, 0x6f, 0x52, 0x53, 0xac, 0x2a, 0xde, 0x9a, 0x49, 0x1c, 0x70,
+	0x54, 0xaf, 0xc3, 0x27, 0xd2, 0xa8, 0x99, 0xc4, 0x71, 0x98, 0x2b, 0xb2, 0x71, 0xf8, 0xaa, 0x85,
+	0x01, 0x0b, 0x38, 0x48, 0x2c, 0xbf, 0x97, 0xa5, 0x23, 0xc0, 0x8b, 0x85, 0xfe, 0x1a, 0x65, 0x5e,
+	0xb4, 0x59, 0x97, 0xab, 0x55, 0xe6, 0x39, 0x2e, 0x2f, 0xd1, 0x2d, 0x8f, 0x72, 0x17, 0x3f, 0x46,
+	0xa8, 0x43, 0x3d, 0xac, 0x8c, 0x2b, 0xb9, 0xfe, 0xd9, 0x9b, 0x06, 0x48, 0xdb, 0x25, 0x1a, 0xb2,
+	0x21, 0xe0, 0x66, 0xac, 0x12, 0x9b, 0x82, 0xb6, 0x74, 0x46, 0xa9, 0xef, 0x29, 0x68, 0xe8, 0x2f,
+	0x03, 0xde, 0x60, 0x0e, 0xa7, 0xf8, 0x1e, 0xea, 0x23, 0xb0, 0x37, 0xac, 0x8c, 0x5f, 0xca, 0xf5,
+	0xcf, 0x66, 0x0c, 0x59, 0xa5, 0xe1, 0x37, 0xc0, 0x58, 0x76, 0x5a, 0xc5, 0x81, 0xc3, 0xfd, 0x42,
+	0x1f, 0xa8, 0x57, 0x4a, 0xa7, 0x1a, 0xfc, 0x24, 0x40, 0xd8, 0x23, 0x08, 0xa7, 0xce, 0x25, 0x94,
+	0xe6, 0x01, 0xc4, 0x45, 0x94, 0x3e, 0x4b, 0xe8, 0x77, 0x60, 0x18, 0xa5, 0x88, 0x65, 0x35, 0x29,
+	0xe7, 0xa2, 0xfc, 0x2b, 0x25, 0x7f, 0x79, 0xa7, 0x6f, 0x77, 0x2f, 0x9b, 0xf8, 0xb5, 0x97, 0x4d,
+	0xe8, 0x2f, 0x83, 0xdd, 0x3b, 0xad, 0xed, 0x2e, 0x4a, 0x01, 0x27, 0xb4, 0x2e, 0x4e, 0x69, 0xbe,
+	0x44, 0xcf, 0x20, 0x2c, 0xb2, 0xae, 0x92, 0x26, 0xa9, 0xfb, 0x27, 0xa2, 0xaf, 0xa2, 0x74, 0x60,
+	0x17, 0xac, 0x16, 0x51, 0xb2, 0x21, 0x76, 0xc0, 0x69, 0xd4, 0x08, 0x19, 0x4e, 0x43, 0x8a, 0x8a,
+	0xbd, 0x07, 0xdf, 0xb3, 0x89, 0x12, 0x08, 0xf4, 0x79, 0x94, 0x15, 0x19, 0x9f, 0x31, 0xcb, 0xdb,
+	0xa4, 0xc0, 0x51, 0x6c, 0x3d, 0x27, 0x75, 0xff, 0x28, 0x31, 0x46, 0xbd, 0x0e, 0xa9, 0x53, 0xe8,
+	0x80, 0xf8, 0xaf, 0xaf, 0xa1, 0xf1, 0x68, 0x19, 0x50, 0x15, 0xe3, 0x35, 0x00, 0x1f, 0xee, 0x17,
+	0x06, 0x03, 0x79, 0xce, 0xb4, 0x81, 0x80, 0xcf, 0x43, 0xda, 0xac, 0x6d, 0x53, 0x0b, 0xc2, 0xe4,
+	0x19, 0xf8, 0x7c, 0xd7, 0x50, 0xb2, 0x2e, 0xf6, 0x81, 0x10, 0x56, 0x78, 0x12, 0x0d, 0x5a, 0x6d,
+	0x99, 0x38, 0xe1, 0xf2, 0x06, 0x6d, 0x89, 0x01, 0x19, 0x28, 0x5d, 0xed, 0xec, 0x3e, 0xa5, 0x2d,
+	0x7d, 0x09, 0x4d, 0x74, 0xb1, 0x80, 0x5a, 0x22, 0x07, 0x21, 0x82, 0x30, 0x38, 0x46, 0xff, 0x48,
+	0xe8, 0xa0, 0x89, 0x2e, 0x16, 0x40, 0xb8, 0x12, 0xaf, 0xdb, 0x23, 0x87, 0xfb, 0x85, 0xa1, 0xb0,
+	0x44, 0x9d, 0xa6, 0xcf, 0xfe, 0x4e, 0xa1, 0xcb, 0xc2, 0x10, 0xef, 0x2a, 0xc8, 0x9f, 0x4d, 0x8e,
+	0xf3, 0xa1, 0x53, 0x15, 0xf6, 0x72, 0xa8, 0xd3, 0x71, 0x42, 0x25, 0xb8, 0x3e, 0xf9, 0xfe, 0xeb,
+	0xcf, 0x4f, 0x3d, 0x59, 0x3c, 0x66, 0x86, 0xbe, 0x60, 0xbe, 0xfb, 0x07, 0x05, 0xa5, 0x40, 0x8b,
+	0x73, 0xe7, 0xa6, 0xf7, 0x41, 0xf2, 0x31, 0x22, 0x81, 0xc3, 0x14, 0x1c, 0x79, 0x3c, 0xd5, 0x95,
+	0xc3, 0x7c, 0x0b, 0x07, 0xbf, 0x83, 0xdf, 0x29, 0x28, 0x29, 0xef, 0x14, 0x9e, 0x8a, 0xb6, 0x09,
+	0x5c, 0x60, 0x35, 0x77, 0x7e, 0x20, 0xe0, 0xdc, 0x10, 0x38, 0x63, 0x78, 0x34, 0x14, 0x47, 0xde,
+	0x5e, 0xfc, 0x59, 0x41, 0xe9, 0x90, 0x2b, 0x88, 0x6f, 0x47, 0xdb, 0x44, 0x5f, 0x74, 0x75, 0xfe,
+	0x82, 0x2a, 0x20, 0x9d, 0x13, 0xa4, 0x05, 0x7c, 0x2b, 0x94, 0x54, 0x8e, 0x7a, 0xb9, 0xd3, 0xbf,
+	0xf6, 0xfb, 0xb1, 0x83, 0xbf, 0x28, 0x28, 0x13, 0x76, 0xe3, 0x70, 0x17, 0x88, 0x2e, 0x8f, 0x80,
+	0xba, 0x70, 0x51, 0x19, 0xc0, 0x3f, 0x12, 0xf0, 0xf7, 0xf1, 0x52, 0x3c, 0x78, 0xb9, 0xb1, 0x63,
+	0x5a, 0x32, 0x67, 0x19, 0x86, 0x21, 0xa4, 0x1c, 0x18, 0xd5, 0xd8, 0xe5, 0x04, 0xe7, 0x76, 0xe1,
+	0xa2, 0xb2, 0xff, 0x54, 0x0e, 0x1c, 0xf0, 0x83, 0x83, 0x63, 0x4d, 0x39, 0x3a, 0xd6, 0x94, 0x1f,
+	0xc7, 0x9a, 0xf2, 0xf1, 0x44, 0x4b, 0x1c, 0x9d, 0x68, 0x89, 0x6f, 0x27, 0x5a, 0xe2, 0x55, 0xde,
+	0xae, 0xb9, 0xeb, 0x5e, 0xc5, 0xa8, 0xb2, 0xba, 0x6f, 0x21, 0x7f, 0x0a, 0xdc, 0xda, 0x30, 0xdf,
+	0x48, 0x3f, 0xb7, 0xd5, 0xa0, 0xbc, 0x92, 0x14, 0x0f, 0xcf, 0xdc, 0x9f, 0x01, 0x00, 0xd6, 0x45,
+	0xf0, 0x2d, 0x4b, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -474,6 +686,12 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// ModuleAccountByName returns the module account info by module name
 	ModuleAccountByName(ctx context.Context, in *QueryModuleAccountByNameRequest, opts ...grpc.CallOption) (*QueryModuleAccountByNameResponse, error)
+	// DerivedModuleAddress returns the address of the account derived from a
+	// module name and a derivation key, whether or not it has been created.
+	DerivedModuleAddress(ctx context.Context, in *QueryDerivedModuleAddressRequest, opts ...grpc.CallOption) (*QueryDerivedModuleAddressResponse, error)
+	// DerivedModuleAccount returns the derived module account of a module name
+	// and a derivation key.
+	DerivedModuleAccount(ctx context.Context, in *QueryDerivedModuleAccountRequest, opts ...grpc.CallOption) (*QueryDerivedModuleAccountResponse, error)
 }
 
 type queryClient struct {
@@ -520,6 +738,24 @@ func (c *queryClient) ModuleAccountByName(ctx context.Context, in *QueryModuleAc
 	return out, nil
 }
 
+func (c *queryClient) DerivedModuleAddress(ctx context.Context, in *QueryDerivedModuleAddressRequest, opts ...grpc.CallOption) (*QueryDerivedModuleAddressResponse, error) {
+	out := new(QueryDerivedModuleAddressResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/DerivedModuleAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DerivedModuleAccount(ctx context.Context, in *QueryDerivedModuleAccountRequest, opts ...grpc.CallOption) (*QueryDerivedModuleAccountResponse, error) {
+	out := new(QueryDerivedModuleAccountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/DerivedModuleAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts
@@ -532,6 +768,12 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// ModuleAccountByName returns the module account info by module name
 	ModuleAccountByName(context.Context, *QueryModuleAccountByNameRequest) (*QueryModuleAccountByNameResponse, error)
+	// DerivedModuleAddress returns the address of the account derived from a
+	// module name and a derivation key, whether or not it has been created.
+	DerivedModuleAddress(context.Context, *QueryDerivedModuleAddressRequest) (*QueryDerivedModuleAddressResponse, error)
+	// DerivedModuleAccount returns the derived module account of a module name
+	// and a derivation key.
+	DerivedModuleAccount(context.Context, *QueryDerivedModuleAccountRequest) (*QueryDerivedModuleAccountResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -550,6 +792,12 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) ModuleAccountByName(ctx context.Context, req *QueryModuleAccountByNameRequest) (*QueryModuleAccountByNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccountByName not implemented")
 }
+func (*UnimplementedQueryServer) DerivedModuleAddress(ctx context.Context, req *QueryDerivedModuleAddressRequest) (*QueryDerivedModuleAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DerivedModuleAddress not implemented")
+}
+func (*UnimplementedQueryServer) DerivedModuleAccount(ctx context.Context, req *QueryDerivedModuleAccountRequest) (*QueryDerivedModuleAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DerivedModuleAccount not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -627,6 +875,42 @@ func _Query_ModuleAccountByName_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DerivedModuleAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDerivedModuleAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DerivedModuleAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/DerivedModuleAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DerivedModuleAddress(ctx, req.(*QueryDerivedModuleAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DerivedModuleAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDerivedModuleAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DerivedModuleAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/DerivedModuleAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DerivedModuleAccount(ctx, req.(*QueryDerivedModuleAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -647,6 +931,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleAccountByName",
 			Handler:    _Query_ModuleAccountByName_Handler,
 		},
+		{
+			MethodName: "DerivedModuleAddress",
+			Handler:    _Query_DerivedModuleAddress_Handler,
+		},
+		{
+			MethodName: "DerivedModuleAccount",
+			Handler:    _Query_DerivedModuleAccount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -922,86 +1214,225 @@ func (m *QueryModuleAccountByNameResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDerivedModuleAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryDerivedModuleAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDerivedModuleAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.DerivationKey) > 0 {
+		i -= len(m.DerivationKey)
+		copy(dAtA[i:], m.DerivationKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DerivationKey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDerivedModuleAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Account != nil {
-		l = m.Account.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryDerivedModuleAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDerivedModuleAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
+func (m *QueryDerivedModuleAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDerivedModuleAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDerivedModuleAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DerivationKey) > 0 {
+		i -= len(m.DerivationKey)
+		copy(dAtA[i:], m.DerivationKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DerivationKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDerivedModuleAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDerivedModuleAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDerivedModuleAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1021,29 +1452,377 @@ func (m *QueryModuleAccountByNameRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryModuleAccountByNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDerivedModuleAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DerivationKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDerivedModuleAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDerivedModuleAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DerivationKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDerivedModuleAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &types.Any{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryModuleAccountByNameResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Account != nil {
-		l = m.Account.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1066,15 +1845,15 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1101,10 +1880,10 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Account == nil {
+				m.Account = &types.Any{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1129,7 +1908,7 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1152,49 +1931,65 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, &types.Any{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1221,10 +2016,7 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1249,7 +2041,7 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleAccountByNameRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1272,15 +2064,15 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleAccountByNameRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleAccountByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1308,7 +2100,7 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1331,7 +2123,7 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleAccountByNameResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1354,10 +2146,10 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleAccountByNameResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleAccountByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1417,7 +2209,7 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDerivedModuleAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1440,12 +2232,78 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDerivedModuleAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDerivedModuleAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivationKey = append(m.DerivationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.DerivationKey == nil {
+				m.DerivationKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1467,7 +2325,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDerivedModuleAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1490,17 +2348,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDerivedModuleAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDerivedModuleAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1510,24 +2368,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1550,7 +2407,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAccountByNameRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDerivedModuleAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1573,15 +2430,15 @@ func (m *QueryModuleAccountByNameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAccountByNameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDerivedModuleAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAccountByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDerivedModuleAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1609,7 +2466,41 @@ func (m *QueryModuleAccountByNameRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivationKey = append(m.DerivationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.DerivationKey == nil {
+				m.DerivationKey = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1632,7 +2523,7 @@ func (m *QueryModuleAccountByNameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAccountByNameResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDerivedModuleAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1655,10 +2546,10 @@ func (m *QueryModuleAccountByNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAccountByNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDerivedModuleAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAccountByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDerivedModuleAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
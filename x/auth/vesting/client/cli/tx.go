@@ -18,6 +18,9 @@ import (
 // Transaction command flags
 const (
 	FlagDelayed = "delayed"
+	FlagLockup  = "lockup"
+	FlagVesting = "vesting"
+	FlagDest    = "dest"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -34,6 +37,8 @@ func GetTxCmd() *cobra.Command {
 		NewMsgCreateVestingAccountCmd(),
 		NewMsgCreatePeriodicVestingAccountCmd(),
 		NewMsgDonateAllVestingTokensCmd(),
+		NewMsgCreateClawbackVestingAccountCmd(),
+		NewMsgClawbackCmd(),
 	)
 
 	return txCmd
@@ -131,35 +136,109 @@ func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
 				return err
 			}
 
-			contents, err := ioutil.ReadFile(args[1])
+			startTime, periods, err := readVestingData(args[1])
 			if err != nil {
 				return err
 			}
 
-			var vestingData VestingData
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, periods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgDonateAllVestingTokensCmd returns a CLI command handler for creating a
+// MsgDonateAllVestingTokens transaction.
+func NewMsgDonateAllVestingTokensCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "donate-all-vesting-tokens",
+		Short: "Donate all vesting tokens of a vesting account to community pool.",
+		Long: `Donate all vesting tokens of a vesting account to community pool. 
+		The account must not have any delegated vesting tokens to prevent complex
+		vesting logic changes. After donation, the account will be changed to normal 
+		"BaseAccount".`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDonateAllVestingTokens(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address]",
+		Short: "Create a new vesting account funded with an allocation of tokens, subject to clawback.",
+		Long: `Must provide a lockup periods file (--lockup), a vesting periods file (--vesting), or both.
+If both files are given, they must describe the same total amount and start at the same time.
+If one file is omitted, it defaults to a schedule that immediately unlocks or vests the entire amount.
+Both files have the same format as the periods file of create-periodic-vesting-account.
+The funds are unlocked or vested at the end of each period. Unvested funds can be
+clawed back by the sender of this transaction with the 'clawback' command.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
 
-			err = json.Unmarshal(contents, &vestingData)
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
-			var periods []types.Period
+			lockupFile, _ := cmd.Flags().GetString(FlagLockup)
+			vestingFile, _ := cmd.Flags().GetString(FlagVesting)
+			if lockupFile == "" && vestingFile == "" {
+				return fmt.Errorf("must specify at least one of --%s or --%s", FlagLockup, FlagVesting)
+			}
+
+			var (
+				startTime                     int64
+				lockupPeriods, vestingPeriods []types.Period
+			)
 
-			for i, p := range vestingData.Periods {
+			if lockupFile != "" {
+				startTime, lockupPeriods, err = readVestingData(lockupFile)
+				if err != nil {
+					return err
+				}
+			}
 
-				amount, err := sdk.ParseCoinsNormalized(p.Coins)
+			if vestingFile != "" {
+				vestingStart, periods, err := readVestingData(vestingFile)
 				if err != nil {
 					return err
 				}
 
-				if p.Length < 0 {
-					return fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+				if lockupFile != "" && vestingStart != startTime {
+					return fmt.Errorf("lockup start time %d and vesting start time %d must be equal", startTime, vestingStart)
 				}
-				period := types.Period{Length: p.Length, Amount: amount}
-				periods = append(periods, period)
+
+				startTime, vestingPeriods = vestingStart, periods
 			}
 
-			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods)
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, lockupPeriods, vestingPeriods)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -168,35 +247,87 @@ func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagLockup, "", "path to file containing unlocking periods")
+	cmd.Flags().String(FlagVesting, "", "path to file containing vesting periods")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
-// NewMsgDonateAllVestingTokensCmd returns a CLI command handler for creating a
-// MsgDonateAllVestingTokens transaction.
-func NewMsgDonateAllVestingTokensCmd() *cobra.Command {
+// NewMsgClawbackCmd returns a CLI command handler for creating a
+// MsgClawback transaction.
+func NewMsgClawbackCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "donate-all-vesting-tokens",
-		Short: "Donate all vesting tokens of a vesting account to community pool.",
-		Long: `Donate all vesting tokens of a vesting account to community pool. 
-		The account must not have any delegated vesting tokens to prevent complex
-		vesting logic changes. After donation, the account will be changed to normal 
-		"BaseAccount".`,
-		Args: cobra.NoArgs,
+		Use:   "clawback [address]",
+		Short: "Transfer unvested amount out of a ClawbackVestingAccount.",
+		Long: `Must be requested by the original funder address (--from).
+May provide a destination address (--dest), otherwise the coins return to the funder.
+Delegated or unbonding staking tokens will be transferred in the delegated or unbonding state.
+The recipient is vulnerable to slashing, and must act to unbond the tokens if desired.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgDonateAllVestingTokens(clientCtx.GetFromAddress())
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var dest sdk.AccAddress
+			if destString, _ := cmd.Flags().GetString(FlagDest); destString != "" {
+				dest, err = sdk.AccAddressFromBech32(destString)
+				if err != nil {
+					return fmt.Errorf("bad dest address: %w", err)
+				}
+			}
+
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, dest)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagDest, "", "address of destination (defaults to funder)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// readVestingData reads the start time and the periods of a vesting schedule
+// from a JSON file in the VestingData format.
+func readVestingData(path string) (int64, []types.Period, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var vestingData VestingData
+
+	err = json.Unmarshal(contents, &vestingData)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var periods []types.Period
+
+	for i, p := range vestingData.Periods {
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		if p.Length < 0 {
+			return 0, nil, fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+		}
+		period := types.Period{Length: p.Length, Amount: amount}
+		periods = append(periods, period)
+	}
+
+	return vestingData.StartTime, periods, nil
+}
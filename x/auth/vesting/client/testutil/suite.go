@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -130,3 +131,77 @@ func (s *IntegrationTestSuite) TestNewMsgCreateVestingAccountCmd() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewMsgCreateClawbackVestingAccountCmd() {
+	val := s.network.Validators[0]
+	addr := sdk.AccAddress("addr5_______________")
+
+	periodsJSON := fmt.Sprintf(`{"start_time": %%d, "periods": [{"coins": "10%s", "length_seconds": 2592000}]}`, s.cfg.BondDenom)
+	lockupFile := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(periodsJSON, 4070908800))
+	vestingFile := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(periodsJSON, 4070908800))
+	otherStartFile := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(periodsJSON, 4070908801))
+
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := map[string]struct {
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		"no schedule": {
+			args:      append([]string{addr.String()}, txFlags...),
+			expectErr: true,
+		},
+		"mismatched start times": {
+			args: append([]string{
+				addr.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagLockup, lockupFile.Name()),
+				fmt.Sprintf("--%s=%s", cli.FlagVesting, otherStartFile.Name()),
+			}, txFlags...),
+			expectErr: true,
+		},
+		"create a clawback vesting account": {
+			args: append([]string{
+				addr.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagLockup, lockupFile.Name()),
+				fmt.Sprintf("--%s=%s", cli.FlagVesting, vestingFile.Name()),
+			}, txFlags...),
+			expectErr:    false,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			clientCtx := val.ClientCtx
+
+			bw, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewMsgCreateClawbackVestingAccountCmd(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bw.Bytes(), tc.respType), bw.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+	}
+
+	// the funder claws the unvested coins back
+	bw, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewMsgClawbackCmd(), append([]string{addr.String()}, txFlags...))
+	s.Require().NoError(err)
+
+	var txResp sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(bw.Bytes(), &txResp), bw.String())
+	s.Require().Equal(uint32(0), txResp.Code, txResp.RawLog)
+}
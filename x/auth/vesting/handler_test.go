@@ -2,13 +2,16 @@ package vesting_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
@@ -181,6 +184,171 @@ func (suite *HandlerTestSuite) TestMsgDonateVestingToken() {
 	}
 }
 
+func (suite *HandlerTestSuite) TestMsgCreateClawbackVestingAccount() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1})
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(simapp.FundAccount(suite.app.BankKeeper, ctx, addr1, balances))
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+	periods := []types.Period{{Length: 100, Amount: amount}}
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreateClawbackVestingAccount
+		expectErr bool
+	}{
+		{
+			name:      "create clawback vesting account",
+			msg:       types.NewMsgCreateClawbackVestingAccount(addr1, addr2, ctx.BlockTime().Unix(), periods, periods),
+			expectErr: false,
+		},
+		{
+			name:      "create clawback vesting account without lockup",
+			msg:       types.NewMsgCreateClawbackVestingAccount(addr1, addr3, ctx.BlockTime().Unix(), nil, periods),
+			expectErr: false,
+		},
+		{
+			name:      "clawback vesting account already exists",
+			msg:       types.NewMsgCreateClawbackVestingAccount(addr1, addr3, ctx.BlockTime().Unix(), nil, periods),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			res, err := suite.handler(ctx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				toAddr, err := sdk.AccAddressFromBech32(tc.msg.ToAddress)
+				suite.Require().NoError(err)
+				accI := suite.app.AccountKeeper.GetAccount(ctx, toAddr)
+				suite.Require().NotNil(accI)
+
+				acc, ok := accI.(*types.ClawbackVestingAccount)
+				suite.Require().True(ok)
+				suite.Require().NoError(acc.Validate())
+				suite.Require().Equal(tc.msg.FromAddress, acc.FunderAddress)
+				suite.Require().Equal(amount, acc.GetVestingCoins(ctx.BlockTime()))
+				suite.Require().Equal(amount, suite.app.BankKeeper.GetAllBalances(ctx, toAddr))
+			}
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestMsgClawback() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1, Time: time.Unix(1000, 0)})
+	bondDenom := suite.app.StakingKeeper.BondDenom(ctx)
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	funder := sdk.AccAddress([]byte("funder______________"))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	dest := sdk.AccAddress([]byte("dest________________"))
+	valAddr := sdk.ValAddress([]byte("validator___________"))
+
+	suite.app.AccountKeeper.SetAccount(ctx, suite.app.AccountKeeper.NewAccountWithAddress(ctx, funder))
+	suite.Require().NoError(simapp.FundAccount(suite.app.BankKeeper, ctx, funder, balances))
+	suite.app.AccountKeeper.SetAccount(ctx, suite.app.AccountKeeper.NewAccountWithAddress(ctx, sdk.AccAddress(valAddr)))
+	suite.Require().NoError(simapp.FundAccount(suite.app.BankKeeper, ctx, sdk.AccAddress(valAddr), balances))
+
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt(100), true)
+
+	// addr1 vests 300 coins after 100 seconds and 300 more after 200 seconds
+	periods := []types.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 300))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 300))},
+	}
+	_, err := suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(funder, addr1, ctx.BlockTime().Unix(), nil, periods))
+	suite.Require().NoError(err)
+
+	// addr1 delegates 400 of its vesting coins
+	tstaking.Delegate(addr1, valAddr, sdk.NewInt(400))
+
+	acc2 := types.NewPermanentLockedAccount(suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr2).(*authtypes.BaseAccount), balances)
+	suite.app.AccountKeeper.SetAccount(ctx, acc2)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(150 * time.Second))
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgClawback
+		expectErr bool
+	}{
+		{
+			name:      "clawback from unknown account",
+			msg:       types.NewMsgClawback(funder, dest, nil),
+			expectErr: true,
+		},
+		{
+			name:      "clawback from other vesting account",
+			msg:       types.NewMsgClawback(funder, addr2, nil),
+			expectErr: true,
+		},
+		{
+			name:      "clawback by other than the funder",
+			msg:       types.NewMsgClawback(addr2, addr1, nil),
+			expectErr: true,
+		},
+		{
+			name:      "clawback to dest",
+			msg:       types.NewMsgClawback(funder, addr1, dest),
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			res, err := suite.handler(ctx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			}
+		})
+	}
+
+	// the unvested 300 coins were recovered, 200 from the bank balance and
+	// 100 from the delegation
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 200)), suite.app.BankKeeper.GetAllBalances(ctx, dest))
+	delegation, found := suite.app.StakingKeeper.GetDelegation(ctx, dest, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(100), delegation.Shares)
+
+	delegation, found = suite.app.StakingKeeper.GetDelegation(ctx, addr1, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(300), delegation.Shares)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(ctx, addr1).IsZero())
+
+	acc1, ok := suite.app.AccountKeeper.GetAccount(ctx, addr1).(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().NoError(acc1.Validate())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 300)), acc1.GetOriginalVesting())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 300)), acc1.GetDelegatedFree())
+	suite.Require().True(acc1.GetDelegatedVesting().IsZero())
+
+	// nothing is left to claw back
+	_, err = suite.handler(ctx, types.NewMsgClawback(funder, addr1, nil))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(ctx, funder).IsEqual(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 400))))
+}
+
 func TestHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(HandlerTestSuite))
 }
@@ -2,6 +2,7 @@ package vesting
 
 import (
 	"context"
+	"math"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -224,3 +225,219 @@ func (s msgServer) DonateAllVestingTokens(goCtx context.Context, msg *types.MsgD
 
 	return &types.MsgDonateAllVestingTokensResponse{}, nil
 }
+
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	lockupPeriods := types.Periods(msg.LockupPeriods)
+	vestingPeriods := types.Periods(msg.VestingPeriods)
+
+	// an empty schedule means the coins are unlocked or vested from the start
+	var totalCoins sdk.Coins
+	switch {
+	case len(lockupPeriods) == 0:
+		totalCoins = vestingPeriods.TotalAmount()
+		lockupPeriods = types.Periods{{Length: 0, Amount: totalCoins}}
+	case len(vestingPeriods) == 0:
+		totalCoins = lockupPeriods.TotalAmount()
+		vestingPeriods = types.Periods{{Length: 0, Amount: totalCoins}}
+	default:
+		totalCoins = vestingPeriods.TotalAmount()
+	}
+
+	if err := bk.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	baseAccount := ak.NewAccountWithAddress(ctx, to)
+	if _, ok := baseAccount.(*authtypes.BaseAccount); !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", baseAccount)
+	}
+
+	acc := types.NewClawbackVestingAccount(baseAccount.(*authtypes.BaseAccount), from, totalCoins, msg.StartTime, lockupPeriods, vestingPeriods)
+
+	ak.SetAccount(ctx, acc)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_clawback_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	err = bk.SendCoins(ctx, from, to, totalCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	dest := funder
+	if msg.DestAddress != "" {
+		dest, err = sdk.AccAddressFromBech32(msg.DestAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if bk.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s does not exist", msg.Address)
+	}
+
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+
+	if va.FunderAddress != msg.FunderAddress {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "clawback can only be requested by the original funder %s", va.FunderAddress)
+	}
+
+	if err := s.clawback(ctx, va, dest); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgClawbackResponse{}, nil
+}
+
+// clawback transfers the unvested coins of a ClawbackVestingAccount to dest.
+// Unvested coins are taken from the bank balance first. If the account staked
+// some of them, the remainder of the staking denom is recovered by handing
+// over its unbonding entries and then its delegations to dest.
+func (s msgServer) clawback(ctx sdk.Context, va *types.ClawbackVestingAccount, dest sdk.AccAddress) error {
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+	sk := s.StakingKeeper
+
+	toClawBack := va.ComputeClawback(ctx.BlockTime().Unix())
+	if toClawBack.IsZero() {
+		return nil
+	}
+
+	addr := va.GetAddress()
+	bondDenom := sk.BondDenom(ctx)
+
+	encumbered := va.GetVestingCoins(ctx.BlockTime())
+	bonded := sdk.NewCoins(sdk.NewCoin(bondDenom, sk.GetDelegatorBonded(ctx, addr)))
+	unbonding := sdk.NewCoins(sdk.NewCoin(bondDenom, sk.GetDelegatorUnbonding(ctx, addr)))
+	unbonded := bk.GetAllBalances(ctx, addr)
+	toClawBack = va.UpdateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded)
+
+	// The account must be stored before sending so that the bank module no
+	// longer considers the clawed back coins locked.
+	ak.SetAccount(ctx, va)
+
+	toXfer := toClawBack.Min(bk.SpendableCoins(ctx, addr))
+	if err := bk.SendCoins(ctx, addr, dest, toXfer); err != nil {
+		return err
+	}
+
+	// Staking is the only way unvested coins can be missing from the bank
+	// balance, so whatever is left is looked up in unbonding entries first
+	// and in delegations last.
+	want := toClawBack.Sub(toXfer).AmountOf(bondDenom)
+
+	for _, ubd := range sk.GetUnbondingDelegations(ctx, addr, math.MaxUint16) {
+		if !want.IsPositive() {
+			return nil
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		transferred := sk.TransferUnbonding(ctx, addr, dest, valAddr, want)
+		want = want.Sub(transferred)
+	}
+
+	for _, delegation := range sk.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		if !want.IsPositive() {
+			return nil
+		}
+
+		valAddr := delegation.GetValidatorAddr()
+
+		validator, found := sk.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		wantShares, err := validator.SharesFromTokensTruncated(want)
+		if err != nil {
+			// the validator has no tokens left
+			continue
+		}
+
+		transferredShares := sk.TransferDelegation(ctx, addr, dest, valAddr, wantShares)
+
+		// round up so that no more than the unvested amount is clawed back
+		transferred := validator.TokensFromSharesRoundUp(transferredShares).RoundInt()
+		want = want.Sub(transferred)
+	}
+
+	// Anything still wanted at this point was lost to slashing.
+	return nil
+}
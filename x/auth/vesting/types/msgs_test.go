@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestMsgCreateClawbackVestingAccountValidateBasic(t *testing.T) {
+	from := sdk.AccAddress([]byte("from________________"))
+	to := sdk.AccAddress([]byte("to__________________"))
+
+	periods := func(coins ...sdk.Coin) []types.Period {
+		return []types.Period{{Length: 100, Amount: sdk.NewCoins(coins...)}}
+	}
+
+	tests := []struct {
+		name   string
+		msg    *types.MsgCreateClawbackVestingAccount
+		expErr bool
+	}{
+		{
+			"valid",
+			types.NewMsgCreateClawbackVestingAccount(from, to, 1, periods(sdk.NewInt64Coin("foo", 10)), periods(sdk.NewInt64Coin("foo", 10))),
+			false,
+		},
+		{
+			"valid without lockup",
+			types.NewMsgCreateClawbackVestingAccount(from, to, 1, nil, periods(sdk.NewInt64Coin("foo", 10))),
+			false,
+		},
+		{
+			"empty schedules",
+			types.NewMsgCreateClawbackVestingAccount(from, to, 1, nil, nil),
+			true,
+		},
+		{
+			"invalid start time",
+			types.NewMsgCreateClawbackVestingAccount(from, to, 0, nil, periods(sdk.NewInt64Coin("foo", 10))),
+			true,
+		},
+		{
+			"mismatched amounts",
+			types.NewMsgCreateClawbackVestingAccount(from, to, 1, periods(sdk.NewInt64Coin("foo", 10)), periods(sdk.NewInt64Coin("foo", 20))),
+			true,
+		},
+		{
+			"mismatched denoms",
+			types.NewMsgCreateClawbackVestingAccount(from, to, 1, periods(sdk.NewInt64Coin("foo", 10)), periods(sdk.NewInt64Coin("bar", 10))),
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expErr, tt.msg.ValidateBasic() != nil)
+		})
+	}
+}
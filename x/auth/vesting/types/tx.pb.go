@@ -339,6 +339,234 @@ func (m *MsgDonateAllVestingTokensResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDonateAllVestingTokensResponse proto.InternalMessageInfo
 
+// MsgCreateClawbackVestingAccount defines a message that enables creating a
+// ClawbackVestingAccount.
+type MsgCreateClawbackVestingAccount struct {
+	// from_address specifies the account to provide the funds and sign the
+	// clawback request
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty" yaml:"from_address"`
+	// to_address specifies the account to receive the funds
+	ToAddress string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty" yaml:"to_address"`
+	// start_time defines the time at which the vesting period begins
+	StartTime int64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" yaml:"start_time"`
+	// lockup_periods defines the unlocking schedule relative to the start_time
+	LockupPeriods []Period `protobuf:"bytes,4,rep,name=lockup_periods,json=lockupPeriods,proto3" json:"lockup_periods" yaml:"lockup_periods"`
+	// vesting_periods defines the vesting schedule relative to the start_time
+	VestingPeriods []Period `protobuf:"bytes,5,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+func (m *MsgCreateClawbackVestingAccount) Reset()         { *m = MsgCreateClawbackVestingAccount{} }
+func (m *MsgCreateClawbackVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateClawbackVestingAccount) ProtoMessage()    {}
+func (*MsgCreateClawbackVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{6}
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateClawbackVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateClawbackVestingAccount.Merge(m, src)
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateClawbackVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateClawbackVestingAccount proto.InternalMessageInfo
+
+func (m *MsgCreateClawbackVestingAccount) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateClawbackVestingAccount) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateClawbackVestingAccount) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *MsgCreateClawbackVestingAccount) GetLockupPeriods() []Period {
+	if m != nil {
+		return m.LockupPeriods
+	}
+	return nil
+}
+
+func (m *MsgCreateClawbackVestingAccount) GetVestingPeriods() []Period {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return nil
+}
+
+// MsgCreateClawbackVestingAccountResponse defines the
+// Msg/CreateClawbackVestingAccount response type.
+type MsgCreateClawbackVestingAccountResponse struct {
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) Reset() {
+	*m = MsgCreateClawbackVestingAccountResponse{}
+}
+func (m *MsgCreateClawbackVestingAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateClawbackVestingAccountResponse) ProtoMessage()    {}
+func (*MsgCreateClawbackVestingAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{7}
+}
+func (m *MsgCreateClawbackVestingAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateClawbackVestingAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateClawbackVestingAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateClawbackVestingAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateClawbackVestingAccountResponse.Merge(m, src)
+}
+func (m *MsgCreateClawbackVestingAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateClawbackVestingAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateClawbackVestingAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateClawbackVestingAccountResponse proto.InternalMessageInfo
+
+// MsgClawback defines a message that removes unvested tokens from a
+// ClawbackVestingAccount.
+type MsgClawback struct {
+	// funder_address is the address which funded the account
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty" yaml:"funder_address"`
+	// address is the address of the ClawbackVestingAccount to claw back from.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// dest_address specifies where the clawed-back tokens should be transferred
+	// to. If empty, the tokens will be transferred back to the original funder of
+	// the account.
+	DestAddress string `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty" yaml:"dest_address"`
+}
+
+func (m *MsgClawback) Reset()         { *m = MsgClawback{} }
+func (m *MsgClawback) String() string { return proto.CompactTextString(m) }
+func (*MsgClawback) ProtoMessage()    {}
+func (*MsgClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{8}
+}
+func (m *MsgClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawback.Merge(m, src)
+}
+func (m *MsgClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawback proto.InternalMessageInfo
+
+func (m *MsgClawback) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgClawback) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgClawback) GetDestAddress() string {
+	if m != nil {
+		return m.DestAddress
+	}
+	return ""
+}
+
+// MsgClawbackResponse defines the MsgClawback response type.
+type MsgClawbackResponse struct {
+}
+
+func (m *MsgClawbackResponse) Reset()         { *m = MsgClawbackResponse{} }
+func (m *MsgClawbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackResponse) ProtoMessage()    {}
+func (*MsgClawbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{9}
+}
+func (m *MsgClawbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackResponse.Merge(m, src)
+}
+func (m *MsgClawbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateVestingAccount)(nil), "cosmos.vesting.v1beta1.MsgCreateVestingAccount")
 	proto.RegisterType((*MsgCreateVestingAccountResponse)(nil), "cosmos.vesting.v1beta1.MsgCreateVestingAccountResponse")
@@ -346,48 +574,62 @@ func init() {
 	proto.RegisterType((*MsgCreatePeriodicVestingAccountResponse)(nil), "cosmos.vesting.v1beta1.MsgCreatePeriodicVestingAccountResponse")
 	proto.RegisterType((*MsgDonateAllVestingTokens)(nil), "cosmos.vesting.v1beta1.MsgDonateAllVestingTokens")
 	proto.RegisterType((*MsgDonateAllVestingTokensResponse)(nil), "cosmos.vesting.v1beta1.MsgDonateAllVestingTokensResponse")
+	proto.RegisterType((*MsgCreateClawbackVestingAccount)(nil), "cosmos.vesting.v1beta1.MsgCreateClawbackVestingAccount")
+	proto.RegisterType((*MsgCreateClawbackVestingAccountResponse)(nil), "cosmos.vesting.v1beta1.MsgCreateClawbackVestingAccountResponse")
+	proto.RegisterType((*MsgClawback)(nil), "cosmos.vesting.v1beta1.MsgClawback")
+	proto.RegisterType((*MsgClawbackResponse)(nil), "cosmos.vesting.v1beta1.MsgClawbackResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/vesting/v1beta1/tx.proto", fileDescriptor_5338ca97811f9792) }
 
 var fileDescriptor_5338ca97811f9792 = []byte{
-	// 569 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0xc5, 0xa1, 0x6d, 0xae, 0x88, 0x0a, 0xb7, 0xb4, 0x6e, 0x84, 0xec, 0xd4, 0x20, 0x11,
-	0x06, 0x6c, 0x52, 0x2a, 0x21, 0xb2, 0xa0, 0xb8, 0x6c, 0x28, 0x12, 0xb2, 0x2a, 0x06, 0x24, 0x14,
-	0x5d, 0xec, 0xc3, 0xb5, 0x1a, 0xfb, 0x22, 0xdf, 0xa5, 0x6a, 0x36, 0x26, 0x66, 0x46, 0x26, 0xc4,
-	0x8c, 0xf8, 0x11, 0x8c, 0x1d, 0x3b, 0x32, 0x05, 0x94, 0x2c, 0xcc, 0xf9, 0x05, 0xc8, 0x77, 0x67,
-	0x37, 0x43, 0x9c, 0xaa, 0x30, 0x30, 0xd9, 0xcf, 0xef, 0xfb, 0xbe, 0x7b, 0xef, 0xbb, 0xe7, 0x07,
-	0x0d, 0x8f, 0xd0, 0x88, 0x50, 0xfb, 0x14, 0x53, 0x16, 0xc6, 0x81, 0x7d, 0xda, 0xec, 0x61, 0x86,
-	0x9a, 0x36, 0x3b, 0xb3, 0x06, 0x09, 0x61, 0x44, 0xdd, 0x16, 0x00, 0x4b, 0x02, 0x2c, 0x09, 0xa8,
-	0x6d, 0x05, 0x24, 0x20, 0x1c, 0x62, 0xa7, 0x6f, 0x02, 0x5d, 0xd3, 0xa5, 0x5c, 0x0f, 0x51, 0x9c,
-	0x6b, 0x79, 0x24, 0x8c, 0x65, 0xfe, 0x7e, 0xc1, 0x71, 0x99, 0x3a, 0x47, 0x99, 0xdf, 0xcb, 0x70,
-	0xa7, 0x43, 0x83, 0xc3, 0x04, 0x23, 0x86, 0x5f, 0x8b, 0x54, 0xdb, 0xf3, 0xc8, 0x30, 0x66, 0x6a,
-	0x0b, 0xde, 0x7c, 0x97, 0x90, 0xa8, 0x8b, 0x7c, 0x3f, 0xc1, 0x94, 0x6a, 0xa0, 0x0e, 0x1a, 0x55,
-	0x67, 0x67, 0x36, 0x36, 0x36, 0x47, 0x28, 0xea, 0xb7, 0xcc, 0xf9, 0xac, 0xe9, 0xae, 0xa7, 0x61,
-	0x5b, 0x44, 0xea, 0x01, 0x84, 0x8c, 0xe4, 0xcc, 0x32, 0x67, 0xde, 0x99, 0x8d, 0x8d, 0xdb, 0x82,
-	0x79, 0x99, 0x33, 0xdd, 0x2a, 0x23, 0x19, 0xcb, 0x83, 0x2b, 0x28, 0x4a, 0xcf, 0xd6, 0x94, 0xba,
-	0xd2, 0x58, 0xdf, 0xdf, 0xb5, 0xa4, 0x25, 0x69, 0x93, 0x99, 0x1f, 0xd6, 0x21, 0x09, 0x63, 0xe7,
-	0xf1, 0xf9, 0xd8, 0x28, 0x7d, 0xfd, 0x69, 0x34, 0x82, 0x90, 0x1d, 0x0f, 0x7b, 0x96, 0x47, 0x22,
-	0x5b, 0x76, 0x2c, 0x1e, 0x8f, 0xa8, 0x7f, 0x62, 0xb3, 0xd1, 0x00, 0x53, 0x4e, 0xa0, 0xae, 0x94,
-	0x56, 0x2d, 0xb8, 0x86, 0x63, 0xbf, 0xcb, 0xc2, 0x08, 0x6b, 0x95, 0x3a, 0x68, 0x28, 0xce, 0xe6,
-	0x6c, 0x6c, 0x6c, 0x88, 0xc2, 0xb2, 0x8c, 0xe9, 0xae, 0xe2, 0xd8, 0x3f, 0x0a, 0x23, 0xac, 0x6a,
-	0x70, 0xd5, 0xc7, 0x7d, 0x34, 0xc2, 0xbe, 0x76, 0xa3, 0x0e, 0x1a, 0x6b, 0x6e, 0x16, 0xb6, 0x2a,
-	0xbf, 0xbf, 0x18, 0xc0, 0xdc, 0x83, 0x46, 0x81, 0x83, 0x2e, 0xa6, 0x03, 0x12, 0x53, 0x6c, 0x7e,
-	0x2e, 0xcf, 0x61, 0x5e, 0xe1, 0x24, 0x24, 0x7e, 0xe8, 0xfd, 0x77, 0xb7, 0x0f, 0x20, 0xa4, 0x0c,
-	0x25, 0x4c, 0x58, 0xa1, 0x70, 0x2b, 0xe6, 0x58, 0x97, 0x39, 0xd3, 0xad, 0xf2, 0x80, 0xdb, 0xd1,
-	0x81, 0x1b, 0x72, 0x84, 0xba, 0x03, 0xde, 0x09, 0xd5, 0x2a, 0xfc, 0xb2, 0x74, 0x6b, 0xf1, 0xfc,
-	0x5a, 0xa2, 0x61, 0xa7, 0x92, 0xde, 0x98, 0x7b, 0x4b, 0x66, 0xc5, 0x47, 0xca, 0x3d, 0x2c, 0x99,
-	0x0f, 0xe1, 0x83, 0x2b, 0xfc, 0xc9, 0xbd, 0x7c, 0x0b, 0x77, 0x3b, 0x34, 0x78, 0x41, 0x62, 0xc4,
-	0x70, 0xbb, 0xdf, 0x97, 0xa8, 0x23, 0x72, 0x82, 0x63, 0xfa, 0x2f, 0x26, 0xca, 0x4a, 0xee, 0xc1,
-	0xbd, 0x42, 0xf9, 0xac, 0x86, 0xfd, 0x6f, 0x0a, 0x54, 0x3a, 0x34, 0x50, 0xdf, 0x03, 0xb8, 0xb5,
-	0xf0, 0xd7, 0xb1, 0x8b, 0xbc, 0x28, 0x98, 0x94, 0xda, 0xd3, 0x6b, 0x12, 0xb2, 0x52, 0xd4, 0x4f,
-	0x00, 0xde, 0x5d, 0x3a, 0x57, 0x57, 0x2b, 0x2f, 0x26, 0xd6, 0x9e, 0xff, 0x25, 0x31, 0x2f, 0xed,
-	0x03, 0x80, 0xdb, 0x05, 0xf7, 0xd4, 0x5c, 0xa2, 0xbd, 0x98, 0x52, 0x7b, 0x76, 0x6d, 0x4a, 0x56,
-	0x88, 0xf3, 0xf2, 0x7c, 0xa2, 0x83, 0x8b, 0x89, 0x0e, 0x7e, 0x4d, 0x74, 0xf0, 0x71, 0xaa, 0x97,
-	0x2e, 0xa6, 0x7a, 0xe9, 0xc7, 0x54, 0x2f, 0xbd, 0x69, 0x2e, 0xdd, 0x1e, 0x67, 0x36, 0x1a, 0xb2,
-	0xe3, 0x7c, 0x83, 0xf2, 0x65, 0xd2, 0x5b, 0xe1, 0x8b, 0xf3, 0xc9, 0x9f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xb0, 0xfb, 0x1a, 0x04, 0xcf, 0x05, 0x00, 0x00,
+	// 731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4b, 0x6f, 0xd3, 0x4a,
+	0x18, 0x8d, 0xeb, 0xf4, 0x35, 0xb9, 0x6d, 0x75, 0xdd, 0x97, 0x6b, 0xdd, 0x6b, 0xa7, 0xd3, 0x2b,
+	0xdd, 0x20, 0x84, 0x4d, 0x4a, 0x25, 0x44, 0x37, 0xa5, 0x29, 0x3b, 0x14, 0x09, 0x59, 0x15, 0x0b,
+	0x04, 0xaa, 0x1c, 0x7b, 0xea, 0x5a, 0x89, 0x3d, 0x91, 0x67, 0x52, 0xda, 0x1d, 0x2b, 0xd6, 0x2c,
+	0x59, 0x21, 0x36, 0x6c, 0xe0, 0x4f, 0xb0, 0xec, 0xb2, 0x4b, 0x56, 0x01, 0xb5, 0x1b, 0xd6, 0xf9,
+	0x05, 0xc8, 0x9e, 0xb1, 0x93, 0x14, 0x3b, 0x7d, 0xb0, 0x40, 0xac, 0xda, 0xf1, 0x77, 0xce, 0x99,
+	0xf9, 0xce, 0xf9, 0x32, 0x36, 0xd0, 0x6c, 0x4c, 0x7c, 0x4c, 0x8c, 0x43, 0x44, 0xa8, 0x17, 0xb8,
+	0xc6, 0x61, 0xb5, 0x81, 0xa8, 0x55, 0x35, 0xe8, 0x91, 0xde, 0x0e, 0x31, 0xc5, 0xd2, 0x12, 0x03,
+	0xe8, 0x1c, 0xa0, 0x73, 0x80, 0xb2, 0xe0, 0x62, 0x17, 0xc7, 0x10, 0x23, 0xfa, 0x8f, 0xa1, 0x15,
+	0x95, 0xcb, 0x35, 0x2c, 0x82, 0x52, 0x2d, 0x1b, 0x7b, 0x01, 0xaf, 0xff, 0x97, 0xb3, 0x5d, 0xa2,
+	0x1e, 0xa3, 0xe0, 0xe7, 0x31, 0xb0, 0x5c, 0x27, 0xee, 0x4e, 0x88, 0x2c, 0x8a, 0x9e, 0xb2, 0xd2,
+	0xb6, 0x6d, 0xe3, 0x4e, 0x40, 0xa5, 0x4d, 0xf0, 0xd7, 0x7e, 0x88, 0xfd, 0x3d, 0xcb, 0x71, 0x42,
+	0x44, 0x88, 0x2c, 0x94, 0x85, 0xca, 0x74, 0x6d, 0xb9, 0xd7, 0xd5, 0xe6, 0x8f, 0x2d, 0xbf, 0xb5,
+	0x09, 0x07, 0xab, 0xd0, 0x2c, 0x45, 0xcb, 0x6d, 0xb6, 0x92, 0x36, 0x00, 0xa0, 0x38, 0x65, 0x8e,
+	0xc5, 0xcc, 0xc5, 0x5e, 0x57, 0xfb, 0x9b, 0x31, 0xfb, 0x35, 0x68, 0x4e, 0x53, 0x9c, 0xb0, 0x6c,
+	0x30, 0x61, 0xf9, 0xd1, 0xde, 0xb2, 0x58, 0x16, 0x2b, 0xa5, 0xf5, 0x15, 0x9d, 0x5b, 0x12, 0x35,
+	0x99, 0xf8, 0xa1, 0xef, 0x60, 0x2f, 0xa8, 0xdd, 0x3d, 0xe9, 0x6a, 0x85, 0x8f, 0x5f, 0xb5, 0x8a,
+	0xeb, 0xd1, 0x83, 0x4e, 0x43, 0xb7, 0xb1, 0x6f, 0xf0, 0x8e, 0xd9, 0x9f, 0x3b, 0xc4, 0x69, 0x1a,
+	0xf4, 0xb8, 0x8d, 0x48, 0x4c, 0x20, 0x26, 0x97, 0x96, 0x74, 0x30, 0x85, 0x02, 0x67, 0x8f, 0x7a,
+	0x3e, 0x92, 0x8b, 0x65, 0xa1, 0x22, 0xd6, 0xe6, 0x7b, 0x5d, 0x6d, 0x8e, 0x1d, 0x2c, 0xa9, 0x40,
+	0x73, 0x12, 0x05, 0xce, 0xae, 0xe7, 0x23, 0x49, 0x06, 0x93, 0x0e, 0x6a, 0x59, 0xc7, 0xc8, 0x91,
+	0xc7, 0xcb, 0x42, 0x65, 0xca, 0x4c, 0x96, 0x9b, 0xc5, 0xef, 0xef, 0x35, 0x01, 0xae, 0x02, 0x2d,
+	0xc7, 0x41, 0x13, 0x91, 0x36, 0x0e, 0x08, 0x82, 0xef, 0xc6, 0x06, 0x30, 0x4f, 0x50, 0xe8, 0x61,
+	0xc7, 0xb3, 0x7f, 0xbb, 0xdb, 0x1b, 0x00, 0x10, 0x6a, 0x85, 0x94, 0x59, 0x21, 0xc6, 0x56, 0x0c,
+	0xb0, 0xfa, 0x35, 0x68, 0x4e, 0xc7, 0x8b, 0xd8, 0x8e, 0x3a, 0x98, 0xe3, 0x23, 0xb4, 0xd7, 0x8e,
+	0x3b, 0x21, 0x72, 0x31, 0x0e, 0x4b, 0xd5, 0xb3, 0xe7, 0x57, 0x67, 0x0d, 0xd7, 0x8a, 0x51, 0x62,
+	0xe6, 0x2c, 0xaf, 0xb2, 0x87, 0x24, 0xf6, 0xb0, 0x00, 0x6f, 0x81, 0xff, 0x2f, 0xf1, 0x27, 0xf5,
+	0xf2, 0x05, 0x58, 0xa9, 0x13, 0xf7, 0x11, 0x0e, 0x2c, 0x8a, 0xb6, 0x5b, 0x2d, 0x8e, 0xda, 0xc5,
+	0x4d, 0x14, 0x90, 0x5f, 0x31, 0x91, 0x9f, 0x64, 0x0d, 0xac, 0xe6, 0xca, 0xf7, 0xf3, 0x14, 0x07,
+	0xf2, 0xdc, 0x69, 0x59, 0x2f, 0x1b, 0x96, 0xdd, 0xfc, 0x43, 0xf3, 0x74, 0xc0, 0x6c, 0x0b, 0xdb,
+	0xcd, 0x4e, 0xfb, 0x9a, 0x71, 0xfe, 0x1b, 0xc5, 0xd9, 0xeb, 0x6a, 0x8b, 0x4c, 0x7d, 0x58, 0x03,
+	0x9a, 0x33, 0xec, 0x01, 0x03, 0x13, 0xc9, 0xfd, 0x79, 0x6a, 0xc6, 0xaf, 0xb4, 0x8d, 0xca, 0xb7,
+	0x59, 0x62, 0xdb, 0x5c, 0x10, 0x81, 0x57, 0x98, 0xa7, 0xec, 0x7c, 0xd2, 0x2c, 0x3f, 0x09, 0xa0,
+	0x14, 0x61, 0x39, 0x4a, 0x7a, 0x08, 0x66, 0xf7, 0x3b, 0x81, 0x83, 0xc2, 0x0b, 0xc9, 0xad, 0xf4,
+	0x7b, 0x1d, 0xae, 0x43, 0x73, 0x86, 0x3d, 0x48, 0x72, 0x90, 0xc1, 0xe4, 0x50, 0x74, 0x66, 0xb2,
+	0x8c, 0x66, 0xc2, 0x41, 0x84, 0xa6, 0xca, 0xe2, 0xc5, 0x99, 0x18, 0xac, 0x42, 0xb3, 0x14, 0x2d,
+	0x87, 0xc7, 0x73, 0x11, 0xcc, 0x0f, 0x1c, 0x36, 0x69, 0x62, 0xfd, 0xc3, 0x38, 0x10, 0xeb, 0xc4,
+	0x95, 0x5e, 0x09, 0x60, 0x21, 0xf3, 0x2e, 0x37, 0xf2, 0x6c, 0xce, 0xb9, 0xba, 0x94, 0xfb, 0xd7,
+	0x24, 0x24, 0x47, 0x91, 0xde, 0x0a, 0xe0, 0x9f, 0x91, 0x17, 0xdd, 0xe5, 0xca, 0xd9, 0x44, 0x65,
+	0xeb, 0x86, 0xc4, 0xf4, 0x68, 0xaf, 0x05, 0xb0, 0x94, 0x73, 0x71, 0x54, 0x47, 0x68, 0x67, 0x53,
+	0x94, 0x07, 0xd7, 0xa6, 0x64, 0x78, 0x94, 0x73, 0x79, 0x5c, 0xee, 0x51, 0x36, 0x51, 0xd9, 0xba,
+	0x21, 0x31, 0x3d, 0xda, 0x73, 0x30, 0x95, 0xfe, 0x14, 0xd6, 0x46, 0x89, 0x71, 0x90, 0x72, 0xfb,
+	0x0a, 0xa0, 0x44, 0xbd, 0xf6, 0xf8, 0xe4, 0x4c, 0x15, 0x4e, 0xcf, 0x54, 0xe1, 0xdb, 0x99, 0x2a,
+	0xbc, 0x39, 0x57, 0x0b, 0xa7, 0xe7, 0x6a, 0xe1, 0xcb, 0xb9, 0x5a, 0x78, 0x56, 0x1d, 0xf9, 0x1e,
+	0x3f, 0x32, 0xac, 0x0e, 0x3d, 0x48, 0xbf, 0x65, 0xe2, 0xd7, 0x7a, 0x63, 0x22, 0xfe, 0x84, 0xb9,
+	0xf7, 0x63, 0x00, 0xb9, 0xf4, 0xf4, 0xd0, 0x59, 0x09, 0x00, 0x00,
 }
 
 func (this *MsgCreateVestingAccount) Equal(that interface{}) bool {
@@ -453,6 +695,11 @@ type MsgClient interface {
 	// DonateAllVestingTokens defines a method that enables donating all vesting
 	// tokens to community pool
 	DonateAllVestingTokens(ctx context.Context, in *MsgDonateAllVestingTokens, opts ...grpc.CallOption) (*MsgDonateAllVestingTokensResponse, error)
+	// CreateClawbackVestingAccount defines a method that enables creating a
+	// vesting account that is subject to clawback.
+	CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error)
+	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
+	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
 }
 
 type msgClient struct {
@@ -490,6 +737,24 @@ func (c *msgClient) DonateAllVestingTokens(ctx context.Context, in *MsgDonateAll
 	return out, nil
 }
 
+func (c *msgClient) CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error) {
+	out := new(MsgCreateClawbackVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Msg/CreateClawbackVestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error) {
+	out := new(MsgClawbackResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Msg/Clawback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateVestingAccount defines a method that enables creating a vesting
@@ -501,6 +766,11 @@ type MsgServer interface {
 	// DonateAllVestingTokens defines a method that enables donating all vesting
 	// tokens to community pool
 	DonateAllVestingTokens(context.Context, *MsgDonateAllVestingTokens) (*MsgDonateAllVestingTokensResponse, error)
+	// CreateClawbackVestingAccount defines a method that enables creating a
+	// vesting account that is subject to clawback.
+	CreateClawbackVestingAccount(context.Context, *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error)
+	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
+	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -516,6 +786,12 @@ func (*UnimplementedMsgServer) CreatePeriodicVestingAccount(ctx context.Context,
 func (*UnimplementedMsgServer) DonateAllVestingTokens(ctx context.Context, req *MsgDonateAllVestingTokens) (*MsgDonateAllVestingTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DonateAllVestingTokens not implemented")
 }
+func (*UnimplementedMsgServer) CreateClawbackVestingAccount(ctx context.Context, req *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClawbackVestingAccount not implemented")
+}
+func (*UnimplementedMsgServer) Clawback(ctx context.Context, req *MsgClawback) (*MsgClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clawback not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -575,6 +851,42 @@ func _Msg_DonateAllVestingTokens_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateClawbackVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateClawbackVestingAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateClawbackVestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Msg/CreateClawbackVestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateClawbackVestingAccount(ctx, req.(*MsgCreateClawbackVestingAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Clawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Msg/Clawback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Clawback(ctx, req.(*MsgClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.vesting.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -591,6 +903,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DonateAllVestingTokens",
 			Handler:    _Msg_DonateAllVestingTokens_Handler,
 		},
+		{
+			MethodName: "CreateClawbackVestingAccount",
+			Handler:    _Msg_CreateClawbackVestingAccount_Handler,
+		},
+		{
+			MethodName: "Clawback",
+			Handler:    _Msg_Clawback_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/vesting/v1beta1/tx.proto",
@@ -817,50 +1137,210 @@ func (m *MsgDonateAllVestingTokensResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCreateClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCreateClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if m.EndTime != 0 {
-		n += 1 + sovTx(uint64(m.EndTime))
+	if m.StartTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Delayed {
-		n += 2
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateVestingAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateClawbackVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DestAddress) > 0 {
+		i -= len(m.DestAddress)
+		copy(dAtA[i:], m.DestAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovTx(uint64(m.EndTime))
+	}
+	if m.Delayed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreateVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	return n
@@ -923,6 +1403,77 @@ func (m *MsgDonateAllVestingTokensResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTx(uint64(m.StartTime))
+	}
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1515,6 +2066,453 @@ func (m *MsgDonateAllVestingTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	yaml "gopkg.in/yaml.v2"
@@ -18,6 +19,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 // Base Vesting Account
@@ -164,6 +166,8 @@ type vestingAccountYAML struct {
 	// custom fields based on concrete vesting type which can be omitted
 	StartTime      int64   `json:"start_time,omitempty" yaml:"start_time,omitempty"`
 	VestingPeriods Periods `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	FunderAddress  string  `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
+	LockupPeriods  Periods `json:"lockup_periods,omitempty" yaml:"lockup_periods,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -596,6 +600,262 @@ func (plva PermanentLockedAccount) String() string {
 	return out.(string)
 }
 
+// Clawback Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccountRaw creates a new ClawbackVestingAccount object from BaseVestingAccount
+func NewClawbackVestingAccountRaw(bva *BaseVestingAccount, funder sdk.AccAddress, startTime int64, lockupPeriods, vestingPeriods Periods) *ClawbackVestingAccount {
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: bva,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	}
+}
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins, startTime int64, lockupPeriods, vestingPeriods Periods) *ClawbackVestingAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         scheduleEndTime(startTime, lockupPeriods, vestingPeriods),
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	}
+}
+
+// GetVestedCoins returns the total number of vested coins, i.e. the coins that
+// are both vested and unlocked. If no coins are vested, nil is returned.
+func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	coins := va.GetUnlockedOnly(blockTime).Min(va.GetVestedOnly(blockTime))
+	if coins.IsZero() {
+		return nil
+	}
+
+	return coins
+}
+
+// GetVestingCoins returns the total number of vesting coins, i.e. the coins
+// that are still unvested or locked. If no coins are vesting, nil is returned.
+func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime))
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.BaseVestingAccount.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting and unlocking start for a
+// clawback vesting account.
+func (va ClawbackVestingAccount) GetStartTime() int64 {
+	return va.StartTime
+}
+
+// GetUnlockedOnly returns the coins unlocked by the lockup schedule at
+// blockTime, regardless of the vesting schedule. The coins of a period unlock
+// all at once at the end of the period.
+func (va ClawbackVestingAccount) GetUnlockedOnly(blockTime time.Time) sdk.Coins {
+	return readSchedule(va.StartTime, va.LockupPeriods, blockTime.Unix())
+}
+
+// GetVestedOnly returns the coins vested by the vesting schedule at blockTime,
+// regardless of the lockup schedule. The coins of a period vest all at once
+// at the end of the period.
+func (va ClawbackVestingAccount) GetVestedOnly(blockTime time.Time) sdk.Coins {
+	return readSchedule(va.StartTime, va.VestingPeriods, blockTime.Unix())
+}
+
+// ComputeClawback removes all future vesting events from the account and
+// returns their total amount. The account is left unchanged if nothing is
+// left to claw back. The lockup schedule is capped to the remaining
+// original vesting amount, so unlocking events that unlock already vested
+// coins are preserved. The delegation bookkeeping is left untouched, see
+// UpdateDelegation.
+func (va *ClawbackVestingAccount) ComputeClawback(clawbackTime int64) sdk.Coins {
+	totalVested := sdk.NewCoins()
+	totalUnvested := sdk.NewCoins()
+
+	vestTime := va.StartTime
+	vestedIdx := 0
+
+	for i, period := range va.VestingPeriods {
+		vestTime += period.Length
+		if vestTime <= clawbackTime {
+			totalVested = totalVested.Add(period.Amount...)
+			vestedIdx = i + 1
+		} else {
+			totalUnvested = totalUnvested.Add(period.Amount...)
+		}
+	}
+
+	if totalUnvested.IsZero() {
+		return totalUnvested
+	}
+
+	va.VestingPeriods = va.VestingPeriods[:vestedIdx]
+	va.LockupPeriods = capPeriods(va.LockupPeriods, totalVested)
+	va.OriginalVesting = totalVested
+
+	va.EndTime = scheduleEndTime(va.StartTime, va.LockupPeriods, va.VestingPeriods)
+
+	return totalUnvested
+}
+
+// UpdateDelegation recomputes the delegation bookkeeping of the account after
+// ComputeClawback, given the coins that are still encumbered, the coins to
+// claw back and the current bonded, unbonding and bank balances of the
+// account. It returns the amount to claw back, capped to what the account
+// actually holds since the account may have been slashed.
+//
+// The unbonded balance is clawed back first, the delegated balance second.
+// Whatever is still delegated after the clawback, plus the amount lost to
+// slashing, counts as delegated vesting up to the encumbered amount and as
+// delegated free beyond it.
+func (va *ClawbackVestingAccount) UpdateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded sdk.Coins) sdk.Coins {
+	delegated := bonded.Add(unbonding...)
+	oldDelegated := va.DelegatedVesting.Add(va.DelegatedFree...)
+	slashed := oldDelegated.Sub(delegated.Min(oldDelegated))
+	total := delegated.Add(unbonded...)
+
+	toClawBack = toClawBack.Min(total)
+	newDelegated := delegated.Min(total.Sub(toClawBack)).Add(slashed...)
+
+	va.DelegatedVesting = encumbered.Min(newDelegated)
+	va.DelegatedFree = newDelegated.Sub(va.DelegatedVesting)
+
+	return toClawBack
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if va.GetStartTime() > va.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(va.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+
+	if scheduleEndTime(va.StartTime, va.LockupPeriods, va.VestingPeriods) != va.EndTime {
+		return errors.New("vesting end time does not match length of all lockup and vesting periods")
+	}
+
+	if !coinsEq(Periods(va.LockupPeriods).TotalAmount(), va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in lockup periods")
+	}
+
+	if !coinsEq(Periods(va.VestingPeriods).TotalAmount(), va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return va.BaseVestingAccount.Validate()
+}
+
+func (va ClawbackVestingAccount) String() string {
+	out, _ := va.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(va.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    va.AccountNumber,
+		PubKey:           getPKString(va),
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		StartTime:        va.StartTime,
+		VestingPeriods:   va.VestingPeriods,
+		FunderAddress:    va.FunderAddress,
+		LockupPeriods:    va.LockupPeriods,
+	}
+	return marshalYaml(out)
+}
+
+// scheduleEndTime returns the time at which both the lockup and the vesting
+// schedules starting at startTime have ended.
+func scheduleEndTime(startTime int64, lockupPeriods, vestingPeriods Periods) int64 {
+	length := lockupPeriods.TotalLength()
+	if vestingLength := vestingPeriods.TotalLength(); vestingLength > length {
+		length = vestingLength
+	}
+
+	return startTime + length
+}
+
+// coinsEq returns whether a and b hold the same amounts of the same denoms.
+// Unlike sdk.Coins.IsEqual, it does not panic when the denoms differ.
+func coinsEq(a, b sdk.Coins) bool {
+	return a.IsAllGTE(b) && b.IsAllGTE(a)
+}
+
+// readSchedule returns the sum of the amounts of all periods of the schedule
+// starting at startTime which have ended at readTime.
+func readSchedule(startTime int64, periods Periods, readTime int64) sdk.Coins {
+	coins := sdk.NewCoins()
+	endTime := startTime
+
+	for _, period := range periods {
+		endTime += period.Length
+		if readTime < endTime {
+			break
+		}
+
+		coins = coins.Add(period.Amount...)
+	}
+
+	return coins
+}
+
+// capPeriods returns a copy of the schedule in which the running total never
+// exceeds limit. Periods left empty at the end of the schedule are dropped.
+func capPeriods(periods Periods, limit sdk.Coins) Periods {
+	capped := make(Periods, 0, len(periods))
+	total := sdk.NewCoins()
+	prevCapped := sdk.NewCoins()
+
+	for _, period := range periods {
+		total = total.Add(period.Amount...)
+		newCapped := total.Min(limit)
+		capped = append(capped, Period{Length: period.Length, Amount: newCapped.Sub(prevCapped)})
+		prevCapped = newCapped
+	}
+
+	for len(capped) > 0 && capped[len(capped)-1].Amount.IsZero() {
+		capped = capped[:len(capped)-1]
+	}
+
+	return capped
+}
+
 type getPK interface {
 	GetPubKey() cryptotypes.PubKey
 }
@@ -660,6 +660,125 @@ func TestTrackUndelegationPermLockedVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, plva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(16 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.Equal(t, now.Add(24*time.Hour).Unix(), va.GetEndTime())
+
+	// require no coins vested at the beginning of the vesting schedule
+	require.Nil(t, va.GetVestedCoins(now))
+	require.Equal(t, origCoins, va.GetVestingCoins(now))
+	require.Equal(t, origCoins, va.LockedCoins(now))
+
+	// require the first vesting event to be held back by the lockup
+	require.Nil(t, va.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedOnly(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.NewCoins(), va.GetUnlockedOnly(now.Add(12*time.Hour)))
+
+	// require the vested coins to be released once the lockup ends
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedCoins(now.Add(16*time.Hour)))
+
+	// require vesting events to apply once unlocked
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}, va.GetVestedCoins(now.Add(18*time.Hour)))
+
+	// require all coins vested at the end of the schedules
+	require.Equal(t, origCoins, va.GetVestedCoins(now.Add(24*time.Hour)))
+	require.Nil(t, va.GetVestingCoins(now.Add(24*time.Hour)))
+	require.Equal(t, sdk.NewCoins(), va.LockedCoins(now.Add(24*time.Hour)))
+}
+
+func TestComputeClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 600), sdk.NewInt64Coin(stakeDenom, 60)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 400), sdk.NewInt64Coin(stakeDenom, 40)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+
+	// require nothing to claw back once everything vested
+	va2 := *va
+	require.True(t, va2.ComputeClawback(now.Add(18*time.Hour).Unix()).IsZero())
+	require.Equal(t, *va, va2)
+
+	// require the unvested coins to be clawed back, and the lockup capped to
+	// the remaining coins
+	clawback := va.ComputeClawback(now.Add(12 * time.Hour).Unix())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}, clawback)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}, va.OriginalVesting)
+	require.Equal(t, vestingPeriods[:2], types.Periods(va.VestingPeriods))
+	require.Equal(t, types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 600), sdk.NewInt64Coin(stakeDenom, 60)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 150), sdk.NewInt64Coin(stakeDenom, 15)}},
+	}, types.Periods(va.LockupPeriods))
+	require.Equal(t, now.Add(24*time.Hour).Unix(), va.GetEndTime())
+	require.NoError(t, va.Validate())
+
+	// require the remaining coins to keep following the lockup schedule
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 600), sdk.NewInt64Coin(stakeDenom, 60)}, va.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, va.OriginalVesting, va.GetVestedCoins(now.Add(24*time.Hour)))
+}
+
+func TestUpdateDelegationClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	stake := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, amt)) }
+	lockupPeriods := types.Periods{
+		types.Period{Length: 0, Amount: stake(100)},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: stake(50)},
+		types.Period{Length: int64(12 * 60 * 60), Amount: stake(50)},
+	}
+
+	bacc, _ := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	va := types.NewClawbackVestingAccount(bacc, funder, stake(100), now.Unix(), lockupPeriods, vestingPeriods)
+
+	// delegate 80 of the 100 vesting coins
+	va.TrackDelegation(now, stake(100), stake(80))
+	require.Equal(t, stake(80), va.DelegatedVesting)
+
+	clawback := va.ComputeClawback(now.Add(12 * time.Hour).Unix())
+	require.Equal(t, stake(50), clawback)
+
+	// the 20 unbonded coins are clawed back first, 30 delegated coins last
+	encumbered := va.GetVestingCoins(now.Add(12 * time.Hour))
+	clawback = va.UpdateDelegation(encumbered, clawback, stake(80), sdk.NewCoins(), stake(20))
+	require.Equal(t, stake(50), clawback)
+	require.Equal(t, sdk.NewCoins(), va.DelegatedVesting)
+	require.Equal(t, stake(50), va.DelegatedFree)
+
+	// require the clawback to be capped by what is left after slashing
+	va = types.NewClawbackVestingAccount(bacc, funder, stake(100), now.Unix(), lockupPeriods, vestingPeriods)
+	va.TrackDelegation(now, stake(100), stake(100))
+
+	clawback = va.ComputeClawback(now.Unix())
+	require.Equal(t, stake(100), clawback)
+
+	clawback = va.UpdateDelegation(va.GetVestingCoins(now), clawback, stake(60), sdk.NewCoins(), sdk.NewCoins())
+	require.Equal(t, stake(60), clawback)
+	require.Equal(t, sdk.NewCoins(), va.DelegatedVesting)
+	require.Equal(t, stake(40), va.DelegatedFree)
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -725,6 +844,50 @@ func TestGenesisAccountValidate(t *testing.T) {
 			&types.PermanentLockedAccount{BaseVestingAccount: baseVestingWithCoins},
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(50), Amount: initialVesting}},
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			false,
+		},
+		{
+			"invalid clawback vesting period amounts",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(50), Amount: initialVesting}},
+				types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}}),
+			true,
+		},
+		{
+			"invalid clawback lockup period amounts",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(50), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}},
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			true,
+		},
+		{
+			"invalid clawback vesting period denoms",
+			types.NewClawbackVestingAccountRaw(baseVestingWithCoins, addr, 0,
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+				types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 50)}}}),
+			true,
+		},
+		{
+			"invalid clawback vesting end time",
+			types.NewClawbackVestingAccountRaw(baseVestingWithCoins, addr, 0,
+				types.Periods{types.Period{Length: int64(50), Amount: initialVesting}},
+				types.Periods{types.Period{Length: int64(50), Amount: initialVesting}}),
+			true,
+		},
+		{
+			"invalid clawback funder address",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: baseVestingWithCoins,
+				LockupPeriods:      types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+				VestingPeriods:     types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+			},
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -805,6 +968,25 @@ func TestPermanentLockedAccountMarshal(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestClawbackVestingAccountMarshal(t *testing.T) {
+	baseAcc, coins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(),
+		types.Periods{types.Period{3600, coins}}, types.Periods{types.Period{7200, coins}})
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(t, err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(t, err)
+	require.IsType(t, &types.ClawbackVestingAccount{}, acc2)
+	require.Equal(t, acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(t, err)
+}
+
 func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
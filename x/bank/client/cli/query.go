@@ -33,6 +33,8 @@ func GetQueryCmd() *cobra.Command {
 		GetBalancesCmd(),
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
+		GetCmdDenomOwners(),
+		GetCmdQuerySendEnabled(),
 	)
 
 	return cmd
@@ -101,6 +103,103 @@ Example:
 	return cmd
 }
 
+// GetCmdDenomOwners defines the cobra command to query the holders of a coin
+// denomination.
+func GetCmdDenomOwners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-owners [denom]",
+		Short: "Query for all account addresses that own a particular token denomination",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all account addresses that own a particular token denomination, with
+their balance of the denomination.
+
+Example:
+  $ %s query %s denom-owners [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DenomOwners(cmd.Context(), &types.QueryDenomOwnersRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom owners")
+
+	return cmd
+}
+
+// GetCmdQuerySendEnabled defines the cobra command to query the send enabled
+// flags of the denominations.
+func GetCmdQuerySendEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-enabled [denom1 ...]",
+		Short: "Query for the send enabled flags of some or all the denominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the send enabled flags set for the given denominations, or for all
+the denominations having one if none is given. The denominations without a
+flag use the default_send_enabled param.
+
+Example:
+  $ %s query %s send-enabled
+  $ %s query %s send-enabled foocoin barcoin
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SendEnabled(cmd.Context(), &types.QuerySendEnabledRequest{
+				Denoms:     args,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "send enabled entries")
+
+	return cmd
+}
+
 // GetCmdDenomsMetadata defines the cobra command to query client denomination metadata.
 func GetCmdDenomsMetadata() *cobra.Command {
 	cmd := &cobra.Command{
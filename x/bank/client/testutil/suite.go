@@ -361,6 +361,122 @@ func (s *IntegrationTestSuite) TestGetCmdQueryDenomsMetadata() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdDenomOwners() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		respType  proto.Message
+		expected  proto.Message
+	}{
+		{
+			name: "owners of the validator token",
+			args: []string{
+				fmt.Sprintf("%stoken", val.Moniker),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			respType: &types.QueryDenomOwnersResponse{},
+			expected: &types.QueryDenomOwnersResponse{
+				DenomOwners: []*types.DenomOwner{
+					{
+						Address: val.Address.String(),
+						Balance: sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens),
+					},
+				},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			name: "owners of a bogus denom",
+			args: []string{
+				"foobar",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			respType: &types.QueryDenomOwnersResponse{},
+			expected: &types.QueryDenomOwnersResponse{
+				DenomOwners: []*types.DenomOwner{},
+				Pagination:  &query.PageResponse{},
+			},
+		},
+		{
+			name: "invalid denom",
+			args: []string{
+				"1foo",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdDenomOwners()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType))
+				s.Require().Equal(tc.expected, tc.respType)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQuerySendEnabled() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expected proto.Message
+	}{
+		{
+			name: "all send enabled entries",
+			args: []string{
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			expected: &types.QuerySendEnabledResponse{
+				SendEnabled: []types.SendEnabled{},
+				Pagination:  &query.PageResponse{},
+			},
+		},
+		{
+			name: "denominations without entries",
+			args: []string{
+				s.cfg.BondDenom,
+				"foobar",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			expected: &types.QuerySendEnabledResponse{
+				SendEnabled: []types.SendEnabled{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQuerySendEnabled()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+
+			var res types.QuerySendEnabledResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+			s.Require().Equal(tc.expected, &res)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewSendTxCmdGenOnly() {
 	val := s.network.Validators[0]
 
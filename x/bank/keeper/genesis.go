@@ -10,7 +10,10 @@ import (
 
 // InitGenesis initializes the bank module's state from a given genesis state.
 func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	// the send enabled flags of the deprecated params list are moved to the
+	// store by SetParams
 	k.SetParams(ctx, genState.Params)
+	k.SetAllSendEnabled(ctx, genState.SendEnabled)
 
 	totalSupply := sdk.Coins{}
 
@@ -50,5 +53,6 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetAccountsBalances(ctx),
 		totalSupply,
 		k.GetAllDenomMetaData(ctx),
+		k.GetAllSendEnabledEntries(ctx),
 	)
 }
@@ -27,10 +27,12 @@ func (suite *IntegrationTestSuite) TestExportGenesis() {
 			NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, accAddr, expectedBalances[i].Coins))
 	}
 	app.BankKeeper.SetParams(ctx, types.DefaultParams())
+	app.BankKeeper.SetSendEnabled(ctx, "testcoin1", false)
 
 	exportGenesis := app.BankKeeper.ExportGenesis(ctx)
 
 	suite.Require().Len(exportGenesis.Params.SendEnabled, 0)
+	suite.Require().Equal([]types.SendEnabled{{Denom: "testcoin1", Enabled: false}}, exportGenesis.SendEnabled)
 	suite.Require().Equal(types.DefaultParams().DefaultSendEnabled, exportGenesis.Params.DefaultSendEnabled)
 	suite.Require().Equal(totalSupply, exportGenesis.Supply)
 	suite.Require().Equal(expectedBalances, exportGenesis.Balances)
@@ -83,17 +85,17 @@ func (suite *IntegrationTestSuite) TestTotalSupply() {
 	}{
 		{
 			"calculation NOT matching genesis Supply field",
-			types.NewGenesisState(defaultGenesis.Params, balances, sdk.NewCoins(sdk.NewCoin("wrongcoin", sdk.NewInt(1))), defaultGenesis.DenomMetadata),
+			types.NewGenesisState(defaultGenesis.Params, balances, sdk.NewCoins(sdk.NewCoin("wrongcoin", sdk.NewInt(1))), defaultGenesis.DenomMetadata, defaultGenesis.SendEnabled),
 			nil, true, "genesis supply is incorrect, expected 1wrongcoin, got 21barcoin,11foocoin",
 		},
 		{
 			"calculation matches genesis Supply field",
-			types.NewGenesisState(defaultGenesis.Params, balances, totalSupply, defaultGenesis.DenomMetadata),
+			types.NewGenesisState(defaultGenesis.Params, balances, totalSupply, defaultGenesis.DenomMetadata, defaultGenesis.SendEnabled),
 			totalSupply, false, "",
 		},
 		{
 			"calculation is correct, empty genesis Supply field",
-			types.NewGenesisState(defaultGenesis.Params, balances, nil, defaultGenesis.DenomMetadata),
+			types.NewGenesisState(defaultGenesis.Params, balances, nil, defaultGenesis.DenomMetadata, defaultGenesis.SendEnabled),
 			totalSupply, false, "",
 		},
 	}
@@ -112,3 +114,30 @@ func (suite *IntegrationTestSuite) TestTotalSupply() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestInitGenesisSendEnabled() {
+	g := types.DefaultGenesisState()
+	g.Params.SendEnabled = []*types.SendEnabled{types.NewSendEnabled("foocoin", false)}
+	g.SendEnabled = []types.SendEnabled{{Denom: "barcoin", Enabled: false}}
+	suite.Require().NoError(g.Validate())
+	suite.Require().Equal([]types.SendEnabled{
+		{Denom: "foocoin", Enabled: false},
+		{Denom: "barcoin", Enabled: false},
+	}, g.GetAllSendEnabled())
+
+	bk := suite.app.BankKeeper
+	bk.InitGenesis(suite.ctx, g)
+
+	// the flags of the deprecated params list are moved to the store
+	suite.Require().Empty(bk.GetParams(suite.ctx).SendEnabled)
+	suite.Require().False(bk.IsSendEnabledDenom(suite.ctx, "foocoin"))
+	suite.Require().False(bk.IsSendEnabledDenom(suite.ctx, "barcoin"))
+	suite.Require().True(bk.IsSendEnabledDenom(suite.ctx, "bazcoin"))
+
+	exportGenesis := bk.ExportGenesis(suite.ctx)
+	suite.Require().Empty(exportGenesis.Params.SendEnabled)
+	suite.Require().Equal([]types.SendEnabled{
+		{Denom: "barcoin", Enabled: false},
+		{Denom: "foocoin", Enabled: false},
+	}, exportGenesis.SendEnabled)
+}
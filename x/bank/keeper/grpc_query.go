@@ -198,3 +198,72 @@ func (k BaseKeeper) DenomMetadata(c context.Context, req *types.QueryDenomMetada
 		Metadata: metadata,
 	}, nil
 }
+
+// DenomOwners implements the Query/DenomOwners gRPC method
+func (k BaseKeeper) DenomOwners(goCtx context.Context, req *types.QueryDenomOwnersRequest) (*types.QueryDenomOwnersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	denomAddressStore := k.getDenomAddressPrefixStore(ctx, req.Denom)
+
+	var denomOwners []*types.DenomOwner
+	pageRes, err := query.Paginate(denomAddressStore, req.Pagination, func(key, _ []byte) error {
+		// the keys are length-prefixed addresses, as in the balances store
+		address, err := types.AddressFromBalancesStore(key)
+		if err != nil {
+			return err
+		}
+
+		denomOwners = append(denomOwners, &types.DenomOwner{
+			Address: address.String(),
+			Balance: k.GetBalance(ctx, address, req.Denom),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomOwnersResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
+}
+
+// SendEnabled implements the Query/SendEnabled gRPC method
+func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabledRequest) (*types.QuerySendEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &types.QuerySendEnabledResponse{}
+
+	if len(req.Denoms) > 0 {
+		for _, denom := range req.Denoms {
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+		for _, denom := range req.Denoms {
+			if se, ok := k.GetSendEnabledEntry(ctx, denom); ok {
+				resp.SendEnabled = append(resp.SendEnabled, se)
+			}
+		}
+		return resp, nil
+	}
+
+	pageRes, err := query.Paginate(k.getSendEnabledPrefixStore(ctx), req.Pagination, func(key, value []byte) error {
+		resp.SendEnabled = append(resp.SendEnabled, types.SendEnabled{Denom: string(key), Enabled: isSendEnabledValue(value)})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp.Pagination = pageRes
+
+	return resp, nil
+}
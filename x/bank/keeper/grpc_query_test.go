@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -357,3 +360,106 @@ func (suite *IntegrationTestSuite) QueryDenomMetadataRequest() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestQueryDenomOwners() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	_, err := queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{Denom: "1foo"})
+	suite.Require().Error(err)
+
+	addrs := make([]sdk.AccAddress, 5)
+	for i := range addrs {
+		_, _, addrs[i] = testdata.KeyTestPubAddr()
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addrs[i]))
+		coins := sdk.NewCoins(newFooCoin(int64(i + 1)))
+		if i%2 == 0 {
+			coins = coins.Add(newBarCoin(int64(i + 1)))
+		}
+		suite.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, addrs[i], coins))
+	}
+
+	denomOwners := func(denom string, pageReq *query.PageRequest) map[string]sdk.Coin {
+		res, err := queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{
+			Denom:      denom,
+			Pagination: pageReq,
+		})
+		suite.Require().NoError(err)
+		owners := make(map[string]sdk.Coin, len(res.DenomOwners))
+		for _, owner := range res.DenomOwners {
+			owners[owner.Address] = owner.Balance
+		}
+		return owners
+	}
+
+	owners := denomOwners(fooDenom, nil)
+	suite.Require().Len(owners, len(addrs))
+	for i, addr := range addrs {
+		suite.Require().Equal(newFooCoin(int64(i+1)), owners[addr.String()])
+	}
+
+	owners = denomOwners(barDenom, nil)
+	suite.Require().Len(owners, 3)
+	suite.Require().Equal(newBarCoin(3), owners[addrs[2].String()])
+	suite.Require().NotContains(owners, addrs[1].String())
+
+	res, err := queryClient.DenomOwners(gocontext.Background(), &types.QueryDenomOwnersRequest{
+		Denom:      fooDenom,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.DenomOwners, 2)
+	suite.Require().Equal(uint64(len(addrs)), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	// the accounts sending all their balance of a denomination are no longer owners
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addrs[0], addrs[1], sdk.NewCoins(newBarCoin(1))))
+	owners = denomOwners(barDenom, nil)
+	suite.Require().Len(owners, 3)
+	suite.Require().NotContains(owners, addrs[0].String())
+	suite.Require().Equal(newBarCoin(1), owners[addrs[1].String()])
+
+	suite.Require().Empty(denomOwners("baz", nil))
+}
+
+func (suite *IntegrationTestSuite) TestQuerySendEnabled() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	app.BankKeeper.SetSendEnabled(ctx, "barcoin", true)
+	app.BankKeeper.SetSendEnabled(ctx, "bazcoin", false)
+	app.BankKeeper.SetSendEnabled(ctx, "foocoin", false)
+
+	res, err := queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SendEnabled{
+		{Denom: "barcoin", Enabled: true},
+		{Denom: "bazcoin", Enabled: false},
+		{Denom: "foocoin", Enabled: false},
+	}, res.SendEnabled)
+
+	res, err = queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.SendEnabled, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	// the denominations without a flag are not returned
+	res, err = queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{
+		Denoms: []string{"foocoin", "othercoin", "barcoin"},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SendEnabled{
+		{Denom: "foocoin", Enabled: false},
+		{Denom: "barcoin", Enabled: true},
+	}, res.SendEnabled)
+	suite.Require().Nil(res.Pagination)
+
+	_, err = queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{
+		Denoms: []string{"foocoin", "0foo"},
+	})
+	suite.Require().Error(err)
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
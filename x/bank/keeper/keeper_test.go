@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -20,7 +21,9 @@ import (
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 const (
@@ -87,7 +90,7 @@ func (suite *IntegrationTestSuite) initKeepersWithmAccPerms(blockedAddrs map[str
 	)
 	keeper := keeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), blockedAddrs,
+		app.GetSubspace(types.ModuleName), blockedAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	return authKeeper, keeper
@@ -522,6 +525,104 @@ func (suite *IntegrationTestSuite) TestSendEnabled() {
 	suite.Require().Error(err)
 }
 
+func (suite *IntegrationTestSuite) TestUpdateDeprecatedSendEnabledParam() {
+	app, ctx := suite.app, suite.ctx
+	subspace := app.GetSubspace(types.ModuleName)
+
+	// a param change proposal cannot set the deprecated param, it would have no effect
+	err := subspace.Update(ctx, types.KeySendEnabled, []byte(`[{"denom":"foocoin","enabled":false}]`))
+	suite.Require().Error(err)
+	suite.Require().True(app.BankKeeper.IsSendEnabledDenom(ctx, "foocoin"))
+	suite.Require().Empty(app.BankKeeper.GetParams(ctx).SendEnabled)
+
+	suite.Require().NoError(subspace.Update(ctx, types.KeySendEnabled, []byte(`[]`)))
+	suite.Require().NoError(subspace.Update(ctx, types.KeyDefaultSendEnabled, []byte(`false`)))
+	suite.Require().False(app.BankKeeper.IsSendEnabledDenom(ctx, "foocoin"))
+}
+
+func (suite *IntegrationTestSuite) TestSendEnabledEntries() {
+	app, ctx := suite.app, suite.ctx
+
+	_, found := app.BankKeeper.GetSendEnabledEntry(ctx, "foocoin")
+	suite.Require().False(found)
+	suite.Require().Empty(app.BankKeeper.GetAllSendEnabledEntries(ctx))
+
+	app.BankKeeper.SetSendEnabled(ctx, "foocoin", false)
+	app.BankKeeper.SetAllSendEnabled(ctx, []types.SendEnabled{
+		{Denom: "barcoin", Enabled: true},
+		{Denom: "bazcoin", Enabled: false},
+	})
+
+	se, found := app.BankKeeper.GetSendEnabledEntry(ctx, "foocoin")
+	suite.Require().True(found)
+	suite.Require().Equal(types.SendEnabled{Denom: "foocoin", Enabled: false}, se)
+	suite.Require().False(app.BankKeeper.IsSendEnabledDenom(ctx, "foocoin"))
+	suite.Require().True(app.BankKeeper.IsSendEnabledDenom(ctx, "barcoin"))
+	suite.Require().True(app.BankKeeper.IsSendEnabledDenom(ctx, "otherCoin"))
+	suite.Require().Equal([]types.SendEnabled{
+		{Denom: "barcoin", Enabled: true},
+		{Denom: "bazcoin", Enabled: false},
+		{Denom: "foocoin", Enabled: false},
+	}, app.BankKeeper.GetAllSendEnabledEntries(ctx))
+
+	var denoms []string
+	app.BankKeeper.IterateSendEnabledEntries(ctx, func(denom string, _ bool) bool {
+		denoms = append(denoms, denom)
+		return len(denoms) == 2
+	})
+	suite.Require().Equal([]string{"barcoin", "bazcoin"}, denoms)
+
+	// the denominations without a flag use the default
+	app.BankKeeper.DeleteSendEnabled(ctx, "foocoin", "bazcoin")
+	_, found = app.BankKeeper.GetSendEnabledEntry(ctx, "foocoin")
+	suite.Require().False(found)
+	suite.Require().True(app.BankKeeper.IsSendEnabledDenom(ctx, "foocoin"))
+	suite.Require().NoError(app.BankKeeper.IsSendEnabledCoins(ctx, sdk.NewInt64Coin("foocoin", 1), sdk.NewInt64Coin("bazcoin", 1)))
+
+	params := app.BankKeeper.GetParams(ctx)
+	params.DefaultSendEnabled = false
+	app.BankKeeper.SetParams(ctx, params)
+	suite.Require().False(app.BankKeeper.IsSendEnabledDenom(ctx, "foocoin"))
+	suite.Require().True(app.BankKeeper.IsSendEnabledDenom(ctx, "barcoin"))
+
+	// the flags of the deprecated params list are moved to the store
+	params.SendEnabled = []*types.SendEnabled{types.NewSendEnabled("foocoin", true)}
+	app.BankKeeper.SetParams(ctx, params)
+	suite.Require().Empty(app.BankKeeper.GetParams(ctx).SendEnabled)
+	suite.Require().Equal([]types.SendEnabled{
+		{Denom: "barcoin", Enabled: true},
+		{Denom: "foocoin", Enabled: true},
+	}, app.BankKeeper.GetAllSendEnabledEntries(ctx))
+}
+
+func (suite *IntegrationTestSuite) TestMsgSetSendEnabled() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	suite.Require().Equal(authority, app.BankKeeper.GetAuthority())
+
+	app.BankKeeper.SetSendEnabled(ctx, "bazcoin", false)
+
+	_, err := msgServer.SetSendEnabled(sdk.WrapSDKContext(ctx), types.NewMsgSetSendEnabled(
+		sdk.AccAddress("addr1_______________").String(),
+		[]types.SendEnabled{{Denom: "foocoin", Enabled: false}},
+		nil,
+	))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().True(app.BankKeeper.IsSendEnabledDenom(ctx, "foocoin"))
+
+	_, err = msgServer.SetSendEnabled(sdk.WrapSDKContext(ctx), types.NewMsgSetSendEnabled(
+		authority,
+		[]types.SendEnabled{{Denom: "foocoin", Enabled: false}, {Denom: "barcoin", Enabled: true}},
+		[]string{"bazcoin"},
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SendEnabled{
+		{Denom: "barcoin", Enabled: true},
+		{Denom: "foocoin", Enabled: false},
+	}, app.BankKeeper.GetAllSendEnabledEntries(ctx))
+}
+
 func (suite *IntegrationTestSuite) TestHasBalance() {
 	app, ctx := suite.app, suite.ctx
 	addr := sdk.AccAddress([]byte("addr1_______________"))
@@ -1047,7 +1148,7 @@ func (suite *IntegrationTestSuite) TestBalanceTrackingEvents() {
 	)
 
 	suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil)
+		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	// set account with multiple permissions
 	suite.app.AccountKeeper.SetModuleAccount(suite.ctx, multiPermAcc)
@@ -1161,6 +1262,96 @@ func (suite *IntegrationTestSuite) getTestMetadata() []types.Metadata {
 	}
 }
 
+func (suite *IntegrationTestSuite) TestSendRestrictions() {
+	app, ctx := suite.app, suite.ctx
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	frozenAddr := sdk.AccAddress([]byte("frozen______________"))
+	fromAddr := sdk.AccAddress([]byte("from________________"))
+	toAddr := sdk.AccAddress([]byte("to__________________"))
+	suite.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, addr1, sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+	suite.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, frozenAddr, sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+
+	// the restrictions apply to the copies of the keeper made before they are added
+	bankKeeper := app.BankKeeper
+
+	errFrozen := fmt.Errorf("frozen")
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		if (from.Equals(frozenAddr) || to.Equals(frozenAddr)) && !amt.AmountOf(fooDenom).IsZero() {
+			return to, errFrozen
+		}
+		return to, nil
+	})
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, to sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if to.Equals(fromAddr) {
+			return toAddr, nil
+		}
+		return to, nil
+	})
+
+	suite.Require().ErrorIs(bankKeeper.SendCoins(ctx, addr1, frozenAddr, sdk.NewCoins(newFooCoin(10))), errFrozen)
+	suite.Require().ErrorIs(bankKeeper.SendCoins(ctx, frozenAddr, addr1, sdk.NewCoins(newFooCoin(10), newBarCoin(10))), errFrozen)
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, frozenAddr, addr1, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().Equal(newFooCoin(100), bankKeeper.GetBalance(ctx, frozenAddr, fooDenom))
+
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, addr1, fromAddr, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().True(bankKeeper.GetAllBalances(ctx, fromAddr).Empty())
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), bankKeeper.GetAllBalances(ctx, toAddr))
+	suite.Require().True(app.AccountKeeper.HasAccount(ctx, toAddr))
+	suite.Require().False(app.AccountKeeper.HasAccount(ctx, fromAddr))
+
+	// the sends from the modules are restricted too
+	suite.Require().NoError(simapp.FundModuleAccount(app.BankKeeper, ctx, minttypes.ModuleName, sdk.NewCoins(newFooCoin(100))))
+	suite.Require().ErrorIs(bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, frozenAddr, sdk.NewCoins(newFooCoin(10))), errFrozen)
+
+	// the restrictions apply between each input and output, to the coins of the output the input sends
+	inputs := []types.Input{
+		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: frozenAddr.String(), Coins: sdk.NewCoins(newBarCoin(10))},
+	}
+	outputs := []types.Output{
+		{Address: fromAddr.String(), Coins: sdk.NewCoins(newFooCoin(10), newBarCoin(10))},
+	}
+	suite.Require().NoError(bankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(20), newBarCoin(10)), bankKeeper.GetAllBalances(ctx, toAddr))
+
+	inputs[1].Coins = sdk.NewCoins(newFooCoin(5), newBarCoin(5))
+	outputs[0].Coins = sdk.NewCoins(newFooCoin(15), newBarCoin(5))
+	suite.Require().ErrorIs(bankKeeper.InputOutputCoins(ctx, inputs, outputs), errFrozen)
+
+	// the delegations and undelegations are restricted, but cannot be redirected
+	suite.Require().ErrorIs(bankKeeper.DelegateCoinsFromAccountToModule(ctx, frozenAddr, stakingtypes.BondedPoolName, sdk.NewCoins(newFooCoin(10))), errFrozen)
+	suite.Require().NoError(bankKeeper.DelegateCoinsFromAccountToModule(ctx, addr1, stakingtypes.BondedPoolName, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().ErrorIs(bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, stakingtypes.BondedPoolName, fromAddr, sdk.NewCoins(newFooCoin(10))), sdkerrors.ErrUnauthorized)
+	suite.Require().NoError(bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, stakingtypes.BondedPoolName, addr1, sdk.NewCoins(newFooCoin(10))))
+
+	// the prepended restrictions are applied first
+	errPrepended := fmt.Errorf("prepended")
+	app.BankKeeper.PrependSendRestriction(func(_ sdk.Context, _, to sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return to, errPrepended
+	})
+	suite.Require().ErrorIs(bankKeeper.SendCoins(ctx, addr1, frozenAddr, sdk.NewCoins(newFooCoin(10))), errPrepended)
+
+	app.BankKeeper.ClearSendRestriction()
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, addr1, frozenAddr, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal(newFooCoin(110), bankKeeper.GetBalance(ctx, frozenAddr, fooDenom))
+
+	// the transfers cannot be redirected to a blocked address
+	mintAddr := authtypes.NewModuleAddress(minttypes.ModuleName)
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, to sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if to.Equals(frozenAddr) {
+			return mintAddr, nil
+		}
+		return to, nil
+	})
+	suite.Require().ErrorIs(bankKeeper.SendCoins(ctx, addr1, frozenAddr, sdk.NewCoins(newFooCoin(10))), sdkerrors.ErrUnauthorized)
+	inputs = []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(10))}}
+	outputs = []types.Output{{Address: frozenAddr.String(), Coins: sdk.NewCoins(newFooCoin(10))}}
+	suite.Require().ErrorIs(bankKeeper.InputOutputCoins(ctx, inputs, outputs), sdkerrors.ErrUnauthorized)
+	suite.Require().Equal(newFooCoin(110), bankKeeper.GetBalance(ctx, frozenAddr, fooDenom))
+	app.BankKeeper.ClearSendRestriction()
+}
+
 func (suite *IntegrationTestSuite) TestMintCoinRestrictions() {
 	type BankMintingRestrictionFn func(ctx sdk.Context, coins sdk.Coins) error
 
@@ -1208,7 +1399,7 @@ func (suite *IntegrationTestSuite) TestMintCoinRestrictions() {
 
 	for _, test := range tests {
 		suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-			suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil).WithMintCoinsRestriction(keeper.MintingRestrictionFn(test.restrictionFn))
+			suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil, authtypes.NewModuleAddress(govtypes.ModuleName).String()).WithMintCoinsRestriction(keeper.MintingRestrictionFn(test.restrictionFn))
 		for _, testCase := range test.testCases {
 			if testCase.expectPass {
 				suite.Require().NoError(
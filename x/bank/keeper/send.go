@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -23,7 +25,20 @@ type SendKeeper interface {
 	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
+	IsSendEnabledDenom(ctx sdk.Context, denom string) bool
+	GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool)
+	SetSendEnabled(ctx sdk.Context, denom string, value bool)
+	SetAllSendEnabled(ctx sdk.Context, sendEnableds []types.SendEnabled)
+	DeleteSendEnabled(ctx sdk.Context, denoms ...string)
+	IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, sendEnabled bool) (stop bool))
+	GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled
+
 	BlockedAddr(addr sdk.AccAddress) bool
+	GetAuthority() string
+
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -40,35 +55,71 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// the address allowed to set the send enabled flags, usually the gov
+	// module account
+	authority string
+
+	sendRestriction *sendRestriction
 }
 
 func NewBaseSendKeeper(
 	cdc codec.BinaryCodec, storeKey sdk.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blockedAddrs map[string]bool,
+	authority string,
 ) BaseSendKeeper {
 	return BaseSendKeeper{
-		BaseViewKeeper: NewBaseViewKeeper(cdc, storeKey, ak),
-		cdc:            cdc,
-		ak:             ak,
-		storeKey:       storeKey,
-		paramSpace:     paramSpace,
-		blockedAddrs:   blockedAddrs,
+		BaseViewKeeper:  NewBaseViewKeeper(cdc, storeKey, ak),
+		cdc:             cdc,
+		ak:              ak,
+		storeKey:        storeKey,
+		paramSpace:      paramSpace,
+		blockedAddrs:    blockedAddrs,
+		authority:       authority,
+		sendRestriction: &sendRestriction{},
 	}
 }
 
+// AppendSendRestriction adds a restriction applied to the transfers of coins
+// after the existing ones. The restrictions are shared by all the copies of the
+// keeper, including the ones already given to the other modules.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.append(restriction)
+}
+
+// PrependSendRestriction adds a restriction applied to the transfers of coins
+// before the existing ones.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.prepend(restriction)
+}
+
+// ClearSendRestriction removes all the restrictions applied to the transfers
+// of coins.
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendRestriction.clear()
+}
+
 // GetParams returns the total set of bank parameters.
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
 	return params
 }
 
-// SetParams sets the total set of bank parameters.
+// SetParams sets the total set of bank parameters. The send enabled flags of
+// the deprecated SendEnabled list are moved to the store.
 func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
+	if len(params.SendEnabled) > 0 {
+		for _, se := range params.SendEnabled {
+			k.SetSendEnabled(ctx, se.Denom, se.Enabled)
+		}
+		params.SendEnabled = types.SendEnabledParams{}
+	}
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
-// inputs and outputs don't lineup or if any single transfer of tokens fails.
+// inputs and outputs don't lineup, if any single transfer of tokens fails or
+// if a send restriction rejects a transfer.
 func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -76,61 +127,98 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
-	for _, in := range inputs {
+	inAddresses := make([]sdk.AccAddress, len(inputs))
+	for i, in := range inputs {
 		inAddress, err := sdk.AccAddressFromBech32(in.Address)
 		if err != nil {
 			return err
 		}
+		inAddresses[i] = inAddress
+	}
 
-		err = k.subUnlockedCoins(ctx, inAddress, in.Coins)
+	outAddresses := make([]sdk.AccAddress, len(outputs))
+	for i, out := range outputs {
+		outAddress, err := sdk.AccAddressFromBech32(out.Address)
 		if err != nil {
 			return err
 		}
-
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				sdk.EventTypeMessage,
-				sdk.NewAttribute(types.AttributeKeySender, in.Address),
-			),
-		)
+		outAddresses[i] = outAddress
 	}
 
-	for _, out := range outputs {
-		outAddress, err := sdk.AccAddressFromBech32(out.Address)
+	// The send restrictions are applied to the coins each input sends to each
+	// output, before any transfer.
+	transfers := matchInputsOutputs(inputs, outputs)
+	for i, t := range transfers {
+		toAddr, err := k.applySendRestriction(ctx, inAddresses[t.in], outAddresses[t.out], t.coins)
 		if err != nil {
 			return err
 		}
-		err = k.addCoins(ctx, outAddress, out.Coins)
+		transfers[i].toAddr = toAddr
+	}
+
+	for i, in := range inputs {
+		err := k.subUnlockedCoins(ctx, inAddresses[i], in.Coins)
 		if err != nil {
 			return err
 		}
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
-				types.EventTypeTransfer,
-				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address),
-				sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(types.AttributeKeySender, in.Address),
 			),
 		)
+	}
 
-		// Create account if recipient does not exist.
-		//
-		// NOTE: This should ultimately be removed in favor a more flexible approach
-		// such as delegated fee messages.
-		accExists := k.ak.HasAccount(ctx, outAddress)
-		if !accExists {
-			defer telemetry.IncrCounter(1, "new", "account")
-			k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, outAddress))
+	for i := range outputs {
+		// the coins of an output go to its address, unless a send restriction
+		// redirected some of them
+		var recipients []recipient
+		for _, t := range transfers {
+			if t.out == i {
+				recipients = addRecipient(recipients, t.toAddr, t.coins)
+			}
+		}
+
+		for _, r := range recipients {
+			err := k.addCoins(ctx, r.addr, r.coins)
+			if err != nil {
+				return err
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeTransfer,
+					sdk.NewAttribute(types.AttributeKeyRecipient, r.addr.String()),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, r.coins.String()),
+				),
+			)
+
+			// Create account if recipient does not exist.
+			//
+			// NOTE: This should ultimately be removed in favor a more flexible approach
+			// such as delegated fee messages.
+			accExists := k.ak.HasAccount(ctx, r.addr)
+			if !accExists {
+				defer telemetry.IncrCounter(1, "new", "account")
+				k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, r.addr))
+			}
 		}
 	}
 
 	return nil
 }
 
-// SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// SendCoins transfers amt coins from a sending account to a receiving account,
+// or to the account the send restrictions redirect them to. An error is
+// returned upon failure, or if a send restriction rejects the transfer.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
-	err := k.subUnlockedCoins(ctx, fromAddr, amt)
+	toAddr, err := k.applySendRestriction(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -240,6 +328,8 @@ func (k BaseSendKeeper) initBalances(ctx sdk.Context, addr sdk.AccAddress, balan
 		if !balance.IsZero() {
 			bz := k.cdc.MustMarshal(&balance)
 			accountStore.Set([]byte(balance.Denom), bz)
+
+			k.getDenomAddressPrefixStore(ctx, balance.Denom).Set(address.MustLengthPrefix(addr), []byte{0})
 		}
 	}
 
@@ -253,13 +343,22 @@ func (k BaseSendKeeper) setBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 	}
 
 	accountStore := k.getAccountStore(ctx, addr)
+	denomAddressStore := k.getDenomAddressPrefixStore(ctx, balance.Denom)
+	denomAddressKey := address.MustLengthPrefix(addr)
 
 	// Bank invariants require to not store zero balances.
 	if balance.IsZero() {
 		accountStore.Delete([]byte(balance.Denom))
+		denomAddressStore.Delete(denomAddressKey)
 	} else {
 		bz := k.cdc.MustMarshal(&balance)
 		accountStore.Set([]byte(balance.Denom), bz)
+
+		// the reverse index from the denomination to the address holds a
+		// sentinel value, only written when the account starts holding it
+		if !denomAddressStore.Has(denomAddressKey) {
+			denomAddressStore.Set(denomAddressKey, []byte{0})
+		}
 	}
 
 	return nil
@@ -269,8 +368,14 @@ func (k BaseSendKeeper) setBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 // any of the coins are not configured for sending.  Returns nil if sending is enabled
 // for all provided coin
 func (k BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error {
+	if len(coins) == 0 {
+		return nil
+	}
+
+	store := k.getSendEnabledPrefixStore(ctx)
+	defaultVal := k.getDefaultSendEnabled(ctx)
 	for _, coin := range coins {
-		if !k.IsSendEnabledCoin(ctx, coin) {
+		if !getSendEnabledOrDefault(store, coin.Denom, defaultVal) {
 			return sdkerrors.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", coin.Denom)
 		}
 	}
@@ -279,7 +384,69 @@ func (k BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) e
 
 // IsSendEnabledCoin returns the current SendEnabled status of the provided coin's denom
 func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
-	return k.GetParams(ctx).SendEnabledDenom(coin.Denom)
+	return k.IsSendEnabledDenom(ctx, coin.Denom)
+}
+
+// IsSendEnabledDenom returns the send enabled flag of a denomination, or the
+// default_send_enabled param if it has none.
+func (k BaseSendKeeper) IsSendEnabledDenom(ctx sdk.Context, denom string) bool {
+	return getSendEnabledOrDefault(k.getSendEnabledPrefixStore(ctx), denom, k.getDefaultSendEnabled(ctx))
+}
+
+// GetSendEnabledEntry returns the send enabled flag of a denomination, and
+// false if it has none.
+func (k BaseSendKeeper) GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool) {
+	bz := k.getSendEnabledPrefixStore(ctx).Get([]byte(denom))
+	if bz == nil {
+		return types.SendEnabled{}, false
+	}
+	return types.SendEnabled{Denom: denom, Enabled: isSendEnabledValue(bz)}, true
+}
+
+// SetSendEnabled sets the send enabled flag of a denomination.
+func (k BaseSendKeeper) SetSendEnabled(ctx sdk.Context, denom string, value bool) {
+	k.getSendEnabledPrefixStore(ctx).Set([]byte(denom), sendEnabledValue(value))
+}
+
+// SetAllSendEnabled sets the send enabled flags of several denominations.
+func (k BaseSendKeeper) SetAllSendEnabled(ctx sdk.Context, sendEnableds []types.SendEnabled) {
+	store := k.getSendEnabledPrefixStore(ctx)
+	for _, se := range sendEnableds {
+		store.Set([]byte(se.Denom), sendEnabledValue(se.Enabled))
+	}
+}
+
+// DeleteSendEnabled deletes the send enabled flags of some denominations, which
+// then use the default_send_enabled param.
+func (k BaseSendKeeper) DeleteSendEnabled(ctx sdk.Context, denoms ...string) {
+	store := k.getSendEnabledPrefixStore(ctx)
+	for _, denom := range denoms {
+		store.Delete([]byte(denom))
+	}
+}
+
+// IterateSendEnabledEntries iterates over the send enabled flags of the
+// denominations, by denomination, until cb returns true.
+func (k BaseSendKeeper) IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, sendEnabled bool) (stop bool)) {
+	iterator := k.getSendEnabledPrefixStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key()), isSendEnabledValue(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetAllSendEnabledEntries returns the send enabled flags of all the
+// denominations having one.
+func (k BaseSendKeeper) GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled {
+	var sendEnableds []types.SendEnabled
+	k.IterateSendEnabledEntries(ctx, func(denom string, sendEnabled bool) bool {
+		sendEnableds = append(sendEnableds, types.SendEnabled{Denom: denom, Enabled: sendEnabled})
+		return false
+	})
+	return sendEnableds
 }
 
 // BlockedAddr checks if a given address is restricted from
@@ -287,3 +454,174 @@ func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
 func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
 	return k.blockedAddrs[addr.String()]
 }
+
+// GetAuthority returns the address allowed to set the send enabled flags.
+func (k BaseSendKeeper) GetAuthority() string {
+	return k.authority
+}
+
+// getSendEnabledPrefixStore returns the store of the send enabled flags, by
+// denomination.
+func (k BaseSendKeeper) getSendEnabledPrefixStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.SendEnabledPrefix)
+}
+
+// getDefaultSendEnabled returns the default_send_enabled param.
+func (k BaseSendKeeper) getDefaultSendEnabled(ctx sdk.Context) bool {
+	var defaultVal bool
+	k.paramSpace.Get(ctx, types.KeyDefaultSendEnabled, &defaultVal)
+	return defaultVal
+}
+
+// getSendEnabledOrDefault returns the send enabled flag of a denomination in
+// the send enabled store, or defaultVal if it has none.
+func getSendEnabledOrDefault(store prefix.Store, denom string, defaultVal bool) bool {
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return defaultVal
+	}
+	return isSendEnabledValue(bz)
+}
+
+// sendEnabledValue returns the value stored for a send enabled flag.
+func sendEnabledValue(enabled bool) []byte {
+	if enabled {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+// isSendEnabledValue returns the send enabled flag of a stored value.
+func isSendEnabledValue(bz []byte) bool {
+	return len(bz) == 1 && bz[0] == 1
+}
+
+// inputOutputTransfer holds the coins an input of a multi-send sends to an
+// output, and the address they are sent to once the send restrictions are
+// applied.
+type inputOutputTransfer struct {
+	in, out int
+	coins   sdk.Coins
+	toAddr  sdk.AccAddress
+}
+
+// recipient holds the coins an address receives from an output of a
+// multi-send.
+type recipient struct {
+	addr  sdk.AccAddress
+	coins sdk.Coins
+}
+
+// addRecipient returns the recipients with the coins added to the ones of addr.
+func addRecipient(recipients []recipient, addr sdk.AccAddress, coins sdk.Coins) []recipient {
+	for i := range recipients {
+		if recipients[i].addr.Equals(addr) {
+			recipients[i].coins = recipients[i].coins.Add(coins...)
+			return recipients
+		}
+	}
+
+	return append(recipients, recipient{addr: addr, coins: coins})
+}
+
+// matchInputsOutputs returns the coins each input sends to each output. The
+// coins of the outputs are taken, in order, from the remaining coins of the
+// inputs, in order, so that each input sends exactly its coins and each output
+// receives exactly its coins. The inputs and outputs must be valid.
+func matchInputsOutputs(inputs []types.Input, outputs []types.Output) []inputOutputTransfer {
+	remaining := make([]sdk.Coins, len(inputs))
+	for i, in := range inputs {
+		remaining[i] = in.Coins
+	}
+
+	var transfers []inputOutputTransfer
+	for i, out := range outputs {
+		needed := out.Coins
+		for j := range inputs {
+			if needed.Empty() {
+				break
+			}
+			amt := commonCoins(remaining[j], needed)
+			if amt.Empty() {
+				continue
+			}
+			remaining[j] = remaining[j].Sub(amt)
+			needed = needed.Sub(amt)
+			transfers = append(transfers, inputOutputTransfer{in: j, out: i, coins: amt})
+		}
+	}
+
+	return transfers
+}
+
+// commonCoins returns the coins of b whose denominations are in a, with the
+// smallest of their amounts in a and b.
+func commonCoins(a, b sdk.Coins) sdk.Coins {
+	var common sdk.Coins
+	for _, coin := range b {
+		amt := sdk.MinInt(a.AmountOf(coin.Denom), coin.Amount)
+		if amt.IsPositive() {
+			common = append(common, sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+	return common
+}
+
+// applySendRestriction applies the send restrictions to a transfer, returning
+// the address of the recipient. The restrictions may not redirect the coins to
+// a blocked address.
+func (k BaseSendKeeper) applySendRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	newToAddr, err := k.sendRestriction.apply(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+
+	if !newToAddr.Equals(toAddr) && k.BlockedAddr(newToAddr) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", newToAddr)
+	}
+
+	return newToAddr, nil
+}
+
+// applyFixedSendRestriction applies the send restrictions to a transfer whose
+// recipient cannot change, e.g. a delegation to the bonded pool, which is
+// rejected if a restriction redirects it.
+func (k BaseSendKeeper) applyFixedSendRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	newToAddr, err := k.sendRestriction.apply(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	if !newToAddr.Equals(toAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "the transfer to %s cannot be redirected", toAddr)
+	}
+
+	return nil
+}
+
+// sendRestriction holds the send restriction of a keeper. It is shared by the
+// copies of the keeper through a pointer.
+type sendRestriction struct {
+	fn types.SendRestrictionFn
+}
+
+func (r *sendRestriction) append(restriction types.SendRestrictionFn) {
+	r.fn = r.fn.Then(restriction)
+}
+
+func (r *sendRestriction) prepend(restriction types.SendRestrictionFn) {
+	r.fn = restriction.Then(r.fn)
+}
+
+func (r *sendRestriction) clear() {
+	r.fn = nil
+}
+
+// apply applies the restriction to a transfer, returning the address of the
+// recipient.
+func (r *sendRestriction) apply(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if r == nil || r.fn == nil {
+		return toAddr, nil
+	}
+	return r.fn(ctx, fromAddr, toAddr, amt)
+}
@@ -231,3 +231,9 @@ func (k BaseViewKeeper) getAccountStore(ctx sdk.Context, addr sdk.AccAddress) pr
 
 	return prefix.NewStore(store, types.CreateAccountBalancesPrefix(addr))
 }
+
+// getDenomAddressPrefixStore returns the store of the reverse index from the
+// given denomination to the length-prefixed addresses of its holders.
+func (k BaseViewKeeper) getDenomAddressPrefixStore(ctx sdk.Context, denom string) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateDenomAddressPrefix(denom))
+}
@@ -77,6 +77,7 @@ func TestMigrateJSON(t *testing.T) {
 		"default_send_enabled": false,
 		"send_enabled": []
 	},
+	"send_enabled": [],
 	"supply": [
 		{
 			"amount": "20",
@@ -0,0 +1,22 @@
+package v046
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "bank"
+)
+
+// KVStore keys
+var (
+	BalancesPrefix     = []byte{0x02}
+	DenomAddressPrefix = []byte{0x03}
+	SendEnabledPrefix  = []byte{0x04}
+)
+
+// CreateDenomAddressPrefix creates the prefix of the reverse index from a
+// denomination to the addresses of the accounts holding it.
+func CreateDenomAddressPrefix(denom string) []byte {
+	key := make([]byte, len(DenomAddressPrefix)+len(denom)+1)
+	copy(key, DenomAddressPrefix)
+	copy(key[len(DenomAddressPrefix):], denom)
+	return key
+}
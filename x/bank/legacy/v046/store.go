@@ -0,0 +1,69 @@
+package v046
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.46. The
+// migration includes:
+//
+//   - Add the reverse index from the denominations to the addresses of the
+//     accounts holding them.
+//   - Move the send enabled flags of the SendEnabled param to the store.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace) error {
+	store := ctx.KVStore(storeKey)
+	if err := addDenomReverseIndex(store); err != nil {
+		return fmt.Errorf("failed to add denom reverse index: %w", err)
+	}
+
+	migrateSendEnabled(ctx, store, paramSpace)
+
+	return nil
+}
+
+// migrateSendEnabled moves the send enabled flags of the SendEnabled param to
+// the send enabled store, and empties the param.
+func migrateSendEnabled(ctx sdk.Context, store sdk.KVStore, paramSpace paramtypes.Subspace) {
+	var sendEnabled []*types.SendEnabled
+	paramSpace.GetIfExists(ctx, types.KeySendEnabled, &sendEnabled)
+
+	sendEnabledStore := prefix.NewStore(store, SendEnabledPrefix)
+	for _, se := range sendEnabled {
+		value := []byte{0}
+		if se.Enabled {
+			value = []byte{1}
+		}
+		sendEnabledStore.Set([]byte(se.Denom), value)
+	}
+
+	paramSpace.Set(ctx, types.KeySendEnabled, []*types.SendEnabled{})
+}
+
+// addDenomReverseIndex indexes the address of every balance under its
+// denomination. The zero balances are already pruned from the store.
+func addDenomReverseIndex(store sdk.KVStore) error {
+	balancesStore := prefix.NewStore(store, BalancesPrefix)
+
+	iterator := balancesStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the length-prefixed address followed by the denomination
+		addr, err := types.AddressFromBalancesStore(iterator.Key())
+		if err != nil {
+			return err
+		}
+		denom := string(iterator.Key()[1+len(addr):])
+
+		denomAddressStore := prefix.NewStore(store, CreateDenomAddressPrefix(denom))
+		denomAddressStore.Set(address.MustLengthPrefix(addr), []byte{0})
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	v046bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v046"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	bankKey := sdk.NewKVStoreKey("bank")
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(bankKey, tKey)
+	store := ctx.KVStore(bankKey)
+
+	// The params are stored under the bank store key, which is enough for
+	// testing the migration.
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, bankKey, tKey, "bank").WithKeyTable(types.ParamKeyTable())
+	paramSpace.Set(ctx, types.KeySendEnabled, []*types.SendEnabled{
+		types.NewSendEnabled("foo", false),
+		types.NewSendEnabled("bar", true),
+	})
+	paramSpace.Set(ctx, types.KeyDefaultSendEnabled, true)
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	balances := map[string]sdk.Coins{
+		addr1.String(): sdk.NewCoins(sdk.NewInt64Coin("foo", 10), sdk.NewInt64Coin("foobar", 20)),
+		addr2.String(): sdk.NewCoins(sdk.NewInt64Coin("foo", 30)),
+	}
+	for _, addr := range []sdk.AccAddress{addr1, addr2} {
+		accountStore := prefix.NewStore(store, types.CreateAccountBalancesPrefix(addr))
+		for _, coin := range balances[addr.String()] {
+			coin := coin
+			accountStore.Set([]byte(coin.Denom), encCfg.Marshaler.MustMarshal(&coin))
+		}
+	}
+
+	require.NoError(t, v046bank.MigrateStore(ctx, bankKey, paramSpace))
+
+	owners := func(denom string) []sdk.AccAddress {
+		var addrs []sdk.AccAddress
+		iterator := prefix.NewStore(store, types.CreateDenomAddressPrefix(denom)).Iterator(nil, nil)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			addr, err := types.AddressFromBalancesStore(iterator.Key())
+			require.NoError(t, err)
+			require.Equal(t, address.MustLengthPrefix(addr), iterator.Key())
+			addrs = append(addrs, addr)
+		}
+		return addrs
+	}
+
+	require.ElementsMatch(t, []sdk.AccAddress{addr1, addr2}, owners("foo"))
+	require.Equal(t, []sdk.AccAddress{addr1}, owners("foobar"))
+	require.Empty(t, owners("bar"))
+
+	var params types.Params
+	paramSpace.GetParamSet(ctx, &params)
+	require.Empty(t, params.SendEnabled)
+	require.True(t, params.DefaultSendEnabled)
+
+	sendEnabledStore := prefix.NewStore(store, types.SendEnabledPrefix)
+	require.Equal(t, []byte{0}, sendEnabledStore.Get([]byte("foo")))
+	require.Equal(t, []byte{1}, sendEnabledStore.Get([]byte("bar")))
+	require.Nil(t, sendEnabledStore.Get([]byte("foobar")))
+}
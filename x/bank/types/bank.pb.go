@@ -28,6 +28,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the bank module.
 type Params struct {
+	// Deprecated: the send enabled flags of the denominations are kept in the
+	// bank store, and set with MsgSetSendEnabled. The flags of this list are moved
+	// to the store when the params are set.
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty" yaml:"send_enabled,omitempty"`
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty" yaml:"default_send_enabled,omitempty"`
 }
@@ -430,13 +433,13 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 592 bytes of a gzipped FileDescriptorProto
+	// 588 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
 	0x14, 0xf6, 0x35, 0x8d, 0x49, 0x2f, 0xb0, 0x1c, 0x15, 0x72, 0x23, 0x61, 0x1b, 0x4b, 0x48, 0x29,
 	0xa2, 0x4e, 0x0a, 0x0c, 0x28, 0x0b, 0x52, 0xca, 0x0f, 0x75, 0x40, 0x20, 0x57, 0x08, 0x09, 0x86,
 	0xe8, 0x9c, 0xbb, 0x06, 0xab, 0xf6, 0x9d, 0x95, 0x3b, 0x57, 0xf5, 0x7f, 0xc0, 0x04, 0x8c, 0x8c,
 	0x9d, 0x59, 0xe1, 0x7f, 0xa0, 0x63, 0x05, 0x0b, 0x53, 0x40, 0xc9, 0xc2, 0xdc, 0xbf, 0x00, 0xf9,
-	0xce, 0xf9, 0x51, 0x29, 0x20, 0x06, 0x06, 0xa6, 0xbc, 0xef, 0xbd, 0xef, 0x7d, 0xef, 0xe9, 0xbb,
+	0xce, 0xf9, 0x51, 0x29, 0x20, 0x16, 0x24, 0xa6, 0xbc, 0xef, 0xbd, 0xef, 0x7d, 0xef, 0xe9, 0xbb,
 	0xe7, 0x40, 0xbb, 0xcf, 0x45, 0xc2, 0x45, 0x2b, 0xc4, 0xec, 0xa0, 0x75, 0xb8, 0x1d, 0x52, 0x89,
 	0xb7, 0x15, 0xf0, 0xd3, 0x21, 0x97, 0x1c, 0x5d, 0xd6, 0x75, 0x5f, 0xa5, 0xca, 0x7a, 0x63, 0x7d,
 	0xc0, 0x07, 0x5c, 0xd5, 0x5b, 0x45, 0xa4, 0xa9, 0x8d, 0x0d, 0x4d, 0xed, 0xe9, 0x42, 0xd9, 0xa7,
@@ -454,20 +457,20 @@ var fileDescriptor_dd052eee12edf988 = []byte{
 	0xeb, 0xb6, 0x4f, 0x46, 0x8e, 0xf1, 0xe1, 0xbb, 0xd3, 0x1c, 0x44, 0xf2, 0x55, 0x16, 0xfa, 0x7d,
 	0x9e, 0x94, 0xaf, 0x55, 0xfe, 0x6c, 0x09, 0x72, 0xd0, 0x92, 0x79, 0x4a, 0x85, 0x6a, 0x10, 0x81,
 	0x56, 0xee, 0xd4, 0x5e, 0xeb, 0x85, 0x0c, 0xef, 0x2d, 0x80, 0xe6, 0x93, 0x4c, 0xfe, 0x47, 0x1b,
-	0x7d, 0x04, 0xd0, 0xdc, 0xcb, 0xd2, 0x34, 0xce, 0x8b, 0xb9, 0x92, 0x4b, 0x1c, 0x97, 0xa7, 0xf3,
-	0x6f, 0xe7, 0x2a, 0xe5, 0xce, 0xc3, 0x72, 0x2e, 0xf8, 0xf2, 0x69, 0xeb, 0xee, 0x8d, 0x3f, 0x76,
-	0x1f, 0xe9, 0x4f, 0x2b, 0xa6, 0x03, 0xdc, 0xcf, 0x5b, 0x87, 0xed, 0x3b, 0x6d, 0x5f, 0xef, 0xb9,
-	0x6b, 0x01, 0xef, 0x39, 0x5c, 0xbb, 0x5f, 0x5c, 0xc1, 0x33, 0x16, 0xc9, 0xdf, 0xdc, 0x47, 0x03,
-	0xd6, 0xe8, 0x51, 0xca, 0x19, 0x65, 0x52, 0x1d, 0xc8, 0xa5, 0x60, 0x86, 0x95, 0xf7, 0x71, 0x84,
-	0x05, 0x15, 0x56, 0xc5, 0xad, 0x28, 0xef, 0x35, 0xf4, 0x3e, 0x03, 0x58, 0x7b, 0x4c, 0x25, 0x26,
-	0x58, 0x62, 0xe4, 0xc2, 0x3a, 0xa1, 0xa2, 0x3f, 0x8c, 0x52, 0x19, 0x71, 0x56, 0xca, 0x2f, 0xa6,
-	0xd0, 0xbd, 0x82, 0xc1, 0x78, 0xd2, 0xcb, 0x58, 0x24, 0xa7, 0x0f, 0x66, 0x2f, 0xfd, 0xe6, 0x66,
-	0xfb, 0x06, 0x90, 0x4c, 0x43, 0x81, 0x10, 0x5c, 0x2d, 0xec, 0xb5, 0x2a, 0x4a, 0x5b, 0xc5, 0xc5,
-	0x76, 0x24, 0x12, 0x69, 0x8c, 0x73, 0x6b, 0x55, 0x5f, 0x46, 0x09, 0x0b, 0x36, 0xc3, 0x09, 0xb5,
-	0xaa, 0x9a, 0x5d, 0xc4, 0xe8, 0x0a, 0x34, 0x45, 0x9e, 0x84, 0x3c, 0xb6, 0x4c, 0x95, 0x2d, 0x51,
-	0x77, 0xe7, 0x64, 0x6c, 0x83, 0xd3, 0xb1, 0x0d, 0x7e, 0x8c, 0x6d, 0xf0, 0x6e, 0x62, 0x1b, 0xa7,
-	0x13, 0xdb, 0xf8, 0x36, 0xb1, 0x8d, 0x17, 0x9b, 0x7f, 0xe3, 0xbb, 0x7a, 0xbc, 0xd0, 0x54, 0x7f,
-	0x33, 0xb7, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x03, 0xbf, 0xe9, 0xee, 0x04, 0x00, 0x00,
+	0x7d, 0x04, 0xd0, 0xdc, 0xcb, 0xd2, 0x34, 0xce, 0x8b, 0xb9, 0x92, 0x4b, 0x1c, 0x5b, 0xe0, 0x1f,
+	0xcc, 0x55, 0xca, 0x9d, 0x87, 0xe5, 0x5c, 0xf0, 0xe5, 0xd3, 0xd6, 0xdd, 0x1b, 0x7f, 0xec, 0x3e,
+	0xd2, 0x9f, 0x56, 0x4c, 0x07, 0xb8, 0x9f, 0xb7, 0x0e, 0xdb, 0x77, 0xda, 0xbe, 0xde, 0x73, 0xd7,
+	0x02, 0xde, 0x73, 0xb8, 0x76, 0xbf, 0xb8, 0x82, 0x67, 0x2c, 0x92, 0xbf, 0xb9, 0x8f, 0x06, 0xac,
+	0xd1, 0xa3, 0x94, 0x33, 0xca, 0xa4, 0x3a, 0x90, 0x4b, 0xc1, 0x0c, 0x2b, 0xef, 0xe3, 0x08, 0x0b,
+	0x2a, 0xac, 0x8a, 0x5b, 0x51, 0xde, 0x6b, 0xe8, 0x7d, 0x06, 0xb0, 0xf6, 0x98, 0x4a, 0x4c, 0xb0,
+	0xc4, 0xc8, 0x85, 0x75, 0x42, 0x45, 0x7f, 0x18, 0xa5, 0x32, 0xe2, 0xac, 0x94, 0x5f, 0x4c, 0xa1,
+	0x7b, 0x05, 0x83, 0xf1, 0xa4, 0x97, 0xb1, 0x48, 0x4e, 0x1f, 0xcc, 0x5e, 0xfa, 0xcd, 0xcd, 0xf6,
+	0x0d, 0x20, 0x99, 0x86, 0x02, 0x21, 0xb8, 0x5a, 0xd8, 0x6b, 0x55, 0x94, 0xb6, 0x8a, 0x8b, 0xed,
+	0x48, 0x24, 0xd2, 0x18, 0xe7, 0xd6, 0xaa, 0xbe, 0x8c, 0x12, 0x16, 0x6c, 0x86, 0x13, 0x6a, 0x55,
+	0x35, 0xbb, 0x88, 0xd1, 0x15, 0x68, 0x8a, 0x3c, 0x09, 0x79, 0x6c, 0x99, 0x2a, 0x5b, 0xa2, 0xee,
+	0xce, 0xc9, 0xd8, 0x06, 0xa7, 0x63, 0x1b, 0xfc, 0x18, 0xdb, 0xe0, 0xdd, 0xc4, 0x36, 0x4e, 0x27,
+	0xb6, 0xf1, 0x6d, 0x62, 0x1b, 0x2f, 0x36, 0xff, 0xc6, 0x77, 0xf5, 0x78, 0xa1, 0xa9, 0xfe, 0x66,
+	0x6e, 0xff, 0x1a, 0x00, 0xcc, 0x03, 0xbf, 0xe9, 0xee, 0x04, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -17,6 +17,25 @@ func (gs GenesisState) Validate() error {
 
 	seenBalances := make(map[string]bool)
 	seenMetadatas := make(map[string]bool)
+	seenSendEnabled := make(map[string]bool)
+
+	// the send enabled flags of the deprecated params list are validated by
+	// the params, but must not be set twice
+	for _, se := range gs.Params.SendEnabled {
+		seenSendEnabled[se.Denom] = true
+	}
+
+	for _, se := range gs.SendEnabled {
+		if seenSendEnabled[se.Denom] {
+			return fmt.Errorf("duplicate send enabled found: '%s'", se.Denom)
+		}
+
+		if err := validateSendEnabled(se); err != nil {
+			return err
+		}
+
+		seenSendEnabled[se.Denom] = true
+	}
 
 	totalSupply := sdk.Coins{}
 
@@ -62,18 +81,29 @@ func (gs GenesisState) Validate() error {
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, balances []Balance, supply sdk.Coins, denomMetaData []Metadata) *GenesisState {
+func NewGenesisState(params Params, balances []Balance, supply sdk.Coins, denomMetaData []Metadata, sendEnabled []SendEnabled) *GenesisState {
 	return &GenesisState{
 		Params:        params,
 		Balances:      balances,
 		Supply:        supply,
 		DenomMetadata: denomMetaData,
+		SendEnabled:   sendEnabled,
 	}
 }
 
 // DefaultGenesisState returns a default bank module genesis state.
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), []Balance{}, sdk.Coins{}, []Metadata{})
+	return NewGenesisState(DefaultParams(), []Balance{}, sdk.Coins{}, []Metadata{}, []SendEnabled{})
+}
+
+// GetAllSendEnabled returns the send enabled flags of the genesis state,
+// including the ones of the deprecated params list.
+func (gs GenesisState) GetAllSendEnabled() []SendEnabled {
+	sendEnabled := make([]SendEnabled, 0, len(gs.Params.SendEnabled)+len(gs.SendEnabled))
+	for _, se := range gs.Params.SendEnabled {
+		sendEnabled = append(sendEnabled, *se)
+	}
+	return append(sendEnabled, gs.SendEnabled...)
 }
 
 // GetGenesisStateFromAppState returns x/bank GenesisState given raw application
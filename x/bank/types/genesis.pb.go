@@ -36,6 +36,9 @@ type GenesisState struct {
 	Supply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=supply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"supply"`
 	// denom_metadata defines the metadata of the differents coins.
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata" yaml:"denom_metadata"`
+	// send_enabled defines the denominations for which sending is enabled or
+	// disabled, overriding the default_send_enabled param.
+	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled" yaml:"send_enabled"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -99,6 +102,13 @@ func (m *GenesisState) GetDenomMetadata() []Metadata {
 	return nil
 }
 
+func (m *GenesisState) GetSendEnabled() []SendEnabled {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -149,31 +159,34 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3f, 0x4f, 0xc2, 0x40,
-	0x18, 0x87, 0x5b, 0x40, 0xc0, 0x43, 0x1d, 0xaa, 0x26, 0x15, 0xa5, 0xc5, 0x4e, 0x38, 0xd8, 0x0a,
-	0x4e, 0x32, 0x38, 0x94, 0xc1, 0xc9, 0xc4, 0xd4, 0xcd, 0xc5, 0x5c, 0xdb, 0x4b, 0x6d, 0xa0, 0xbd,
-	0x86, 0x3b, 0x8c, 0x7c, 0x03, 0x47, 0x3e, 0x02, 0xb3, 0x9f, 0x84, 0x91, 0xc4, 0xc5, 0x09, 0x0d,
-	0x2c, 0xce, 0x7e, 0x02, 0xd3, 0xbb, 0xa3, 0x6a, 0x24, 0x4e, 0x4e, 0xfd, 0xf3, 0xfe, 0x9e, 0xe7,
-	0xbd, 0xf7, 0xee, 0xc0, 0xa1, 0x87, 0x49, 0x84, 0x89, 0xe5, 0xc2, 0xb8, 0x6b, 0xdd, 0x37, 0x5d,
-	0x44, 0x61, 0xd3, 0x0a, 0x50, 0x8c, 0x48, 0x48, 0xcc, 0xa4, 0x8f, 0x29, 0x56, 0xb6, 0x79, 0xc4,
-	0x4c, 0x23, 0xa6, 0x88, 0x54, 0x77, 0x02, 0x1c, 0x60, 0x56, 0xb7, 0xd2, 0x37, 0x1e, 0xad, 0x6a,
-	0x99, 0x8d, 0xa0, 0xcc, 0xe6, 0xe1, 0x30, 0xfe, 0x55, 0xff, 0xd6, 0x8d, 0x79, 0x59, 0xdd, 0x78,
-	0xce, 0x81, 0x8d, 0x0b, 0xde, 0xfc, 0x9a, 0x42, 0x8a, 0x94, 0x33, 0x50, 0x4c, 0x60, 0x1f, 0x46,
-	0x44, 0x95, 0xeb, 0x72, 0xa3, 0xd2, 0xda, 0x37, 0x57, 0x2c, 0xc6, 0xbc, 0x62, 0x11, 0xbb, 0x30,
-	0x99, 0xe9, 0x92, 0x23, 0x00, 0xe5, 0x1c, 0x94, 0x5d, 0xd8, 0x83, 0xb1, 0x87, 0x88, 0x9a, 0xab,
-	0xe7, 0x1b, 0x95, 0xd6, 0xc1, 0x4a, 0xd8, 0xe6, 0x21, 0x41, 0x67, 0x8c, 0xe2, 0x81, 0x22, 0x19,
-	0x24, 0x49, 0x6f, 0xa8, 0xe6, 0x19, 0xbd, 0xf7, 0x45, 0x13, 0x94, 0xd1, 0x1d, 0x1c, 0xc6, 0xf6,
-	0x49, 0x8a, 0x3e, 0xbd, 0xea, 0x8d, 0x20, 0xa4, 0x77, 0x03, 0xd7, 0xf4, 0x70, 0x64, 0x89, 0x49,
-	0xf9, 0xe3, 0x98, 0xf8, 0x5d, 0x8b, 0x0e, 0x13, 0x44, 0x18, 0x40, 0x1c, 0xa1, 0x56, 0x3c, 0xb0,
-	0xe5, 0xa3, 0x18, 0x47, 0xb7, 0x11, 0xa2, 0xd0, 0x87, 0x14, 0xaa, 0x05, 0xd6, 0xac, 0xb6, 0x72,
-	0xa9, 0x97, 0x22, 0x64, 0xd7, 0xd2, 0x86, 0x1f, 0x33, 0x7d, 0x77, 0x08, 0xa3, 0x5e, 0xdb, 0xf8,
-	0xa9, 0x30, 0x9c, 0x4d, 0xf6, 0x63, 0x99, 0x36, 0x46, 0x32, 0x28, 0x89, 0x29, 0x15, 0x15, 0x94,
-	0xa0, 0xef, 0xf7, 0x11, 0xe1, 0x3b, 0xba, 0xee, 0x2c, 0x3f, 0x15, 0x08, 0xd6, 0xd2, 0x93, 0x5a,
-	0x6e, 0xd6, 0xbf, 0x8e, 0xcb, 0xcd, 0xed, 0xf2, 0xe3, 0x58, 0x97, 0xde, 0xc7, 0xba, 0x64, 0x77,
-	0x26, 0x73, 0x4d, 0x9e, 0xce, 0x35, 0xf9, 0x6d, 0xae, 0xc9, 0xa3, 0x85, 0x26, 0x4d, 0x17, 0x9a,
-	0xf4, 0xb2, 0xd0, 0xa4, 0x9b, 0xa3, 0x3f, 0xa5, 0x0f, 0xfc, 0xea, 0x30, 0xb7, 0x5b, 0x64, 0x97,
-	0xe6, 0xf4, 0x33, 0x00, 0x00, 0xff, 0xff, 0x15, 0x28, 0xf0, 0x44, 0xc4, 0x02, 0x00, 0x00,
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3f, 0x8f, 0xd3, 0x30,
+	0x18, 0xc6, 0x13, 0x7a, 0xd7, 0x3b, 0xdc, 0x83, 0xc1, 0x07, 0x52, 0xb8, 0xd2, 0xa4, 0x64, 0x2a,
+	0x03, 0x09, 0x2d, 0x13, 0x1d, 0x18, 0x52, 0x21, 0x26, 0x24, 0x94, 0x6e, 0x2c, 0xc5, 0x89, 0xad,
+	0x10, 0x35, 0xb1, 0xa3, 0xda, 0x45, 0xf4, 0x1b, 0x30, 0xf6, 0x23, 0x74, 0x66, 0xe7, 0x3b, 0x74,
+	0xec, 0xc8, 0x54, 0x50, 0xbb, 0x30, 0xf3, 0x09, 0x50, 0x6c, 0x37, 0x2d, 0x22, 0x62, 0xba, 0x29,
+	0x7f, 0xde, 0xdf, 0xf3, 0x3c, 0xf6, 0xfb, 0xbe, 0xe0, 0x49, 0xcc, 0x78, 0xce, 0xb8, 0x1f, 0x21,
+	0x3a, 0xf5, 0x3f, 0xf5, 0x23, 0x22, 0x50, 0xdf, 0x4f, 0x08, 0x25, 0x3c, 0xe5, 0x5e, 0x31, 0x63,
+	0x82, 0xc1, 0x6b, 0x85, 0x78, 0x25, 0xe2, 0x69, 0xe4, 0xe6, 0x41, 0xc2, 0x12, 0x26, 0xeb, 0x7e,
+	0xf9, 0xa6, 0xd0, 0x1b, 0xbb, 0x72, 0xe3, 0xa4, 0x72, 0x8b, 0x59, 0x4a, 0xff, 0xa9, 0x9f, 0xa4,
+	0x49, 0x5f, 0x59, 0x77, 0xbf, 0x35, 0xc0, 0xd5, 0x1b, 0x15, 0x3e, 0x16, 0x48, 0x10, 0xf8, 0x12,
+	0x34, 0x0b, 0x34, 0x43, 0x39, 0xb7, 0xcc, 0xae, 0xd9, 0x6b, 0x0d, 0xda, 0x5e, 0xcd, 0x61, 0xbc,
+	0x77, 0x12, 0x09, 0xce, 0xd6, 0x5b, 0xc7, 0x08, 0xb5, 0x00, 0xbe, 0x02, 0x97, 0x11, 0xca, 0x10,
+	0x8d, 0x09, 0xb7, 0xee, 0x74, 0x1b, 0xbd, 0xd6, 0xe0, 0x71, 0xad, 0x38, 0x50, 0x90, 0x56, 0x57,
+	0x1a, 0x18, 0x83, 0x26, 0x9f, 0x17, 0x45, 0xb6, 0xb0, 0x1a, 0x52, 0xfd, 0xe8, 0xa8, 0xe6, 0xa4,
+	0x52, 0x8f, 0x58, 0x4a, 0x83, 0xe7, 0xa5, 0xf4, 0xeb, 0x0f, 0xa7, 0x97, 0xa4, 0xe2, 0xe3, 0x3c,
+	0xf2, 0x62, 0x96, 0xfb, 0xfa, 0xa6, 0xea, 0xf1, 0x8c, 0xe3, 0xa9, 0x2f, 0x16, 0x05, 0xe1, 0x52,
+	0xc0, 0x43, 0x6d, 0x0d, 0x63, 0x70, 0x1f, 0x13, 0xca, 0xf2, 0x49, 0x4e, 0x04, 0xc2, 0x48, 0x20,
+	0xeb, 0x4c, 0x86, 0x75, 0x6a, 0x8f, 0xfa, 0x56, 0x43, 0x41, 0xa7, 0x0c, 0xfc, 0xbd, 0x75, 0x1e,
+	0x2e, 0x50, 0x9e, 0x0d, 0xdd, 0xbf, 0x2d, 0xdc, 0xf0, 0x9e, 0xfc, 0x71, 0xa0, 0xe1, 0x07, 0x70,
+	0xc5, 0x09, 0xc5, 0x13, 0x42, 0x51, 0x94, 0x11, 0x6c, 0x9d, 0xcb, 0x88, 0x6e, 0x6d, 0xc4, 0x98,
+	0x50, 0xfc, 0x5a, 0x71, 0x41, 0x5b, 0xa7, 0x5c, 0xab, 0x94, 0x53, 0x0f, 0x37, 0x6c, 0xf1, 0x23,
+	0xe9, 0x2e, 0x4d, 0x70, 0xa1, 0xfb, 0x08, 0x2d, 0x70, 0x81, 0x30, 0x9e, 0x11, 0xae, 0x66, 0x76,
+	0x37, 0x3c, 0x7c, 0x42, 0x04, 0xce, 0xcb, 0x5d, 0x38, 0x8c, 0xe3, 0x56, 0x1b, 0xaa, 0x9c, 0x87,
+	0x97, 0x5f, 0x56, 0x8e, 0xf1, 0x6b, 0xe5, 0x18, 0xc1, 0x68, 0xbd, 0xb3, 0xcd, 0xcd, 0xce, 0x36,
+	0x7f, 0xee, 0x6c, 0x73, 0xb9, 0xb7, 0x8d, 0xcd, 0xde, 0x36, 0xbe, 0xef, 0x6d, 0xe3, 0xfd, 0xd3,
+	0xff, 0x9a, 0x7e, 0x56, 0xcb, 0x29, 0xbd, 0xa3, 0xa6, 0x5c, 0xcb, 0x17, 0x7f, 0x06, 0x00, 0xcc,
+	0x0d, 0xde, 0xf7, 0x26, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -196,6 +209,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SendEnabled) > 0 {
+		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.DenomMetadata) > 0 {
 		for iNdEx := len(m.DenomMetadata) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -332,6 +359,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SendEnabled) > 0 {
+		for _, e := range m.SendEnabled {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -524,6 +557,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendEnabled = append(m.SendEnabled, SendEnabled{})
+			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
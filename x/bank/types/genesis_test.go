@@ -54,6 +54,36 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid send enabled",
+			GenesisState{
+				Params:      Params{SendEnabled: []*SendEnabled{{"foo", true}}},
+				SendEnabled: []SendEnabled{{"bar", false}},
+			},
+			false,
+		},
+		{
+			"invalid send enabled",
+			GenesisState{
+				SendEnabled: []SendEnabled{{"", false}},
+			},
+			true,
+		},
+		{
+			"dup send enabled",
+			GenesisState{
+				SendEnabled: []SendEnabled{{"foo", true}, {"foo", false}},
+			},
+			true,
+		},
+		{
+			"dup send enabled in params",
+			GenesisState{
+				Params:      Params{SendEnabled: []*SendEnabled{{"foo", true}}},
+				SendEnabled: []SendEnabled{{"foo", false}},
+			},
+			true,
+		},
 		{
 			"dup balances",
 			GenesisState{
@@ -27,6 +27,8 @@ var (
 	BalancesPrefix      = []byte{0x02}
 	SupplyKey           = []byte{0x00}
 	DenomMetadataPrefix = []byte{0x1}
+	DenomAddressPrefix  = []byte{0x03}
+	SendEnabledPrefix   = []byte{0x04}
 )
 
 // DenomMetadataKey returns the denomination metadata key.
@@ -63,3 +65,23 @@ func CreateAccountBalancesPrefix(addr []byte) []byte {
 func CreatePrefixedAccountStoreKey(addr []byte, denom []byte) []byte {
 	return append(CreateAccountBalancesPrefix(addr), denom...)
 }
+
+// CreateDenomAddressPrefix creates the prefix of the reverse index from a
+// denomination to the addresses of the accounts holding it. The denomination is
+// followed by a null byte, so that the prefix of a denomination is not a prefix
+// of the others.
+func CreateDenomAddressPrefix(denom string) []byte {
+	key := make([]byte, len(DenomAddressPrefix)+len(denom)+1)
+	copy(key, DenomAddressPrefix)
+	copy(key[len(DenomAddressPrefix):], denom)
+	return key
+}
+
+// CreateSendEnabledKey returns the key of the send enabled flag of a
+// denomination.
+func CreateSendEnabledKey(denom string) []byte {
+	key := make([]byte, len(SendEnabledPrefix)+len(denom))
+	copy(key, SendEnabledPrefix)
+	copy(key[len(SendEnabledPrefix):], denom)
+	return key
+}
@@ -7,8 +7,9 @@ import (
 
 // bank message types
 const (
-	TypeMsgSend      = "send"
-	TypeMsgMultiSend = "multisend"
+	TypeMsgSend           = "send"
+	TypeMsgMultiSend      = "multisend"
+	TypeMsgSetSendEnabled = "set_send_enabled"
 )
 
 var _ sdk.Msg = &MsgSend{}
@@ -107,6 +108,65 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+var _ sdk.Msg = &MsgSetSendEnabled{}
+
+// NewMsgSetSendEnabled - construct a msg to set the send enabled flags of some
+// denominations, and to reset some others to the default.
+func NewMsgSetSendEnabled(authority string, sendEnabled []SendEnabled, useDefaultFor []string) *MsgSetSendEnabled {
+	return &MsgSetSendEnabled{
+		Authority:     authority,
+		SendEnabled:   sendEnabled,
+		UseDefaultFor: useDefaultFor,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgSetSendEnabled) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetSendEnabled) Type() string { return TypeMsgSetSendEnabled }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetSendEnabled) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	seen := make(map[string]bool)
+	for _, se := range msg.SendEnabled {
+		if seen[se.Denom] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate denom entries found for %q", se.Denom)
+		}
+		if err := validateSendEnabled(se); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		seen[se.Denom] = true
+	}
+
+	for _, denom := range msg.UseDefaultFor {
+		if seen[denom] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate denom entries found for %q", denom)
+		}
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetSendEnabled) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetSendEnabled) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
 // ValidateBasic - validate transaction input
 func (in Input) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(in.Address)
@@ -276,3 +276,38 @@ func TestMsgSendSigners(t *testing.T) {
 
 	require.Equal(t, signers, tx.GetSigners())
 }
+
+func TestMsgSetSendEnabledValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority")).String()
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *MsgSetSendEnabled
+	}{
+		{"", NewMsgSetSendEnabled(authority, []SendEnabled{{"foo", true}, {"bar", false}}, []string{"baz"})},
+		{"", NewMsgSetSendEnabled(authority, nil, nil)},
+		{"Invalid authority address (empty address string is not allowed): invalid address", NewMsgSetSendEnabled("", nil, nil)},
+		{"duplicate denom entries found for \"foo\": invalid request", NewMsgSetSendEnabled(authority, []SendEnabled{{"foo", true}, {"foo", false}}, nil)},
+		{"duplicate denom entries found for \"foo\": invalid request", NewMsgSetSendEnabled(authority, []SendEnabled{{"foo", true}}, []string{"foo"})},
+		{"duplicate denom entries found for \"bar\": invalid request", NewMsgSetSendEnabled(authority, nil, []string{"bar", "bar"})},
+		{"invalid denom: 0foo: invalid request", NewMsgSetSendEnabled(authority, []SendEnabled{{"0foo", true}}, nil)},
+		{"invalid denom: 0bar: invalid request", NewMsgSetSendEnabled(authority, nil, []string{"0bar"})},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgSetSendEnabledGetSigners(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority"))
+	msg := NewMsgSetSendEnabled(authority.String(), nil, nil)
+	require.Equal(t, []sdk.AccAddress{authority}, msg.GetSigners())
+	require.Equal(t, TypeMsgSetSendEnabled, msg.Type())
+	require.Equal(t, RouterKey, msg.Route())
+}
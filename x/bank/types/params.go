@@ -83,7 +83,7 @@ func (p Params) SetSendEnabledParam(denom string, sendEnabled bool) Params {
 // ParamSetPairs implements params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
-		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateSendEnabledParams),
+		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateDeprecatedSendEnabledParams),
 		paramtypes.NewParamSetPair(KeyDefaultSendEnabled, &p.DefaultSendEnabled, validateIsBool),
 	}
 }
@@ -110,6 +110,20 @@ func validateSendEnabledParams(i interface{}) error {
 	return nil
 }
 
+// validateDeprecatedSendEnabledParams validates the value of the deprecated
+// SendEnabled param, which must be empty. The send enabled flags are kept in
+// the bank store, so that a param change proposal setting this param would
+// have no effect.
+func validateDeprecatedSendEnabledParams(i interface{}) error {
+	if err := validateSendEnabledParams(i); err != nil {
+		return err
+	}
+	if params := i.([]*SendEnabled); len(params) > 0 {
+		return fmt.Errorf("the SendEnabled param is deprecated, use MsgSetSendEnabled instead")
+	}
+	return nil
+}
+
 // NewSendEnabled creates a new SendEnabled object
 // The denom may be left empty to control the global default setting of send_enabled
 func NewSendEnabled(denom string, sendEnabled bool) *SendEnabled {
@@ -123,3 +123,9 @@ default_send_enabled: true
 
 	require.Error(t, validateSendEnabledParams(SendEnabledParams{NewSendEnabled("INVALIDDENOM", true)}))
 }
+
+func Test_validateDeprecatedSendEnabledParams(t *testing.T) {
+	require.NoError(t, validateDeprecatedSendEnabledParams([]*SendEnabled{}))
+	require.Error(t, validateDeprecatedSendEnabledParams([]*SendEnabled{NewSendEnabled(sdk.DefaultBondDenom, false)}))
+	require.Error(t, validateDeprecatedSendEnabledParams(true))
+}
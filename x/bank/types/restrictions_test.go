@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestComposeSendRestrictions(t *testing.T) {
+	fromAddr, toAddr, otherAddr := sdk.AccAddress("from"), sdk.AccAddress("to"), sdk.AccAddress("other")
+	amt := sdk.NewCoins(sdk.NewInt64Coin("foo", 10))
+
+	var calls []string
+	recorder := func(name string, newToAddr sdk.AccAddress, err error) types.SendRestrictionFn {
+		return func(_ sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) (sdk.AccAddress, error) {
+			require.Equal(t, fromAddr, from)
+			require.Equal(t, amt, coins)
+			calls = append(calls, name+":"+string(to))
+			if newToAddr == nil {
+				return to, err
+			}
+			return newToAddr, err
+		}
+	}
+	errVeto := errors.New("veto")
+
+	testCases := []struct {
+		name         string
+		restrictions []types.SendRestrictionFn
+		expToAddr    sdk.AccAddress
+		expErr       error
+		expCalls     []string
+	}{
+		{"none", nil, nil, nil, nil},
+		{"only nil", []types.SendRestrictionFn{nil, nil}, nil, nil, nil},
+		{
+			"in order",
+			[]types.SendRestrictionFn{recorder("a", nil, nil), nil, recorder("b", nil, nil)},
+			toAddr, nil, []string{"a:to", "b:to"},
+		},
+		{
+			"redirect",
+			[]types.SendRestrictionFn{recorder("a", otherAddr, nil), recorder("b", nil, nil)},
+			otherAddr, nil, []string{"a:to", "b:other"},
+		},
+		{
+			"veto stops the chain",
+			[]types.SendRestrictionFn{recorder("a", nil, errVeto), recorder("b", nil, nil)},
+			toAddr, errVeto, []string{"a:to"},
+		},
+		{"no-op", []types.SendRestrictionFn{types.NoOpSendRestrictionFn}, toAddr, nil, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls = nil
+			restriction := types.ComposeSendRestrictions(tc.restrictions...)
+			if tc.expToAddr == nil {
+				require.Nil(t, restriction)
+				return
+			}
+			newToAddr, err := restriction(sdk.Context{}, fromAddr, toAddr, amt)
+			require.ErrorIs(t, err, tc.expErr)
+			require.Equal(t, tc.expToAddr, newToAddr)
+			require.Equal(t, tc.expCalls, calls)
+		})
+	}
+}
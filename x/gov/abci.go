@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 // EndBlocker called every block, process inflation, update validator set.
@@ -17,98 +18,143 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	logger := keeper.Logger(ctx)
 
 	// delete inactive proposal from store and its deposits
-	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
-		keeper.DeleteProposal(ctx, proposal.ProposalId)
-		keeper.DeleteDeposits(ctx, proposal.ProposalId)
+	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
+		keeper.DeleteProposal(ctx, proposal.Id)
+		keeper.DeleteDeposits(ctx, proposal.Id)
 
 		// called when proposal become inactive
-		keeper.AfterProposalFailedMinDeposit(ctx, proposal.ProposalId)
+		keeper.AfterProposalFailedMinDeposit(ctx, proposal.Id)
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeInactiveProposal,
-				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
 				sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueProposalDropped),
 			),
 		)
 
 		logger.Info(
 			"proposal did not meet minimum deposit; deleted",
-			"proposal", proposal.ProposalId,
-			"title", proposal.GetTitle(),
-			"min_deposit", keeper.GetDepositParams(ctx).MinDeposit.String(),
-			"total_deposit", proposal.TotalDeposit.String(),
+			"proposal", proposal.Id,
+			"min_deposit", sdk.NewCoins(keeper.GetDepositParams(ctx).MinDeposit...).String(),
+			"total_deposit", sdk.NewCoins(proposal.TotalDeposit...).String(),
 		)
 
 		return false
 	})
 
+	// expedited proposals which failed and were converted to regular proposals,
+	// they are inserted back into the active queue once it has been iterated
+	var convertedProposals []v1.Proposal
+
 	// fetch active proposals whose voting periods have ended (are passed the block time)
-	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
+	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
 		var tagValue, logMsg string
 
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 
-		if burnDeposits {
-			keeper.DeleteDeposits(ctx, proposal.ProposalId)
-		} else {
-			keeper.RefundDeposits(ctx, proposal.ProposalId)
+		// A failed expedited proposal is converted to a regular proposal, so its
+		// deposits are kept until the end of the regular voting period.
+		if passes || !proposal.Expedited {
+			if burnDeposits {
+				keeper.DeleteDeposits(ctx, proposal.Id)
+			} else {
+				keeper.RefundDeposits(ctx, proposal.Id)
+			}
 		}
 
+		keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+
 		if passes {
-			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
+			var (
+				idx    int
+				events sdk.Events
+				msg    sdk.Msg
+			)
+
+			// attempt to execute all messages within the passed proposal
+			// Messages may mutate state thus we use a cached context. If one of
+			// the handlers fails, no state mutation is written and the error
+			// message is logged.
 			cacheCtx, writeCache := ctx.CacheContext()
+			messages, err := proposal.GetMsgs()
+			if err == nil {
+				for idx, msg = range messages {
+					handler := keeper.Router().Handler(msg)
+
+					var res *sdk.Result
+					res, err = handler(cacheCtx, msg)
+					if err != nil {
+						break
+					}
+
+					for _, event := range res.GetEvents() {
+						events = append(events, sdk.Event(event))
+					}
+				}
+			}
 
-			// The proposal handler may execute state mutating logic depending
-			// on the proposal content. If the handler fails, no state mutation
-			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.GetContent())
+			// `err == nil` when all handlers passed.
+			// Or else, `idx` and `err` are populated with the msg index and error.
 			if err == nil {
-				proposal.Status = types.StatusPassed
+				proposal.Status = v1.StatusPassed
 				tagValue = types.AttributeValueProposalPassed
 				logMsg = "passed"
 
-				// The cached context is created with a new EventManager. However, since
-				// the proposal handler execution was successful, we want to track/keep
-				// any events emitted, so we re-emit to "merge" the events into the
-				// original Context's EventManager.
-				ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
-
 				// write state to the underlying multi-store
 				writeCache()
+
+				// The handlers are executed with their own EventManager, so we
+				// propagate the msg events to the current context.
+				ctx.EventManager().EmitEvents(events)
 			} else {
-				proposal.Status = types.StatusFailed
+				proposal.Status = v1.StatusFailed
 				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed, but failed on execution: %s", err)
+				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
 			}
+		} else if proposal.Expedited {
+			// The proposal stays in the voting period, which is extended to the
+			// regular voting period. Once it ends, the proposal is tallied again
+			// according to the regular proposal rules.
+			proposal.Expedited = false
+			endTime := proposal.VotingStartTime.Add(*keeper.GetVotingParams(ctx).VotingPeriod)
+			proposal.VotingEndTime = &endTime
+			convertedProposals = append(convertedProposals, proposal)
+
+			tagValue = types.AttributeValueExpeditedProposalRejected
+			logMsg = "expedited proposal converted to regular"
 		} else {
-			proposal.Status = types.StatusRejected
+			proposal.Status = v1.StatusRejected
 			tagValue = types.AttributeValueProposalRejected
 			logMsg = "rejected"
 		}
 
-		proposal.FinalTallyResult = tallyResults
+		proposal.FinalTallyResult = &tallyResults
 
 		keeper.SetProposal(ctx, proposal)
-		keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
 
 		// when proposal become active
-		keeper.AfterProposalVotingPeriodEnded(ctx, proposal.ProposalId)
+		if proposal.Status != v1.StatusVotingPeriod {
+			keeper.AfterProposalVotingPeriodEnded(ctx, proposal.Id)
+		}
 
 		logger.Info(
 			"proposal tallied",
-			"proposal", proposal.ProposalId,
-			"title", proposal.GetTitle(),
+			"proposal", proposal.Id,
 			"result", logMsg,
 		)
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeActiveProposal,
-				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
 				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
 			),
 		)
 		return false
 	})
+
+	for _, proposal := range convertedProposals {
+		keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+	}
 }
@@ -7,10 +7,12 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 )
 
-func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
+func parseSubmitLegacyProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 	proposal := &proposal{}
 	proposalFile, _ := fs.GetString(FlagProposal)
 
@@ -42,3 +44,47 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 
 	return proposal, nil
 }
+
+// proposalFile defines the JSON file format of a proposal submitted through
+// the submit-proposal command.
+type proposalFile struct {
+	// Messages defines an array of sdk.Msgs proto-JSON-encoded as Anys.
+	Messages  []json.RawMessage `json:"messages"`
+	Metadata  string            `json:"metadata"`
+	Deposit   string            `json:"deposit"`
+	Expedited bool              `json:"expedited"`
+}
+
+// parseSubmitProposal reads and parses the proposal, returning it along with
+// its decoded messages and deposit.
+func parseSubmitProposal(cdc codec.Codec, path string) (proposalFile, []sdk.Msg, sdk.Coins, error) {
+	var proposal proposalFile
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return proposal, nil, nil, err
+	}
+
+	err = json.Unmarshal(contents, &proposal)
+	if err != nil {
+		return proposal, nil, nil, err
+	}
+
+	msgs := make([]sdk.Msg, len(proposal.Messages))
+	for i, anyJSON := range proposal.Messages {
+		var msg sdk.Msg
+		err := cdc.UnmarshalInterfaceJSON(anyJSON, &msg)
+		if err != nil {
+			return proposal, nil, nil, err
+		}
+
+		msgs[i] = msg
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+	if err != nil {
+		return proposal, nil, nil, err
+	}
+
+	return proposal, msgs, deposit, nil
+}
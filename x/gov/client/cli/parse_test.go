@@ -1,14 +1,21 @@
 package cli
 
 import (
+	"encoding/base64"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
-func TestParseSubmitProposalFlags(t *testing.T) {
+func TestParseSubmitLegacyProposalFlags(t *testing.T) {
 	okJSON := testutil.WriteToNewTempFile(t, `
 {
   "title": "Test Proposal",
@@ -19,21 +26,21 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 `)
 
 	badJSON := testutil.WriteToNewTempFile(t, "bad json")
-	fs := NewCmdSubmitProposal().Flags()
+	fs := NewCmdSubmitLegacyProposal().Flags()
 
 	// nonexistent json
 	fs.Set(FlagProposal, "fileDoesNotExist")
-	_, err := parseSubmitProposalFlags(fs)
+	_, err := parseSubmitLegacyProposalFlags(fs)
 	require.Error(t, err)
 
 	// invalid json
 	fs.Set(FlagProposal, badJSON.Name())
-	_, err = parseSubmitProposalFlags(fs)
+	_, err = parseSubmitLegacyProposalFlags(fs)
 	require.Error(t, err)
 
 	// ok json
 	fs.Set(FlagProposal, okJSON.Name())
-	proposal1, err := parseSubmitProposalFlags(fs)
+	proposal1, err := parseSubmitLegacyProposalFlags(fs)
 	require.Nil(t, err, "unexpected error")
 	require.Equal(t, "Test Proposal", proposal1.Title)
 	require.Equal(t, "My awesome proposal", proposal1.Description)
@@ -43,7 +50,7 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	// flags that can't be used with --proposal
 	for _, incompatibleFlag := range ProposalFlags {
 		fs.Set(incompatibleFlag, "some value")
-		_, err := parseSubmitProposalFlags(fs)
+		_, err := parseSubmitLegacyProposalFlags(fs)
 		require.Error(t, err)
 		fs.Set(incompatibleFlag, "")
 	}
@@ -54,7 +61,7 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	fs.Set(FlagDescription, proposal1.Description)
 	fs.Set(FlagProposalType, proposal1.Type)
 	fs.Set(FlagDeposit, proposal1.Deposit)
-	proposal2, err := parseSubmitProposalFlags(fs)
+	proposal2, err := parseSubmitLegacyProposalFlags(fs)
 
 	require.Nil(t, err, "unexpected error")
 	require.Equal(t, proposal1.Title, proposal2.Title)
@@ -67,3 +74,55 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	err = badJSON.Close()
 	require.Nil(t, err, "unexpected error")
 }
+
+func TestParseSubmitProposal(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	expectedMetadata := []byte{42}
+
+	okJSON := testutil.WriteToNewTempFile(t, fmt.Sprintf(`
+{
+	"messages": [
+		{
+			"@type": "/cosmos.bank.v1beta1.MsgSend",
+			"from_address": "%s",
+			"to_address": "%s",
+			"amount":[{"denom": "stake","amount": "10"}]
+		}
+	],
+	"metadata": "%s",
+	"deposit": "1000test",
+	"expedited": true
+}
+`, addr, addr, base64.StdEncoding.EncodeToString(expectedMetadata)))
+
+	badJSON := testutil.WriteToNewTempFile(t, "bad json")
+
+	// nonexistent json
+	_, _, _, err := parseSubmitProposal(cdc, "fileDoesNotExist")
+	require.Error(t, err)
+
+	// invalid json
+	_, _, _, err = parseSubmitProposal(cdc, badJSON.Name())
+	require.Error(t, err)
+
+	// ok json
+	proposal, msgs, deposit, err := parseSubmitProposal(cdc, okJSON.Name())
+	require.NoError(t, err, "unexpected error")
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(1000))), deposit)
+	require.Equal(t, base64.StdEncoding.EncodeToString(expectedMetadata), proposal.Metadata)
+	require.True(t, proposal.Expedited)
+	require.Len(t, msgs, 1)
+	msg, ok := msgs[0].(*banktypes.MsgSend)
+	require.True(t, ok)
+	require.Equal(t, addr.String(), msg.FromAddress)
+	require.Equal(t, addr.String(), msg.ToAddress)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))), msg.Amount)
+
+	err = okJSON.Close()
+	require.Nil(t, err, "unexpected error")
+	err = badJSON.Close()
+	require.Nil(t, err, "unexpected error")
+}
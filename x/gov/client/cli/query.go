@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	gcutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -63,7 +64,7 @@ $ %s query gov proposal 1
 			if err != nil {
 				return err
 			}
-			queryClient := types.NewQueryClient(clientCtx)
+			queryClient := v1.NewQueryClient(clientCtx)
 
 			// validate that the proposal id is a uint
 			proposalID, err := strconv.ParseUint(args[0], 10, 64)
@@ -74,13 +75,13 @@ $ %s query gov proposal 1
 			// Query the proposal
 			res, err := queryClient.Proposal(
 				cmd.Context(),
-				&types.QueryProposalRequest{ProposalId: proposalID},
+				&v1.QueryProposalRequest{ProposalId: proposalID},
 			)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(&res.Proposal)
+			return clientCtx.PrintProto(res.Proposal)
 		},
 	}
 
@@ -112,7 +113,7 @@ $ %s query gov proposals --page=2 --limit=100
 			bechVoterAddr, _ := cmd.Flags().GetString(flagVoter)
 			strProposalStatus, _ := cmd.Flags().GetString(flagStatus)
 
-			var proposalStatus types.ProposalStatus
+			var proposalStatus v1.ProposalStatus
 
 			if len(bechDepositorAddr) != 0 {
 				_, err := sdk.AccAddressFromBech32(bechDepositorAddr)
@@ -129,7 +130,7 @@ $ %s query gov proposals --page=2 --limit=100
 			}
 
 			if len(strProposalStatus) != 0 {
-				proposalStatus1, err := types.ProposalStatusFromString(gcutils.NormalizeProposalStatus(strProposalStatus))
+				proposalStatus1, err := v1.ProposalStatusFromString(gcutils.NormalizeProposalStatus(strProposalStatus))
 				proposalStatus = proposalStatus1
 				if err != nil {
 					return err
@@ -140,7 +141,7 @@ $ %s query gov proposals --page=2 --limit=100
 			if err != nil {
 				return err
 			}
-			queryClient := types.NewQueryClient(clientCtx)
+			queryClient := v1.NewQueryClient(clientCtx)
 
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
@@ -149,7 +150,7 @@ $ %s query gov proposals --page=2 --limit=100
 
 			res, err := queryClient.Proposals(
 				cmd.Context(),
-				&types.QueryProposalsRequest{
+				&v1.QueryProposalsRequest{
 					ProposalStatus: proposalStatus,
 					Voter:          bechVoterAddr,
 					Depositor:      bechDepositorAddr,
@@ -198,7 +199,7 @@ $ %s query gov vote 1 cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 			if err != nil {
 				return err
 			}
-			queryClient := types.NewQueryClient(clientCtx)
+			queryClient := v1.NewQueryClient(clientCtx)
 
 			// validate that the proposal id is a uint
 			proposalID, err := strconv.ParseUint(args[0], 10, 64)
@@ -210,7 +211,7 @@ $ %s query gov vote 1 cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 			ctx := cmd.Context()
 			_, err = queryClient.Proposal(
 				ctx,
-				&types.QueryProposalRequest{ProposalId: proposalID},
+				&v1.QueryProposalRequest{ProposalId: proposalID},
 			)
 			if err != nil {
 				return fmt.Errorf("failed to fetch proposal-id %d: %s", proposalID, err)
@@ -223,7 +224,7 @@ $ %s query gov vote 1 cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 
 			res, err := queryClient.Vote(
 				ctx,
-				&types.QueryVoteRequest{ProposalId: proposalID, Voter: args[1]},
+				&v1.QueryVoteRequest{ProposalId: proposalID, Voter: args[1]},
 			)
 			if err != nil {
 				return err
@@ -237,12 +238,13 @@ $ %s query gov vote 1 cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 					return err
 				}
 
-				if err := clientCtx.Codec.UnmarshalJSON(resByTxQuery, &vote); err != nil {
+				vote = &v1.Vote{}
+				if err := clientCtx.Codec.UnmarshalJSON(resByTxQuery, vote); err != nil {
 					return err
 				}
 			}
 
-			return clientCtx.PrintProto(&res.Vote)
+			return clientCtx.PrintProto(vote)
 		},
 	}
 
@@ -272,7 +274,7 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 			if err != nil {
 				return err
 			}
-			queryClient := types.NewQueryClient(clientCtx)
+			queryClient := v1.NewQueryClient(clientCtx)
 
 			// validate that the proposal id is a uint
 			proposalID, err := strconv.ParseUint(args[0], 10, 64)
@@ -284,14 +286,14 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 			ctx := cmd.Context()
 			proposalRes, err := queryClient.Proposal(
 				ctx,
-				&types.QueryProposalRequest{ProposalId: proposalID},
+				&v1.QueryProposalRequest{ProposalId: proposalID},
 			)
 			if err != nil {
 				return fmt.Errorf("failed to fetch proposal-id %d: %s", proposalID, err)
 			}
 
 			propStatus := proposalRes.GetProposal().Status
-			if !(propStatus == types.StatusVotingPeriod || propStatus == types.StatusDepositPeriod) {
+			if !(propStatus == v1.StatusVotingPeriod || propStatus == v1.StatusDepositPeriod) {
 				page, _ := cmd.Flags().GetInt(flags.FlagPage)
 				limit, _ := cmd.Flags().GetInt(flags.FlagLimit)
 
@@ -301,7 +303,7 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 					return err
 				}
 
-				var votes types.Votes
+				var votes v1.Votes
 				// TODO migrate to use JSONCodec (implement MarshalJSONArray
 				// or wrap lists of proto.Message in some other message)
 				clientCtx.LegacyAmino.MustUnmarshalJSON(resByTxQuery, &votes)
@@ -316,7 +318,7 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 
 			res, err := queryClient.Votes(
 				ctx,
-				&types.QueryVotesRequest{ProposalId: proposalID, Pagination: pageReq},
+				&v1.QueryVotesRequest{ProposalId: proposalID, Pagination: pageReq},
 			)
 			if err != nil {
 				return err
@@ -353,7 +355,7 @@ $ %s query gov deposit 1 cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 			if err != nil {
 				return err
 			}
-			queryClient := types.NewQueryClient(clientCtx)
+			queryClient := v1.NewQueryClient(clientCtx)
 
 			// validate that the proposal id is a uint
 			proposalID, err := strconv.ParseUint(args[0], 10, 64)
@@ -365,7 +367,7 @@ $ %s query gov deposit 1 cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 			ctx := cmd.Context()
 			proposalRes, err := queryClient.Proposal(
 				ctx,
-				&types.QueryProposalRequest{ProposalId: proposalID},
+				&v1.QueryProposalRequest{ProposalId: proposalID},
 			)
 			if err != nil {
 				return fmt.Errorf("failed to fetch proposal-id %d: %s", proposalID, err)
@@ -376,9 +378,9 @@ $ %s query gov deposit 1 cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 				return err
 			}
 
-			var deposit types.Deposit
+			var deposit v1.Deposit
 			propStatus := proposalRes.Proposal.Status
-			if !(propStatus == types.StatusVotingPeriod || propStatus == types.StatusDepositPeriod) {
+			if !(propStatus == v1.StatusVotingPeriod || propStatus == v1.StatusDepositPeriod) {
 				params := types.NewQueryDepositParams(proposalID, depositorAddr)
 				resByTxQuery, err := gcutils.QueryDepositByTxQuery(clientCtx, params)
 				if err != nil {
@@ -390,13 +392,13 @@ $ %s query gov deposit 1 cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 
 			res, err := queryClient.Deposit(
 				ctx,
-				&types.QueryDepositRequest{ProposalId: proposalID, Depositor: args[1]},
+				&v1.QueryDepositRequest{ProposalId: proposalID, Depositor: args[1]},
 			)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(&res.Deposit)
+			return clientCtx.PrintProto(res.Deposit)
 		},
 	}
 
@@ -426,7 +428,7 @@ $ %s query gov deposits 1
 			if err != nil {
 				return err
 			}
-			queryClient := types.NewQueryClient(clientCtx)
+			queryClient := v1.NewQueryClient(clientCtx)
 
 			// validate that the proposal id is a uint
 			proposalID, err := strconv.ParseUint(args[0], 10, 64)
@@ -438,21 +440,21 @@ $ %s query gov deposits 1
 			ctx := cmd.Context()
 			proposalRes, err := queryClient.Proposal(
 				ctx,
-				&types.QueryProposalRequest{ProposalId: proposalID},
+				&v1.QueryProposalRequest{ProposalId: proposalID},
 			)
 			if err != nil {
 				return fmt.Errorf("failed to fetch proposal-id %d: %s", proposalID, err)
 			}
 
 			propStatus := proposalRes.GetProposal().Status
-			if !(propStatus == types.StatusVotingPeriod || propStatus == types.StatusDepositPeriod) {
+			if !(propStatus == v1.StatusVotingPeriod || propStatus == v1.StatusDepositPeriod) {
 				params := types.NewQueryProposalParams(proposalID)
 				resByTxQuery, err := gcutils.QueryDepositsByTxQuery(clientCtx, params)
 				if err != nil {
 					return err
 				}
 
-				var dep types.Deposits
+				var dep v1.Deposits
 				// TODO migrate to use JSONCodec (implement MarshalJSONArray
 				// or wrap lists of proto.Message in some other message)
 				clientCtx.LegacyAmino.MustUnmarshalJSON(resByTxQuery, &dep)
@@ -467,7 +469,7 @@ $ %s query gov deposits 1
 
 			res, err := queryClient.Deposits(
 				ctx,
-				&types.QueryDepositsRequest{ProposalId: proposalID, Pagination: pageReq},
+				&v1.QueryDepositsRequest{ProposalId: proposalID, Pagination: pageReq},
 			)
 			if err != nil {
 				return err
@@ -504,7 +506,7 @@ $ %s query gov tally 1
 			if err != nil {
 				return err
 			}
-			queryClient := types.NewQueryClient(clientCtx)
+			queryClient := v1.NewQueryClient(clientCtx)
 
 			// validate that the proposal id is a uint
 			proposalID, err := strconv.ParseUint(args[0], 10, 64)
@@ -516,7 +518,7 @@ $ %s query gov tally 1
 			ctx := cmd.Context()
 			_, err = queryClient.Proposal(
 				ctx,
-				&types.QueryProposalRequest{ProposalId: proposalID},
+				&v1.QueryProposalRequest{ProposalId: proposalID},
 			)
 			if err != nil {
 				return fmt.Errorf("failed to fetch proposal-id %d: %s", proposalID, err)
@@ -525,13 +527,13 @@ $ %s query gov tally 1
 			// Query store
 			res, err := queryClient.TallyResult(
 				ctx,
-				&types.QueryTallyResultRequest{ProposalId: proposalID},
+				&v1.QueryTallyResultRequest{ProposalId: proposalID},
 			)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(&res.Tally)
+			return clientCtx.PrintProto(res.Tally)
 		},
 	}
 
@@ -560,13 +562,13 @@ $ %s query gov params
 			if err != nil {
 				return err
 			}
-			queryClient := types.NewQueryClient(clientCtx)
+			queryClient := v1.NewQueryClient(clientCtx)
 
 			// Query store for all 3 params
 			ctx := cmd.Context()
 			votingRes, err := queryClient.Params(
 				ctx,
-				&types.QueryParamsRequest{ParamsType: "voting"},
+				&v1.QueryParamsRequest{ParamsType: "voting"},
 			)
 			if err != nil {
 				return err
@@ -574,7 +576,7 @@ $ %s query gov params
 
 			tallyRes, err := queryClient.Params(
 				ctx,
-				&types.QueryParamsRequest{ParamsType: "tallying"},
+				&v1.QueryParamsRequest{ParamsType: "tallying"},
 			)
 			if err != nil {
 				return err
@@ -582,16 +584,16 @@ $ %s query gov params
 
 			depositRes, err := queryClient.Params(
 				ctx,
-				&types.QueryParamsRequest{ParamsType: "deposit"},
+				&v1.QueryParamsRequest{ParamsType: "deposit"},
 			)
 			if err != nil {
 				return err
 			}
 
-			params := types.NewParams(
-				votingRes.GetVotingParams(),
-				tallyRes.GetTallyParams(),
-				depositRes.GetDepositParams(),
+			params := v1.NewParams(
+				*votingRes.GetVotingParams(),
+				*tallyRes.GetTallyParams(),
+				*depositRes.GetDepositParams(),
 			)
 
 			return clientCtx.PrintObjectLegacy(params)
@@ -625,12 +627,12 @@ $ %s query gov param deposit
 			if err != nil {
 				return err
 			}
-			queryClient := types.NewQueryClient(clientCtx)
+			queryClient := v1.NewQueryClient(clientCtx)
 
 			// Query store
 			res, err := queryClient.Params(
 				cmd.Context(),
-				&types.QueryParamsRequest{ParamsType: args[0]},
+				&v1.QueryParamsRequest{ParamsType: args[0]},
 			)
 			if err != nil {
 				return err
@@ -46,7 +46,7 @@ func (s *DepositTestSuite) TestQueryDepositsInitialDeposit() {
 	initialDeposit := sdk.NewCoin(s.cfg.BondDenom, types.DefaultMinDepositTokens.Sub(sdk.NewInt(20))).String()
 
 	// create a proposal with deposit
-	_, err := MsgSubmitProposal(val.ClientCtx, val.Address.String(),
+	_, err := MsgSubmitLegacyProposal(val.ClientCtx, val.Address.String(),
 		"Text Proposal 1", "Where is the title!?", types.ProposalTypeText,
 		fmt.Sprintf("--%s=%s", cli.FlagDeposit, initialDeposit))
 	s.Require().NoError(err)
@@ -74,7 +74,7 @@ func (s *DepositTestSuite) TestQueryDepositsWithoutInitialDeposit() {
 	clientCtx := val.ClientCtx
 
 	// create a proposal without deposit
-	_, err := MsgSubmitProposal(val.ClientCtx, val.Address.String(),
+	_, err := MsgSubmitLegacyProposal(val.ClientCtx, val.Address.String(),
 		"Text Proposal 2", "Where is the title!?", types.ProposalTypeText)
 	s.Require().NoError(err)
 
@@ -102,7 +102,7 @@ func (s *DepositTestSuite) TestQueryProposalNotEnoughDeposits() {
 	initialDeposit := sdk.NewCoin(s.cfg.BondDenom, types.DefaultMinDepositTokens.Sub(sdk.NewInt(2000))).String()
 
 	// create a proposal with deposit
-	_, err := MsgSubmitProposal(val.ClientCtx, val.Address.String(),
+	_, err := MsgSubmitLegacyProposal(val.ClientCtx, val.Address.String(),
 		"Text Proposal 3", "Where is the title!?", types.ProposalTypeText,
 		fmt.Sprintf("--%s=%s", cli.FlagDeposit, initialDeposit))
 	s.Require().NoError(err)
@@ -128,7 +128,7 @@ func (s *DepositTestSuite) TestRejectedProposalDeposits() {
 	initialDeposit := sdk.NewCoin(s.cfg.BondDenom, types.DefaultMinDepositTokens)
 
 	// create a proposal with deposit
-	_, err := MsgSubmitProposal(clientCtx, val.Address.String(),
+	_, err := MsgSubmitLegacyProposal(clientCtx, val.Address.String(),
 		"Text Proposal 4", "Where is the title!?", types.ProposalTypeText,
 		fmt.Sprintf("--%s=%s", cli.FlagDeposit, initialDeposit))
 	s.Require().NoError(err)
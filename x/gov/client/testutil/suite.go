@@ -1,6 +1,7 @@
 package testutil
 
 import (
+	"encoding/base64"
 	"fmt"
 	"strings"
 
@@ -15,8 +16,10 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 type IntegrationTestSuite struct {
@@ -41,7 +44,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	val := s.network.Validators[0]
 
 	// create a proposal with deposit
-	_, err = MsgSubmitProposal(val.ClientCtx, val.Address.String(),
+	_, err = MsgSubmitLegacyProposal(val.ClientCtx, val.Address.String(),
 		"Text Proposal 1", "Where is the title!?", types.ProposalTypeText,
 		fmt.Sprintf("--%s=%s", cli.FlagDeposit, sdk.NewCoin(s.cfg.BondDenom, types.DefaultMinDepositTokens).String()))
 	s.Require().NoError(err)
@@ -53,14 +56,14 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	s.Require().NoError(err)
 
 	// create a proposal without deposit
-	_, err = MsgSubmitProposal(val.ClientCtx, val.Address.String(),
+	_, err = MsgSubmitLegacyProposal(val.ClientCtx, val.Address.String(),
 		"Text Proposal 2", "Where is the title!?", types.ProposalTypeText)
 	s.Require().NoError(err)
 	_, err = s.network.WaitForHeight(1)
 	s.Require().NoError(err)
 
 	// create a proposal3 with deposit
-	_, err = MsgSubmitProposal(val.ClientCtx, val.Address.String(),
+	_, err = MsgSubmitLegacyProposal(val.ClientCtx, val.Address.String(),
 		"Text Proposal 3", "Where is the title!?", types.ProposalTypeText,
 		fmt.Sprintf("--%s=%s", cli.FlagDeposit, sdk.NewCoin(s.cfg.BondDenom, types.DefaultMinDepositTokens).String()))
 	s.Require().NoError(err)
@@ -88,7 +91,7 @@ func (s *IntegrationTestSuite) TestCmdParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"voting_params":{"voting_period":"172800000000000"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000"}}`,
+			`{"voting_params":{"voting_period":"172800000000000","expedited_voting_period":"86400000000000"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","expedited_threshold":"0.667000000000000000"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000"}}`,
 		},
 		{
 			"text output",
@@ -100,10 +103,12 @@ deposit_params:
   - amount: "10000000"
     denom: stake
 tally_params:
+  expedited_threshold: "0.667000000000000000"
   quorum: "0.334000000000000000"
   threshold: "0.500000000000000000"
   veto_threshold: "0.334000000000000000"
 voting_params:
+  expedited_voting_period: "86400000000000"
   voting_period: "172800000000000"
 	`,
 		},
@@ -137,7 +142,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"voting",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"voting_period":"172800000000000"}`,
+			`{"voting_period":"172800000000000","expedited_voting_period":"86400000000000"}`,
 		},
 		{
 			"tally params",
@@ -145,7 +150,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"tallying",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"}`,
+			`{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","expedited_threshold":"0.667000000000000000"}`,
 		},
 		{
 			"deposit params",
@@ -224,7 +229,7 @@ func (s *IntegrationTestSuite) TestCmdTally() {
 		name           string
 		args           []string
 		expectErr      bool
-		expectedOutput types.TallyResult
+		expectedOutput v1.TallyResult
 	}{
 		{
 			"without proposal id",
@@ -232,7 +237,7 @@ func (s *IntegrationTestSuite) TestCmdTally() {
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			true,
-			types.TallyResult{},
+			v1.TallyResult{},
 		},
 		{
 			"json output",
@@ -241,7 +246,7 @@ func (s *IntegrationTestSuite) TestCmdTally() {
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			false,
-			types.NewTallyResult(sdk.NewInt(0), sdk.NewInt(0), sdk.NewInt(0), sdk.NewInt(0)),
+			v1.NewTallyResult(sdk.NewInt(0), sdk.NewInt(0), sdk.NewInt(0), sdk.NewInt(0)),
 		},
 		{
 			"json output",
@@ -250,7 +255,7 @@ func (s *IntegrationTestSuite) TestCmdTally() {
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			false,
-			types.NewTallyResult(s.cfg.BondedTokens, sdk.NewInt(0), sdk.NewInt(0), sdk.NewInt(0)),
+			v1.NewTallyResult(s.cfg.BondedTokens, sdk.NewInt(0), sdk.NewInt(0), sdk.NewInt(0)),
 		},
 	}
 
@@ -265,7 +270,7 @@ func (s *IntegrationTestSuite) TestCmdTally() {
 			if tc.expectErr {
 				s.Require().Error(err)
 			} else {
-				var tally types.TallyResult
+				var tally v1.TallyResult
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &tally), out.String())
 				s.Require().Equal(tally, tc.expectedOutput)
 			}
@@ -275,6 +280,88 @@ func (s *IntegrationTestSuite) TestCmdTally() {
 
 func (s *IntegrationTestSuite) TestNewCmdSubmitProposal() {
 	val := s.network.Validators[0]
+
+	// Create a legacy proposal JSON, make sure it doesn't pass this new CLI
+	// command.
+	invalidProp := `{
+  "title": "",
+  "description": "Where is the title!?",
+  "type": "Text",
+  "deposit": "-324foocoin"
+}`
+	invalidPropFile := testutil.WriteToNewTempFile(s.T(), invalidProp)
+
+	// Create a valid new proposal JSON.
+	propMetadata := []byte{42}
+	validProp := fmt.Sprintf(`
+{
+  "messages": [
+    {
+      "@type": "/cosmos.gov.v1.MsgExecLegacyContent",
+      "authority": "%s",
+      "content": {
+        "@type": "/cosmos.gov.v1beta1.TextProposal",
+        "title": "My awesome title",
+        "description": "My awesome description"
+      }
+    }
+  ],
+  "metadata": "%s",
+  "deposit": "%s"
+}`, authtypes.NewModuleAddress(types.ModuleName), base64.StdEncoding.EncodeToString(propMetadata), sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431)))
+	validPropFile := testutil.WriteToNewTempFile(s.T(), validProp)
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		{
+			"invalid proposal",
+			[]string{
+				invalidPropFile.Name(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, 0, nil,
+		},
+		{
+			"valid proposal",
+			[]string{
+				validPropFile.Name(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0, &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdSubmitProposal()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewCmdSubmitLegacyProposal() {
+	val := s.network.Validators[0]
 	invalidProp := `{
   "title": "",
 	"description": "Where is the title!?",
@@ -349,7 +436,7 @@ func (s *IntegrationTestSuite) TestNewCmdSubmitProposal() {
 		tc := tc
 
 		s.Run(tc.name, func() {
-			cmd := cli.NewCmdSubmitProposal()
+			cmd := cli.NewCmdSubmitLegacyProposal()
 			clientCtx := val.ClientCtx
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
@@ -405,9 +492,14 @@ func (s *IntegrationTestSuite) TestCmdGetProposal() {
 				s.Require().Error(err)
 			} else {
 				s.Require().NoError(err)
-				var proposal types.Proposal
+				var proposal v1.Proposal
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &proposal), out.String())
-				s.Require().Equal(title, proposal.GetTitle())
+				msgs, err := proposal.GetMsgs()
+				s.Require().NoError(err)
+				s.Require().Len(msgs, 1)
+				content, err := v1.LegacyContentFromMessage(msgs[0].(*v1.MsgExecLegacyContent))
+				s.Require().NoError(err)
+				s.Require().Equal(title, content.GetTitle())
 			}
 		})
 	}
@@ -450,7 +542,7 @@ func (s *IntegrationTestSuite) TestCmdGetProposals() {
 				s.Require().Error(err)
 			} else {
 				s.Require().NoError(err)
-				var proposals types.QueryProposalsResponse
+				var proposals v1.QueryProposalsResponse
 
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &proposals), out.String())
 				s.Require().Len(proposals.Proposals, 3)
@@ -497,7 +589,7 @@ func (s *IntegrationTestSuite) TestCmdQueryDeposits() {
 			} else {
 				s.Require().NoError(err)
 
-				var deposits types.QueryDepositsResponse
+				var deposits v1.QueryDepositsResponse
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &deposits), out.String())
 				s.Require().Len(deposits.Deposits, 1)
 			}
@@ -553,9 +645,9 @@ func (s *IntegrationTestSuite) TestCmdQueryDeposit() {
 			} else {
 				s.Require().NoError(err)
 
-				var deposit types.Deposit
+				var deposit v1.Deposit
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &deposit), out.String())
-				s.Require().Equal(depositAmount.String(), deposit.Amount.String())
+				s.Require().Equal(depositAmount.String(), sdk.Coins(deposit.Amount).String())
 			}
 		})
 	}
@@ -682,7 +774,7 @@ func (s *IntegrationTestSuite) TestCmdQueryVotes() {
 			} else {
 				s.Require().NoError(err)
 
-				var votes types.QueryVotesResponse
+				var votes v1.QueryVotesResponse
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &votes), out.String())
 				s.Require().Len(votes.Votes, 1)
 			}
@@ -697,7 +789,7 @@ func (s *IntegrationTestSuite) TestCmdQueryVote() {
 		name           string
 		args           []string
 		expectErr      bool
-		expVoteOptions types.WeightedVoteOptions
+		expVoteOptions v1.WeightedVoteOptions
 	}{
 		{
 			"get vote of non existing proposal",
@@ -706,7 +798,7 @@ func (s *IntegrationTestSuite) TestCmdQueryVote() {
 				val.Address.String(),
 			},
 			true,
-			types.NewNonSplitVoteOption(types.OptionYes),
+			v1.NewNonSplitVoteOption(v1.OptionYes),
 		},
 		{
 			"get vote by wrong voter",
@@ -715,7 +807,7 @@ func (s *IntegrationTestSuite) TestCmdQueryVote() {
 				"wrong address",
 			},
 			true,
-			types.NewNonSplitVoteOption(types.OptionYes),
+			v1.NewNonSplitVoteOption(v1.OptionYes),
 		},
 		{
 			"vote for valid proposal",
@@ -725,7 +817,7 @@ func (s *IntegrationTestSuite) TestCmdQueryVote() {
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			false,
-			types.NewNonSplitVoteOption(types.OptionYes),
+			v1.NewNonSplitVoteOption(v1.OptionYes),
 		},
 		{
 			"split vote for valid proposal",
@@ -735,11 +827,11 @@ func (s *IntegrationTestSuite) TestCmdQueryVote() {
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			false,
-			types.WeightedVoteOptions{
-				types.WeightedVoteOption{Option: types.OptionYes, Weight: sdk.NewDecWithPrec(60, 2)},
-				types.WeightedVoteOption{Option: types.OptionNo, Weight: sdk.NewDecWithPrec(30, 2)},
-				types.WeightedVoteOption{Option: types.OptionAbstain, Weight: sdk.NewDecWithPrec(5, 2)},
-				types.WeightedVoteOption{Option: types.OptionNoWithVeto, Weight: sdk.NewDecWithPrec(5, 2)},
+			v1.WeightedVoteOptions{
+				&v1.WeightedVoteOption{Option: v1.OptionYes, Weight: sdk.NewDecWithPrec(60, 2).String()},
+				&v1.WeightedVoteOption{Option: v1.OptionNo, Weight: sdk.NewDecWithPrec(30, 2).String()},
+				&v1.WeightedVoteOption{Option: v1.OptionAbstain, Weight: sdk.NewDecWithPrec(5, 2).String()},
+				&v1.WeightedVoteOption{Option: v1.OptionNoWithVeto, Weight: sdk.NewDecWithPrec(5, 2).String()},
 			},
 		},
 	}
@@ -757,12 +849,12 @@ func (s *IntegrationTestSuite) TestCmdQueryVote() {
 			} else {
 				s.Require().NoError(err)
 
-				var vote types.Vote
+				var vote v1.Vote
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &vote), out.String())
 				s.Require().Equal(len(vote.Options), len(tc.expVoteOptions))
 				for i, option := range tc.expVoteOptions {
 					s.Require().Equal(option.Option, vote.Options[i].Option)
-					s.Require().True(option.Weight.Equal(vote.Options[i].Weight))
+					s.Require().Equal(option.Weight, vote.Options[i].Weight)
 				}
 			}
 		})
@@ -8,6 +8,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 const (
@@ -38,7 +39,7 @@ func (p Proposer) String() string {
 // NOTE: SearchTxs is used to facilitate the txs query which does not currently
 // support configurable pagination.
 func QueryDepositsByTxQuery(clientCtx client.Context, params types.QueryProposalParams) ([]byte, error) {
-	var deposits []types.Deposit
+	var deposits []v1.Deposit
 
 	// initial deposit was submitted with proposal, so must be queried separately
 	initialDeposit, err := queryInitialDepositByTxQuery(clientCtx, params.ProposalID)
@@ -46,7 +47,7 @@ func QueryDepositsByTxQuery(clientCtx client.Context, params types.QueryProposal
 		return nil, err
 	}
 
-	if !initialDeposit.Amount.IsZero() {
+	if !sdk.Coins(initialDeposit.Amount).IsZero() {
 		deposits = append(deposits, initialDeposit)
 	}
 
@@ -62,6 +63,11 @@ func QueryDepositsByTxQuery(clientCtx client.Context, params types.QueryProposal
 			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, sdk.MsgTypeURL(&types.MsgDeposit{})),
 			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalDeposit, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 		},
+		// Query v1 Msgs event action
+		[]string{
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, sdk.MsgTypeURL(&v1.MsgDeposit{})),
+			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalDeposit, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
+		},
 	)
 	if err != nil {
 		return nil, err
@@ -70,7 +76,15 @@ func QueryDepositsByTxQuery(clientCtx client.Context, params types.QueryProposal
 	for _, info := range searchResult.Txs {
 		for _, msg := range info.GetTx().GetMsgs() {
 			if depMsg, ok := msg.(*types.MsgDeposit); ok {
-				deposits = append(deposits, types.Deposit{
+				deposits = append(deposits, v1.Deposit{
+					Depositor:  depMsg.Depositor,
+					ProposalId: params.ProposalID,
+					Amount:     depMsg.Amount,
+				})
+			}
+
+			if depMsg, ok := msg.(*v1.MsgDeposit); ok {
+				deposits = append(deposits, v1.Deposit{
 					Depositor:  depMsg.Depositor,
 					ProposalId: params.ProposalID,
 					Amount:     depMsg.Amount,
@@ -92,7 +106,7 @@ func QueryDepositsByTxQuery(clientCtx client.Context, params types.QueryProposal
 // marshalled result or any error that occurred.
 func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVotesParams) ([]byte, error) {
 	var (
-		votes      []types.Vote
+		votes      []v1.Vote
 		nextTxPage = defaultPage
 		totalLimit = params.Limit * params.Page
 	)
@@ -122,6 +136,16 @@ func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVot
 				fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, sdk.MsgTypeURL(&types.MsgVoteWeighted{})),
 				fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 			},
+			// Query v1 Vote Msgs
+			[]string{
+				fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, sdk.MsgTypeURL(&v1.MsgVote{})),
+				fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
+			},
+			// Query v1 VoteWeighted Msgs
+			[]string{
+				fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, sdk.MsgTypeURL(&v1.MsgVoteWeighted{})),
+				fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
+			},
 		)
 		if err != nil {
 			return nil, err
@@ -129,20 +153,8 @@ func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVot
 
 		for _, info := range searchResult.Txs {
 			for _, msg := range info.GetTx().GetMsgs() {
-				if voteMsg, ok := msg.(*types.MsgVote); ok {
-					votes = append(votes, types.Vote{
-						Voter:      voteMsg.Voter,
-						ProposalId: params.ProposalID,
-						Options:    types.NewNonSplitVoteOption(voteMsg.Option),
-					})
-				}
-
-				if voteWeightedMsg, ok := msg.(*types.MsgVoteWeighted); ok {
-					votes = append(votes, types.Vote{
-						Voter:      voteWeightedMsg.Voter,
-						ProposalId: params.ProposalID,
-						Options:    voteWeightedMsg.Options,
-					})
+				if vote := voteFromMsg(msg, params.ProposalID); vote != nil {
+					votes = append(votes, *vote)
 				}
 			}
 		}
@@ -154,7 +166,7 @@ func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVot
 	}
 	start, end := client.Paginate(len(votes), params.Page, params.Limit, 100)
 	if start < 0 || end < 0 {
-		votes = []types.Vote{}
+		votes = []v1.Vote{}
 	} else {
 		votes = votes[start:end]
 	}
@@ -195,6 +207,18 @@ func QueryVoteByTxQuery(clientCtx client.Context, params types.QueryVoteParams)
 			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
 		},
+		// Query v1 Vote Msgs
+		[]string{
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, sdk.MsgTypeURL(&v1.MsgVote{})),
+			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
+		},
+		// Query v1 VoteWeighted Msgs
+		[]string{
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, sdk.MsgTypeURL(&v1.MsgVoteWeighted{})),
+			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
+		},
 	)
 	if err != nil {
 		return nil, err
@@ -203,24 +227,7 @@ func QueryVoteByTxQuery(clientCtx client.Context, params types.QueryVoteParams)
 	for _, info := range searchResult.Txs {
 		for _, msg := range info.GetTx().GetMsgs() {
 			// there should only be a single vote under the given conditions
-			var vote *types.Vote
-			if voteMsg, ok := msg.(*types.MsgVote); ok {
-				vote = &types.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalId: params.ProposalID,
-					Options:    types.NewNonSplitVoteOption(voteMsg.Option),
-				}
-			}
-
-			if voteWeightedMsg, ok := msg.(*types.MsgVoteWeighted); ok {
-				vote = &types.Vote{
-					Voter:      voteWeightedMsg.Voter,
-					ProposalId: params.ProposalID,
-					Options:    voteWeightedMsg.Options,
-				}
-			}
-
-			if vote != nil {
+			if vote := voteFromMsg(msg, params.ProposalID); vote != nil {
 				bz, err := clientCtx.Codec.MarshalJSON(vote)
 				if err != nil {
 					return nil, err
@@ -243,7 +250,7 @@ func QueryDepositByTxQuery(clientCtx client.Context, params types.QueryDepositPa
 		return nil, err
 	}
 
-	if !initialDeposit.Amount.IsZero() {
+	if !sdk.Coins(initialDeposit.Amount).IsZero() {
 		bz, err := clientCtx.Codec.MarshalJSON(&initialDeposit)
 		if err != nil {
 			return nil, err
@@ -266,6 +273,12 @@ func QueryDepositByTxQuery(clientCtx client.Context, params types.QueryDepositPa
 			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalDeposit, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Depositor.String())),
 		},
+		// Query v1 Msgs event action
+		[]string{
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, sdk.MsgTypeURL(&v1.MsgDeposit{})),
+			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalDeposit, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Depositor.String())),
+		},
 	)
 	if err != nil {
 		return nil, err
@@ -274,14 +287,25 @@ func QueryDepositByTxQuery(clientCtx client.Context, params types.QueryDepositPa
 	for _, info := range searchResult.Txs {
 		for _, msg := range info.GetTx().GetMsgs() {
 			// there should only be a single deposit under the given conditions
+			var deposit *v1.Deposit
 			if depMsg, ok := msg.(*types.MsgDeposit); ok {
-				deposit := types.Deposit{
+				deposit = &v1.Deposit{
 					Depositor:  depMsg.Depositor,
 					ProposalId: params.ProposalID,
 					Amount:     depMsg.Amount,
 				}
+			}
 
-				bz, err := clientCtx.Codec.MarshalJSON(&deposit)
+			if depMsg, ok := msg.(*v1.MsgDeposit); ok {
+				deposit = &v1.Deposit{
+					Depositor:  depMsg.Depositor,
+					ProposalId: params.ProposalID,
+					Amount:     depMsg.Amount,
+				}
+			}
+
+			if deposit != nil {
+				bz, err := clientCtx.Codec.MarshalJSON(deposit)
 				if err != nil {
 					return nil, err
 				}
@@ -310,6 +334,11 @@ func QueryProposerByTxQuery(clientCtx client.Context, proposalID uint64) (Propos
 			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, sdk.MsgTypeURL(&types.MsgSubmitProposal{})),
 			fmt.Sprintf("%s.%s='%s'", types.EventTypeSubmitProposal, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", proposalID))),
 		},
+		// Query v1 Msgs event action
+		[]string{
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, sdk.MsgTypeURL(&v1.MsgSubmitProposal{})),
+			fmt.Sprintf("%s.%s='%s'", types.EventTypeSubmitProposal, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", proposalID))),
+		},
 	)
 	if err != nil {
 		return Proposer{}, err
@@ -321,6 +350,10 @@ func QueryProposerByTxQuery(clientCtx client.Context, proposalID uint64) (Propos
 			if subMsg, ok := msg.(*types.MsgSubmitProposal); ok {
 				return NewProposer(proposalID, subMsg.Proposer), nil
 			}
+
+			if subMsg, ok := msg.(*v1.MsgSubmitProposal); ok {
+				return NewProposer(proposalID, subMsg.Proposer), nil
+			}
 		}
 	}
 
@@ -367,7 +400,7 @@ func combineEvents(clientCtx client.Context, page int, eventGroups ...[]string)
 
 // queryInitialDepositByTxQuery will query for a initial deposit of a governance proposal by
 // ID.
-func queryInitialDepositByTxQuery(clientCtx client.Context, proposalID uint64) (types.Deposit, error) {
+func queryInitialDepositByTxQuery(clientCtx client.Context, proposalID uint64) (v1.Deposit, error) {
 	searchResult, err := combineEvents(
 		clientCtx, defaultPage,
 		// Query legacy Msgs event action
@@ -380,16 +413,29 @@ func queryInitialDepositByTxQuery(clientCtx client.Context, proposalID uint64) (
 			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, sdk.MsgTypeURL(&types.MsgSubmitProposal{})),
 			fmt.Sprintf("%s.%s='%s'", types.EventTypeSubmitProposal, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", proposalID))),
 		},
+		// Query v1 Msgs event action
+		[]string{
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, sdk.MsgTypeURL(&v1.MsgSubmitProposal{})),
+			fmt.Sprintf("%s.%s='%s'", types.EventTypeSubmitProposal, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", proposalID))),
+		},
 	)
 	if err != nil {
-		return types.Deposit{}, err
+		return v1.Deposit{}, err
 	}
 
 	for _, info := range searchResult.Txs {
 		for _, msg := range info.GetTx().GetMsgs() {
 			// there should only be a single proposal under the given conditions
 			if subMsg, ok := msg.(*types.MsgSubmitProposal); ok {
-				return types.Deposit{
+				return v1.Deposit{
+					ProposalId: proposalID,
+					Depositor:  subMsg.Proposer,
+					Amount:     subMsg.InitialDeposit,
+				}, nil
+			}
+
+			if subMsg, ok := msg.(*v1.MsgSubmitProposal); ok {
+				return v1.Deposit{
 					ProposalId: proposalID,
 					Depositor:  subMsg.Proposer,
 					Amount:     subMsg.InitialDeposit,
@@ -398,5 +444,49 @@ func queryInitialDepositByTxQuery(clientCtx client.Context, proposalID uint64) (
 		}
 	}
 
-	return types.Deposit{}, sdkerrors.ErrNotFound.Wrapf("failed to find the initial deposit for proposalID %d", proposalID)
+	return v1.Deposit{}, sdkerrors.ErrNotFound.Wrapf("failed to find the initial deposit for proposalID %d", proposalID)
+}
+
+// voteFromMsg builds a vote from any of the vote Msgs (both v1beta1 and v1) for
+// the given proposal. It returns nil if the Msg is not a vote.
+func voteFromMsg(msg sdk.Msg, proposalID uint64) *v1.Vote {
+	switch msg := msg.(type) {
+	case *types.MsgVote:
+		return &v1.Vote{
+			Voter:      msg.Voter,
+			ProposalId: proposalID,
+			Options:    v1.NewNonSplitVoteOption(v1.VoteOption(msg.Option)),
+		}
+
+	case *types.MsgVoteWeighted:
+		options := make([]*v1.WeightedVoteOption, len(msg.Options))
+		for i, option := range msg.Options {
+			options[i] = v1.NewWeightedVoteOption(v1.VoteOption(option.Option), option.Weight)
+		}
+
+		return &v1.Vote{
+			Voter:      msg.Voter,
+			ProposalId: proposalID,
+			Options:    options,
+		}
+
+	case *v1.MsgVote:
+		return &v1.Vote{
+			Voter:      msg.Voter,
+			ProposalId: proposalID,
+			Options:    v1.NewNonSplitVoteOption(msg.Option),
+			Metadata:   msg.Metadata,
+		}
+
+	case *v1.MsgVoteWeighted:
+		return &v1.Vote{
+			Voter:      msg.Voter,
+			ProposalId: proposalID,
+			Options:    msg.Options,
+			Metadata:   msg.Metadata,
+		}
+
+	default:
+		return nil
+	}
 }
@@ -6,14 +6,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 // InitGenesis - store genesis parameters
-func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, data *types.GenesisState) {
+func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, data *v1.GenesisState) {
 	k.SetProposalID(ctx, data.StartingProposalId)
-	k.SetDepositParams(ctx, data.DepositParams)
-	k.SetVotingParams(ctx, data.VotingParams)
-	k.SetTallyParams(ctx, data.TallyParams)
+	k.SetDepositParams(ctx, *data.DepositParams)
+	k.SetVotingParams(ctx, *data.VotingParams)
+	k.SetTallyParams(ctx, *data.TallyParams)
 
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
@@ -23,22 +24,22 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 
 	var totalDeposits sdk.Coins
 	for _, deposit := range data.Deposits {
-		k.SetDeposit(ctx, deposit)
+		k.SetDeposit(ctx, *deposit)
 		totalDeposits = totalDeposits.Add(deposit.Amount...)
 	}
 
 	for _, vote := range data.Votes {
-		k.SetVote(ctx, vote)
+		k.SetVote(ctx, *vote)
 	}
 
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
-		case types.StatusDepositPeriod:
-			k.InsertInactiveProposalQueue(ctx, proposal.ProposalId, proposal.DepositEndTime)
-		case types.StatusVotingPeriod:
-			k.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+		case v1.StatusDepositPeriod:
+			k.InsertInactiveProposalQueue(ctx, proposal.Id, *proposal.DepositEndTime)
+		case v1.StatusVotingPeriod:
+			k.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
 		}
-		k.SetProposal(ctx, proposal)
+		k.SetProposal(ctx, *proposal)
 	}
 
 	// if account has zero balance it probably means it's not set, so we set it
@@ -54,30 +55,30 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 }
 
 // ExportGenesis - output genesis parameters
-func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *v1.GenesisState {
 	startingProposalID, _ := k.GetProposalID(ctx)
 	depositParams := k.GetDepositParams(ctx)
 	votingParams := k.GetVotingParams(ctx)
 	tallyParams := k.GetTallyParams(ctx)
 	proposals := k.GetProposals(ctx)
 
-	var proposalsDeposits types.Deposits
-	var proposalsVotes types.Votes
+	var proposalsDeposits v1.Deposits
+	var proposalsVotes v1.Votes
 	for _, proposal := range proposals {
-		deposits := k.GetDeposits(ctx, proposal.ProposalId)
+		deposits := k.GetDeposits(ctx, proposal.Id)
 		proposalsDeposits = append(proposalsDeposits, deposits...)
 
-		votes := k.GetVotes(ctx, proposal.ProposalId)
+		votes := k.GetVotes(ctx, proposal.Id)
 		proposalsVotes = append(proposalsVotes, votes...)
 	}
 
-	return &types.GenesisState{
+	return &v1.GenesisState{
 		StartingProposalId: startingProposalID,
 		Deposits:           proposalsDeposits,
 		Votes:              proposalsVotes,
 		Proposals:          proposals,
-		DepositParams:      depositParams,
-		VotingParams:       votingParams,
-		TallyParams:        tallyParams,
+		DepositParams:      &depositParams,
+		VotingParams:       &votingParams,
+		TallyParams:        &tallyParams,
 	}
 }
@@ -17,6 +17,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 func TestImportExportQueues(t *testing.T) {
@@ -33,13 +34,13 @@ func TestImportExportQueues(t *testing.T) {
 
 	// Create two proposals, put the second into the voting period
 	proposal := TestProposal
-	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal, "", false)
 	require.NoError(t, err)
-	proposalID1 := proposal1.ProposalId
+	proposalID1 := proposal1.Id
 
-	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal, "", false)
 	require.NoError(t, err)
-	proposalID2 := proposal2.ProposalId
+	proposalID2 := proposal2.Id
 
 	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposalID2, addrs[0], app.GovKeeper.GetDepositParams(ctx).MinDeposit)
 	require.NoError(t, err)
@@ -49,8 +50,8 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, ok)
 	proposal2, ok = app.GovKeeper.GetProposal(ctx, proposalID2)
 	require.True(t, ok)
-	require.True(t, proposal1.Status == types.StatusDepositPeriod)
-	require.True(t, proposal2.Status == types.StatusVotingPeriod)
+	require.True(t, proposal1.Status == v1.StatusDepositPeriod)
+	require.True(t, proposal2.Status == v1.StatusVotingPeriod)
 
 	authGenState := auth.ExportGenesis(ctx, app.AccountKeeper)
 	bankGenState := app.BankKeeper.ExportGenesis(ctx)
@@ -88,18 +89,18 @@ func TestImportExportQueues(t *testing.T) {
 	ctx2 := app2.BaseApp.NewContext(false, tmproto.Header{})
 
 	// Jump the time forward past the DepositPeriod and VotingPeriod
-	ctx2 = ctx2.WithBlockTime(ctx2.BlockHeader().Time.Add(app2.GovKeeper.GetDepositParams(ctx2).MaxDepositPeriod).Add(app2.GovKeeper.GetVotingParams(ctx2).VotingPeriod))
+	ctx2 = ctx2.WithBlockTime(ctx2.BlockHeader().Time.Add(*app2.GovKeeper.GetDepositParams(ctx2).MaxDepositPeriod).Add(*app2.GovKeeper.GetVotingParams(ctx2).VotingPeriod))
 
 	// Make sure that they are still in the DepositPeriod and VotingPeriod respectively
 	proposal1, ok = app2.GovKeeper.GetProposal(ctx2, proposalID1)
 	require.True(t, ok)
 	proposal2, ok = app2.GovKeeper.GetProposal(ctx2, proposalID2)
 	require.True(t, ok)
-	require.True(t, proposal1.Status == types.StatusDepositPeriod)
-	require.True(t, proposal2.Status == types.StatusVotingPeriod)
+	require.True(t, proposal1.Status == v1.StatusDepositPeriod)
+	require.True(t, proposal2.Status == v1.StatusVotingPeriod)
 
 	macc := app2.GovKeeper.GetGovernanceAccount(ctx2)
-	require.Equal(t, app2.GovKeeper.GetDepositParams(ctx2).MinDeposit, app2.BankKeeper.GetAllBalances(ctx2, macc.GetAddress()))
+	require.Equal(t, sdk.NewCoins(app2.GovKeeper.GetDepositParams(ctx2).MinDeposit...), app2.BankKeeper.GetAllBalances(ctx2, macc.GetAddress()))
 
 	// Run the endblocker. Check to make sure that proposal1 is removed from state, and proposal2 is finished VotingPeriod.
 	gov.EndBlocker(ctx2, app2.GovKeeper)
@@ -109,15 +110,15 @@ func TestImportExportQueues(t *testing.T) {
 
 	proposal2, ok = app2.GovKeeper.GetProposal(ctx2, proposalID2)
 	require.True(t, ok)
-	require.True(t, proposal2.Status == types.StatusRejected)
+	require.True(t, proposal2.Status == v1.StatusRejected)
 }
 
 func TestImportExportQueues_ErrorUnconsistentState(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
 	require.Panics(t, func() {
-		gov.InitGenesis(ctx, app.AccountKeeper, app.BankKeeper, app.GovKeeper, &types.GenesisState{
-			Deposits: types.Deposits{
+		gov.InitGenesis(ctx, app.AccountKeeper, app.BankKeeper, app.GovKeeper, &v1.GenesisState{
+			Deposits: v1.Deposits{
 				{
 					ProposalId: 1234,
 					Depositor:  "me",
@@ -145,10 +146,10 @@ func TestEqualProposals(t *testing.T) {
 
 	// Submit two proposals
 	proposal := TestProposal
-	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal, "", false)
 	require.NoError(t, err)
 
-	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal, "", false)
 	require.NoError(t, err)
 
 	// They are similar but their IDs should be different
@@ -156,22 +157,20 @@ func TestEqualProposals(t *testing.T) {
 	require.NotEqual(t, proposal1, proposal2)
 
 	// Now create two genesis blocks
-	state1 := types.GenesisState{Proposals: []types.Proposal{proposal1}}
-	state2 := types.GenesisState{Proposals: []types.Proposal{proposal2}}
+	state1 := v1.GenesisState{Proposals: []*v1.Proposal{&proposal1}}
+	state2 := v1.GenesisState{Proposals: []*v1.Proposal{&proposal2}}
 	require.NotEqual(t, state1, state2)
-	require.False(t, state1.Equal(state2))
 
 	// Now make proposals identical by setting both IDs to 55
-	proposal1.ProposalId = 55
-	proposal2.ProposalId = 55
+	proposal1.Id = 55
+	proposal2.Id = 55
 	require.Equal(t, proposal1, proposal1)
 	require.Equal(t, proposal1, proposal2)
 
 	// Reassign proposals into state
-	state1.Proposals[0] = proposal1
-	state2.Proposals[0] = proposal2
+	state1.Proposals[0] = &proposal1
+	state2.Proposals[0] = &proposal2
 
 	// State should be identical now..
 	require.Equal(t, state1, state2)
-	require.True(t, state1.Equal(state2))
 }
@@ -2,7 +2,6 @@ package keeper_test
 
 import (
 	"testing"
-	"time"
 
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -18,9 +17,9 @@ func TestDeposits(t *testing.T) {
 	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", false)
 	require.NoError(t, err)
-	proposalID := proposal.ProposalId
+	proposalID := proposal.Id
 
 	fourStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 4)))
 	fiveStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 5)))
@@ -28,14 +27,14 @@ func TestDeposits(t *testing.T) {
 	addr0Initial := app.BankKeeper.GetAllBalances(ctx, TestAddrs[0])
 	addr1Initial := app.BankKeeper.GetAllBalances(ctx, TestAddrs[1])
 
-	require.True(t, proposal.TotalDeposit.IsEqual(sdk.NewCoins()))
+	require.True(t, sdk.NewCoins(proposal.TotalDeposit...).IsEqual(sdk.NewCoins()))
 
 	// Check no deposits at beginning
 	deposit, found := app.GovKeeper.GetDeposit(ctx, proposalID, TestAddrs[1])
 	require.False(t, found)
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	require.True(t, proposal.VotingStartTime.Equal(time.Time{}))
+	require.Nil(t, proposal.VotingStartTime)
 
 	// Check first deposit
 	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
@@ -43,11 +42,11 @@ func TestDeposits(t *testing.T) {
 	require.False(t, votingStarted)
 	deposit, found = app.GovKeeper.GetDeposit(ctx, proposalID, TestAddrs[0])
 	require.True(t, found)
-	require.Equal(t, fourStake, deposit.Amount)
+	require.Equal(t, fourStake, sdk.NewCoins(deposit.Amount...))
 	require.Equal(t, TestAddrs[0].String(), deposit.Depositor)
 	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	require.Equal(t, fourStake, proposal.TotalDeposit)
+	require.Equal(t, fourStake, sdk.NewCoins(proposal.TotalDeposit...))
 	require.Equal(t, addr0Initial.Sub(fourStake), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 
 	// Check a second deposit from same address
@@ -56,11 +55,11 @@ func TestDeposits(t *testing.T) {
 	require.False(t, votingStarted)
 	deposit, found = app.GovKeeper.GetDeposit(ctx, proposalID, TestAddrs[0])
 	require.True(t, found)
-	require.Equal(t, fourStake.Add(fiveStake...), deposit.Amount)
+	require.Equal(t, fourStake.Add(fiveStake...), sdk.NewCoins(deposit.Amount...))
 	require.Equal(t, TestAddrs[0].String(), deposit.Depositor)
 	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	require.Equal(t, fourStake.Add(fiveStake...), proposal.TotalDeposit)
+	require.Equal(t, fourStake.Add(fiveStake...), sdk.NewCoins(proposal.TotalDeposit...))
 	require.Equal(t, addr0Initial.Sub(fourStake).Sub(fiveStake), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 
 	// Check third deposit from a new address
@@ -70,10 +69,10 @@ func TestDeposits(t *testing.T) {
 	deposit, found = app.GovKeeper.GetDeposit(ctx, proposalID, TestAddrs[1])
 	require.True(t, found)
 	require.Equal(t, TestAddrs[1].String(), deposit.Depositor)
-	require.Equal(t, fourStake, deposit.Amount)
+	require.Equal(t, fourStake, sdk.NewCoins(deposit.Amount...))
 	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	require.Equal(t, fourStake.Add(fiveStake...).Add(fourStake...), proposal.TotalDeposit)
+	require.Equal(t, fourStake.Add(fiveStake...).Add(fourStake...), sdk.NewCoins(proposal.TotalDeposit...))
 	require.Equal(t, addr1Initial.Sub(fourStake), app.BankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 
 	// Check that proposal moved to voting period
@@ -87,14 +86,14 @@ func TestDeposits(t *testing.T) {
 	require.Len(t, deposits, 2)
 	require.Equal(t, deposits, app.GovKeeper.GetDeposits(ctx, proposalID))
 	require.Equal(t, TestAddrs[0].String(), deposits[0].Depositor)
-	require.Equal(t, fourStake.Add(fiveStake...), deposits[0].Amount)
+	require.Equal(t, fourStake.Add(fiveStake...), sdk.NewCoins(deposits[0].Amount...))
 	require.Equal(t, TestAddrs[1].String(), deposits[1].Depositor)
-	require.Equal(t, fourStake, deposits[1].Amount)
+	require.Equal(t, fourStake, sdk.NewCoins(deposits[1].Amount...))
 
 	// Test Refund Deposits
 	deposit, found = app.GovKeeper.GetDeposit(ctx, proposalID, TestAddrs[1])
 	require.True(t, found)
-	require.Equal(t, fourStake, deposit.Amount)
+	require.Equal(t, fourStake, sdk.NewCoins(deposit.Amount...))
 	app.GovKeeper.RefundDeposits(ctx, proposalID)
 	deposit, found = app.GovKeeper.GetDeposit(ctx, proposalID, TestAddrs[1])
 	require.False(t, found)
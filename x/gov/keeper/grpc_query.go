@@ -9,13 +9,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	v046 "github.com/cosmos/cosmos-sdk/x/gov/legacy/v046"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
-var _ types.QueryServer = Keeper{}
+var _ v1.QueryServer = Keeper{}
 
 // Proposal returns proposal details based on ProposalID
-func (q Keeper) Proposal(c context.Context, req *types.QueryProposalRequest) (*types.QueryProposalResponse, error) {
+func (q Keeper) Proposal(c context.Context, req *v1.QueryProposalRequest) (*v1.QueryProposalResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
@@ -31,19 +33,19 @@ func (q Keeper) Proposal(c context.Context, req *types.QueryProposalRequest) (*t
 		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
 	}
 
-	return &types.QueryProposalResponse{Proposal: proposal}, nil
+	return &v1.QueryProposalResponse{Proposal: &proposal}, nil
 }
 
 // Proposals implements the Query/Proposals gRPC method
-func (q Keeper) Proposals(c context.Context, req *types.QueryProposalsRequest) (*types.QueryProposalsResponse, error) {
-	var filteredProposals types.Proposals
+func (q Keeper) Proposals(c context.Context, req *v1.QueryProposalsRequest) (*v1.QueryProposalsResponse, error) {
+	var filteredProposals []*v1.Proposal
 	ctx := sdk.UnwrapSDKContext(c)
 
 	store := ctx.KVStore(q.storeKey)
 	proposalStore := prefix.NewStore(store, types.ProposalsKeyPrefix)
 
 	pageRes, err := query.FilteredPaginate(proposalStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
-		var p types.Proposal
+		var p v1.Proposal
 		if err := q.cdc.Unmarshal(value, &p); err != nil {
 			return false, status.Error(codes.Internal, err.Error())
 		}
@@ -51,7 +53,7 @@ func (q Keeper) Proposals(c context.Context, req *types.QueryProposalsRequest) (
 		matchVoter, matchDepositor, matchStatus := true, true, true
 
 		// match status (if supplied/valid)
-		if types.ValidProposalStatus(req.ProposalStatus) {
+		if v1.ValidProposalStatus(req.ProposalStatus) {
 			matchStatus = p.Status == req.ProposalStatus
 		}
 
@@ -62,7 +64,7 @@ func (q Keeper) Proposals(c context.Context, req *types.QueryProposalsRequest) (
 				return false, err
 			}
 
-			_, matchVoter = q.GetVote(ctx, p.ProposalId, voter)
+			_, matchVoter = q.GetVote(ctx, p.Id, voter)
 		}
 
 		// match depositor (if supplied)
@@ -71,12 +73,12 @@ func (q Keeper) Proposals(c context.Context, req *types.QueryProposalsRequest) (
 			if err != nil {
 				return false, err
 			}
-			_, matchDepositor = q.GetDeposit(ctx, p.ProposalId, depositor)
+			_, matchDepositor = q.GetDeposit(ctx, p.Id, depositor)
 		}
 
 		if matchVoter && matchDepositor && matchStatus {
 			if accumulate {
-				filteredProposals = append(filteredProposals, p)
+				filteredProposals = append(filteredProposals, &p)
 			}
 
 			return true, nil
@@ -88,11 +90,11 @@ func (q Keeper) Proposals(c context.Context, req *types.QueryProposalsRequest) (
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryProposalsResponse{Proposals: filteredProposals, Pagination: pageRes}, nil
+	return &v1.QueryProposalsResponse{Proposals: filteredProposals, Pagination: pageRes}, nil
 }
 
 // Vote returns Voted information based on proposalID, voterAddr
-func (q Keeper) Vote(c context.Context, req *types.QueryVoteRequest) (*types.QueryVoteResponse, error) {
+func (q Keeper) Vote(c context.Context, req *v1.QueryVoteRequest) (*v1.QueryVoteResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
@@ -117,11 +119,11 @@ func (q Keeper) Vote(c context.Context, req *types.QueryVoteRequest) (*types.Que
 			"voter: %v not found for proposal: %v", req.Voter, req.ProposalId)
 	}
 
-	return &types.QueryVoteResponse{Vote: vote}, nil
+	return &v1.QueryVoteResponse{Vote: &vote}, nil
 }
 
 // Votes returns single proposal's votes
-func (q Keeper) Votes(c context.Context, req *types.QueryVotesRequest) (*types.QueryVotesResponse, error) {
+func (q Keeper) Votes(c context.Context, req *v1.QueryVotesRequest) (*v1.QueryVotesResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
@@ -130,31 +132,29 @@ func (q Keeper) Votes(c context.Context, req *types.QueryVotesRequest) (*types.Q
 		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
 	}
 
-	var votes types.Votes
+	var votes []*v1.Vote
 	ctx := sdk.UnwrapSDKContext(c)
 
 	store := ctx.KVStore(q.storeKey)
 	votesStore := prefix.NewStore(store, types.VotesKey(req.ProposalId))
 
 	pageRes, err := query.Paginate(votesStore, req.Pagination, func(key []byte, value []byte) error {
-		var vote types.Vote
+		var vote v1.Vote
 		if err := q.cdc.Unmarshal(value, &vote); err != nil {
 			return err
 		}
-		populateLegacyOption(&vote)
-
-		votes = append(votes, vote)
+		votes = append(votes, &vote)
 		return nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryVotesResponse{Votes: votes, Pagination: pageRes}, nil
+	return &v1.QueryVotesResponse{Votes: votes, Pagination: pageRes}, nil
 }
 
 // Params queries all params
-func (q Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+func (q Keeper) Params(c context.Context, req *v1.QueryParamsRequest) (*v1.QueryParamsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
@@ -164,15 +164,15 @@ func (q Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 	switch req.ParamsType {
 	case types.ParamDeposit:
 		depositParmas := q.GetDepositParams(ctx)
-		return &types.QueryParamsResponse{DepositParams: depositParmas}, nil
+		return &v1.QueryParamsResponse{DepositParams: &depositParmas}, nil
 
 	case types.ParamVoting:
 		votingParmas := q.GetVotingParams(ctx)
-		return &types.QueryParamsResponse{VotingParams: votingParmas}, nil
+		return &v1.QueryParamsResponse{VotingParams: &votingParmas}, nil
 
 	case types.ParamTallying:
 		tallyParams := q.GetTallyParams(ctx)
-		return &types.QueryParamsResponse{TallyParams: tallyParams}, nil
+		return &v1.QueryParamsResponse{TallyParams: &tallyParams}, nil
 
 	default:
 		return nil, status.Errorf(codes.InvalidArgument,
@@ -181,7 +181,7 @@ func (q Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 }
 
 // Deposit queries single deposit information based proposalID, depositAddr
-func (q Keeper) Deposit(c context.Context, req *types.QueryDepositRequest) (*types.QueryDepositResponse, error) {
+func (q Keeper) Deposit(c context.Context, req *v1.QueryDepositRequest) (*v1.QueryDepositResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
@@ -206,11 +206,11 @@ func (q Keeper) Deposit(c context.Context, req *types.QueryDepositRequest) (*typ
 			"depositer: %v not found for proposal: %v", req.Depositor, req.ProposalId)
 	}
 
-	return &types.QueryDepositResponse{Deposit: deposit}, nil
+	return &v1.QueryDepositResponse{Deposit: &deposit}, nil
 }
 
 // Deposits returns single proposal's all deposits
-func (q Keeper) Deposits(c context.Context, req *types.QueryDepositsRequest) (*types.QueryDepositsResponse, error) {
+func (q Keeper) Deposits(c context.Context, req *v1.QueryDepositsRequest) (*v1.QueryDepositsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
@@ -219,30 +219,30 @@ func (q Keeper) Deposits(c context.Context, req *types.QueryDepositsRequest) (*t
 		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
 	}
 
-	var deposits types.Deposits
+	var deposits []*v1.Deposit
 	ctx := sdk.UnwrapSDKContext(c)
 
 	store := ctx.KVStore(q.storeKey)
 	depositStore := prefix.NewStore(store, types.DepositsKey(req.ProposalId))
 
 	pageRes, err := query.Paginate(depositStore, req.Pagination, func(key []byte, value []byte) error {
-		var deposit types.Deposit
+		var deposit v1.Deposit
 		if err := q.cdc.Unmarshal(value, &deposit); err != nil {
 			return err
 		}
 
-		deposits = append(deposits, deposit)
+		deposits = append(deposits, &deposit)
 		return nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryDepositsResponse{Deposits: deposits, Pagination: pageRes}, nil
+	return &v1.QueryDepositsResponse{Deposits: deposits, Pagination: pageRes}, nil
 }
 
 // TallyResult queries the tally of a proposal vote
-func (q Keeper) TallyResult(c context.Context, req *types.QueryTallyResultRequest) (*types.QueryTallyResultResponse, error) {
+func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest) (*v1.QueryTallyResultResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
@@ -258,19 +258,185 @@ func (q Keeper) TallyResult(c context.Context, req *types.QueryTallyResultReques
 		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
 	}
 
-	var tallyResult types.TallyResult
+	var tallyResult v1.TallyResult
 
 	switch {
-	case proposal.Status == types.StatusDepositPeriod:
-		tallyResult = types.EmptyTallyResult()
+	case proposal.Status == v1.StatusDepositPeriod:
+		tallyResult = v1.EmptyTallyResult()
 
-	case proposal.Status == types.StatusPassed || proposal.Status == types.StatusRejected:
-		tallyResult = proposal.FinalTallyResult
+	case proposal.Status == v1.StatusPassed || proposal.Status == v1.StatusRejected:
+		tallyResult = *proposal.FinalTallyResult
 
 	default:
 		// proposal is in voting period
 		_, _, tallyResult = q.Tally(ctx, proposal)
 	}
 
-	return &types.QueryTallyResultResponse{Tally: tallyResult}, nil
+	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
+}
+
+var _ types.QueryServer = legacyQueryServer{}
+
+type legacyQueryServer struct {
+	keeper Keeper
+}
+
+// NewLegacyQueryServer returns an implementation of the v1beta1 legacy QueryServer interface.
+func NewLegacyQueryServer(k Keeper) types.QueryServer {
+	return &legacyQueryServer{keeper: k}
+}
+
+func (q legacyQueryServer) Proposal(c context.Context, req *types.QueryProposalRequest) (*types.QueryProposalResponse, error) {
+	resp, err := q.keeper.Proposal(c, &v1.QueryProposalRequest{
+		ProposalId: req.ProposalId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := v046.ConvertToLegacyProposal(*resp.Proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProposalResponse{Proposal: proposal}, nil
+}
+
+func (q legacyQueryServer) Proposals(c context.Context, req *types.QueryProposalsRequest) (*types.QueryProposalsResponse, error) {
+	resp, err := q.keeper.Proposals(c, &v1.QueryProposalsRequest{
+		ProposalStatus: v1.ProposalStatus(req.ProposalStatus),
+		Voter:          req.Voter,
+		Depositor:      req.Depositor,
+		Pagination:     req.Pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	legacyProposals := make([]types.Proposal, len(resp.Proposals))
+	for idx, proposal := range resp.Proposals {
+		legacyProposals[idx], err = v046.ConvertToLegacyProposal(*proposal)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.QueryProposalsResponse{
+		Proposals:  legacyProposals,
+		Pagination: resp.Pagination,
+	}, nil
+}
+
+func (q legacyQueryServer) Vote(c context.Context, req *types.QueryVoteRequest) (*types.QueryVoteResponse, error) {
+	resp, err := q.keeper.Vote(c, &v1.QueryVoteRequest{
+		ProposalId: req.ProposalId,
+		Voter:      req.Voter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vote, err := v046.ConvertToLegacyVote(*resp.Vote)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVoteResponse{Vote: vote}, nil
+}
+
+func (q legacyQueryServer) Votes(c context.Context, req *types.QueryVotesRequest) (*types.QueryVotesResponse, error) {
+	resp, err := q.keeper.Votes(c, &v1.QueryVotesRequest{
+		ProposalId: req.ProposalId,
+		Pagination: req.Pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	votes := make([]types.Vote, len(resp.Votes))
+	for i, v := range resp.Votes {
+		votes[i], err = v046.ConvertToLegacyVote(*v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.QueryVotesResponse{
+		Votes:      votes,
+		Pagination: resp.Pagination,
+	}, nil
+}
+
+func (q legacyQueryServer) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	resp, err := q.keeper.Params(c, &v1.QueryParamsRequest{
+		ParamsType: req.ParamsType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &types.QueryParamsResponse{}
+
+	if resp.DepositParams != nil {
+		response.DepositParams = v046.ConvertToLegacyDepositParams(*resp.DepositParams)
+	}
+
+	if resp.VotingParams != nil {
+		response.VotingParams = v046.ConvertToLegacyVotingParams(*resp.VotingParams)
+	}
+
+	if resp.TallyParams != nil {
+		response.TallyParams, err = v046.ConvertToLegacyTallyParams(*resp.TallyParams)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return response, nil
+}
+
+func (q legacyQueryServer) Deposit(c context.Context, req *types.QueryDepositRequest) (*types.QueryDepositResponse, error) {
+	resp, err := q.keeper.Deposit(c, &v1.QueryDepositRequest{
+		ProposalId: req.ProposalId,
+		Depositor:  req.Depositor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deposit := v046.ConvertToLegacyDeposit(resp.Deposit)
+	return &types.QueryDepositResponse{Deposit: deposit}, nil
+}
+
+func (q legacyQueryServer) Deposits(c context.Context, req *types.QueryDepositsRequest) (*types.QueryDepositsResponse, error) {
+	resp, err := q.keeper.Deposits(c, &v1.QueryDepositsRequest{
+		ProposalId: req.ProposalId,
+		Pagination: req.Pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deposits := make([]types.Deposit, len(resp.Deposits))
+	for idx, deposit := range resp.Deposits {
+		deposits[idx] = v046.ConvertToLegacyDeposit(deposit)
+	}
+
+	return &types.QueryDepositsResponse{Deposits: deposits, Pagination: resp.Pagination}, nil
+}
+
+func (q legacyQueryServer) TallyResult(c context.Context, req *types.QueryTallyResultRequest) (*types.QueryTallyResultResponse, error) {
+	resp, err := q.keeper.TallyResult(c, &v1.QueryTallyResultRequest{
+		ProposalId: req.ProposalId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tally, err := v046.ConvertToLegacyTallyResult(resp.Tally)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTallyResultResponse{Tally: tally}, nil
 }
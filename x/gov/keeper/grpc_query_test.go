@@ -3,20 +3,21 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
-	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
 
 	var (
-		req         *types.QueryProposalRequest
-		expProposal types.Proposal
+		req         *v1.QueryProposalRequest
+		expProposal v1.Proposal
 	)
 
 	testCases := []struct {
@@ -27,30 +28,29 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 		{
 			"empty request",
 			func() {
-				req = &types.QueryProposalRequest{}
+				req = &v1.QueryProposalRequest{}
 			},
 			false,
 		},
 		{
 			"non existing proposal request",
 			func() {
-				req = &types.QueryProposalRequest{ProposalId: 3}
+				req = &v1.QueryProposalRequest{ProposalId: 3}
 			},
 			false,
 		},
 		{
 			"zero proposal id request",
 			func() {
-				req = &types.QueryProposalRequest{ProposalId: 0}
+				req = &v1.QueryProposalRequest{ProposalId: 0}
 			},
 			false,
 		},
 		{
 			"valid request",
 			func() {
-				req = &types.QueryProposalRequest{ProposalId: 1}
-				testProposal := types.NewTextProposal("Proposal", "testing proposal")
-				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal)
+				req = &v1.QueryProposalRequest{ProposalId: 1}
+				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -68,7 +68,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 
 			if testCase.expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(expProposal.String(), proposalRes.Proposal.String())
+				suite.Require().Equal(app.AppCodec().MustMarshalJSON(&expProposal), app.AppCodec().MustMarshalJSON(proposalRes.Proposal))
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Nil(proposalRes)
@@ -80,11 +80,11 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
 
-	testProposals := []types.Proposal{}
+	testProposals := []*v1.Proposal{}
 
 	var (
-		req    *types.QueryProposalsRequest
-		expRes *types.QueryProposalsResponse
+		req    *v1.QueryProposalsRequest
+		expRes *v1.QueryProposalsResponse
 	)
 
 	testCases := []struct {
@@ -95,7 +95,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 		{
 			"empty state request",
 			func() {
-				req = &types.QueryProposalsRequest{}
+				req = &v1.QueryProposalsRequest{}
 			},
 			true,
 		},
@@ -104,19 +104,21 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 			func() {
 				// create 5 test proposals
 				for i := 0; i < 5; i++ {
-					num := strconv.Itoa(i + 1)
-					testProposal := types.NewTextProposal("Proposal"+num, "testing proposal "+num)
-					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal)
+					govAddress := app.GovKeeper.GetGovernanceAccountAddress()
+					testProposal := []sdk.Msg{
+						banktypes.NewMsgSend(govAddress, addrs[0], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(int64(i+1))))),
+					}
+					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, "", false)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
-					testProposals = append(testProposals, proposal)
+					testProposals = append(testProposals, &proposal)
 				}
 
-				req = &types.QueryProposalsRequest{
+				req = &v1.QueryProposalsRequest{
 					Pagination: &query.PageRequest{Limit: 3},
 				}
 
-				expRes = &types.QueryProposalsResponse{
+				expRes = &v1.QueryProposalsResponse{
 					Proposals: testProposals[:3],
 				}
 			},
@@ -125,11 +127,11 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 		{
 			"request 2nd page with limit 4",
 			func() {
-				req = &types.QueryProposalsRequest{
+				req = &v1.QueryProposalsRequest{
 					Pagination: &query.PageRequest{Offset: 3, Limit: 3},
 				}
 
-				expRes = &types.QueryProposalsResponse{
+				expRes = &v1.QueryProposalsResponse{
 					Proposals: testProposals[3:],
 				}
 			},
@@ -138,11 +140,11 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 		{
 			"request with limit 2 and count true",
 			func() {
-				req = &types.QueryProposalsRequest{
+				req = &v1.QueryProposalsRequest{
 					Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
 				}
 
-				expRes = &types.QueryProposalsResponse{
+				expRes = &v1.QueryProposalsResponse{
 					Proposals: testProposals[:2],
 				}
 			},
@@ -151,11 +153,11 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 		{
 			"request with filter of status deposit period",
 			func() {
-				req = &types.QueryProposalsRequest{
-					ProposalStatus: types.StatusDepositPeriod,
+				req = &v1.QueryProposalsRequest{
+					ProposalStatus: v1.StatusDepositPeriod,
 				}
 
-				expRes = &types.QueryProposalsResponse{
+				expRes = &v1.QueryProposalsResponse{
 					Proposals: testProposals,
 				}
 			},
@@ -165,14 +167,14 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 			"request with filter of deposit address",
 			func() {
 				depositCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 20)))
-				deposit := types.NewDeposit(testProposals[0].ProposalId, addrs[0], depositCoins)
+				deposit := v1.NewDeposit(testProposals[0].Id, addrs[0], depositCoins)
 				app.GovKeeper.SetDeposit(ctx, deposit)
 
-				req = &types.QueryProposalsRequest{
+				req = &v1.QueryProposalsRequest{
 					Depositor: addrs[0].String(),
 				}
 
-				expRes = &types.QueryProposalsResponse{
+				expRes = &v1.QueryProposalsResponse{
 					Proposals: testProposals[:1],
 				}
 			},
@@ -181,15 +183,15 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 		{
 			"request with filter of deposit address",
 			func() {
-				testProposals[1].Status = types.StatusVotingPeriod
-				app.GovKeeper.SetProposal(ctx, testProposals[1])
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, testProposals[1].ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain)))
+				testProposals[1].Status = v1.StatusVotingPeriod
+				app.GovKeeper.SetProposal(ctx, *testProposals[1])
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, testProposals[1].Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionAbstain), ""))
 
-				req = &types.QueryProposalsRequest{
+				req = &v1.QueryProposalsRequest{
 					Voter: addrs[0].String(),
 				}
 
-				expRes = &types.QueryProposalsResponse{
+				expRes = &v1.QueryProposalsResponse{
 					Proposals: testProposals[1:2],
 				}
 			},
@@ -208,7 +210,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 
 				suite.Require().Len(proposals.GetProposals(), len(expRes.GetProposals()))
 				for i := 0; i < len(proposals.GetProposals()); i++ {
-					suite.Require().Equal(proposals.GetProposals()[i].String(), expRes.GetProposals()[i].String())
+					suite.Require().Equal(app.AppCodec().MustMarshalJSON(expRes.GetProposals()[i]), app.AppCodec().MustMarshalJSON(proposals.GetProposals()[i]))
 				}
 
 			} else {
@@ -223,9 +225,9 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
 
 	var (
-		req      *types.QueryVoteRequest
-		expRes   *types.QueryVoteResponse
-		proposal types.Proposal
+		req      *v1.QueryVoteRequest
+		expRes   *v1.QueryVoteResponse
+		proposal v1.Proposal
 	)
 
 	testCases := []struct {
@@ -236,14 +238,14 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 		{
 			"empty request",
 			func() {
-				req = &types.QueryVoteRequest{}
+				req = &v1.QueryVoteRequest{}
 			},
 			false,
 		},
 		{
 			"zero proposal id request",
 			func() {
-				req = &types.QueryVoteRequest{
+				req = &v1.QueryVoteRequest{
 					ProposalId: 0,
 					Voter:      addrs[0].String(),
 				}
@@ -253,7 +255,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 		{
 			"empty voter request",
 			func() {
-				req = &types.QueryVoteRequest{
+				req = &v1.QueryVoteRequest{
 					ProposalId: 1,
 					Voter:      "",
 				}
@@ -263,7 +265,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 		{
 			"non existed proposal",
 			func() {
-				req = &types.QueryVoteRequest{
+				req = &v1.QueryVoteRequest{
 					ProposalId: 3,
 					Voter:      addrs[0].String(),
 				}
@@ -274,43 +276,43 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", false)
 				suite.Require().NoError(err)
 
-				req = &types.QueryVoteRequest{
-					ProposalId: proposal.ProposalId,
+				req = &v1.QueryVoteRequest{
+					ProposalId: proposal.Id,
 					Voter:      addrs[0].String(),
 				}
 
-				expRes = &types.QueryVoteResponse{}
+				expRes = &v1.QueryVoteResponse{}
 			},
 			false,
 		},
 		{
 			"valid request",
 			func() {
-				proposal.Status = types.StatusVotingPeriod
+				proposal.Status = v1.StatusVotingPeriod
 				app.GovKeeper.SetProposal(ctx, proposal)
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain)))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionAbstain), ""))
 
-				req = &types.QueryVoteRequest{
-					ProposalId: proposal.ProposalId,
+				req = &v1.QueryVoteRequest{
+					ProposalId: proposal.Id,
 					Voter:      addrs[0].String(),
 				}
 
-				expRes = &types.QueryVoteResponse{Vote: types.Vote{ProposalId: proposal.ProposalId, Voter: addrs[0].String(), Option: types.OptionAbstain, Options: []types.WeightedVoteOption{{Option: types.OptionAbstain, Weight: sdk.MustNewDecFromStr("1.0")}}}}
+				expRes = &v1.QueryVoteResponse{Vote: &v1.Vote{ProposalId: proposal.Id, Voter: addrs[0].String(), Options: []*v1.WeightedVoteOption{{Option: v1.OptionAbstain, Weight: sdk.MustNewDecFromStr("1.0").String()}}}}
 			},
 			true,
 		},
 		{
 			"wrong voter id request",
 			func() {
-				req = &types.QueryVoteRequest{
-					ProposalId: proposal.ProposalId,
+				req = &v1.QueryVoteRequest{
+					ProposalId: proposal.Id,
 					Voter:      addrs[1].String(),
 				}
 
-				expRes = &types.QueryVoteResponse{}
+				expRes = &v1.QueryVoteResponse{}
 			},
 			false,
 		},
@@ -339,10 +341,10 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
 
 	var (
-		req      *types.QueryVotesRequest
-		expRes   *types.QueryVotesResponse
-		proposal types.Proposal
-		votes    types.Votes
+		req      *v1.QueryVotesRequest
+		expRes   *v1.QueryVotesResponse
+		proposal v1.Proposal
+		votes    v1.Votes
 	)
 
 	testCases := []struct {
@@ -353,14 +355,14 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 		{
 			"empty request",
 			func() {
-				req = &types.QueryVotesRequest{}
+				req = &v1.QueryVotesRequest{}
 			},
 			false,
 		},
 		{
 			"zero proposal id request",
 			func() {
-				req = &types.QueryVotesRequest{
+				req = &v1.QueryVotesRequest{
 					ProposalId: 0,
 				}
 			},
@@ -369,7 +371,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 		{
 			"non existed proposals",
 			func() {
-				req = &types.QueryVotesRequest{
+				req = &v1.QueryVotesRequest{
 					ProposalId: 2,
 				}
 			},
@@ -379,11 +381,11 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", false)
 				suite.Require().NoError(err)
 
-				req = &types.QueryVotesRequest{
-					ProposalId: proposal.ProposalId,
+				req = &v1.QueryVotesRequest{
+					ProposalId: proposal.Id,
 				}
 			},
 			true,
@@ -391,25 +393,25 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 		{
 			"request after adding 2 votes",
 			func() {
-				proposal.Status = types.StatusVotingPeriod
+				proposal.Status = v1.StatusVotingPeriod
 				app.GovKeeper.SetProposal(ctx, proposal)
 
-				votes = []types.Vote{
-					{ProposalId: proposal.ProposalId, Voter: addrs[0].String(), Option: types.OptionAbstain, Options: types.NewNonSplitVoteOption(types.OptionAbstain)},
-					{ProposalId: proposal.ProposalId, Voter: addrs[1].String(), Option: types.OptionYes, Options: types.NewNonSplitVoteOption(types.OptionYes)},
+				votes = []*v1.Vote{
+					{ProposalId: proposal.Id, Voter: addrs[0].String(), Options: v1.NewNonSplitVoteOption(v1.OptionAbstain)},
+					{ProposalId: proposal.Id, Voter: addrs[1].String(), Options: v1.NewNonSplitVoteOption(v1.OptionYes)},
 				}
 				accAddr1, err1 := sdk.AccAddressFromBech32(votes[0].Voter)
 				accAddr2, err2 := sdk.AccAddressFromBech32(votes[1].Voter)
 				suite.Require().NoError(err1)
 				suite.Require().NoError(err2)
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, accAddr1, votes[0].Options))
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, accAddr2, votes[1].Options))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.Id, accAddr1, votes[0].Options, ""))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.Id, accAddr2, votes[1].Options, ""))
 
-				req = &types.QueryVotesRequest{
-					ProposalId: proposal.ProposalId,
+				req = &v1.QueryVotesRequest{
+					ProposalId: proposal.Id,
 				}
 
-				expRes = &types.QueryVotesResponse{
+				expRes = &v1.QueryVotesResponse{
 					Votes: votes,
 				}
 			},
@@ -438,8 +440,8 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 	queryClient := suite.queryClient
 
 	var (
-		req    *types.QueryParamsRequest
-		expRes *types.QueryParamsResponse
+		req    *v1.QueryParamsRequest
+		expRes *v1.QueryParamsResponse
 	)
 
 	testCases := []struct {
@@ -450,17 +452,17 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 		{
 			"empty request",
 			func() {
-				req = &types.QueryParamsRequest{}
+				req = &v1.QueryParamsRequest{}
 			},
 			false,
 		},
 		{
 			"deposit params request",
 			func() {
-				req = &types.QueryParamsRequest{ParamsType: types.ParamDeposit}
-				expRes = &types.QueryParamsResponse{
-					DepositParams: types.DefaultDepositParams(),
-					TallyParams:   types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+				req = &v1.QueryParamsRequest{ParamsType: types.ParamDeposit}
+				depositParams := v1.DefaultDepositParams()
+				expRes = &v1.QueryParamsResponse{
+					DepositParams: &depositParams,
 				}
 			},
 			true,
@@ -468,10 +470,10 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 		{
 			"voting params request",
 			func() {
-				req = &types.QueryParamsRequest{ParamsType: types.ParamVoting}
-				expRes = &types.QueryParamsResponse{
-					VotingParams: types.DefaultVotingParams(),
-					TallyParams:  types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+				req = &v1.QueryParamsRequest{ParamsType: types.ParamVoting}
+				votingParams := v1.DefaultVotingParams()
+				expRes = &v1.QueryParamsResponse{
+					VotingParams: &votingParams,
 				}
 			},
 			true,
@@ -479,9 +481,10 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 		{
 			"tally params request",
 			func() {
-				req = &types.QueryParamsRequest{ParamsType: types.ParamTallying}
-				expRes = &types.QueryParamsResponse{
-					TallyParams: types.DefaultTallyParams(),
+				req = &v1.QueryParamsRequest{ParamsType: types.ParamTallying}
+				tallyParams := v1.DefaultTallyParams()
+				expRes = &v1.QueryParamsResponse{
+					TallyParams: &tallyParams,
 				}
 			},
 			true,
@@ -489,8 +492,8 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 		{
 			"invalid request",
 			func() {
-				req = &types.QueryParamsRequest{ParamsType: "wrongPath"}
-				expRes = &types.QueryParamsResponse{}
+				req = &v1.QueryParamsRequest{ParamsType: "wrongPath"}
+				expRes = &v1.QueryParamsResponse{}
 			},
 			false,
 		},
@@ -519,9 +522,9 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
 
 	var (
-		req      *types.QueryDepositRequest
-		expRes   *types.QueryDepositResponse
-		proposal types.Proposal
+		req      *v1.QueryDepositRequest
+		expRes   *v1.QueryDepositResponse
+		proposal v1.Proposal
 	)
 
 	testCases := []struct {
@@ -532,14 +535,14 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 		{
 			"empty request",
 			func() {
-				req = &types.QueryDepositRequest{}
+				req = &v1.QueryDepositRequest{}
 			},
 			false,
 		},
 		{
 			"zero proposal id request",
 			func() {
-				req = &types.QueryDepositRequest{
+				req = &v1.QueryDepositRequest{
 					ProposalId: 0,
 					Depositor:  addrs[0].String(),
 				}
@@ -549,7 +552,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 		{
 			"empty deposit address request",
 			func() {
-				req = &types.QueryDepositRequest{
+				req = &v1.QueryDepositRequest{
 					ProposalId: 1,
 					Depositor:  "",
 				}
@@ -559,7 +562,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 		{
 			"non existed proposal",
 			func() {
-				req = &types.QueryDepositRequest{
+				req = &v1.QueryDepositRequest{
 					ProposalId: 2,
 					Depositor:  addrs[0].String(),
 				}
@@ -570,12 +573,12 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
-				req = &types.QueryDepositRequest{
-					ProposalId: proposal.ProposalId,
+				req = &v1.QueryDepositRequest{
+					ProposalId: proposal.Id,
 					Depositor:  addrs[0].String(),
 				}
 			},
@@ -585,15 +588,15 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 			"valid request",
 			func() {
 				depositCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 20)))
-				deposit := types.NewDeposit(proposal.ProposalId, addrs[0], depositCoins)
+				deposit := v1.NewDeposit(proposal.Id, addrs[0], depositCoins)
 				app.GovKeeper.SetDeposit(ctx, deposit)
 
-				req = &types.QueryDepositRequest{
-					ProposalId: proposal.ProposalId,
+				req = &v1.QueryDepositRequest{
+					ProposalId: proposal.Id,
 					Depositor:  addrs[0].String(),
 				}
 
-				expRes = &types.QueryDepositResponse{Deposit: deposit}
+				expRes = &v1.QueryDepositResponse{Deposit: &deposit}
 			},
 			true,
 		},
@@ -620,9 +623,9 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
 
 	var (
-		req      *types.QueryDepositsRequest
-		expRes   *types.QueryDepositsResponse
-		proposal types.Proposal
+		req      *v1.QueryDepositsRequest
+		expRes   *v1.QueryDepositsResponse
+		proposal v1.Proposal
 	)
 
 	testCases := []struct {
@@ -633,14 +636,14 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 		{
 			"empty request",
 			func() {
-				req = &types.QueryDepositsRequest{}
+				req = &v1.QueryDepositsRequest{}
 			},
 			false,
 		},
 		{
 			"zero proposal id request",
 			func() {
-				req = &types.QueryDepositsRequest{
+				req = &v1.QueryDepositsRequest{
 					ProposalId: 0,
 				}
 			},
@@ -649,7 +652,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 		{
 			"non existed proposal",
 			func() {
-				req = &types.QueryDepositsRequest{
+				req = &v1.QueryDepositsRequest{
 					ProposalId: 2,
 				}
 			},
@@ -659,11 +662,11 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", false)
 				suite.Require().NoError(err)
 
-				req = &types.QueryDepositsRequest{
-					ProposalId: proposal.ProposalId,
+				req = &v1.QueryDepositsRequest{
+					ProposalId: proposal.Id,
 				}
 			},
 			true,
@@ -672,20 +675,20 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 			"get deposits with default limit",
 			func() {
 				depositAmount1 := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 20)))
-				deposit1 := types.NewDeposit(proposal.ProposalId, addrs[0], depositAmount1)
+				deposit1 := v1.NewDeposit(proposal.Id, addrs[0], depositAmount1)
 				app.GovKeeper.SetDeposit(ctx, deposit1)
 
 				depositAmount2 := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 30)))
-				deposit2 := types.NewDeposit(proposal.ProposalId, addrs[1], depositAmount2)
+				deposit2 := v1.NewDeposit(proposal.Id, addrs[1], depositAmount2)
 				app.GovKeeper.SetDeposit(ctx, deposit2)
 
-				deposits := types.Deposits{deposit1, deposit2}
+				deposits := v1.Deposits{&deposit1, &deposit2}
 
-				req = &types.QueryDepositsRequest{
-					ProposalId: proposal.ProposalId,
+				req = &v1.QueryDepositsRequest{
+					ProposalId: proposal.Id,
 				}
 
-				expRes = &types.QueryDepositsResponse{
+				expRes = &v1.QueryDepositsResponse{
 					Deposits: deposits,
 				}
 			},
@@ -716,9 +719,9 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 	addrs, _ := createValidators(suite.T(), ctx, app, []int64{5, 5, 5})
 
 	var (
-		req      *types.QueryTallyResultRequest
-		expRes   *types.QueryTallyResultResponse
-		proposal types.Proposal
+		req      *v1.QueryTallyResultRequest
+		expRes   *v1.QueryTallyResultResponse
+		proposal v1.Proposal
 	)
 
 	testCases := []struct {
@@ -729,21 +732,21 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 		{
 			"empty request",
 			func() {
-				req = &types.QueryTallyResultRequest{}
+				req = &v1.QueryTallyResultRequest{}
 			},
 			false,
 		},
 		{
 			"zero proposal id request",
 			func() {
-				req = &types.QueryTallyResultRequest{ProposalId: 0}
+				req = &v1.QueryTallyResultRequest{ProposalId: 0}
 			},
 			false,
 		},
 		{
 			"query non existed proposal",
 			func() {
-				req = &types.QueryTallyResultRequest{ProposalId: 1}
+				req = &v1.QueryTallyResultRequest{ProposalId: 1}
 			},
 			false,
 		},
@@ -751,14 +754,15 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 			"create a proposal and get tally",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
-				req = &types.QueryTallyResultRequest{ProposalId: proposal.ProposalId}
+				req = &v1.QueryTallyResultRequest{ProposalId: proposal.Id}
 
-				expRes = &types.QueryTallyResultResponse{
-					Tally: types.EmptyTallyResult(),
+				tallyResult := v1.EmptyTallyResult()
+				expRes = &v1.QueryTallyResultResponse{
+					Tally: &tallyResult,
 				}
 			},
 			true,
@@ -766,18 +770,21 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 		{
 			"request tally after few votes",
 			func() {
-				proposal.Status = types.StatusVotingPeriod
+				proposal.Status = v1.StatusVotingPeriod
 				app.GovKeeper.SetProposal(ctx, proposal)
 
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[2], types.NewNonSplitVoteOption(types.OptionYes)))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.Id, addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.Id, addrs[2], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
 
-				req = &types.QueryTallyResultRequest{ProposalId: proposal.ProposalId}
+				req = &v1.QueryTallyResultRequest{ProposalId: proposal.Id}
 
-				expRes = &types.QueryTallyResultResponse{
-					Tally: types.TallyResult{
-						Yes: sdk.NewInt(3 * 5 * 1000000),
+				expRes = &v1.QueryTallyResultResponse{
+					Tally: &v1.TallyResult{
+						YesCount:        sdk.NewInt(3 * 5 * 1000000).String(),
+						NoCount:         "0",
+						AbstainCount:    "0",
+						NoWithVetoCount: "0",
 					},
 				}
 			},
@@ -786,13 +793,13 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 		{
 			"request final tally after status changed",
 			func() {
-				proposal.Status = types.StatusPassed
+				proposal.Status = v1.StatusPassed
 				app.GovKeeper.SetProposal(ctx, proposal)
-				proposal, _ = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+				proposal, _ = app.GovKeeper.GetProposal(ctx, proposal.Id)
 
-				req = &types.QueryTallyResultRequest{ProposalId: proposal.ProposalId}
+				req = &v1.QueryTallyResultRequest{ProposalId: proposal.Id}
 
-				expRes = &types.QueryTallyResultResponse{
+				expRes = &v1.QueryTallyResultResponse{
 					Tally: proposal.FinalTallyResult,
 				}
 			},
@@ -816,3 +823,38 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestLegacyGRPCQuery() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.legacyQueryClient, suite.addrs
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", false)
+	suite.Require().NoError(err)
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+	suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	proposalRes, err := queryClient.Proposal(gocontext.Background(), &types.QueryProposalRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(proposal.Id, proposalRes.Proposal.ProposalId)
+	suite.Require().Equal(types.StatusVotingPeriod, proposalRes.Proposal.Status)
+	suite.Require().Equal("/cosmos.gov.v1beta1.TextProposal", proposalRes.Proposal.Content.TypeUrl)
+
+	voteRes, err := queryClient.Vote(gocontext.Background(), &types.QueryVoteRequest{ProposalId: proposal.Id, Voter: addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.OptionYes, voteRes.Vote.Option) //nolint:staticcheck
+	suite.Require().Equal([]types.WeightedVoteOption(types.NewNonSplitVoteOption(types.OptionYes)), voteRes.Vote.Options)
+
+	paramsRes, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{ParamsType: types.ParamTallying})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultTallyParams(), paramsRes.TallyParams)
+
+	// proposals without a legacy content message cannot be represented in the
+	// legacy format
+	govAcct := app.GovKeeper.GetGovernanceAccountAddress()
+	msgs := []sdk.Msg{banktypes.NewMsgSend(govAcct, addrs[0], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1))))}
+	proposal, err = app.GovKeeper.SubmitProposal(ctx, msgs, "", false)
+	suite.Require().NoError(err)
+
+	_, err = queryClient.Proposal(gocontext.Background(), &types.QueryProposalRequest{ProposalId: proposal.Id})
+	suite.Require().Error(err)
+}
@@ -6,41 +6,43 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp)
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "", false)
 	suite.Require().NoError(err)
-	proposalID := proposal.ProposalId
+	proposalID := proposal.Id
 	suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
 
 	gotProposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposalID)
 	suite.Require().True(ok)
-	suite.Require().True(proposal.Equal(gotProposal))
+	suite.Require().Equal(suite.app.AppCodec().MustMarshalJSON(&proposal), suite.app.AppCodec().MustMarshalJSON(&gotProposal))
 }
 
 func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp)
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "", false)
 	suite.Require().NoError(err)
 
-	suite.Require().True(proposal.VotingStartTime.Equal(time.Time{}))
+	suite.Require().Nil(proposal.VotingStartTime)
 
 	suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, proposal)
 
-	suite.Require().True(proposal.VotingStartTime.Equal(suite.ctx.BlockHeader().Time))
-
-	proposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+	proposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.Id)
 	suite.Require().True(ok)
+	suite.Require().True(proposal.VotingStartTime.Equal(suite.ctx.BlockHeader().Time))
 
-	activeIterator := suite.app.GovKeeper.ActiveProposalQueueIterator(suite.ctx, proposal.VotingEndTime)
+	activeIterator := suite.app.GovKeeper.ActiveProposalQueueIterator(suite.ctx, *proposal.VotingEndTime)
 	suite.Require().True(activeIterator.Valid())
 
 	proposalID := types.GetProposalIDFromBytes(activeIterator.Value())
-	suite.Require().Equal(proposalID, proposal.ProposalId)
+	suite.Require().Equal(proposalID, proposal.Id)
 	activeIterator.Close()
 }
 
@@ -49,42 +51,76 @@ type invalidProposalRoute struct{ types.TextProposal }
 func (invalidProposalRoute) ProposalRoute() string { return "nonexistingroute" }
 
 func (suite *KeeperTestSuite) TestSubmitProposal() {
+	govAcct := suite.app.GovKeeper.GetGovernanceAccountAddress().String()
+	_, _, randomAddr := testdata.KeyTestPubAddr()
+
 	testCases := []struct {
 		content     types.Content
+		authority   string
+		metadata    string
 		expectedErr error
 	}{
-		{&types.TextProposal{Title: "title", Description: "description"}, nil},
-		// Keeper does not check the validity of title and description, no error
-		{&types.TextProposal{Title: "", Description: "description"}, nil},
-		{&types.TextProposal{Title: strings.Repeat("1234567890", 100), Description: "description"}, nil},
-		{&types.TextProposal{Title: "title", Description: ""}, nil},
-		{&types.TextProposal{Title: "title", Description: strings.Repeat("1234567890", 1000)}, nil},
+		{&types.TextProposal{Title: "title", Description: "description"}, govAcct, "", nil},
+		{&types.TextProposal{Title: "title", Description: strings.Repeat("1234567890", 1000)}, govAcct, "", nil},
+		// error when the content fails stateless validation
+		{&types.TextProposal{Title: "", Description: "description"}, govAcct, "", types.ErrInvalidProposalMsg},
+		{&types.TextProposal{Title: strings.Repeat("1234567890", 100), Description: "description"}, govAcct, "", types.ErrInvalidProposalMsg},
+		{&types.TextProposal{Title: "title", Description: ""}, govAcct, "", types.ErrInvalidProposalMsg},
+		// error when metadata is too long
+		{&types.TextProposal{Title: "title", Description: "description"}, govAcct, strings.Repeat("a", 256), types.ErrMetadataTooLong},
+		// error when signer is not gov acct
+		{&types.TextProposal{Title: "title", Description: "description"}, randomAddr.String(), "", types.ErrInvalidSigner},
 		// error only when invalid route
-		{&invalidProposalRoute{}, types.ErrNoProposalHandlerExists},
+		{&invalidProposalRoute{TextProposal: types.TextProposal{Title: "title", Description: "description"}}, govAcct, "", types.ErrNoProposalHandlerExists},
+	}
+
+	for i, tc := range testCases {
+		prop, err := v1.NewLegacyContent(tc.content, tc.authority)
+		suite.Require().NoError(err)
+		_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, false)
+		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
+	}
+}
+
+func (suite *KeeperTestSuite) TestSubmitProposalMsgs() {
+	govAcct := suite.app.GovKeeper.GetGovernanceAccountAddress()
+	_, _, randomAddr := testdata.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+
+	testCases := []struct {
+		msgs        []sdk.Msg
+		expectedErr error
+	}{
+		{[]sdk.Msg{banktypes.NewMsgSend(govAcct, randomAddr, coins)}, nil},
+		{[]sdk.Msg{}, nil},
+		// signer is not the gov module account
+		{[]sdk.Msg{banktypes.NewMsgSend(randomAddr, govAcct, coins)}, types.ErrInvalidSigner},
+		// msg fails stateless validation
+		{[]sdk.Msg{banktypes.NewMsgSend(govAcct, randomAddr, sdk.Coins{})}, types.ErrInvalidProposalMsg},
 	}
 
 	for i, tc := range testCases {
-		_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tc.content)
+		_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tc.msgs, "", false)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
 
 func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 	proposalID := uint64(1)
-	status := []types.ProposalStatus{types.StatusDepositPeriod, types.StatusVotingPeriod}
+	status := []v1.ProposalStatus{v1.StatusDepositPeriod, v1.StatusVotingPeriod}
 
 	addr1 := sdk.AccAddress("foo_________________")
 
 	for _, s := range status {
 		for i := 0; i < 50; i++ {
-			p, err := types.NewProposal(TestProposal, proposalID, time.Now(), time.Now())
+			p, err := v1.NewProposal(TestProposal, proposalID, "", time.Now(), time.Now(), false)
 			suite.Require().NoError(err)
 
 			p.Status = s
 
 			if i%2 == 0 {
-				d := types.NewDeposit(proposalID, addr1, nil)
-				v := types.NewVote(proposalID, addr1, types.NewNonSplitVoteOption(types.OptionYes))
+				d := v1.NewDeposit(proposalID, addr1, nil)
+				v := v1.NewVote(proposalID, addr1, v1.NewNonSplitVoteOption(v1.OptionYes), "")
 				suite.app.GovKeeper.SetDeposit(suite.ctx, d)
 				suite.app.GovKeeper.SetVote(suite.ctx, v)
 			}
@@ -119,7 +155,7 @@ func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 
 			for _, p := range proposals {
 				if types.ValidProposalStatus(tc.params.ProposalStatus) {
-					suite.Require().Equal(tc.params.ProposalStatus, p.Status)
+					suite.Require().Equal(v1.ProposalStatus(tc.params.ProposalStatus), p.Status)
 				}
 			}
 		})
@@ -7,7 +7,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	v046 "github.com/cosmos/cosmos-sdk/x/gov/legacy/v046"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 // NewQuerier creates a new gov Querier instance
@@ -47,21 +49,28 @@ func NewQuerier(keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier
 func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
 	switch path[0] {
 	case types.ParamDeposit:
-		bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, keeper.GetDepositParams(ctx))
+		depositParams := v046.ConvertToLegacyDepositParams(keeper.GetDepositParams(ctx))
+		bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, depositParams)
 		if err != nil {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 		}
 		return bz, nil
 
 	case types.ParamVoting:
-		bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, keeper.GetVotingParams(ctx))
+		votingParams := v046.ConvertToLegacyVotingParams(keeper.GetVotingParams(ctx))
+		bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, votingParams)
 		if err != nil {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 		}
 		return bz, nil
 
 	case types.ParamTallying:
-		bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, keeper.GetTallyParams(ctx))
+		tallyParams, err := v046.ConvertToLegacyTallyParams(keeper.GetTallyParams(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, tallyParams)
 		if err != nil {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 		}
@@ -85,7 +94,12 @@ func queryProposal(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 		return nil, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", params.ProposalID)
 	}
 
-	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, proposal)
+	legacyProposal, err := v046.ConvertToLegacyProposal(proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, legacyProposal)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -102,7 +116,7 @@ func queryDeposit(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 	}
 
 	deposit, _ := keeper.GetDeposit(ctx, params.ProposalID, params.Depositor)
-	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, deposit)
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, v046.ConvertToLegacyDeposit(&deposit))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -119,7 +133,12 @@ func queryVote(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Kee
 	}
 
 	vote, _ := keeper.GetVote(ctx, params.ProposalID, params.Voter)
-	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, vote)
+	legacyVote, err := v046.ConvertToLegacyVote(vote)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, legacyVote)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -136,11 +155,12 @@ func queryDeposits(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 	}
 
 	deposits := keeper.GetDeposits(ctx, params.ProposalID)
-	if deposits == nil {
-		deposits = types.Deposits{}
+	legacyDeposits := make(types.Deposits, len(deposits))
+	for i, deposit := range deposits {
+		legacyDeposits[i] = v046.ConvertToLegacyDeposit(deposit)
 	}
 
-	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, deposits)
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, legacyDeposits)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -163,21 +183,26 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 		return nil, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
 	}
 
-	var tallyResult types.TallyResult
+	var tallyResult v1.TallyResult
 
 	switch {
-	case proposal.Status == types.StatusDepositPeriod:
-		tallyResult = types.EmptyTallyResult()
+	case proposal.Status == v1.StatusDepositPeriod:
+		tallyResult = v1.EmptyTallyResult()
 
-	case proposal.Status == types.StatusPassed || proposal.Status == types.StatusRejected:
-		tallyResult = proposal.FinalTallyResult
+	case proposal.Status == v1.StatusPassed || proposal.Status == v1.StatusRejected:
+		tallyResult = *proposal.FinalTallyResult
 
 	default:
 		// proposal is in voting period
 		_, _, tallyResult = keeper.Tally(ctx, proposal)
 	}
 
-	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, tallyResult)
+	legacyTallyResult, err := v046.ConvertToLegacyTallyResult(&tallyResult)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, legacyTallyResult)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -195,17 +220,25 @@ func queryVotes(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 
 	votes := keeper.GetVotes(ctx, params.ProposalID)
 	if votes == nil {
-		votes = types.Votes{}
+		votes = v1.Votes{}
 	} else {
 		start, end := client.Paginate(len(votes), params.Page, params.Limit, 100)
 		if start < 0 || end < 0 {
-			votes = types.Votes{}
+			votes = v1.Votes{}
 		} else {
 			votes = votes[start:end]
 		}
 	}
 
-	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, votes)
+	legacyVotes := make(types.Votes, len(votes))
+	for i, vote := range votes {
+		legacyVotes[i], err = v046.ConvertToLegacyVote(*vote)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, legacyVotes)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -221,11 +254,15 @@ func queryProposals(ctx sdk.Context, _ []string, req abci.RequestQuery, keeper K
 	}
 
 	proposals := keeper.GetProposalsFiltered(ctx, params)
-	if proposals == nil {
-		proposals = types.Proposals{}
+	legacyProposals := make(types.Proposals, len(proposals))
+	for i, proposal := range proposals {
+		legacyProposals[i], err = v046.ConvertToLegacyProposal(*proposal)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, proposals)
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, legacyProposals)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
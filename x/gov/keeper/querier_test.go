@@ -14,7 +14,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	v046 "github.com/cosmos/cosmos-sdk/x/gov/legacy/v046"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 const custom = "custom"
@@ -159,148 +161,153 @@ func TestQueries(t *testing.T) {
 
 	depositParams, _, _ := getQueriedParams(t, ctx, legacyQuerierCdc, querier)
 
+	votingStartTime := ctx.BlockHeader().Time
+	votingEndTime := votingStartTime.Add(types.DefaultPeriod)
+
 	// TestAddrs[0] proposes (and deposits) proposals #1 and #2
-	proposal1, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal1, err := app.GovKeeper.SubmitProposal(ctx, tp, "", false)
 	require.NoError(t, err)
-	deposit1 := types.NewDeposit(proposal1.ProposalId, TestAddrs[0], oneCoins)
+	deposit1 := types.NewDeposit(proposal1.Id, TestAddrs[0], oneCoins)
 	depositer1, err := sdk.AccAddressFromBech32(deposit1.Depositor)
 	require.NoError(t, err)
 	_, err = app.GovKeeper.AddDeposit(ctx, deposit1.ProposalId, depositer1, deposit1.Amount)
 	require.NoError(t, err)
 
-	proposal1.TotalDeposit = proposal1.TotalDeposit.Add(deposit1.Amount...)
+	proposal1.TotalDeposit = sdk.NewCoins(proposal1.TotalDeposit...).Add(deposit1.Amount...)
 
-	proposal2, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal2, err := app.GovKeeper.SubmitProposal(ctx, tp, "", false)
 	require.NoError(t, err)
-	deposit2 := types.NewDeposit(proposal2.ProposalId, TestAddrs[0], consCoins)
+	deposit2 := types.NewDeposit(proposal2.Id, TestAddrs[0], consCoins)
 	depositer2, err := sdk.AccAddressFromBech32(deposit2.Depositor)
 	require.NoError(t, err)
 	_, err = app.GovKeeper.AddDeposit(ctx, deposit2.ProposalId, depositer2, deposit2.Amount)
 	require.NoError(t, err)
 
-	proposal2.TotalDeposit = proposal2.TotalDeposit.Add(deposit2.Amount...)
+	proposal2.TotalDeposit = sdk.NewCoins(proposal2.TotalDeposit...).Add(deposit2.Amount...)
 
 	// TestAddrs[1] proposes (and deposits) on proposal #3
-	proposal3, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal3, err := app.GovKeeper.SubmitProposal(ctx, tp, "", false)
 	require.NoError(t, err)
-	deposit3 := types.NewDeposit(proposal3.ProposalId, TestAddrs[1], oneCoins)
+	deposit3 := types.NewDeposit(proposal3.Id, TestAddrs[1], oneCoins)
 	depositer3, err := sdk.AccAddressFromBech32(deposit3.Depositor)
 	require.NoError(t, err)
 
 	_, err = app.GovKeeper.AddDeposit(ctx, deposit3.ProposalId, depositer3, deposit3.Amount)
 	require.NoError(t, err)
 
-	proposal3.TotalDeposit = proposal3.TotalDeposit.Add(deposit3.Amount...)
+	proposal3.TotalDeposit = sdk.NewCoins(proposal3.TotalDeposit...).Add(deposit3.Amount...)
 
 	// TestAddrs[1] deposits on proposals #2 & #3
-	deposit4 := types.NewDeposit(proposal2.ProposalId, TestAddrs[1], depositParams.MinDeposit)
+	deposit4 := types.NewDeposit(proposal2.Id, TestAddrs[1], depositParams.MinDeposit)
 	depositer4, err := sdk.AccAddressFromBech32(deposit4.Depositor)
 	require.NoError(t, err)
 	_, err = app.GovKeeper.AddDeposit(ctx, deposit4.ProposalId, depositer4, deposit4.Amount)
 	require.NoError(t, err)
 
-	proposal2.TotalDeposit = proposal2.TotalDeposit.Add(deposit4.Amount...)
-	proposal2.Status = types.StatusVotingPeriod
-	proposal2.VotingEndTime = proposal2.VotingEndTime.Add(types.DefaultPeriod)
+	proposal2.TotalDeposit = sdk.NewCoins(proposal2.TotalDeposit...).Add(deposit4.Amount...)
+	proposal2.Status = v1.StatusVotingPeriod
+	proposal2.VotingStartTime = &votingStartTime
+	proposal2.VotingEndTime = &votingEndTime
 
-	deposit5 := types.NewDeposit(proposal3.ProposalId, TestAddrs[1], depositParams.MinDeposit)
+	deposit5 := types.NewDeposit(proposal3.Id, TestAddrs[1], depositParams.MinDeposit)
 	depositer5, err := sdk.AccAddressFromBech32(deposit5.Depositor)
 	require.NoError(t, err)
 	_, err = app.GovKeeper.AddDeposit(ctx, deposit5.ProposalId, depositer5, deposit5.Amount)
 	require.NoError(t, err)
 
-	proposal3.TotalDeposit = proposal3.TotalDeposit.Add(deposit5.Amount...)
-	proposal3.Status = types.StatusVotingPeriod
-	proposal3.VotingEndTime = proposal3.VotingEndTime.Add(types.DefaultPeriod)
+	proposal3.TotalDeposit = sdk.NewCoins(proposal3.TotalDeposit...).Add(deposit5.Amount...)
+	proposal3.Status = v1.StatusVotingPeriod
+	proposal3.VotingStartTime = &votingStartTime
+	proposal3.VotingEndTime = &votingEndTime
 	// total deposit of TestAddrs[1] on proposal #3 is worth the proposal deposit + individual deposit
 	deposit5.Amount = deposit5.Amount.Add(deposit3.Amount...)
 
 	// check deposits on proposal1 match individual deposits
 
-	deposits := getQueriedDeposits(t, ctx, legacyQuerierCdc, querier, proposal1.ProposalId)
+	deposits := getQueriedDeposits(t, ctx, legacyQuerierCdc, querier, proposal1.Id)
 	require.Len(t, deposits, 1)
 	require.Equal(t, deposit1, deposits[0])
 
-	deposit := getQueriedDeposit(t, ctx, legacyQuerierCdc, querier, proposal1.ProposalId, TestAddrs[0])
+	deposit := getQueriedDeposit(t, ctx, legacyQuerierCdc, querier, proposal1.Id, TestAddrs[0])
 	require.Equal(t, deposit1, deposit)
 
 	// check deposits on proposal2 match individual deposits
-	deposits = getQueriedDeposits(t, ctx, legacyQuerierCdc, querier, proposal2.ProposalId)
+	deposits = getQueriedDeposits(t, ctx, legacyQuerierCdc, querier, proposal2.Id)
 	require.Len(t, deposits, 2)
 	// NOTE order of deposits is determined by the addresses
 	require.Equal(t, deposit2, deposits[0])
 	require.Equal(t, deposit4, deposits[1])
 
 	// check deposits on proposal3 match individual deposits
-	deposits = getQueriedDeposits(t, ctx, legacyQuerierCdc, querier, proposal3.ProposalId)
+	deposits = getQueriedDeposits(t, ctx, legacyQuerierCdc, querier, proposal3.Id)
 	require.Len(t, deposits, 1)
 	require.Equal(t, deposit5, deposits[0])
 
-	deposit = getQueriedDeposit(t, ctx, legacyQuerierCdc, querier, proposal3.ProposalId, TestAddrs[1])
+	deposit = getQueriedDeposit(t, ctx, legacyQuerierCdc, querier, proposal3.Id, TestAddrs[1])
 	require.Equal(t, deposit5, deposit)
 
 	// Only proposal #1 should be in types.Deposit Period
 	proposals := getQueriedProposals(t, ctx, legacyQuerierCdc, querier, nil, nil, types.StatusDepositPeriod, 1, 0)
 	require.Len(t, proposals, 1)
-	require.Equal(t, proposal1, proposals[0])
+	checkEqualProposals(t, legacyQuerierCdc, proposal1, proposals[0])
 
 	// Only proposals #2 and #3 should be in Voting Period
 	proposals = getQueriedProposals(t, ctx, legacyQuerierCdc, querier, nil, nil, types.StatusVotingPeriod, 1, 0)
 	require.Len(t, proposals, 2)
-	require.Equal(t, proposal2, proposals[0])
-	require.Equal(t, proposal3, proposals[1])
+	checkEqualProposals(t, legacyQuerierCdc, proposal2, proposals[0])
+	checkEqualProposals(t, legacyQuerierCdc, proposal3, proposals[1])
 
 	// Addrs[0] votes on proposals #2 & #3
-	vote1 := types.NewVote(proposal2.ProposalId, TestAddrs[0], types.NewNonSplitVoteOption(types.OptionYes))
-	vote2 := types.NewVote(proposal3.ProposalId, TestAddrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+	vote1 := v1.NewVote(proposal2.Id, TestAddrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	vote2 := v1.NewVote(proposal3.Id, TestAddrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
 	app.GovKeeper.SetVote(ctx, vote1)
 	app.GovKeeper.SetVote(ctx, vote2)
 
 	// Addrs[1] votes on proposal #3
-	vote3 := types.NewVote(proposal3.ProposalId, TestAddrs[1], types.NewNonSplitVoteOption(types.OptionYes))
+	vote3 := v1.NewVote(proposal3.Id, TestAddrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), "")
 	app.GovKeeper.SetVote(ctx, vote3)
 
 	// Test query voted by TestAddrs[0]
 	proposals = getQueriedProposals(t, ctx, legacyQuerierCdc, querier, nil, TestAddrs[0], types.StatusNil, 1, 0)
-	require.Equal(t, proposal2, proposals[0])
-	require.Equal(t, proposal3, proposals[1])
+	checkEqualProposals(t, legacyQuerierCdc, proposal2, proposals[0])
+	checkEqualProposals(t, legacyQuerierCdc, proposal3, proposals[1])
 
 	// Test query votes on types.Proposal 2
-	votes := getQueriedVotes(t, ctx, legacyQuerierCdc, querier, proposal2.ProposalId, 1, 0)
+	votes := getQueriedVotes(t, ctx, legacyQuerierCdc, querier, proposal2.Id, 1, 0)
 	require.Len(t, votes, 1)
 	checkEqualVotes(t, vote1, votes[0])
 
-	vote := getQueriedVote(t, ctx, legacyQuerierCdc, querier, proposal2.ProposalId, TestAddrs[0])
+	vote := getQueriedVote(t, ctx, legacyQuerierCdc, querier, proposal2.Id, TestAddrs[0])
 	checkEqualVotes(t, vote1, vote)
 
 	// Test query votes on types.Proposal 3
-	votes = getQueriedVotes(t, ctx, legacyQuerierCdc, querier, proposal3.ProposalId, 1, 0)
+	votes = getQueriedVotes(t, ctx, legacyQuerierCdc, querier, proposal3.Id, 1, 0)
 	require.Len(t, votes, 2)
 	checkEqualVotes(t, vote2, votes[0])
 	checkEqualVotes(t, vote3, votes[1])
 
 	// Test query all proposals
 	proposals = getQueriedProposals(t, ctx, legacyQuerierCdc, querier, nil, nil, types.StatusNil, 1, 0)
-	require.Equal(t, proposal1, proposals[0])
-	require.Equal(t, proposal2, proposals[1])
-	require.Equal(t, proposal3, proposals[2])
+	checkEqualProposals(t, legacyQuerierCdc, proposal1, proposals[0])
+	checkEqualProposals(t, legacyQuerierCdc, proposal2, proposals[1])
+	checkEqualProposals(t, legacyQuerierCdc, proposal3, proposals[2])
 
 	// Test query voted by TestAddrs[1]
 	proposals = getQueriedProposals(t, ctx, legacyQuerierCdc, querier, nil, TestAddrs[1], types.StatusNil, 1, 0)
-	require.Equal(t, proposal3.ProposalId, proposals[0].ProposalId)
+	require.Equal(t, proposal3.Id, proposals[0].ProposalId)
 
 	// Test query deposited by TestAddrs[0]
 	proposals = getQueriedProposals(t, ctx, legacyQuerierCdc, querier, TestAddrs[0], nil, types.StatusNil, 1, 0)
-	require.Equal(t, proposal1.ProposalId, proposals[0].ProposalId)
+	require.Equal(t, proposal1.Id, proposals[0].ProposalId)
 
 	// Test query deposited by addr2
 	proposals = getQueriedProposals(t, ctx, legacyQuerierCdc, querier, TestAddrs[1], nil, types.StatusNil, 1, 0)
-	require.Equal(t, proposal2.ProposalId, proposals[0].ProposalId)
-	require.Equal(t, proposal3.ProposalId, proposals[1].ProposalId)
+	require.Equal(t, proposal2.Id, proposals[0].ProposalId)
+	require.Equal(t, proposal3.Id, proposals[1].ProposalId)
 
 	// Test query voted AND deposited by addr1
 	proposals = getQueriedProposals(t, ctx, legacyQuerierCdc, querier, TestAddrs[0], TestAddrs[0], types.StatusNil, 1, 0)
-	require.Equal(t, proposal2.ProposalId, proposals[0].ProposalId)
+	require.Equal(t, proposal2.Id, proposals[0].ProposalId)
 }
 
 func TestPaginatedVotesQuery(t *testing.T) {
@@ -308,14 +315,14 @@ func TestPaginatedVotesQuery(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
 	legacyQuerierCdc := app.LegacyAmino()
 
-	proposal := types.Proposal{
-		ProposalId: 100,
-		Status:     types.StatusVotingPeriod,
+	proposal := v1.Proposal{
+		Id:     100,
+		Status: v1.StatusVotingPeriod,
 	}
 
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	votes := make([]types.Vote, 20)
+	votes := make([]v1.Vote, 20)
 	random := rand.New(rand.NewSource(time.Now().UnixNano()))
 	addrMap := make(map[string]struct{})
 	genAddr := func() string {
@@ -330,10 +337,10 @@ func TestPaginatedVotesQuery(t *testing.T) {
 		}
 	}
 	for i := range votes {
-		vote := types.Vote{
-			ProposalId: proposal.ProposalId,
+		vote := v1.Vote{
+			ProposalId: proposal.Id,
 			Voter:      genAddr(),
-			Options:    types.NewNonSplitVoteOption(types.OptionYes),
+			Options:    v1.NewNonSplitVoteOption(v1.OptionYes),
 		}
 		votes[i] = vote
 		app.GovKeeper.SetVote(ctx, vote)
@@ -342,7 +349,7 @@ func TestPaginatedVotesQuery(t *testing.T) {
 	querier := keeper.NewQuerier(app.GovKeeper, legacyQuerierCdc)
 
 	// keeper preserves consistent order for each query, but this is not the insertion order
-	all := getQueriedVotes(t, ctx, legacyQuerierCdc, querier, proposal.ProposalId, 1, 0)
+	all := getQueriedVotes(t, ctx, legacyQuerierCdc, querier, proposal.Id, 1, 0)
 	require.Equal(t, len(all), len(votes))
 
 	type testCase struct {
@@ -376,7 +383,7 @@ func TestPaginatedVotesQuery(t *testing.T) {
 	} {
 		tc := tc
 		t.Run(tc.description, func(t *testing.T) {
-			votes := getQueriedVotes(t, ctx, legacyQuerierCdc, querier, proposal.ProposalId, tc.page, tc.limit)
+			votes := getQueriedVotes(t, ctx, legacyQuerierCdc, querier, proposal.Id, tc.page, tc.limit)
 			require.Equal(t, len(tc.votes), len(votes))
 			for i := range votes {
 				require.Equal(t, tc.votes[i], votes[i])
@@ -385,13 +392,24 @@ func TestPaginatedVotesQuery(t *testing.T) {
 	}
 }
 
-// checkEqualVotes checks that two votes are equal, without taking into account
-// graceful fallback for `Option`.
+// checkEqualProposals checks that a stored proposal is equal to the legacy
+// proposal returned by the querier.
+func checkEqualProposals(t *testing.T, cdc *codec.LegacyAmino, proposal v1.Proposal, legacyProposal types.Proposal) {
+	expected, err := v046.ConvertToLegacyProposal(proposal)
+	require.NoError(t, err)
+	require.Equal(t, cdc.MustMarshalJSON(expected), cdc.MustMarshalJSON(legacyProposal))
+}
+
+// checkEqualVotes checks that a stored vote is equal to the legacy vote
+// returned by the querier, without taking into account graceful fallback
+// for `Option`.
 // When querying, the keeper populates the `vote.Option` field when there's
 // only 1 vote, this function checks equality of structs while skipping that
 // field.
-func checkEqualVotes(t *testing.T, vote1, vote2 types.Vote) {
-	require.Equal(t, vote1.Options, vote2.Options)
-	require.Equal(t, vote1.Voter, vote2.Voter)
-	require.Equal(t, vote1.ProposalId, vote2.ProposalId)
+func checkEqualVotes(t *testing.T, vote v1.Vote, legacyVote types.Vote) {
+	expected, err := v046.ConvertToLegacyVote(vote)
+	require.NoError(t, err)
+	require.Equal(t, expected.Options, legacyVote.Options)
+	require.Equal(t, expected.Voter, legacyVote.Voter)
+	require.Equal(t, expected.ProposalId, legacyVote.ProposalId)
 }
@@ -6,25 +6,31 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 // AddVote adds a vote on a specific proposal
-func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions) error {
+func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options v1.WeightedVoteOptions, metadata string) error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
 	}
-	if proposal.Status != types.StatusVotingPeriod {
+	if proposal.Status != v1.StatusVotingPeriod {
 		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
+	err := keeper.assertMetadataLength(metadata)
+	if err != nil {
+		return err
+	}
+
 	for _, option := range options {
-		if !types.ValidWeightedVoteOption(option) {
+		if !v1.ValidWeightedVoteOption(*option) {
 			return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
 		}
 	}
 
-	vote := types.NewVote(proposalID, voterAddr, options)
+	vote := v1.NewVote(proposalID, voterAddr, options, metadata)
 	keeper.SetVote(ctx, vote)
 
 	// called after a vote on a proposal is cast
@@ -42,27 +48,25 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 }
 
 // GetAllVotes returns all the votes from the store
-func (keeper Keeper) GetAllVotes(ctx sdk.Context) (votes types.Votes) {
-	keeper.IterateAllVotes(ctx, func(vote types.Vote) bool {
-		populateLegacyOption(&vote)
-		votes = append(votes, vote)
+func (keeper Keeper) GetAllVotes(ctx sdk.Context) (votes v1.Votes) {
+	keeper.IterateAllVotes(ctx, func(vote v1.Vote) bool {
+		votes = append(votes, &vote)
 		return false
 	})
 	return
 }
 
 // GetVotes returns all the votes from a proposal
-func (keeper Keeper) GetVotes(ctx sdk.Context, proposalID uint64) (votes types.Votes) {
-	keeper.IterateVotes(ctx, proposalID, func(vote types.Vote) bool {
-		populateLegacyOption(&vote)
-		votes = append(votes, vote)
+func (keeper Keeper) GetVotes(ctx sdk.Context, proposalID uint64) (votes v1.Votes) {
+	keeper.IterateVotes(ctx, proposalID, func(vote v1.Vote) bool {
+		votes = append(votes, &vote)
 		return false
 	})
 	return
 }
 
 // GetVote gets the vote from an address on a specific proposal
-func (keeper Keeper) GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (vote types.Vote, found bool) {
+func (keeper Keeper) GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (vote v1.Vote, found bool) {
 	store := ctx.KVStore(keeper.storeKey)
 	bz := store.Get(types.VoteKey(proposalID, voterAddr))
 	if bz == nil {
@@ -70,18 +74,12 @@ func (keeper Keeper) GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 	}
 
 	keeper.cdc.MustUnmarshal(bz, &vote)
-	populateLegacyOption(&vote)
 
 	return vote, true
 }
 
 // SetVote sets a Vote to the gov store
-func (keeper Keeper) SetVote(ctx sdk.Context, vote types.Vote) {
-	// vote.Option is a deprecated field, we don't set it in state
-	if vote.Option != types.OptionEmpty { //nolint
-		vote.Option = types.OptionEmpty //nolint
-	}
-
+func (keeper Keeper) SetVote(ctx sdk.Context, vote v1.Vote) {
 	store := ctx.KVStore(keeper.storeKey)
 	bz := keeper.cdc.MustMarshal(&vote)
 	addr := sdk.MustAccAddressFromBech32(vote.Voter)
@@ -90,15 +88,14 @@ func (keeper Keeper) SetVote(ctx sdk.Context, vote types.Vote) {
 }
 
 // IterateAllVotes iterates over the all the stored votes and performs a callback function
-func (keeper Keeper) IterateAllVotes(ctx sdk.Context, cb func(vote types.Vote) (stop bool)) {
+func (keeper Keeper) IterateAllVotes(ctx sdk.Context, cb func(vote v1.Vote) (stop bool)) {
 	store := ctx.KVStore(keeper.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, types.VotesKeyPrefix)
 
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		var vote types.Vote
+		var vote v1.Vote
 		keeper.cdc.MustUnmarshal(iterator.Value(), &vote)
-		populateLegacyOption(&vote)
 
 		if cb(vote) {
 			break
@@ -107,15 +104,14 @@ func (keeper Keeper) IterateAllVotes(ctx sdk.Context, cb func(vote types.Vote) (
 }
 
 // IterateVotes iterates over the all the proposals votes and performs a callback function
-func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vote types.Vote) (stop bool)) {
+func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vote v1.Vote) (stop bool)) {
 	store := ctx.KVStore(keeper.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, types.VotesKey(proposalID))
 
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		var vote types.Vote
+		var vote v1.Vote
 		keeper.cdc.MustUnmarshal(iterator.Value(), &vote)
-		populateLegacyOption(&vote)
 
 		if cb(vote) {
 			break
@@ -128,11 +124,3 @@ func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sd
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(types.VoteKey(proposalID, voterAddr))
 }
-
-// populateLegacyOption adds graceful fallback of deprecated `Option` field, in case
-// there's only 1 VoteOption.
-func populateLegacyOption(vote *types.Vote) {
-	if len(vote.Options) == 1 && vote.Options[0].Weight.Equal(sdk.MustNewDecFromStr("1.0")) {
-		vote.Option = vote.Options[0].Option //nolint
-	}
-}
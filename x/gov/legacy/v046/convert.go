@@ -0,0 +1,246 @@
+package v046
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// ConvertToLegacyProposal takes a new proposal and attempts to convert it to the
+// legacy proposal format. This conversion is best effort. New proposal types that
+// don't have a legacy message will return a "nil" content.
+func ConvertToLegacyProposal(proposal v1.Proposal) (types.Proposal, error) {
+	var err error
+	legacyProposal := types.Proposal{
+		ProposalId:   proposal.Id,
+		Status:       types.ProposalStatus(proposal.Status),
+		TotalDeposit: sdk.NewCoins(proposal.TotalDeposit...),
+	}
+
+	legacyProposal.FinalTallyResult, err = ConvertToLegacyTallyResult(proposal.FinalTallyResult)
+	if err != nil {
+		return types.Proposal{}, err
+	}
+
+	if proposal.VotingStartTime != nil {
+		legacyProposal.VotingStartTime = *proposal.VotingStartTime
+	}
+
+	if proposal.VotingEndTime != nil {
+		legacyProposal.VotingEndTime = *proposal.VotingEndTime
+	}
+
+	if proposal.SubmitTime != nil {
+		legacyProposal.SubmitTime = *proposal.SubmitTime
+	}
+
+	if proposal.DepositEndTime != nil {
+		legacyProposal.DepositEndTime = *proposal.DepositEndTime
+	}
+
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return types.Proposal{}, err
+	}
+
+	for _, msg := range msgs {
+		if legacyMsg, ok := msg.(*v1.MsgExecLegacyContent); ok {
+			// check that the content struct can be unmarshalled
+			_, err := v1.LegacyContentFromMessage(legacyMsg)
+			if err != nil {
+				return types.Proposal{}, err
+			}
+			legacyProposal.Content = legacyMsg.Content
+			return legacyProposal, nil
+		}
+	}
+
+	return types.Proposal{}, sdkerrors.ErrInvalidType.Wrapf("proposal %d is not a legacy content-based proposal", proposal.Id)
+}
+
+// ConvertToLegacyTallyResult converts a new tally result to the legacy format.
+func ConvertToLegacyTallyResult(tally *v1.TallyResult) (types.TallyResult, error) {
+	if tally == nil {
+		return types.EmptyTallyResult(), nil
+	}
+
+	yes, ok := sdk.NewIntFromString(tally.YesCount)
+	if !ok {
+		return types.TallyResult{}, fmt.Errorf("unable to convert yes tally string (%s) to int", tally.YesCount)
+	}
+	no, ok := sdk.NewIntFromString(tally.NoCount)
+	if !ok {
+		return types.TallyResult{}, fmt.Errorf("unable to convert no tally string (%s) to int", tally.NoCount)
+	}
+	veto, ok := sdk.NewIntFromString(tally.NoWithVetoCount)
+	if !ok {
+		return types.TallyResult{}, fmt.Errorf("unable to convert no with veto tally string (%s) to int", tally.NoWithVetoCount)
+	}
+	abstain, ok := sdk.NewIntFromString(tally.AbstainCount)
+	if !ok {
+		return types.TallyResult{}, fmt.Errorf("unable to convert abstain tally string (%s) to int", tally.AbstainCount)
+	}
+
+	return types.NewTallyResult(yes, abstain, no, veto), nil
+}
+
+// ConvertToLegacyVote converts a new vote to the legacy format. The deprecated
+// Option field is populated when the vote has a single option of weight 1.
+func ConvertToLegacyVote(vote v1.Vote) (types.Vote, error) {
+	options, err := ConvertToLegacyVoteOptions(vote.Options)
+	if err != nil {
+		return types.Vote{}, err
+	}
+
+	legacyVote := types.Vote{
+		ProposalId: vote.ProposalId,
+		Voter:      vote.Voter,
+		Options:    options,
+	}
+
+	if len(options) == 1 && options[0].Weight.Equal(sdk.OneDec()) {
+		legacyVote.Option = options[0].Option //nolint:staticcheck
+	}
+
+	return legacyVote, nil
+}
+
+// ConvertToLegacyVoteOptions converts new vote options to the legacy format.
+func ConvertToLegacyVoteOptions(voteOptions []*v1.WeightedVoteOption) (types.WeightedVoteOptions, error) {
+	options := make(types.WeightedVoteOptions, len(voteOptions))
+	for i, option := range voteOptions {
+		weight, err := sdk.NewDecFromStr(option.Weight)
+		if err != nil {
+			return options, err
+		}
+		options[i] = types.WeightedVoteOption{
+			Option: types.VoteOption(option.Option),
+			Weight: weight,
+		}
+	}
+	return options, nil
+}
+
+// ConvertToLegacyDeposit converts a new deposit to the legacy format.
+func ConvertToLegacyDeposit(deposit *v1.Deposit) types.Deposit {
+	return types.Deposit{
+		ProposalId: deposit.ProposalId,
+		Depositor:  deposit.Depositor,
+		Amount:     sdk.NewCoins(deposit.Amount...),
+	}
+}
+
+// ConvertToLegacyDepositParams converts new deposit params to the legacy format.
+func ConvertToLegacyDepositParams(params v1.DepositParams) types.DepositParams {
+	legacyParams := types.DepositParams{
+		MinDeposit: sdk.NewCoins(params.MinDeposit...),
+	}
+	if params.MaxDepositPeriod != nil {
+		legacyParams.MaxDepositPeriod = *params.MaxDepositPeriod
+	}
+	return legacyParams
+}
+
+// ConvertToLegacyVotingParams converts new voting params to the legacy format.
+func ConvertToLegacyVotingParams(params v1.VotingParams) types.VotingParams {
+	var legacyParams types.VotingParams
+	if params.VotingPeriod != nil {
+		legacyParams.VotingPeriod = *params.VotingPeriod
+	}
+	return legacyParams
+}
+
+// ConvertToLegacyTallyParams converts new tally params to the legacy format.
+func ConvertToLegacyTallyParams(params v1.TallyParams) (types.TallyParams, error) {
+	quorum, err := sdk.NewDecFromStr(params.Quorum)
+	if err != nil {
+		return types.TallyParams{}, err
+	}
+	threshold, err := sdk.NewDecFromStr(params.Threshold)
+	if err != nil {
+		return types.TallyParams{}, err
+	}
+	vetoThreshold, err := sdk.NewDecFromStr(params.VetoThreshold)
+	if err != nil {
+		return types.TallyParams{}, err
+	}
+
+	return types.NewTallyParams(quorum, threshold, vetoThreshold), nil
+}
+
+// convertToNewProposal converts a legacy proposal to the new format, wrapping
+// its content into a MsgExecLegacyContent executed by the gov module account.
+func convertToNewProposal(oldProp types.Proposal) (v1.Proposal, error) {
+	msg, err := v1.NewLegacyContent(oldProp.GetContent(), authorityAddress())
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	return v1.Proposal{
+		Id:       oldProp.ProposalId,
+		Messages: []*codectypes.Any{msgAny},
+		Status:   v1.ProposalStatus(oldProp.Status),
+		FinalTallyResult: &v1.TallyResult{
+			YesCount:        oldProp.FinalTallyResult.Yes.String(),
+			NoCount:         oldProp.FinalTallyResult.No.String(),
+			AbstainCount:    oldProp.FinalTallyResult.Abstain.String(),
+			NoWithVetoCount: oldProp.FinalTallyResult.NoWithVeto.String(),
+		},
+		SubmitTime:      &oldProp.SubmitTime,
+		DepositEndTime:  &oldProp.DepositEndTime,
+		TotalDeposit:    oldProp.TotalDeposit,
+		VotingStartTime: &oldProp.VotingStartTime,
+		VotingEndTime:   &oldProp.VotingEndTime,
+	}, nil
+}
+
+// convertToNewVote converts a legacy vote to the new format.
+func convertToNewVote(oldVote types.Vote) v1.Vote {
+	options := make(v1.WeightedVoteOptions, len(oldVote.Options))
+	for i, option := range oldVote.Options {
+		options[i] = v1.NewWeightedVoteOption(v1.VoteOption(option.Option), option.Weight)
+	}
+
+	return v1.Vote{
+		ProposalId: oldVote.ProposalId,
+		Voter:      oldVote.Voter,
+		Options:    options,
+	}
+}
+
+// convertToNewDeposit converts a legacy deposit to the new format.
+func convertToNewDeposit(oldDeposit types.Deposit) v1.Deposit {
+	return v1.Deposit{
+		ProposalId: oldDeposit.ProposalId,
+		Depositor:  oldDeposit.Depositor,
+		Amount:     oldDeposit.Amount,
+	}
+}
+
+// addExpeditedParams sets the expedited voting period and threshold, which
+// did not exist before v0.46, to their defaults. The defaults are capped by the
+// regular voting period and threshold so that the resulting params are valid.
+func addExpeditedParams(votingParams v1.VotingParams, tallyParams v1.TallyParams) (v1.VotingParams, v1.TallyParams, error) {
+	expeditedPeriod := v1.DefaultExpeditedPeriod
+	if votingParams.VotingPeriod != nil && *votingParams.VotingPeriod < expeditedPeriod {
+		expeditedPeriod = *votingParams.VotingPeriod
+	}
+	votingParams.ExpeditedVotingPeriod = &expeditedPeriod
+
+	threshold, err := sdk.NewDecFromStr(tallyParams.Threshold)
+	if err != nil {
+		return v1.VotingParams{}, v1.TallyParams{}, err
+	}
+	tallyParams.ExpeditedThreshold = sdk.MaxDec(v1.DefaultExpeditedThreshold, threshold).String()
+
+	return votingParams, tallyParams, nil
+}
@@ -0,0 +1,59 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// MigrateJSON accepts exported v0.43 x/gov genesis state and migrates it to
+// v0.46 x/gov genesis state. The migration includes:
+//
+// - Updating everything to v1.
+// - Migrating proposals to be Msg-based.
+// - Adding the expedited voting period and threshold params.
+func MigrateJSON(oldState *types.GenesisState) (*v1.GenesisState, error) {
+	newProps := make([]*v1.Proposal, len(oldState.Proposals))
+	for i, oldProp := range oldState.Proposals {
+		p, err := convertToNewProposal(oldProp)
+		if err != nil {
+			return nil, err
+		}
+
+		newProps[i] = &p
+	}
+
+	newVotes := make([]*v1.Vote, len(oldState.Votes))
+	for i, oldVote := range oldState.Votes {
+		v := convertToNewVote(oldVote)
+		newVotes[i] = &v
+	}
+
+	newDeposits := make([]*v1.Deposit, len(oldState.Deposits))
+	for i, oldDeposit := range oldState.Deposits {
+		d := convertToNewDeposit(oldDeposit)
+		newDeposits[i] = &d
+	}
+
+	depositParams := v1.NewDepositParams(oldState.DepositParams.MinDeposit, oldState.DepositParams.MaxDepositPeriod)
+	votingParams, tallyParams, err := addExpeditedParams(
+		v1.VotingParams{VotingPeriod: &oldState.VotingParams.VotingPeriod},
+		v1.TallyParams{
+			Quorum:        oldState.TallyParams.Quorum.String(),
+			Threshold:     oldState.TallyParams.Threshold.String(),
+			VetoThreshold: oldState.TallyParams.VetoThreshold.String(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GenesisState{
+		StartingProposalId: oldState.StartingProposalId,
+		Deposits:           newDeposits,
+		Votes:              newVotes,
+		Proposals:          newProps,
+		DepositParams:      &depositParams,
+		VotingParams:       &votingParams,
+		TallyParams:        &tallyParams,
+	}, nil
+}
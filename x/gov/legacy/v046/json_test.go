@@ -0,0 +1,155 @@
+package v046_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v046"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMigrateJSON(t *testing.T) {
+	encodingConfig := simapp.MakeTestEncodingConfig()
+	clientCtx := client.Context{}.
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithTxConfig(encodingConfig.TxConfig).
+		WithCodec(encodingConfig.Marshaler)
+
+	voter, err := sdk.AccAddressFromBech32("cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh")
+	require.NoError(t, err)
+
+	propTime := time.Unix(1e9, 0).UTC()
+	prop1, err := types.NewProposal(types.NewTextProposal("my title 1", "my desc 1"), 1, propTime, propTime)
+	require.NoError(t, err)
+
+	govGenState := types.DefaultGenesisState()
+	govGenState.Proposals = types.Proposals{prop1}
+	govGenState.Votes = types.Votes{
+		types.Vote{ProposalId: 1, Voter: voter.String(), Options: types.NewNonSplitVoteOption(types.OptionAbstain)},
+		types.Vote{ProposalId: 2, Voter: voter.String(), Options: types.WeightedVoteOptions{
+			{Option: types.OptionYes, Weight: sdk.NewDecWithPrec(7, 1)},
+			{Option: types.OptionNo, Weight: sdk.NewDecWithPrec(3, 1)},
+		}},
+	}
+	govGenState.Deposits = types.Deposits{
+		types.NewDeposit(1, voter, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))),
+	}
+
+	migrated, err := v046gov.MigrateJSON(govGenState)
+	require.NoError(t, err)
+
+	bz, err := clientCtx.Codec.MarshalJSON(migrated)
+	require.NoError(t, err)
+
+	// Indent the JSON bz correctly.
+	var jsonObj map[string]interface{}
+	err = json.Unmarshal(bz, &jsonObj)
+	require.NoError(t, err)
+	indentedBz, err := json.MarshalIndent(jsonObj, "", "\t")
+	require.NoError(t, err)
+
+	// Make sure about:
+	// - Proposals use MsgExecLegacyContent
+	// - Votes no longer have the deprecated option field
+	// - Weights and params are encoded as strings
+	expected := `{
+	"deposit_params": {
+		"max_deposit_period": "172800s",
+		"min_deposit": [
+			{
+				"amount": "10000000",
+				"denom": "stake"
+			}
+		]
+	},
+	"deposits": [
+		{
+			"amount": [
+				{
+					"amount": "10",
+					"denom": "stake"
+				}
+			],
+			"depositor": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh",
+			"proposal_id": "1"
+		}
+	],
+	"proposals": [
+		{
+			"deposit_end_time": "2001-09-09T01:46:40Z",
+			"expedited": false,
+			"final_tally_result": {
+				"abstain_count": "0",
+				"no_count": "0",
+				"no_with_veto_count": "0",
+				"yes_count": "0"
+			},
+			"id": "1",
+			"messages": [
+				{
+					"@type": "/cosmos.gov.v1.MsgExecLegacyContent",
+					"authority": "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+					"content": {
+						"@type": "/cosmos.gov.v1beta1.TextProposal",
+						"description": "my desc 1",
+						"title": "my title 1"
+					}
+				}
+			],
+			"metadata": "",
+			"status": "PROPOSAL_STATUS_DEPOSIT_PERIOD",
+			"submit_time": "2001-09-09T01:46:40Z",
+			"total_deposit": [],
+			"voting_end_time": "0001-01-01T00:00:00Z",
+			"voting_start_time": "0001-01-01T00:00:00Z"
+		}
+	],
+	"starting_proposal_id": "1",
+	"tally_params": {
+		"expedited_threshold": "0.667000000000000000",
+		"quorum": "0.334000000000000000",
+		"threshold": "0.500000000000000000",
+		"veto_threshold": "0.334000000000000000"
+	},
+	"votes": [
+		{
+			"metadata": "",
+			"options": [
+				{
+					"option": "VOTE_OPTION_ABSTAIN",
+					"weight": "1.000000000000000000"
+				}
+			],
+			"proposal_id": "1",
+			"voter": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
+		},
+		{
+			"metadata": "",
+			"options": [
+				{
+					"option": "VOTE_OPTION_YES",
+					"weight": "0.700000000000000000"
+				},
+				{
+					"option": "VOTE_OPTION_NO",
+					"weight": "0.300000000000000000"
+				}
+			],
+			"proposal_id": "2",
+			"voter": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
+		}
+	],
+	"voting_params": {
+		"expedited_voting_period": "86400s",
+		"voting_period": "172800s"
+	}
+}`
+
+	require.Equal(t, expected, string(indentedBz))
+}
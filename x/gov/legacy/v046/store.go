@@ -0,0 +1,112 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// authorityAddress returns the address of the gov module account, which is
+// set as the authority of the migrated legacy content messages.
+func authorityAddress() string {
+	return authtypes.NewModuleAddress(ModuleName).String()
+}
+
+// migrateProposals migrates all legacy proposals into MsgExecLegacyContent
+// proposals.
+func migrateProposals(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	propStore := sdk.KVStorePrefixIterator(store, types.ProposalsKeyPrefix)
+
+	defer propStore.Close()
+	for ; propStore.Valid(); propStore.Next() {
+		var oldProp types.Proposal
+		err := cdc.Unmarshal(propStore.Value(), &oldProp)
+		if err != nil {
+			return err
+		}
+
+		newProp, err := convertToNewProposal(oldProp)
+		if err != nil {
+			return err
+		}
+		bz, err := cdc.Marshal(&newProp)
+		if err != nil {
+			return err
+		}
+
+		// Set new value on store.
+		store.Set(propStore.Key(), bz)
+	}
+
+	return nil
+}
+
+// migrateVotes migrates all v1beta1 weighted votes (with sdk.Dec as weight)
+// to v1 weighted votes (with string as weight).
+func migrateVotes(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	votesStore := sdk.KVStorePrefixIterator(store, types.VotesKeyPrefix)
+
+	defer votesStore.Close()
+	for ; votesStore.Valid(); votesStore.Next() {
+		var oldVote types.Vote
+		err := cdc.Unmarshal(votesStore.Value(), &oldVote)
+		if err != nil {
+			return err
+		}
+
+		newVote := convertToNewVote(oldVote)
+		bz, err := cdc.Marshal(&newVote)
+		if err != nil {
+			return err
+		}
+
+		// Set new value on store.
+		store.Set(votesStore.Key(), bz)
+	}
+
+	return nil
+}
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.46. The
+// migration includes:
+//
+//   - Migrate proposals to be Msg-based, wrapping their Content into a
+//     MsgExecLegacyContent.
+//   - Migrate vote weights from sdk.Dec to string.
+//   - Add the expedited voting period and threshold params.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, paramSpace types.ParamSubspace, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	if err := migrateVotes(store, cdc); err != nil {
+		return err
+	}
+
+	if err := migrateProposals(store, cdc); err != nil {
+		return err
+	}
+
+	return migrateParams(ctx, paramSpace)
+}
+
+// migrateParams adds the expedited proposal params to the voting and tally
+// params.
+func migrateParams(ctx sdk.Context, paramSpace types.ParamSubspace) error {
+	var (
+		votingParams v1.VotingParams
+		tallyParams  v1.TallyParams
+	)
+	paramSpace.Get(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+	paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+
+	votingParams, tallyParams, err := addExpeditedParams(votingParams, tallyParams)
+	if err != nil {
+		return err
+	}
+
+	paramSpace.Set(ctx, types.ParamStoreKeyVotingParams, votingParams)
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, tallyParams)
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package v046_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v046"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	cdc := encCfg.Marshaler
+	govKey := sdk.NewKVStoreKey("gov")
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(govKey, tKey)
+	store := ctx.KVStore(govKey)
+
+	// The params are stored under the gov store key, which is enough for
+	// testing the migration.
+	paramSpace := paramstypes.NewSubspace(cdc, encCfg.Amino, govKey, tKey, "gov").WithKeyTable(v1.ParamKeyTable())
+	votingPeriod := 12 * time.Hour
+	paramSpace.Set(ctx, types.ParamStoreKeyVotingParams, v1.VotingParams{VotingPeriod: &votingPeriod})
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, v1.TallyParams{Quorum: "0.4", Threshold: "0.7", VetoThreshold: "0.3"})
+
+	propTime := time.Unix(1e9, 0).UTC()
+
+	// Create 2 proposals
+	prop1, err := types.NewProposal(types.NewTextProposal("my title 1", "my desc 1"), 1, propTime, propTime)
+	require.NoError(t, err)
+	prop1Bz, err := cdc.Marshal(&prop1)
+	require.NoError(t, err)
+	prop2, err := types.NewProposal(types.NewTextProposal("my title 2", "my desc 2"), 2, propTime, propTime)
+	require.NoError(t, err)
+	prop2Bz, err := cdc.Marshal(&prop2)
+	require.NoError(t, err)
+
+	store.Set(types.ProposalKey(prop1.ProposalId), prop1Bz)
+	store.Set(types.ProposalKey(prop2.ProposalId), prop2Bz)
+
+	// Vote on prop 1
+	_, _, voter := testdata.KeyTestPubAddr()
+	options := types.WeightedVoteOptions{
+		{Option: types.OptionNo, Weight: sdk.MustNewDecFromStr("0.3")},
+		{Option: types.OptionYes, Weight: sdk.MustNewDecFromStr("0.7")},
+	}
+	vote1 := types.NewVote(1, voter, options)
+	vote1Bz := cdc.MustMarshal(&vote1)
+	store.Set(types.VoteKey(1, voter), vote1Bz)
+
+	// Run migrations.
+	err = v046gov.MigrateStore(ctx, govKey, paramSpace, cdc)
+	require.NoError(t, err)
+
+	// The expedited params defaults are capped by the regular params.
+	var votingParams v1.VotingParams
+	paramSpace.Get(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+	require.Equal(t, votingPeriod, *votingParams.VotingPeriod)
+	require.Equal(t, votingPeriod, *votingParams.ExpeditedVotingPeriod)
+	var tallyParams v1.TallyParams
+	paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+	require.Equal(t, "0.700000000000000000", tallyParams.ExpeditedThreshold)
+
+	var newProp1 v1.Proposal
+	err = cdc.Unmarshal(store.Get(types.ProposalKey(prop1.ProposalId)), &newProp1)
+	require.NoError(t, err)
+	compareProps(t, prop1, newProp1)
+
+	var newProp2 v1.Proposal
+	err = cdc.Unmarshal(store.Get(types.ProposalKey(prop2.ProposalId)), &newProp2)
+	require.NoError(t, err)
+	compareProps(t, prop2, newProp2)
+
+	var newVote1 v1.Vote
+	err = cdc.Unmarshal(store.Get(types.VoteKey(prop1.ProposalId, voter)), &newVote1)
+	require.NoError(t, err)
+	// Without the votes migration, we would have 300000000000000000 in state,
+	// because of how sdk.Dec stores itself in state.
+	require.Equal(t, "0.300000000000000000", newVote1.Options[0].Weight)
+	require.Equal(t, "0.700000000000000000", newVote1.Options[1].Weight)
+
+	// Make sure the migrated proposal converts back to the original one.
+	legacyProp1, err := v046gov.ConvertToLegacyProposal(newProp1)
+	require.NoError(t, err)
+	require.Equal(t, prop1.GetContent().GetTitle(), legacyProp1.GetContent().GetTitle())
+}
+
+func compareProps(t *testing.T, oldProp types.Proposal, newProp v1.Proposal) {
+	require.Equal(t, oldProp.ProposalId, newProp.Id)
+	require.Equal(t, oldProp.TotalDeposit.String(), sdk.Coins(newProp.TotalDeposit).String())
+	require.Equal(t, oldProp.Status.String(), newProp.Status.String())
+	require.Equal(t, oldProp.FinalTallyResult.Yes.String(), newProp.FinalTallyResult.YesCount)
+	require.Equal(t, oldProp.FinalTallyResult.No.String(), newProp.FinalTallyResult.NoCount)
+	require.Equal(t, oldProp.FinalTallyResult.NoWithVeto.String(), newProp.FinalTallyResult.NoWithVetoCount)
+	require.Equal(t, oldProp.FinalTallyResult.Abstain.String(), newProp.FinalTallyResult.AbstainCount)
+
+	newContent := newProp.Messages[0].GetCachedValue().(*v1.MsgExecLegacyContent).Content.GetCachedValue().(types.Content)
+	require.Equal(t, oldProp.Content.GetCachedValue().(types.Content), newContent)
+
+	// Compare UNIX times, as a simple Equal gives difference between Local and
+	// UTC times.
+	// ref: https://github.com/golang/protobuf/issues/1394#issuecomment-1003014284
+	require.Equal(t, oldProp.SubmitTime.UTC(), newProp.SubmitTime.UTC())
+	require.Equal(t, oldProp.DepositEndTime.UTC(), newProp.DepositEndTime.UTC())
+	require.Equal(t, oldProp.VotingStartTime.UTC(), newProp.VotingStartTime.UTC())
+	require.Equal(t, oldProp.VotingEndTime.UTC(), newProp.VotingEndTime.UTC())
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 // NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
@@ -16,12 +17,12 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
 		case bytes.Equal(kvA.Key[:1], types.ProposalsKeyPrefix):
-			var proposalA types.Proposal
+			var proposalA v1.Proposal
 			err := cdc.Unmarshal(kvA.Value, &proposalA)
 			if err != nil {
 				panic(err)
 			}
-			var proposalB types.Proposal
+			var proposalB v1.Proposal
 			err = cdc.Unmarshal(kvB.Value, &proposalB)
 			if err != nil {
 				panic(err)
@@ -36,13 +37,13 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			return fmt.Sprintf("proposalIDA: %d\nProposalIDB: %d", proposalIDA, proposalIDB)
 
 		case bytes.Equal(kvA.Key[:1], types.DepositsKeyPrefix):
-			var depositA, depositB types.Deposit
+			var depositA, depositB v1.Deposit
 			cdc.MustUnmarshal(kvA.Value, &depositA)
 			cdc.MustUnmarshal(kvB.Value, &depositB)
 			return fmt.Sprintf("%v\n%v", depositA, depositB)
 
 		case bytes.Equal(kvA.Key[:1], types.VotesKeyPrefix):
-			var voteA, voteB types.Vote
+			var voteA, voteB v1.Vote
 			cdc.MustUnmarshal(kvA.Value, &voteA)
 			cdc.MustUnmarshal(kvB.Value, &voteB)
 			return fmt.Sprintf("%v\n%v", voteA, voteB)
@@ -14,6 +14,7 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/gov/simulation"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 // TestRandomizedGenState tests the normal scenario of applying RandomizedGenState.
@@ -37,23 +38,28 @@ func TestRandomizedGenState(t *testing.T) {
 
 	simulation.RandomizedGenState(&simState)
 
-	var govGenesis types.GenesisState
+	var govGenesis v1.GenesisState
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &govGenesis)
 
-	dec1, _ := sdk.NewDecFromStr("0.361000000000000000")
-	dec2, _ := sdk.NewDecFromStr("0.512000000000000000")
-	dec3, _ := sdk.NewDecFromStr("0.267000000000000000")
+	const (
+		tallyQuorum             = "0.361000000000000000"
+		tallyThreshold          = "0.512000000000000000"
+		tallyVetoThreshold      = "0.267000000000000000"
+		tallyExpeditedThreshold = "0.624000000000000000"
+	)
 
-	require.Equal(t, "905stake", govGenesis.DepositParams.MinDeposit.String())
+	require.Equal(t, "905stake", sdk.Coins(govGenesis.DepositParams.MinDeposit).String())
 	require.Equal(t, "77h26m10s", govGenesis.DepositParams.MaxDepositPeriod.String())
 	require.Equal(t, float64(148296), govGenesis.VotingParams.VotingPeriod.Seconds())
-	require.Equal(t, dec1, govGenesis.TallyParams.Quorum)
-	require.Equal(t, dec2, govGenesis.TallyParams.Threshold)
-	require.Equal(t, dec3, govGenesis.TallyParams.VetoThreshold)
+	require.Equal(t, float64(121649), govGenesis.VotingParams.ExpeditedVotingPeriod.Seconds())
+	require.Equal(t, tallyQuorum, govGenesis.TallyParams.Quorum)
+	require.Equal(t, tallyThreshold, govGenesis.TallyParams.Threshold)
+	require.Equal(t, tallyVetoThreshold, govGenesis.TallyParams.VetoThreshold)
+	require.Equal(t, tallyExpeditedThreshold, govGenesis.TallyParams.ExpeditedThreshold)
 	require.Equal(t, uint64(0x28), govGenesis.StartingProposalId)
-	require.Equal(t, types.Deposits{}, govGenesis.Deposits)
-	require.Equal(t, types.Votes{}, govGenesis.Votes)
-	require.Equal(t, types.Proposals{}, govGenesis.Proposals)
+	require.Len(t, govGenesis.Deposits, 0)
+	require.Len(t, govGenesis.Votes, 0)
+	require.Len(t, govGenesis.Proposals, 0)
 }
 
 // TestRandomizedGenState tests abnormal scenarios of applying RandomizedGenState.
@@ -0,0 +1,73 @@
+package v1
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state for the governance module
+func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParams, tp TallyParams) *GenesisState {
+	return &GenesisState{
+		StartingProposalId: startingProposalID,
+		DepositParams:      &dp,
+		VotingParams:       &vp,
+		TallyParams:        &tp,
+	}
+}
+
+// DefaultGenesisState defines the default governance genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(
+		DefaultStartingProposalID,
+		DefaultDepositParams(),
+		DefaultVotingParams(),
+		DefaultTallyParams(),
+	)
+}
+
+// ValidateGenesis checks if parameters are within valid ranges
+func ValidateGenesis(data *GenesisState) error {
+	if data.TallyParams == nil || data.VotingParams == nil || data.DepositParams == nil {
+		return fmt.Errorf("governance genesis params cannot be nil")
+	}
+
+	threshold, err := sdk.NewDecFromStr(data.TallyParams.Threshold)
+	if err != nil {
+		return fmt.Errorf("invalid governance vote threshold: %w", err)
+	}
+	if threshold.IsNegative() || threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance vote threshold should be positive and less or equal to one, is %s",
+			threshold.String())
+	}
+
+	veto, err := sdk.NewDecFromStr(data.TallyParams.VetoThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid governance vote veto threshold: %w", err)
+	}
+	if veto.IsNegative() || veto.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance vote veto threshold should be positive and less or equal to one, is %s",
+			veto.String())
+	}
+
+	if minDeposit := sdk.Coins(data.DepositParams.MinDeposit); !minDeposit.IsValid() {
+		return fmt.Errorf("governance deposit amount must be a valid sdk.Coins amount, is %s",
+			minDeposit.String())
+	}
+
+	return nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, p := range data.Proposals {
+		err := p.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
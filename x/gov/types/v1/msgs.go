@@ -0,0 +1,306 @@
+package v1
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	_, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}
+	_, _          codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+)
+
+// NewMsgSubmitProposal creates a new MsgSubmitProposal.
+//
+//nolint:interfacer
+func NewMsgSubmitProposal(messages []sdk.Msg, initialDeposit sdk.Coins, proposer string, metadata string, expedited bool) (*MsgSubmitProposal, error) {
+	m := &MsgSubmitProposal{
+		InitialDeposit: initialDeposit,
+		Proposer:       proposer,
+		Metadata:       metadata,
+		Expedited:      expedited,
+	}
+
+	anys, err := setMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Messages = anys
+
+	return m, nil
+}
+
+// GetMsgs unpacks m.Messages Any's into sdk.Msg's
+func (m *MsgSubmitProposal) GetMsgs() ([]sdk.Msg, error) {
+	return getMsgs(m.Messages)
+}
+
+// SetMsgs packs sdk.Msg's into m.Messages Any's
+// NOTE: this will overwrite any existing messages
+func (m *MsgSubmitProposal) SetMsgs(msgs []sdk.Msg) error {
+	anys, err := setMsgs(msgs)
+	if err != nil {
+		return err
+	}
+
+	m.Messages = anys
+	return nil
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgSubmitProposal) Route() string { return types.RouterKey }
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgSubmitProposal) Type() string { return sdk.MsgTypeURL(&m) }
+
+// ValidateBasic implements the sdk.Msg.ValidateBasic method.
+func (m MsgSubmitProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Proposer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
+	}
+
+	deposit := sdk.Coins(m.InitialDeposit)
+	if !deposit.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, deposit.String())
+	}
+
+	if deposit.IsAnyNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, deposit.String())
+	}
+
+	// Check that either metadata or Msgs length is non nil.
+	if len(m.Messages) == 0 && len(m.Metadata) == 0 {
+		return sdkerrors.Wrap(types.ErrNoProposalMsgs, "either metadata or Msgs length must be non-nil")
+	}
+
+	msgs, err := m.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	for idx, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidProposalMsg,
+				fmt.Sprintf("msg: %d, err: %s", idx, err.Error()))
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgSubmitProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements the sdk.Msg.GetSigners method.
+func (m MsgSubmitProposal) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(m.Proposer)
+	return []sdk.AccAddress{proposer}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgSubmitProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackMsgs(m.Messages, unpacker)
+}
+
+// NewMsgDeposit creates a new MsgDeposit instance
+//
+//nolint:interfacer
+func NewMsgDeposit(depositor sdk.AccAddress, proposalID uint64, amount sdk.Coins) *MsgDeposit {
+	return &MsgDeposit{proposalID, depositor.String(), amount}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgDeposit) Route() string { return types.RouterKey }
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgDeposit) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg.ValidateBasic method.
+func (msg MsgDeposit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid depositor address: %s", err)
+	}
+
+	amount := sdk.Coins(msg.Amount)
+	if !amount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+	if amount.IsAnyNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgDeposit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements the sdk.Msg.GetSigners method.
+func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
+	depositor, _ := sdk.AccAddressFromBech32(msg.Depositor)
+	return []sdk.AccAddress{depositor}
+}
+
+// NewMsgVote creates a message to cast a vote on an active proposal
+//
+//nolint:interfacer
+func NewMsgVote(voter sdk.AccAddress, proposalID uint64, option VoteOption, metadata string) *MsgVote {
+	return &MsgVote{proposalID, voter.String(), option, metadata}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgVote) Route() string { return types.RouterKey }
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgVote) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg.ValidateBasic method.
+func (msg MsgVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+
+	if !ValidVoteOption(msg.Option) {
+		return sdkerrors.Wrap(types.ErrInvalidVote, msg.Option.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements the sdk.Msg.GetSigners method.
+func (msg MsgVote) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
+
+// NewMsgVoteWeighted creates a message to cast a vote on an active proposal
+//
+//nolint:interfacer
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions, metadata string) *MsgVoteWeighted {
+	return &MsgVoteWeighted{proposalID, voter.String(), options, metadata}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgVoteWeighted) Route() string { return types.RouterKey }
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgVoteWeighted) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg.ValidateBasic method.
+func (msg MsgVoteWeighted) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+
+	if len(msg.Options) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, WeightedVoteOptions(msg.Options).String())
+	}
+
+	totalWeight := sdk.NewDec(0)
+	usedOptions := make(map[VoteOption]bool)
+	for _, option := range msg.Options {
+		if !ValidWeightedVoteOption(*option) {
+			return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
+		}
+		weight, err := sdk.NewDecFromStr(option.Weight)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidVote, "Invalid weight: %s", err)
+		}
+		totalWeight = totalWeight.Add(weight)
+		if usedOptions[option.Option] {
+			return sdkerrors.Wrap(types.ErrInvalidVote, "Duplicated vote option")
+		}
+		usedOptions[option.Option] = true
+	}
+
+	if totalWeight.GT(sdk.NewDec(1)) {
+		return sdkerrors.Wrap(types.ErrInvalidVote, "Total weight overflow 1.00")
+	}
+
+	if totalWeight.LT(sdk.NewDec(1)) {
+		return sdkerrors.Wrap(types.ErrInvalidVote, "Total weight lower than 1.00")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements the sdk.Msg.GetSigners method.
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
+
+// NewMsgExecLegacyContent creates a new MsgExecLegacyContent instance
+//
+//nolint:interfacer
+func NewMsgExecLegacyContent(content *codectypes.Any, authority string) *MsgExecLegacyContent {
+	return &MsgExecLegacyContent{
+		Content:   content,
+		Authority: authority,
+	}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (c MsgExecLegacyContent) Route() string { return types.RouterKey }
+
+// Type implements the LegacyMsg.Type method.
+func (c MsgExecLegacyContent) Type() string { return sdk.MsgTypeURL(&c) }
+
+// ValidateBasic implements the sdk.Msg.ValidateBasic method.
+func (c MsgExecLegacyContent) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(c.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	content, err := LegacyContentFromMessage(&c)
+	if err != nil {
+		return err
+	}
+
+	if !types.IsValidProposalType(content.ProposalType()) {
+		return sdkerrors.Wrap(types.ErrInvalidProposalType, content.ProposalType())
+	}
+
+	return content.ValidateBasic()
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (c MsgExecLegacyContent) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&c)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements the sdk.Msg.GetSigners method.
+func (c MsgExecLegacyContent) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(c.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (c MsgExecLegacyContent) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var content types.Content
+	return unpacker.UnpackAny(c.Content, &content)
+}
@@ -0,0 +1,188 @@
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default governance params
+var (
+	DefaultMinDepositTokens = types.DefaultMinDepositTokens
+	DefaultQuorum           = types.DefaultQuorum
+	DefaultThreshold        = types.DefaultThreshold
+	DefaultVetoThreshold    = types.DefaultVetoThreshold
+
+	DefaultExpeditedPeriod    time.Duration = time.Hour * 24 // 1 day
+	DefaultExpeditedThreshold               = sdk.NewDecWithPrec(667, 3)
+)
+
+// ParamKeyTable - Key declaration for parameters
+//
+// The keys are shared with the v1beta1 parameters, both representations
+// have the same legacy Amino JSON encoding in the param store.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable(
+		paramtypes.NewParamSetPair(types.ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams),
+		paramtypes.NewParamSetPair(types.ParamStoreKeyVotingParams, VotingParams{}, validateVotingParams),
+		paramtypes.NewParamSetPair(types.ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams),
+	)
+}
+
+// NewDepositParams creates a new DepositParams object
+func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+	return DepositParams{
+		MinDeposit:       minDeposit,
+		MaxDepositPeriod: &maxDepositPeriod,
+	}
+}
+
+// DefaultDepositParams default parameters for deposits
+func DefaultDepositParams() DepositParams {
+	return NewDepositParams(
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
+		types.DefaultPeriod,
+	)
+}
+
+func validateDepositParams(i interface{}) error {
+	v, ok := i.(DepositParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	minDeposit := sdk.Coins(v.MinDeposit)
+	if !minDeposit.IsValid() {
+		return fmt.Errorf("invalid minimum deposit: %s", minDeposit)
+	}
+	if v.MaxDepositPeriod == nil || *v.MaxDepositPeriod <= 0 {
+		return fmt.Errorf("maximum deposit period must be positive: %s", v.MaxDepositPeriod)
+	}
+
+	return nil
+}
+
+// NewTallyParams creates a new TallyParams object
+func NewTallyParams(quorum, threshold, vetoThreshold, expeditedThreshold sdk.Dec) TallyParams {
+	return TallyParams{
+		Quorum:             quorum.String(),
+		Threshold:          threshold.String(),
+		VetoThreshold:      vetoThreshold.String(),
+		ExpeditedThreshold: expeditedThreshold.String(),
+	}
+}
+
+// DefaultTallyParams default parameters for tallying
+func DefaultTallyParams() TallyParams {
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold, DefaultExpeditedThreshold)
+}
+
+func validateTallyParams(i interface{}) error {
+	v, ok := i.(TallyParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	quorum, err := sdk.NewDecFromStr(v.Quorum)
+	if err != nil {
+		return fmt.Errorf("invalid quorum string: %w", err)
+	}
+	if quorum.IsNegative() {
+		return fmt.Errorf("quorom cannot be negative: %s", quorum)
+	}
+	if quorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("quorom too large: %s", quorum)
+	}
+
+	threshold, err := sdk.NewDecFromStr(v.Threshold)
+	if err != nil {
+		return fmt.Errorf("invalid threshold string: %w", err)
+	}
+	if !threshold.IsPositive() {
+		return fmt.Errorf("vote threshold must be positive: %s", threshold)
+	}
+	if threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("vote threshold too large: %s", threshold)
+	}
+
+	vetoThreshold, err := sdk.NewDecFromStr(v.VetoThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid vetoThreshold string: %w", err)
+	}
+	if !vetoThreshold.IsPositive() {
+		return fmt.Errorf("veto threshold must be positive: %s", vetoThreshold)
+	}
+	if vetoThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("veto threshold too large: %s", vetoThreshold)
+	}
+
+	expeditedThreshold, err := sdk.NewDecFromStr(v.ExpeditedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid expedited threshold string: %w", err)
+	}
+	if expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", expeditedThreshold)
+	}
+	if expeditedThreshold.LT(threshold) {
+		return fmt.Errorf("expedited vote threshold %s must be greater than or equal to the vote threshold %s", expeditedThreshold, threshold)
+	}
+
+	return nil
+}
+
+// NewVotingParams creates a new VotingParams object
+func NewVotingParams(votingPeriod, expeditedVotingPeriod time.Duration) VotingParams {
+	return VotingParams{
+		VotingPeriod:          &votingPeriod,
+		ExpeditedVotingPeriod: &expeditedVotingPeriod,
+	}
+}
+
+// DefaultVotingParams default parameters for voting
+func DefaultVotingParams() VotingParams {
+	return NewVotingParams(types.DefaultPeriod, DefaultExpeditedPeriod)
+}
+
+func validateVotingParams(i interface{}) error {
+	v, ok := i.(VotingParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.VotingPeriod == nil || *v.VotingPeriod <= 0 {
+		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
+	}
+
+	if v.ExpeditedVotingPeriod == nil || *v.ExpeditedVotingPeriod <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", v.ExpeditedVotingPeriod)
+	}
+	if *v.ExpeditedVotingPeriod > *v.VotingPeriod {
+		return fmt.Errorf("expedited voting period %s must not be longer than the voting period %s", v.ExpeditedVotingPeriod, v.VotingPeriod)
+	}
+
+	return nil
+}
+
+// Params returns all of the governance params
+type Params struct {
+	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
+	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
+	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_params"`
+}
+
+// NewParams creates a new gov Params instance
+func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
+	return Params{
+		VotingParams:  vp,
+		DepositParams: dp,
+		TallyParams:   tp,
+	}
+}
+
+// DefaultParams default governance params
+func DefaultParams() Params {
+	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams())
+}
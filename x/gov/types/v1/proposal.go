@@ -0,0 +1,174 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultStartingProposalID is 1
+const DefaultStartingProposalID uint64 = 1
+
+// Proposal statuses, aliased for convenience.
+const (
+	StatusNil           = ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+	StatusDepositPeriod = ProposalStatus_PROPOSAL_STATUS_DEPOSIT_PERIOD
+	StatusVotingPeriod  = ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD
+	StatusPassed        = ProposalStatus_PROPOSAL_STATUS_PASSED
+	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
+	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
+)
+
+// NewProposal creates a new Proposal instance
+func NewProposal(messages []sdk.Msg, id uint64, metadata string, submitTime, depositEndTime time.Time, expedited bool) (Proposal, error) {
+	msgs, err := setMsgs(messages)
+	if err != nil {
+		return Proposal{}, err
+	}
+
+	tally := EmptyTallyResult()
+
+	p := Proposal{
+		Id:               id,
+		Messages:         msgs,
+		Metadata:         metadata,
+		Status:           StatusDepositPeriod,
+		FinalTallyResult: &tally,
+		SubmitTime:       &submitTime,
+		DepositEndTime:   &depositEndTime,
+		Expedited:        expedited,
+	}
+
+	return p, nil
+}
+
+// GetMsgs unpacks p.Messages Any's into sdk.Msg's
+func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
+	return getMsgs(p.Messages)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p Proposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackMsgs(p.Messages, unpacker)
+}
+
+// Proposals is an array of proposal
+type Proposals []*Proposal
+
+var _ codectypes.UnpackInterfacesMessage = Proposals{}
+
+// Equal returns true if two slices (order-dependant) of proposals are equal.
+func (p Proposals) Equal(other Proposals) bool {
+	if len(p) != len(other) {
+		return false
+	}
+
+	for i, proposal := range p {
+		if proposal.String() != other[i].String() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String implements stringer interface
+func (p Proposals) String() string {
+	out := "ID - (Status) [Messages] Metadata\n"
+	for _, prop := range p {
+		msgTypes := make([]string, len(prop.Messages))
+		for i, msg := range prop.Messages {
+			msgTypes[i] = msg.TypeUrl
+		}
+		out += fmt.Sprintf("%d - (%s) [%s] %s\n",
+			prop.Id, prop.Status,
+			strings.Join(msgTypes, ","), prop.Metadata)
+	}
+	return strings.TrimSpace(out)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p Proposals) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, x := range p {
+		err := x.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type (
+	// ProposalQueue defines a queue for proposal ids
+	ProposalQueue []uint64
+)
+
+// ProposalStatusFromString turns a string into a ProposalStatus
+func ProposalStatusFromString(str string) (ProposalStatus, error) {
+	num, ok := ProposalStatus_value[str]
+	if !ok {
+		return StatusNil, fmt.Errorf("'%s' is not a valid proposal status", str)
+	}
+	return ProposalStatus(num), nil
+}
+
+// ValidProposalStatus returns true if the proposal status is valid and false
+// otherwise.
+func ValidProposalStatus(status ProposalStatus) bool {
+	if status == StatusDepositPeriod ||
+		status == StatusVotingPeriod ||
+		status == StatusPassed ||
+		status == StatusRejected ||
+		status == StatusFailed {
+		return true
+	}
+	return false
+}
+
+// Format implements the fmt.Formatter interface.
+// nolint: errcheck
+func (status ProposalStatus) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		s.Write([]byte(status.String()))
+	default:
+		s.Write([]byte(fmt.Sprintf("%v", byte(status))))
+	}
+}
+
+func getMsgs(anys []*codectypes.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(anys))
+	for i, any := range anys {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, fmt.Errorf("messages contains %T which is not a sdk.Msg", any.GetCachedValue())
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
+
+func setMsgs(msgs []sdk.Msg) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		var err error
+		anys[i], err = codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return anys, nil
+}
+
+func unpackMsgs(anys []*codectypes.Any, unpacker codectypes.AnyUnpacker) error {
+	for _, any := range anys {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
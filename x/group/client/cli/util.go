@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// parseDecisionPolicy reads and parses the decision policy.
+func parseDecisionPolicy(cdc codec.Codec, decisionPolicyFile string) (group.DecisionPolicy, error) {
+	if decisionPolicyFile == "" {
+		return nil, fmt.Errorf("decision policy is required")
+	}
+
+	contents, err := os.ReadFile(decisionPolicyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy group.DecisionPolicy
+	if err := cdc.UnmarshalInterfaceJSON(contents, &policy); err != nil {
+		return nil, fmt.Errorf("failed to parse decision policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// parseMembers reads and parses the members.
+func parseMembers(membersFile string) ([]group.MemberRequest, error) {
+	members := struct {
+		Members []group.MemberRequest `json:"members"`
+	}{}
+
+	if membersFile == "" {
+		return members.Members, nil
+	}
+
+	contents, err := os.ReadFile(membersFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(contents, &members); err != nil {
+		return nil, err
+	}
+
+	return members.Members, nil
+}
+
+// cliProposal defines a Msg-wrapping proposal as it is read from a JSON file
+// by the submit-proposal command.
+type cliProposal struct {
+	GroupPolicyAddress string `json:"group_policy_address"`
+	// Messages defines an array of sdk.Msgs proto-JSON-encoded as Anys.
+	Messages  []json.RawMessage `json:"messages,omitempty"`
+	Metadata  string            `json:"metadata"`
+	Proposers []string          `json:"proposers"`
+}
+
+// parseCLIProposal reads and parses the proposal.
+func parseCLIProposal(path string) (cliProposal, error) {
+	var p cliProposal
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return p, err
+	}
+
+	if err := json.Unmarshal(contents, &p); err != nil {
+		return p, err
+	}
+
+	return p, nil
+}
+
+// parseMsgs unpacks the proto-JSON encoded messages of a proposal.
+func parseMsgs(cdc codec.Codec, p cliProposal) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(p.Messages))
+	for i, anyJSON := range p.Messages {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(anyJSON, &msg); err != nil {
+			return nil, err
+		}
+
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
@@ -0,0 +1,23 @@
+package group
+
+import "time"
+
+// Config is used to configure the group module from the app, e.g. to bound
+// the execution window of proposals and the size of the metadata fields.
+type Config struct {
+	// MaxExecutionPeriod defines the max duration after a proposal's voting
+	// period ends that members can send a MsgExec to execute the proposal.
+	MaxExecutionPeriod time.Duration
+
+	// MaxMetadataLen defines the max length of the metadata field for the
+	// various entities within the group module.
+	MaxMetadataLen uint64
+}
+
+// DefaultConfig returns the default config for the group module.
+func DefaultConfig() Config {
+	return Config{
+		MaxExecutionPeriod: 2 * time.Hour * 24 * 7, // Two weeks.
+		MaxMetadataLen:     255,
+	}
+}
@@ -0,0 +1,58 @@
+// Package math provides helper functions for doing the decimal arithmetic
+// needed by the group module, e.g. on member weights and tally results, which
+// are stored as strings.
+package math
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewDecFromString parses s as a decimal.
+func NewDecFromString(s string) (sdk.Dec, error) {
+	d, err := sdk.NewDecFromStr(s)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.ErrInvalidRequest.Wrapf("%s: %s", s, err)
+	}
+
+	return d, nil
+}
+
+// NewPositiveDecFromString parses s as a decimal and returns an error if it
+// isn't strictly positive.
+func NewPositiveDecFromString(s string) (sdk.Dec, error) {
+	d, err := NewDecFromString(s)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !d.IsPositive() {
+		return sdk.Dec{}, sdkerrors.ErrInvalidRequest.Wrapf("expected a positive decimal, got %s", s)
+	}
+
+	return d, nil
+}
+
+// NewNonNegativeDecFromString parses s as a decimal and returns an error if
+// it is negative.
+func NewNonNegativeDecFromString(s string) (sdk.Dec, error) {
+	d, err := NewDecFromString(s)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if d.IsNegative() {
+		return sdk.Dec{}, sdkerrors.ErrInvalidRequest.Wrapf("expected a non-negative decimal, got %s", s)
+	}
+
+	return d, nil
+}
+
+// SubNonNegative subtracts y from x and returns an error if the result is
+// negative.
+func SubNonNegative(x sdk.Dec, y sdk.Dec) (sdk.Dec, error) {
+	z := x.Sub(y)
+	if z.IsNegative() {
+		return sdk.Dec{}, sdkerrors.ErrInvalidRequest.Wrap("result negative during non-negative subtraction")
+	}
+
+	return z, nil
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// InitGenesis initializes the group module's genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *group.GenesisState) {
+	k.setSeq(ctx, GroupSeqKey, genState.GroupSeq)
+	for _, g := range genState.Groups {
+		if err := k.setGroupInfo(ctx, *g); err != nil {
+			panic(fmt.Errorf("failed to import group %d: %w", g.Id, err))
+		}
+	}
+
+	for _, m := range genState.GroupMembers {
+		if err := k.setGroupMember(ctx, *m); err != nil {
+			panic(fmt.Errorf("failed to import member of group %d: %w", m.GroupId, err))
+		}
+	}
+
+	k.setSeq(ctx, GroupPolicySeqKey, genState.GroupPolicySeq)
+	for _, p := range genState.GroupPolicies {
+		if err := k.setGroupPolicyInfo(ctx, *p); err != nil {
+			panic(fmt.Errorf("failed to import group policy %s: %w", p.Address, err))
+		}
+	}
+
+	k.setSeq(ctx, ProposalSeqKey, genState.ProposalSeq)
+	for _, p := range genState.Proposals {
+		if err := k.setProposal(ctx, *p); err != nil {
+			panic(fmt.Errorf("failed to import proposal %d: %w", p.Id, err))
+		}
+	}
+
+	for _, v := range genState.Votes {
+		if err := k.setVote(ctx, *v); err != nil {
+			panic(fmt.Errorf("failed to import vote on proposal %d: %w", v.ProposalId, err))
+		}
+	}
+}
+
+// ExportGenesis returns the group module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *group.GenesisState {
+	genState := group.NewGenesisState()
+
+	genState.GroupSeq = k.getSeq(ctx, GroupSeqKey)
+	k.iterateGroups(ctx, func(g group.GroupInfo) bool {
+		genState.Groups = append(genState.Groups, &g)
+		return false
+	})
+
+	k.iterateGroupMembers(ctx, func(m group.GroupMember) bool {
+		genState.GroupMembers = append(genState.GroupMembers, &m)
+		return false
+	})
+
+	genState.GroupPolicySeq = k.getSeq(ctx, GroupPolicySeqKey)
+	err := k.iterateGroupPolicies(ctx, func(p group.GroupPolicyInfo) bool {
+		genState.GroupPolicies = append(genState.GroupPolicies, &p)
+		return false
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to export group policies: %w", err))
+	}
+
+	genState.ProposalSeq = k.getSeq(ctx, ProposalSeqKey)
+	err = k.iterateProposals(ctx, func(p group.Proposal) bool {
+		genState.Proposals = append(genState.Proposals, &p)
+		return false
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to export proposals: %w", err))
+	}
+
+	k.iterateVotes(ctx, func(v group.Vote) bool {
+		genState.Votes = append(genState.Votes, &v)
+		return false
+	})
+
+	return genState
+}
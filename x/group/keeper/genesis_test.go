@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+func (s *TestSuite) TestImportExportGenesis() {
+	proposalID := s.submitSendProposal(s.addrs[1], group.Exec_EXEC_UNSPECIFIED)
+	s.Require().NoError(s.vote(proposalID, s.addrs[1], group.VOTE_OPTION_YES, group.Exec_EXEC_UNSPECIFIED))
+
+	genesis := s.app.GroupKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genesis.Validate())
+	s.Require().Len(genesis.Groups, 1)
+	s.Require().Len(genesis.GroupMembers, 3)
+	s.Require().Len(genesis.GroupPolicies, 1)
+	s.Require().Len(genesis.Proposals, 1)
+	s.Require().Len(genesis.Votes, 1)
+
+	// import into a fresh app
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(s.blockTime)
+	app.GroupKeeper.InitGenesis(ctx, genesis)
+
+	newGenesis := app.GroupKeeper.ExportGenesis(ctx)
+	cdc := app.AppCodec()
+	s.Require().Equal(cdc.MustMarshalJSON(genesis), cdc.MustMarshalJSON(newGenesis))
+
+	// sequences are restored, so that new ids don't collide
+	res, err := app.GroupKeeper.CreateGroup(sdk.WrapSDKContext(ctx), &group.MsgCreateGroup{Admin: s.addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(s.groupID+1, res.GroupId)
+}
@@ -0,0 +1,364 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+var _ group.QueryServer = Keeper{}
+
+// GroupInfo queries info about a group.
+func (k Keeper) GroupInfo(goCtx context.Context, request *group.QueryGroupInfoRequest) (*group.QueryGroupInfoResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	groupInfo, err := k.GetGroupInfo(ctx, request.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupInfoResponse{Info: &groupInfo}, nil
+}
+
+// GroupPolicyInfo queries info about a group policy.
+func (k Keeper) GroupPolicyInfo(goCtx context.Context, request *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	groupPolicyInfo, err := k.GetGroupPolicyInfo(ctx, request.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupPolicyInfoResponse{Info: &groupPolicyInfo}, nil
+}
+
+// GroupMembers queries all members of a group.
+func (k Keeper) GroupMembers(goCtx context.Context, request *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.key), groupMembersPrefix(request.GroupId))
+
+	var members []*group.GroupMember
+	pageRes, err := query.Paginate(store, request.Pagination, func(_ []byte, value []byte) error {
+		var member group.GroupMember
+		if err := k.cdc.Unmarshal(value, &member); err != nil {
+			return err
+		}
+		members = append(members, &member)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupMembersResponse{
+		Members:    members,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GroupsByAdmin queries all groups where a given address is admin.
+func (k Keeper) GroupsByAdmin(goCtx context.Context, request *group.QueryGroupsByAdminRequest) (*group.QueryGroupsByAdminResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(request.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.key), groupByAdminPrefix(addr))
+
+	var groups []*group.GroupInfo
+	pageRes, err := query.Paginate(store, request.Pagination, func(key []byte, _ []byte) error {
+		groupInfo, err := k.GetGroupInfo(ctx, uint64FromBytes(key))
+		if err != nil {
+			return err
+		}
+		groups = append(groups, &groupInfo)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupsByAdminResponse{
+		Groups:     groups,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GroupPoliciesByGroup queries all groups policies of a given group.
+func (k Keeper) GroupPoliciesByGroup(goCtx context.Context, request *group.QueryGroupPoliciesByGroupRequest) (*group.QueryGroupPoliciesByGroupResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.key), groupPolicyByGroupPrefix(request.GroupId))
+
+	policies, pageRes, err := k.paginateGroupPolicies(ctx, store, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupPoliciesByGroupResponse{
+		GroupPolicies: policies,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// GroupPoliciesByAdmin queries all groups policies where a given address is
+// admin.
+func (k Keeper) GroupPoliciesByAdmin(goCtx context.Context, request *group.QueryGroupPoliciesByAdminRequest) (*group.QueryGroupPoliciesByAdminResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(request.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.key), groupPolicyByAdminPrefix(addr))
+
+	policies, pageRes, err := k.paginateGroupPolicies(ctx, store, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupPoliciesByAdminResponse{
+		GroupPolicies: policies,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// paginateGroupPolicies paginates over a group policy index, whose keys end
+// with the length-prefixed address of the group policy.
+func (k Keeper) paginateGroupPolicies(ctx sdk.Context, index prefix.Store, pageReq *query.PageRequest) ([]*group.GroupPolicyInfo, *query.PageResponse, error) {
+	var policies []*group.GroupPolicyInfo
+	pageRes, err := query.Paginate(index, pageReq, func(key []byte, _ []byte) error {
+		policy, err := k.GetGroupPolicyInfo(ctx, addressFromLengthPrefixed(key).String())
+		if err != nil {
+			return err
+		}
+		policies = append(policies, &policy)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return policies, pageRes, nil
+}
+
+// Proposal queries a proposal.
+func (k Keeper) Proposal(goCtx context.Context, request *group.QueryProposalRequest) (*group.QueryProposalResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposal, err := k.getProposal(ctx, request.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryProposalResponse{Proposal: &proposal}, nil
+}
+
+// ProposalsByGroupPolicy queries all proposals of a group policy.
+func (k Keeper) ProposalsByGroupPolicy(goCtx context.Context, request *group.QueryProposalsByGroupPolicyRequest) (*group.QueryProposalsByGroupPolicyResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.key), proposalByGroupPolicyPrefix(addr))
+
+	var proposals []*group.Proposal
+	pageRes, err := query.Paginate(store, request.Pagination, func(key []byte, _ []byte) error {
+		proposal, err := k.getProposal(ctx, uint64FromBytes(key))
+		if err != nil {
+			return err
+		}
+		proposals = append(proposals, &proposal)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryProposalsByGroupPolicyResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
+
+// VoteByProposalVoter queries a vote given a voter and a proposal ID.
+func (k Keeper) VoteByProposalVoter(goCtx context.Context, request *group.QueryVoteByProposalVoterRequest) (*group.QueryVoteByProposalVoterResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(request.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	vote, err := k.getVote(ctx, request.ProposalId, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryVoteByProposalVoterResponse{Vote: &vote}, nil
+}
+
+// VotesByProposal queries all votes on a proposal.
+func (k Keeper) VotesByProposal(goCtx context.Context, request *group.QueryVotesByProposalRequest) (*group.QueryVotesByProposalResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.key), votesByProposalPrefix(request.ProposalId))
+
+	var votes []*group.Vote
+	pageRes, err := query.Paginate(store, request.Pagination, func(_ []byte, value []byte) error {
+		var vote group.Vote
+		if err := k.cdc.Unmarshal(value, &vote); err != nil {
+			return err
+		}
+		votes = append(votes, &vote)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryVotesByProposalResponse{
+		Votes:      votes,
+		Pagination: pageRes,
+	}, nil
+}
+
+// VotesByVoter queries all votes of a voter.
+func (k Keeper) VotesByVoter(goCtx context.Context, request *group.QueryVotesByVoterRequest) (*group.QueryVotesByVoterResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(request.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.key), voteByVoterPrefix(addr))
+
+	var votes []*group.Vote
+	pageRes, err := query.Paginate(store, request.Pagination, func(key []byte, _ []byte) error {
+		vote, err := k.getVote(ctx, uint64FromBytes(key), addr)
+		if err != nil {
+			return err
+		}
+		votes = append(votes, &vote)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryVotesByVoterResponse{
+		Votes:      votes,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GroupsByMember queries all groups where the given address is a member of.
+func (k Keeper) GroupsByMember(goCtx context.Context, request *group.QueryGroupsByMemberRequest) (*group.QueryGroupsByMemberResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.key), groupMemberByMemberPrefix(addr))
+
+	var groups []*group.GroupInfo
+	pageRes, err := query.Paginate(store, request.Pagination, func(key []byte, _ []byte) error {
+		groupInfo, err := k.GetGroupInfo(ctx, uint64FromBytes(key))
+		if err != nil {
+			return err
+		}
+		groups = append(groups, &groupInfo)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupsByMemberResponse{
+		Groups:     groups,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TallyResult computes the live tally result of a proposal.
+func (k Keeper) TallyResult(goCtx context.Context, request *group.QueryTallyResultRequest) (*group.QueryTallyResultResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposal, err := k.getProposal(ctx, request.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.Status == group.PROPOSAL_STATUS_WITHDRAWN || proposal.Status == group.PROPOSAL_STATUS_ABORTED {
+		return nil, status.Errorf(codes.InvalidArgument, "can't get the tally of a proposal with status %s", proposal.Status)
+	}
+
+	policyInfo, err := k.GetGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	tallyResult, err := k.Tally(ctx, proposal, policyInfo.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryTallyResultResponse{
+		Tally: tallyResult,
+	}, nil
+}
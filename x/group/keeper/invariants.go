@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
+)
+
+const weightInvariant = "Group-TotalWeight"
+
+// RegisterInvariants registers all group invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(group.ModuleName, weightInvariant, GroupTotalWeightInvariant(keeper))
+}
+
+// GroupTotalWeightInvariant checks that group's TotalWeight must be equal to the sum of its members.
+func GroupTotalWeightInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := GroupTotalWeightInvariantHelper(ctx, keeper)
+		return sdk.FormatInvariant(group.ModuleName, weightInvariant, msg), broken
+	}
+}
+
+// GroupTotalWeightInvariantHelper returns a message describing the groups
+// whose TotalWeight doesn't match the sum of their members' weights, and
+// whether the invariant is broken.
+func GroupTotalWeightInvariantHelper(ctx sdk.Context, keeper Keeper) (string, bool) {
+	var msg string
+	var broken bool
+
+	membersWeight := make(map[uint64]sdk.Dec)
+	keeper.iterateGroupMembers(ctx, func(m group.GroupMember) bool {
+		weight, err := math.NewNonNegativeDecFromString(m.Member.Weight)
+		if err != nil {
+			msg += fmt.Sprintf("error while parsing weight of member %s of group %d\n%v\n", m.Member.Address, m.GroupId, err)
+			broken = true
+			return false
+		}
+		total, ok := membersWeight[m.GroupId]
+		if !ok {
+			total = sdk.ZeroDec()
+		}
+		membersWeight[m.GroupId] = total.Add(weight)
+		return false
+	})
+
+	keeper.iterateGroups(ctx, func(g group.GroupInfo) bool {
+		groupWeight, err := math.NewNonNegativeDecFromString(g.TotalWeight)
+		if err != nil {
+			msg += fmt.Sprintf("error while parsing total weight of group %d\n%v\n", g.Id, err)
+			broken = true
+			return false
+		}
+
+		membersTotal, ok := membersWeight[g.Id]
+		if !ok {
+			membersTotal = sdk.ZeroDec()
+		}
+		if !groupWeight.Equal(membersTotal) {
+			msg += fmt.Sprintf("group's TotalWeight must be equal to the sum of its members' weights\ngroup weight: %s\nSum of group members weights: %s\n", groupWeight.String(), membersTotal.String())
+			broken = true
+		}
+		return false
+	})
+
+	return msg, broken
+}
@@ -0,0 +1,487 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+type Keeper struct {
+	key       sdk.StoreKey
+	cdc       codec.Codec
+	accKeeper group.AccountKeeper
+	router    *baseapp.MsgServiceRouter
+	config    group.Config
+}
+
+// NewKeeper creates a new group keeper.
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.Codec, router *baseapp.MsgServiceRouter, accKeeper group.AccountKeeper, config group.Config) Keeper {
+	defaultConfig := group.DefaultConfig()
+	// Set the max execution period to the default if it's not set.
+	if config.MaxExecutionPeriod <= 0 {
+		config.MaxExecutionPeriod = defaultConfig.MaxExecutionPeriod
+	}
+	// Set the max metadata length to the default if it's not set.
+	if config.MaxMetadataLen == 0 {
+		config.MaxMetadataLen = defaultConfig.MaxMetadataLen
+	}
+
+	return Keeper{
+		key:       storeKey,
+		cdc:       cdc,
+		accKeeper: accKeeper,
+		router:    router,
+		config:    config,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", group.ModuleName))
+}
+
+// getSeq returns the current value of the sequence stored at key.
+func (k Keeper) getSeq(ctx sdk.Context, key []byte) uint64 {
+	bz := ctx.KVStore(k.key).Get(key)
+	if bz == nil {
+		return 0
+	}
+	return uint64FromBytes(bz)
+}
+
+// setSeq sets the value of the sequence stored at key.
+func (k Keeper) setSeq(ctx sdk.Context, key []byte, seq uint64) {
+	ctx.KVStore(k.key).Set(key, uint64ToBytes(seq))
+}
+
+// nextSeq increments the sequence stored at key and returns its new value.
+func (k Keeper) nextSeq(ctx sdk.Context, key []byte) uint64 {
+	seq := k.getSeq(ctx, key) + 1
+	k.setSeq(ctx, key, seq)
+	return seq
+}
+
+// GetGroupInfo returns the group with the given id.
+func (k Keeper) GetGroupInfo(ctx sdk.Context, id uint64) (group.GroupInfo, error) {
+	var obj group.GroupInfo
+	bz := ctx.KVStore(k.key).Get(groupKey(id))
+	if bz == nil {
+		return obj, sdkerrors.ErrNotFound.Wrapf("group: %d", id)
+	}
+	k.cdc.MustUnmarshal(bz, &obj)
+	return obj, nil
+}
+
+// setGroupInfo stores g and updates the group by admin index, removing the
+// entry of the previous admin if it changed.
+func (k Keeper) setGroupInfo(ctx sdk.Context, g group.GroupInfo) error {
+	store := ctx.KVStore(k.key)
+	admin, err := sdk.AccAddressFromBech32(g.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+
+	if old, err := k.GetGroupInfo(ctx, g.Id); err == nil && old.Admin != g.Admin {
+		oldAdmin, err := sdk.AccAddressFromBech32(old.Admin)
+		if err != nil {
+			return err
+		}
+		store.Delete(groupByAdminKey(oldAdmin, g.Id))
+	}
+
+	store.Set(groupKey(g.Id), k.cdc.MustMarshal(&g))
+	store.Set(groupByAdminKey(admin, g.Id), []byte{})
+	return nil
+}
+
+// iterateGroups calls cb on all the groups, stopping when cb returns true.
+func (k Keeper) iterateGroups(ctx sdk.Context, cb func(g group.GroupInfo) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), GroupTablePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var g group.GroupInfo
+		k.cdc.MustUnmarshal(iter.Value(), &g)
+		if cb(g) {
+			break
+		}
+	}
+}
+
+// getGroupMember returns the member of a group with the given address.
+func (k Keeper) getGroupMember(ctx sdk.Context, groupID uint64, member sdk.AccAddress) (group.GroupMember, error) {
+	var obj group.GroupMember
+	bz := ctx.KVStore(k.key).Get(groupMemberKey(groupID, member))
+	if bz == nil {
+		return obj, sdkerrors.ErrNotFound.Wrapf("group member %s of group %d", member, groupID)
+	}
+	k.cdc.MustUnmarshal(bz, &obj)
+	return obj, nil
+}
+
+// setGroupMember stores m and its group member by member index entry.
+func (k Keeper) setGroupMember(ctx sdk.Context, m group.GroupMember) error {
+	addr, err := sdk.AccAddressFromBech32(m.Member.Address)
+	if err != nil {
+		return sdkerrors.Wrap(err, "member address")
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Set(groupMemberKey(m.GroupId, addr), k.cdc.MustMarshal(&m))
+	store.Set(groupMemberByMemberKey(addr, m.GroupId), []byte{})
+	return nil
+}
+
+// deleteGroupMember removes a member from a group.
+func (k Keeper) deleteGroupMember(ctx sdk.Context, groupID uint64, member sdk.AccAddress) {
+	store := ctx.KVStore(k.key)
+	store.Delete(groupMemberKey(groupID, member))
+	store.Delete(groupMemberByMemberKey(member, groupID))
+}
+
+// iterateGroupMembers calls cb on all the members of all the groups,
+// stopping when cb returns true.
+func (k Keeper) iterateGroupMembers(ctx sdk.Context, cb func(m group.GroupMember) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), GroupMemberTablePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var m group.GroupMember
+		k.cdc.MustUnmarshal(iter.Value(), &m)
+		if cb(m) {
+			break
+		}
+	}
+}
+
+// GetGroupPolicyInfo returns the group policy with the given address.
+func (k Keeper) GetGroupPolicyInfo(ctx sdk.Context, accountAddress string) (group.GroupPolicyInfo, error) {
+	var obj group.GroupPolicyInfo
+	addr, err := sdk.AccAddressFromBech32(accountAddress)
+	if err != nil {
+		return obj, sdkerrors.Wrap(err, "group policy address")
+	}
+	bz := ctx.KVStore(k.key).Get(groupPolicyKey(addr))
+	if bz == nil {
+		return obj, sdkerrors.ErrNotFound.Wrapf("group policy: %s", accountAddress)
+	}
+	if err := k.cdc.Unmarshal(bz, &obj); err != nil {
+		return obj, err
+	}
+	return obj, nil
+}
+
+// setGroupPolicyInfo stores p and updates its indexes, removing the group
+// policy by admin index entry of the previous admin if it changed.
+func (k Keeper) setGroupPolicyInfo(ctx sdk.Context, p group.GroupPolicyInfo) error {
+	store := ctx.KVStore(k.key)
+	addr, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group policy address")
+	}
+	admin, err := sdk.AccAddressFromBech32(p.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+
+	if old, err := k.GetGroupPolicyInfo(ctx, p.Address); err == nil && old.Admin != p.Admin {
+		oldAdmin, err := sdk.AccAddressFromBech32(old.Admin)
+		if err != nil {
+			return err
+		}
+		store.Delete(groupPolicyByAdminKey(oldAdmin, addr))
+	}
+
+	bz, err := k.cdc.Marshal(&p)
+	if err != nil {
+		return err
+	}
+	store.Set(groupPolicyKey(addr), bz)
+	store.Set(groupPolicyByGroupKey(p.GroupId, addr), []byte{})
+	store.Set(groupPolicyByAdminKey(admin, addr), []byte{})
+	return nil
+}
+
+// iterateGroupPolicies calls cb on all the group policies, stopping when cb
+// returns true.
+func (k Keeper) iterateGroupPolicies(ctx sdk.Context, cb func(p group.GroupPolicyInfo) (stop bool)) error {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), GroupPolicyTablePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var p group.GroupPolicyInfo
+		if err := k.cdc.Unmarshal(iter.Value(), &p); err != nil {
+			return err
+		}
+		if cb(p) {
+			break
+		}
+	}
+	return nil
+}
+
+// getGroupPolicyAddressesByGroup returns the addresses of all the group
+// policies of a group.
+func (k Keeper) getGroupPolicyAddressesByGroup(ctx sdk.Context, groupID uint64) []sdk.AccAddress {
+	store := prefix.NewStore(ctx.KVStore(k.key), groupPolicyByGroupPrefix(groupID))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var addrs []sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		addrs = append(addrs, addressFromLengthPrefixed(iter.Key()))
+	}
+	return addrs
+}
+
+// getProposal returns the proposal with the given id.
+func (k Keeper) getProposal(ctx sdk.Context, proposalID uint64) (group.Proposal, error) {
+	var p group.Proposal
+	bz := ctx.KVStore(k.key).Get(proposalKey(proposalID))
+	if bz == nil {
+		return p, sdkerrors.ErrNotFound.Wrapf("proposal: %d", proposalID)
+	}
+	if err := k.cdc.Unmarshal(bz, &p); err != nil {
+		return p, err
+	}
+	return p, nil
+}
+
+// setProposal stores p along with its group policy index entry and its entry
+// in the voting period end queue.
+func (k Keeper) setProposal(ctx sdk.Context, p group.Proposal) error {
+	policy, err := sdk.AccAddressFromBech32(p.GroupPolicyAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group policy address")
+	}
+
+	bz, err := k.cdc.Marshal(&p)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Set(proposalKey(p.Id), bz)
+	store.Set(proposalByGroupPolicyKey(policy, p.Id), []byte{})
+	store.Set(proposalsByVotingPeriodEndKey(p.VotingPeriodEnd, p.Id), []byte{})
+	return nil
+}
+
+// pruneProposal deletes a proposal, its index entries and all of its votes.
+func (k Keeper) pruneProposal(ctx sdk.Context, p group.Proposal) error {
+	policy, err := sdk.AccAddressFromBech32(p.GroupPolicyAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group policy address")
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Delete(proposalKey(p.Id))
+	store.Delete(proposalByGroupPolicyKey(policy, p.Id))
+	store.Delete(proposalsByVotingPeriodEndKey(p.VotingPeriodEnd, p.Id))
+
+	k.pruneVotes(ctx, p.Id)
+	k.Logger(ctx).Debug(fmt.Sprintf("pruned proposal %d", p.Id))
+	return nil
+}
+
+// abortProposals sets the status of all the SUBMITTED proposals of a group
+// policy to ABORTED. It's called every time the group policy, or its group,
+// is updated, as the decision rules of these proposals have changed.
+func (k Keeper) abortProposals(ctx sdk.Context, groupPolicyAddr sdk.AccAddress) error {
+	store := prefix.NewStore(ctx.KVStore(k.key), proposalByGroupPolicyPrefix(groupPolicyAddr))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var proposals []group.Proposal
+	for ; iter.Valid(); iter.Next() {
+		p, err := k.getProposal(ctx, uint64FromBytes(iter.Key()))
+		if err != nil {
+			return err
+		}
+		if p.Status == group.PROPOSAL_STATUS_SUBMITTED {
+			proposals = append(proposals, p)
+		}
+	}
+
+	for _, p := range proposals {
+		p.Status = group.PROPOSAL_STATUS_ABORTED
+		if err := k.setProposal(ctx, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// iterateProposals calls cb on all the proposals, stopping when cb returns
+// true.
+func (k Keeper) iterateProposals(ctx sdk.Context, cb func(p group.Proposal) (stop bool)) error {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), ProposalTablePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var p group.Proposal
+		if err := k.cdc.Unmarshal(iter.Value(), &p); err != nil {
+			return err
+		}
+		if cb(p) {
+			break
+		}
+	}
+	return nil
+}
+
+// getVote returns the vote of voter on a proposal.
+func (k Keeper) getVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (group.Vote, error) {
+	var v group.Vote
+	bz := ctx.KVStore(k.key).Get(voteKey(proposalID, voter))
+	if bz == nil {
+		return v, sdkerrors.ErrNotFound.Wrapf("vote of %s on proposal %d", voter, proposalID)
+	}
+	k.cdc.MustUnmarshal(bz, &v)
+	return v, nil
+}
+
+// setVote stores v and its vote by voter index entry.
+func (k Keeper) setVote(ctx sdk.Context, v group.Vote) error {
+	voter, err := sdk.AccAddressFromBech32(v.Voter)
+	if err != nil {
+		return sdkerrors.Wrap(err, "voter")
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Set(voteKey(v.ProposalId, voter), k.cdc.MustMarshal(&v))
+	store.Set(voteByVoterKey(voter, v.ProposalId), []byte{})
+	return nil
+}
+
+// iterateVotesByProposal calls cb on all the votes of a proposal, stopping
+// when cb returns true.
+func (k Keeper) iterateVotesByProposal(ctx sdk.Context, proposalID uint64, cb func(v group.Vote) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), votesByProposalPrefix(proposalID))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var v group.Vote
+		k.cdc.MustUnmarshal(iter.Value(), &v)
+		if cb(v) {
+			break
+		}
+	}
+}
+
+// iterateVotes calls cb on all the votes, stopping when cb returns true.
+func (k Keeper) iterateVotes(ctx sdk.Context, cb func(v group.Vote) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), VoteTablePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var v group.Vote
+		k.cdc.MustUnmarshal(iter.Value(), &v)
+		if cb(v) {
+			break
+		}
+	}
+}
+
+// pruneVotes deletes all the votes of a proposal.
+func (k Keeper) pruneVotes(ctx sdk.Context, proposalID uint64) {
+	var votes []group.Vote
+	k.iterateVotesByProposal(ctx, proposalID, func(v group.Vote) bool {
+		votes = append(votes, v)
+		return false
+	})
+
+	store := ctx.KVStore(k.key)
+	for _, v := range votes {
+		voter := sdk.MustAccAddressFromBech32(v.Voter)
+		store.Delete(voteKey(proposalID, voter))
+		store.Delete(voteByVoterKey(voter, proposalID))
+	}
+}
+
+// iterateProposalsByVPEnd iterates over the proposals whose voting period
+// ended before or at endTime, stopping when cb returns true.
+func (k Keeper) iterateProposalsByVPEnd(ctx sdk.Context, endTime time.Time, cb func(p group.Proposal) (stop bool, err error)) error {
+	store := ctx.KVStore(k.key)
+	end := sdk.PrefixEndBytes(concat(ProposalsByVotingPeriodEndPrefix, sdk.FormatTimeBytes(endTime)))
+	iter := store.Iterator(ProposalsByVotingPeriodEndPrefix, end)
+	defer iter.Close()
+
+	var ids []uint64
+	for ; iter.Valid(); iter.Next() {
+		_, id := splitProposalsByVotingPeriodEndKey(iter.Key())
+		ids = append(ids, id)
+	}
+
+	// The callbacks are called once the iterator has been consumed, as they
+	// usually write to the store.
+	for _, id := range ids {
+		p, err := k.getProposal(ctx, id)
+		if err != nil {
+			return err
+		}
+		stop, err := cb(p)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// TallyProposalsAtVPEnd iterates over all the proposals whose voting period
+// has ended, tallies their votes, prunes them if they have been aborted or
+// withdrawn, and persists the final tally result otherwise.
+func (k Keeper) TallyProposalsAtVPEnd(ctx sdk.Context) error {
+	return k.iterateProposalsByVPEnd(ctx, ctx.BlockTime(), func(proposal group.Proposal) (bool, error) {
+		switch proposal.Status {
+		case group.PROPOSAL_STATUS_ABORTED, group.PROPOSAL_STATUS_WITHDRAWN:
+			if err := k.pruneProposal(ctx, proposal); err != nil {
+				return true, err
+			}
+		case group.PROPOSAL_STATUS_SUBMITTED:
+			policyInfo, err := k.GetGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+			if err != nil {
+				return true, sdkerrors.Wrap(err, "group policy")
+			}
+			electorate, err := k.GetGroupInfo(ctx, policyInfo.GroupId)
+			if err != nil {
+				return true, sdkerrors.Wrap(err, "group")
+			}
+
+			if err := k.doTallyAndUpdate(ctx, &proposal, electorate, policyInfo); err != nil {
+				return true, sdkerrors.Wrap(err, "doTallyAndUpdate")
+			}
+			if err := k.setProposal(ctx, proposal); err != nil {
+				return true, err
+			}
+		}
+
+		return false, nil
+	})
+}
+
+// PruneProposals prunes all the proposals that can't be executed anymore,
+// i.e. whose voting period ended more than MaxExecutionPeriod ago.
+func (k Keeper) PruneProposals(ctx sdk.Context) error {
+	endTime := ctx.BlockTime().Add(-k.config.MaxExecutionPeriod)
+	return k.iterateProposalsByVPEnd(ctx, endTime, func(proposal group.Proposal) (bool, error) {
+		if err := k.pruneProposal(ctx, proposal); err != nil {
+			return true, err
+		}
+		return false, nil
+	})
+}
+
+// assertMetadataLength returns an error if given metadata length
+// is greater than the configured MaxMetadataLen.
+func (k Keeper) assertMetadataLength(metadata string, description string) error {
+	if metadata != "" && uint64(len(metadata)) > k.config.MaxMetadataLen {
+		return sdkerrors.Wrapf(group.ErrMaxLimit, description)
+	}
+	return nil
+}
@@ -0,0 +1,482 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/module"
+)
+
+const votingPeriod = time.Hour
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient group.QueryClient
+
+	groupID     uint64
+	policyAddr  sdk.AccAddress
+	blockTime   time.Time
+	initBalance sdk.Coins
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	s.blockTime = tmtime.Now()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(s.blockTime)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	group.RegisterQueryServer(queryHelper, app.GroupKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = group.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 6, sdk.NewInt(30000000))
+
+	// create a group of three members with a 2/3 threshold policy
+	res, err := app.GroupKeeper.CreateGroupWithPolicy(sdk.WrapSDKContext(ctx), s.newCreateGroupWithPolicyMsg())
+	s.Require().NoError(err)
+
+	s.groupID = res.GroupId
+	s.policyAddr, err = sdk.AccAddressFromBech32(res.GroupPolicyAddress)
+	s.Require().NoError(err)
+
+	s.initBalance = sdk.NewCoins(sdk.NewInt64Coin("test", 100000))
+	s.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, s.policyAddr, s.initBalance))
+}
+
+func (s *TestSuite) newCreateGroupWithPolicyMsg() *group.MsgCreateGroupWithPolicy {
+	msg, err := group.NewMsgCreateGroupWithPolicy(
+		s.addrs[0].String(),
+		[]group.MemberRequest{
+			{Address: s.addrs[1].String(), Weight: "1"},
+			{Address: s.addrs[2].String(), Weight: "1"},
+			{Address: s.addrs[3].String(), Weight: "1"},
+		},
+		"group metadata",
+		"policy metadata",
+		false,
+		group.NewThresholdDecisionPolicy("2", votingPeriod, 0),
+	)
+	s.Require().NoError(err)
+	return msg
+}
+
+func (s *TestSuite) submitSendProposal(proposer sdk.AccAddress, exec group.Exec) uint64 {
+	msg, err := group.NewMsgSubmitProposal(
+		s.policyAddr.String(),
+		[]string{proposer.String()},
+		[]sdk.Msg{banktypes.NewMsgSend(s.policyAddr, s.addrs[5], sdk.NewCoins(sdk.NewInt64Coin("test", 100)))},
+		"proposal metadata",
+		exec,
+	)
+	s.Require().NoError(err)
+
+	res, err := s.app.GroupKeeper.SubmitProposal(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+	return res.ProposalId
+}
+
+func (s *TestSuite) vote(proposalID uint64, voter sdk.AccAddress, option group.VoteOption, exec group.Exec) error {
+	_, err := s.app.GroupKeeper.Vote(sdk.WrapSDKContext(s.ctx), &group.MsgVote{
+		ProposalId: proposalID,
+		Voter:      voter.String(),
+		Option:     option,
+		Exec:       exec,
+	})
+	return err
+}
+
+func (s *TestSuite) getProposal(proposalID uint64) (*group.Proposal, error) {
+	res, err := s.queryClient.Proposal(sdk.WrapSDKContext(s.ctx), &group.QueryProposalRequest{ProposalId: proposalID})
+	if err != nil {
+		return nil, err
+	}
+	return res.Proposal, nil
+}
+
+func (s *TestSuite) TestCreateGroup() {
+	ctx := sdk.WrapSDKContext(s.ctx)
+	k := s.app.GroupKeeper
+
+	testCases := []struct {
+		msg    *group.MsgCreateGroup
+		expErr bool
+	}{
+		{
+			msg: &group.MsgCreateGroup{
+				Admin:   s.addrs[0].String(),
+				Members: []group.MemberRequest{{Address: s.addrs[1].String(), Weight: "1.5"}},
+			},
+		},
+		{
+			msg: &group.MsgCreateGroup{Admin: s.addrs[0].String()},
+		},
+		{
+			msg: &group.MsgCreateGroup{
+				Admin:   s.addrs[0].String(),
+				Members: []group.MemberRequest{{Address: s.addrs[1].String(), Weight: "0"}},
+			},
+			expErr: true,
+		},
+		{
+			msg: &group.MsgCreateGroup{
+				Admin:    s.addrs[0].String(),
+				Metadata: string(make([]byte, group.DefaultConfig().MaxMetadataLen+1)),
+			},
+			expErr: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		res, err := k.CreateGroup(ctx, tc.msg)
+		if tc.expErr {
+			s.Require().Error(err, "test case %d", i)
+			continue
+		}
+		s.Require().NoError(err, "test case %d", i)
+
+		info, err := k.GetGroupInfo(s.ctx, res.GroupId)
+		s.Require().NoError(err)
+		s.Require().Equal(tc.msg.Admin, info.Admin)
+		s.Require().Equal(uint64(1), info.Version)
+	}
+}
+
+func (s *TestSuite) TestUpdateGroupMembers() {
+	ctx := sdk.WrapSDKContext(s.ctx)
+	k := s.app.GroupKeeper
+
+	proposalID := s.submitSendProposal(s.addrs[1], group.Exec_EXEC_UNSPECIFIED)
+
+	// only the admin can update the members
+	_, err := k.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembers{
+		Admin:         s.addrs[1].String(),
+		GroupId:       s.groupID,
+		MemberUpdates: []group.MemberRequest{{Address: s.addrs[4].String(), Weight: "1"}},
+	})
+	s.Require().Error(err)
+
+	_, err = k.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembers{
+		Admin:   s.addrs[0].String(),
+		GroupId: s.groupID,
+		MemberUpdates: []group.MemberRequest{
+			{Address: s.addrs[3].String(), Weight: "0"},
+			{Address: s.addrs[4].String(), Weight: "2"},
+		},
+	})
+	s.Require().NoError(err)
+
+	info, err := k.GetGroupInfo(s.ctx, s.groupID)
+	s.Require().NoError(err)
+	s.Require().Equal("4.000000000000000000", info.TotalWeight)
+	s.Require().Equal(uint64(2), info.Version)
+
+	res, err := s.queryClient.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: s.groupID})
+	s.Require().NoError(err)
+	s.Require().Len(res.Members, 3)
+
+	// the pending proposal is aborted as the group changed
+	proposal, err := s.getProposal(proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_ABORTED, proposal.Status)
+
+	// removing an unknown member fails
+	_, err = k.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembers{
+		Admin:   s.addrs[0].String(),
+		GroupId: s.groupID,
+		MemberUpdates: []group.MemberRequest{
+			{Address: s.addrs[5].String(), Weight: "0"},
+		},
+	})
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestUpdateGroupAdmin() {
+	ctx := sdk.WrapSDKContext(s.ctx)
+	k := s.app.GroupKeeper
+
+	_, err := k.UpdateGroupAdmin(ctx, &group.MsgUpdateGroupAdmin{
+		Admin:    s.addrs[0].String(),
+		GroupId:  s.groupID,
+		NewAdmin: s.addrs[4].String(),
+	})
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.GroupsByAdmin(ctx, &group.QueryGroupsByAdminRequest{Admin: s.addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Groups)
+
+	res, err = s.queryClient.GroupsByAdmin(ctx, &group.QueryGroupsByAdminRequest{Admin: s.addrs[4].String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Groups, 1)
+	s.Require().Equal(s.groupID, res.Groups[0].Id)
+
+	// the former admin can't update the group anymore
+	_, err = k.UpdateGroupMetadata(ctx, &group.MsgUpdateGroupMetadata{
+		Admin:    s.addrs[0].String(),
+		GroupId:  s.groupID,
+		Metadata: "new metadata",
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (s *TestSuite) TestCreateGroupPolicy() {
+	ctx := sdk.WrapSDKContext(s.ctx)
+	k := s.app.GroupKeeper
+
+	msg, err := group.NewMsgCreateGroupPolicy(s.addrs[0], s.groupID, "", group.NewPercentageDecisionPolicy("0.5", votingPeriod, 0))
+	s.Require().NoError(err)
+	res, err := k.CreateGroupPolicy(ctx, msg)
+	s.Require().NoError(err)
+	s.Require().NotEqual(s.policyAddr.String(), res.Address)
+
+	// a group policy account is created
+	addr, err := sdk.AccAddressFromBech32(res.Address)
+	s.Require().NoError(err)
+	s.Require().NotNil(s.app.AccountKeeper.GetAccount(s.ctx, addr))
+
+	policies, err := s.queryClient.GroupPoliciesByGroup(ctx, &group.QueryGroupPoliciesByGroupRequest{GroupId: s.groupID})
+	s.Require().NoError(err)
+	s.Require().Len(policies.GroupPolicies, 2)
+
+	// only the group admin can create a policy
+	msg, err = group.NewMsgCreateGroupPolicy(s.addrs[1], s.groupID, "", group.NewPercentageDecisionPolicy("0.5", votingPeriod, 0))
+	s.Require().NoError(err)
+	_, err = k.CreateGroupPolicy(ctx, msg)
+	s.Require().Error(err)
+
+	// the minimum execution period can't exceed the voting plus max execution periods
+	msg, err = group.NewMsgCreateGroupPolicy(s.addrs[0], s.groupID, "", group.NewPercentageDecisionPolicy("0.5", votingPeriod, votingPeriod+group.DefaultConfig().MaxExecutionPeriod+time.Second))
+	s.Require().NoError(err)
+	_, err = k.CreateGroupPolicy(ctx, msg)
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestUpdateGroupPolicyDecisionPolicy() {
+	ctx := sdk.WrapSDKContext(s.ctx)
+	k := s.app.GroupKeeper
+
+	proposalID := s.submitSendProposal(s.addrs[1], group.Exec_EXEC_UNSPECIFIED)
+
+	msg, err := group.NewMsgUpdateGroupPolicyDecisionPolicy(s.addrs[0], s.policyAddr, group.NewThresholdDecisionPolicy("1", votingPeriod, 0))
+	s.Require().NoError(err)
+	_, err = k.UpdateGroupPolicyDecisionPolicy(ctx, msg)
+	s.Require().NoError(err)
+
+	info, err := k.GetGroupPolicyInfo(s.ctx, s.policyAddr.String())
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), info.Version)
+	policy, err := info.GetDecisionPolicy()
+	s.Require().NoError(err)
+	s.Require().Equal("1", policy.(*group.ThresholdDecisionPolicy).Threshold)
+
+	proposal, err := s.getProposal(proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_ABORTED, proposal.Status)
+}
+
+func (s *TestSuite) TestSubmitProposal() {
+	ctx := sdk.WrapSDKContext(s.ctx)
+	k := s.app.GroupKeeper
+
+	testCases := map[string]struct {
+		proposers []string
+		msgs      []sdk.Msg
+		expErr    bool
+	}{
+		"valid proposal": {
+			proposers: []string{s.addrs[1].String()},
+			msgs:      []sdk.Msg{banktypes.NewMsgSend(s.policyAddr, s.addrs[5], sdk.NewCoins(sdk.NewInt64Coin("test", 100)))},
+		},
+		"proposal without msgs": {
+			proposers: []string{s.addrs[1].String(), s.addrs[2].String()},
+		},
+		"proposer is not a member": {
+			proposers: []string{s.addrs[4].String()},
+			expErr:    true,
+		},
+		"msg signer is not the group policy": {
+			proposers: []string{s.addrs[1].String()},
+			msgs:      []sdk.Msg{banktypes.NewMsgSend(s.addrs[1], s.addrs[5], sdk.NewCoins(sdk.NewInt64Coin("test", 100)))},
+			expErr:    true,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			msg, err := group.NewMsgSubmitProposal(s.policyAddr.String(), tc.proposers, tc.msgs, "", group.Exec_EXEC_UNSPECIFIED)
+			s.Require().NoError(err)
+
+			res, err := k.SubmitProposal(ctx, msg)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			proposal, err := s.getProposal(res.ProposalId)
+			s.Require().NoError(err)
+			s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, proposal.Status)
+			s.Require().Equal(tc.proposers, proposal.Proposers)
+			s.Require().Equal(s.blockTime.Add(votingPeriod), proposal.VotingPeriodEnd)
+		})
+	}
+}
+
+func (s *TestSuite) TestWithdrawProposal() {
+	ctx := sdk.WrapSDKContext(s.ctx)
+	k := s.app.GroupKeeper
+
+	proposalID := s.submitSendProposal(s.addrs[1], group.Exec_EXEC_UNSPECIFIED)
+
+	// only a proposer or the group policy admin can withdraw
+	_, err := k.WithdrawProposal(ctx, &group.MsgWithdrawProposal{ProposalId: proposalID, Address: s.addrs[2].String()})
+	s.Require().Error(err)
+
+	_, err = k.WithdrawProposal(ctx, &group.MsgWithdrawProposal{ProposalId: proposalID, Address: s.addrs[1].String()})
+	s.Require().NoError(err)
+
+	proposal, err := s.getProposal(proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_WITHDRAWN, proposal.Status)
+
+	// a withdrawn proposal can't be withdrawn again nor voted on
+	_, err = k.WithdrawProposal(ctx, &group.MsgWithdrawProposal{ProposalId: proposalID, Address: s.addrs[1].String()})
+	s.Require().Error(err)
+	s.Require().Error(s.vote(proposalID, s.addrs[2], group.VOTE_OPTION_YES, group.Exec_EXEC_UNSPECIFIED))
+
+	// withdrawn proposals are pruned at the end of the voting period
+	s.ctx = s.ctx.WithBlockTime(s.blockTime.Add(votingPeriod))
+	module.EndBlocker(s.ctx, k)
+	_, err = s.getProposal(proposalID)
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestVote() {
+	k := s.app.GroupKeeper
+
+	proposalID := s.submitSendProposal(s.addrs[1], group.Exec_EXEC_UNSPECIFIED)
+
+	s.Require().NoError(s.vote(proposalID, s.addrs[1], group.VOTE_OPTION_YES, group.Exec_EXEC_UNSPECIFIED))
+	// voting twice is not allowed
+	s.Require().Error(s.vote(proposalID, s.addrs[1], group.VOTE_OPTION_NO, group.Exec_EXEC_UNSPECIFIED))
+	// only members can vote
+	s.Require().Error(s.vote(proposalID, s.addrs[4], group.VOTE_OPTION_YES, group.Exec_EXEC_UNSPECIFIED))
+	s.Require().NoError(s.vote(proposalID, s.addrs[2], group.VOTE_OPTION_NO, group.Exec_EXEC_UNSPECIFIED))
+
+	tally, err := s.queryClient.TallyResult(sdk.WrapSDKContext(s.ctx), &group.QueryTallyResultRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal("1.000000000000000000", tally.Tally.YesCount)
+	s.Require().Equal("1.000000000000000000", tally.Tally.NoCount)
+
+	votes, err := s.queryClient.VotesByProposal(sdk.WrapSDKContext(s.ctx), &group.QueryVotesByProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Len(votes.Votes, 2)
+
+	// votes are not accepted after the voting period end
+	s.ctx = s.ctx.WithBlockTime(s.blockTime.Add(votingPeriod))
+	s.Require().Error(s.vote(proposalID, s.addrs[3], group.VOTE_OPTION_YES, group.Exec_EXEC_UNSPECIFIED))
+
+	// the proposal is rejected at the end of the voting period and its votes are pruned
+	module.EndBlocker(s.ctx, k)
+	proposal, err := s.getProposal(proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_REJECTED, proposal.Status)
+	s.Require().Equal("1.000000000000000000", proposal.FinalTallyResult.YesCount)
+
+	votes, err = s.queryClient.VotesByProposal(sdk.WrapSDKContext(s.ctx), &group.QueryVotesByProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Empty(votes.Votes)
+}
+
+func (s *TestSuite) TestExec() {
+	k := s.app.GroupKeeper
+
+	proposalID := s.submitSendProposal(s.addrs[1], group.Exec_EXEC_UNSPECIFIED)
+	s.Require().NoError(s.vote(proposalID, s.addrs[1], group.VOTE_OPTION_YES, group.Exec_EXEC_UNSPECIFIED))
+
+	// the proposal is not accepted yet, so it is not run
+	res, err := k.Exec(sdk.WrapSDKContext(s.ctx), &group.MsgExec{ProposalId: proposalID, Executor: s.addrs[4].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, res.Result)
+
+	// the threshold is reached and the proposal is executed right away
+	s.Require().NoError(s.vote(proposalID, s.addrs[2], group.VOTE_OPTION_YES, group.Exec_EXEC_TRY))
+
+	s.Require().Equal(sdk.NewInt(100), s.app.BankKeeper.GetBalance(s.ctx, s.addrs[5], "test").Amount)
+	s.Require().Equal(s.initBalance.Sub(sdk.NewCoins(sdk.NewInt64Coin("test", 100))), s.app.BankKeeper.GetAllBalances(s.ctx, s.policyAddr))
+
+	// successfully executed proposals are pruned
+	_, err = s.getProposal(proposalID)
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestExecFailure() {
+	k := s.app.GroupKeeper
+
+	msg, err := group.NewMsgSubmitProposal(
+		s.policyAddr.String(),
+		[]string{s.addrs[1].String(), s.addrs[2].String()},
+		[]sdk.Msg{banktypes.NewMsgSend(s.policyAddr, s.addrs[5], sdk.NewCoins(sdk.NewInt64Coin("test", 1000000)))},
+		"",
+		group.Exec_EXEC_TRY,
+	)
+	s.Require().NoError(err)
+
+	res, err := k.SubmitProposal(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	// the proposal is accepted, but its execution fails due to insufficient funds
+	proposal, err := s.getProposal(res.ProposalId)
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, proposal.Status)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, proposal.ExecutorResult)
+	s.Require().Equal(s.initBalance, s.app.BankKeeper.GetAllBalances(s.ctx, s.policyAddr))
+
+	// once the max execution period is over, the proposal is pruned
+	s.ctx = s.ctx.WithBlockTime(s.blockTime.Add(votingPeriod + group.DefaultConfig().MaxExecutionPeriod))
+	module.EndBlocker(s.ctx, k)
+	_, err = s.getProposal(res.ProposalId)
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestLeaveGroup() {
+	ctx := sdk.WrapSDKContext(s.ctx)
+	k := s.app.GroupKeeper
+
+	_, err := k.LeaveGroup(ctx, &group.MsgLeaveGroup{Address: s.addrs[4].String(), GroupId: s.groupID})
+	s.Require().Error(err)
+
+	_, err = k.LeaveGroup(ctx, &group.MsgLeaveGroup{Address: s.addrs[3].String(), GroupId: s.groupID})
+	s.Require().NoError(err)
+
+	info, err := k.GetGroupInfo(s.ctx, s.groupID)
+	s.Require().NoError(err)
+	s.Require().Equal("2.000000000000000000", info.TotalWeight)
+
+	res, err := s.queryClient.GroupsByMember(ctx, &group.QueryGroupsByMemberRequest{Address: s.addrs[3].String()})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Groups)
+
+	// a former member can't leave again
+	_, err = k.LeaveGroup(ctx, &group.MsgLeaveGroup{Address: s.addrs[3].String(), GroupId: s.groupID})
+	s.Require().Error(err)
+}
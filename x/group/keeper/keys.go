@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// Keys for group store
+// Items are stored with the following key: values
+//
+// - 0x10<groupID_Bytes><memberAddrLen (1 Byte)><memberAddr_Bytes>: GroupMember
+// - 0x12<memberAddrLen (1 Byte)><memberAddr_Bytes><groupID_Bytes>: []byte{}
+//
+// - 0x20<groupID_Bytes>: GroupInfo
+// - 0x21: groupSeq
+// - 0x22<adminAddrLen (1 Byte)><adminAddr_Bytes><groupID_Bytes>: []byte{}
+//
+// - 0x30<policyAddrLen (1 Byte)><policyAddr_Bytes>: GroupPolicyInfo
+// - 0x31: groupPolicySeq
+// - 0x32<groupID_Bytes><policyAddrLen (1 Byte)><policyAddr_Bytes>: []byte{}
+// - 0x33<adminAddrLen (1 Byte)><adminAddr_Bytes><policyAddrLen (1 Byte)><policyAddr_Bytes>: []byte{}
+//
+// - 0x40<proposalID_Bytes>: Proposal
+// - 0x41: proposalSeq
+// - 0x42<policyAddrLen (1 Byte)><policyAddr_Bytes><proposalID_Bytes>: []byte{}
+// - 0x43<votingPeriodEnd_Bytes><proposalID_Bytes>: []byte{}
+//
+// - 0x50<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Vote
+// - 0x52<voterAddrLen (1 Byte)><voterAddr_Bytes><proposalID_Bytes>: []byte{}
+var (
+	GroupMemberTablePrefix         = []byte{0x10}
+	GroupMemberByMemberIndexPrefix = []byte{0x12}
+
+	GroupTablePrefix        = []byte{0x20}
+	GroupSeqKey             = []byte{0x21}
+	GroupByAdminIndexPrefix = []byte{0x22}
+
+	GroupPolicyTablePrefix        = []byte{0x30}
+	GroupPolicySeqKey             = []byte{0x31}
+	GroupPolicyByGroupIndexPrefix = []byte{0x32}
+	GroupPolicyByAdminIndexPrefix = []byte{0x33}
+
+	ProposalTablePrefix              = []byte{0x40}
+	ProposalSeqKey                   = []byte{0x41}
+	ProposalByGroupPolicyIndexPrefix = []byte{0x42}
+	ProposalsByVotingPeriodEndPrefix = []byte{0x43}
+
+	VoteTablePrefix        = []byte{0x50}
+	VoteByVoterIndexPrefix = []byte{0x52}
+)
+
+var lenTime = len(sdk.FormatTimeBytes(time.Now()))
+
+// uint64ToBytes returns the big endian representation of id, so that ids are
+// iterated in ascending order.
+func uint64ToBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// uint64FromBytes returns the uint64 encoded by uint64ToBytes.
+func uint64FromBytes(bz []byte) uint64 {
+	kv.AssertKeyLength(bz, 8)
+	return binary.BigEndian.Uint64(bz)
+}
+
+func concat(parts ...[]byte) []byte {
+	var l int
+	for _, p := range parts {
+		l += len(p)
+	}
+	key := make([]byte, 0, l)
+	for _, p := range parts {
+		key = append(key, p...)
+	}
+	return key
+}
+
+// groupKey returns the key of the GroupInfo with the given id.
+func groupKey(groupID uint64) []byte {
+	return concat(GroupTablePrefix, uint64ToBytes(groupID))
+}
+
+// groupByAdminPrefix returns the prefix of all the group by admin index
+// entries of admin.
+func groupByAdminPrefix(admin sdk.AccAddress) []byte {
+	return concat(GroupByAdminIndexPrefix, address.MustLengthPrefix(admin))
+}
+
+// groupByAdminKey returns the group by admin index key of a group.
+func groupByAdminKey(admin sdk.AccAddress, groupID uint64) []byte {
+	return concat(groupByAdminPrefix(admin), uint64ToBytes(groupID))
+}
+
+// groupMembersPrefix returns the prefix of all the members of a group.
+func groupMembersPrefix(groupID uint64) []byte {
+	return concat(GroupMemberTablePrefix, uint64ToBytes(groupID))
+}
+
+// groupMemberKey returns the key of a GroupMember.
+func groupMemberKey(groupID uint64, member sdk.AccAddress) []byte {
+	return concat(groupMembersPrefix(groupID), address.MustLengthPrefix(member))
+}
+
+// groupMemberByMemberPrefix returns the prefix of all the group member by
+// member index entries of member.
+func groupMemberByMemberPrefix(member sdk.AccAddress) []byte {
+	return concat(GroupMemberByMemberIndexPrefix, address.MustLengthPrefix(member))
+}
+
+// groupMemberByMemberKey returns the group member by member index key of a
+// GroupMember.
+func groupMemberByMemberKey(member sdk.AccAddress, groupID uint64) []byte {
+	return concat(groupMemberByMemberPrefix(member), uint64ToBytes(groupID))
+}
+
+// groupPolicyKey returns the key of a GroupPolicyInfo.
+func groupPolicyKey(policy sdk.AccAddress) []byte {
+	return concat(GroupPolicyTablePrefix, address.MustLengthPrefix(policy))
+}
+
+// groupPolicyByGroupPrefix returns the prefix of all the group policy by
+// group index entries of a group.
+func groupPolicyByGroupPrefix(groupID uint64) []byte {
+	return concat(GroupPolicyByGroupIndexPrefix, uint64ToBytes(groupID))
+}
+
+// groupPolicyByGroupKey returns the group policy by group index key of a
+// GroupPolicyInfo.
+func groupPolicyByGroupKey(groupID uint64, policy sdk.AccAddress) []byte {
+	return concat(groupPolicyByGroupPrefix(groupID), address.MustLengthPrefix(policy))
+}
+
+// groupPolicyByAdminPrefix returns the prefix of all the group policy by
+// admin index entries of admin.
+func groupPolicyByAdminPrefix(admin sdk.AccAddress) []byte {
+	return concat(GroupPolicyByAdminIndexPrefix, address.MustLengthPrefix(admin))
+}
+
+// groupPolicyByAdminKey returns the group policy by admin index key of a
+// GroupPolicyInfo.
+func groupPolicyByAdminKey(admin sdk.AccAddress, policy sdk.AccAddress) []byte {
+	return concat(groupPolicyByAdminPrefix(admin), address.MustLengthPrefix(policy))
+}
+
+// proposalKey returns the key of the Proposal with the given id.
+func proposalKey(proposalID uint64) []byte {
+	return concat(ProposalTablePrefix, uint64ToBytes(proposalID))
+}
+
+// proposalByGroupPolicyPrefix returns the prefix of all the proposal by group
+// policy index entries of a group policy.
+func proposalByGroupPolicyPrefix(policy sdk.AccAddress) []byte {
+	return concat(ProposalByGroupPolicyIndexPrefix, address.MustLengthPrefix(policy))
+}
+
+// proposalByGroupPolicyKey returns the proposal by group policy index key of
+// a Proposal.
+func proposalByGroupPolicyKey(policy sdk.AccAddress, proposalID uint64) []byte {
+	return concat(proposalByGroupPolicyPrefix(policy), uint64ToBytes(proposalID))
+}
+
+// proposalsByVotingPeriodEndKey returns the voting period end queue key of a
+// Proposal.
+func proposalsByVotingPeriodEndKey(votingPeriodEnd time.Time, proposalID uint64) []byte {
+	return concat(ProposalsByVotingPeriodEndPrefix, sdk.FormatTimeBytes(votingPeriodEnd), uint64ToBytes(proposalID))
+}
+
+// splitProposalsByVotingPeriodEndKey returns the voting period end and the
+// proposal id encoded in a voting period end queue key.
+func splitProposalsByVotingPeriodEndKey(key []byte) (time.Time, uint64) {
+	kv.AssertKeyLength(key[1:], lenTime+8)
+	endTime, err := sdk.ParseTimeBytes(key[1 : 1+lenTime])
+	if err != nil {
+		panic(err)
+	}
+
+	return endTime, uint64FromBytes(key[1+lenTime:])
+}
+
+// votesByProposalPrefix returns the prefix of all the votes of a proposal.
+func votesByProposalPrefix(proposalID uint64) []byte {
+	return concat(VoteTablePrefix, uint64ToBytes(proposalID))
+}
+
+// voteKey returns the key of a Vote.
+func voteKey(proposalID uint64, voter sdk.AccAddress) []byte {
+	return concat(votesByProposalPrefix(proposalID), address.MustLengthPrefix(voter))
+}
+
+// voteByVoterPrefix returns the prefix of all the vote by voter index entries
+// of voter.
+func voteByVoterPrefix(voter sdk.AccAddress) []byte {
+	return concat(VoteByVoterIndexPrefix, address.MustLengthPrefix(voter))
+}
+
+// voteByVoterKey returns the vote by voter index key of a Vote.
+func voteByVoterKey(voter sdk.AccAddress, proposalID uint64) []byte {
+	return concat(voteByVoterPrefix(voter), uint64ToBytes(proposalID))
+}
+
+// addressFromLengthPrefixed parses an address prefixed with its length, as
+// found in the index keys once the index prefix has been stripped.
+func addressFromLengthPrefixed(bz []byte) sdk.AccAddress {
+	kv.AssertKeyAtLeastLength(bz, 1)
+	addrLen := int(bz[0])
+	kv.AssertKeyLength(bz[1:], addrLen)
+	return sdk.AccAddress(bz[1:])
+}
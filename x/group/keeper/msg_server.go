@@ -0,0 +1,895 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
+)
+
+var _ group.MsgServer = Keeper{}
+
+// CreateGroup creates a new group with an admin account address, a list of
+// members and some optional metadata.
+func (k Keeper) CreateGroup(goCtx context.Context, req *group.MsgCreateGroup) (*group.MsgCreateGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := sdk.AccAddressFromBech32(req.Admin); err != nil {
+		return nil, sdkerrors.Wrap(err, "admin address")
+	}
+
+	if err := k.assertMetadataLength(req.Metadata, "group metadata"); err != nil {
+		return nil, err
+	}
+
+	totalWeight := sdk.ZeroDec()
+	for _, m := range req.Members {
+		if err := k.assertMetadataLength(m.Metadata, "member metadata"); err != nil {
+			return nil, err
+		}
+
+		// Members of a group must have a positive weight.
+		weight, err := math.NewPositiveDecFromString(m.Weight)
+		if err != nil {
+			return nil, err
+		}
+
+		totalWeight = totalWeight.Add(weight)
+	}
+
+	groupInfo := group.GroupInfo{
+		Id:          k.nextSeq(ctx, GroupSeqKey),
+		Admin:       req.Admin,
+		Metadata:    req.Metadata,
+		Version:     1,
+		TotalWeight: totalWeight.String(),
+		CreatedAt:   ctx.BlockTime(),
+	}
+	if err := k.setGroupInfo(ctx, groupInfo); err != nil {
+		return nil, sdkerrors.Wrap(err, "could not create group")
+	}
+
+	for _, m := range req.Members {
+		err := k.setGroupMember(ctx, group.GroupMember{
+			GroupId: groupInfo.Id,
+			Member: &group.Member{
+				Address:  m.Address,
+				Weight:   m.Weight,
+				Metadata: m.Metadata,
+				AddedAt:  ctx.BlockTime(),
+			},
+		})
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "could not store member %d", groupInfo.Id)
+		}
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventCreateGroup{GroupId: groupInfo.Id}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgCreateGroupResponse{GroupId: groupInfo.Id}, nil
+}
+
+// UpdateGroupMembers updates the group members with the given member
+// updates. A member with a weight of zero is removed from the group.
+func (k Keeper) UpdateGroupMembers(goCtx context.Context, req *group.MsgUpdateGroupMembers) (*group.MsgUpdateGroupMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	action := func(g *group.GroupInfo) error {
+		totalWeight, err := math.NewNonNegativeDecFromString(g.TotalWeight)
+		if err != nil {
+			return sdkerrors.Wrap(err, "group total weight")
+		}
+
+		for _, m := range req.MemberUpdates {
+			if err := k.assertMetadataLength(m.Metadata, "group member metadata"); err != nil {
+				return err
+			}
+			addr, err := sdk.AccAddressFromBech32(m.Address)
+			if err != nil {
+				return sdkerrors.Wrap(err, "member address")
+			}
+			newMemberWeight, err := math.NewNonNegativeDecFromString(m.Weight)
+			if err != nil {
+				return err
+			}
+
+			prevGroupMember, err := k.getGroupMember(ctx, req.GroupId, addr)
+			found := err == nil
+			if err != nil && !sdkerrors.ErrNotFound.Is(err) {
+				return sdkerrors.Wrap(err, "get group member")
+			}
+
+			// Handle delete for members with zero weight.
+			if newMemberWeight.IsZero() {
+				// We can't delete a group member that doesn't already exist.
+				if !found {
+					return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "unknown member %s", m.Address)
+				}
+
+				previousMemberWeight, err := math.NewPositiveDecFromString(prevGroupMember.Member.Weight)
+				if err != nil {
+					return err
+				}
+
+				// Subtract the weight of the group member to delete from the group total weight.
+				totalWeight, err = math.SubNonNegative(totalWeight, previousMemberWeight)
+				if err != nil {
+					return err
+				}
+
+				k.deleteGroupMember(ctx, req.GroupId, addr)
+				continue
+			}
+
+			addedAt := ctx.BlockTime()
+			// If group member already exists, handle update.
+			if found {
+				previousMemberWeight, err := math.NewPositiveDecFromString(prevGroupMember.Member.Weight)
+				if err != nil {
+					return err
+				}
+				// Subtract previous weight from the group total weight.
+				totalWeight, err = math.SubNonNegative(totalWeight, previousMemberWeight)
+				if err != nil {
+					return err
+				}
+				addedAt = prevGroupMember.Member.AddedAt
+			}
+
+			// Add the new member weight to the group total weight.
+			totalWeight = totalWeight.Add(newMemberWeight)
+
+			err = k.setGroupMember(ctx, group.GroupMember{
+				GroupId: req.GroupId,
+				Member: &group.Member{
+					Address:  m.Address,
+					Weight:   m.Weight,
+					Metadata: m.Metadata,
+					AddedAt:  addedAt,
+				},
+			})
+			if err != nil {
+				return sdkerrors.Wrap(err, "add member")
+			}
+		}
+
+		// Update group in the groupTable.
+		g.TotalWeight = totalWeight.String()
+		g.Version++
+
+		if err := k.validateDecisionPolicies(ctx, *g); err != nil {
+			return err
+		}
+
+		return k.setGroupInfo(ctx, *g)
+	}
+
+	if err := k.doUpdateGroup(ctx, req.GroupId, req.Admin, action, "members updated"); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupMembersResponse{}, nil
+}
+
+// UpdateGroupAdmin updates the admin of a group.
+func (k Keeper) UpdateGroupAdmin(goCtx context.Context, req *group.MsgUpdateGroupAdmin) (*group.MsgUpdateGroupAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	action := func(g *group.GroupInfo) error {
+		g.Admin = req.NewAdmin
+		g.Version++
+
+		return k.setGroupInfo(ctx, *g)
+	}
+
+	if err := k.doUpdateGroup(ctx, req.GroupId, req.Admin, action, "admin updated"); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupAdminResponse{}, nil
+}
+
+// UpdateGroupMetadata updates the metadata of a group.
+func (k Keeper) UpdateGroupMetadata(goCtx context.Context, req *group.MsgUpdateGroupMetadata) (*group.MsgUpdateGroupMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	action := func(g *group.GroupInfo) error {
+		g.Metadata = req.Metadata
+		g.Version++
+		return k.setGroupInfo(ctx, *g)
+	}
+
+	if err := k.assertMetadataLength(req.Metadata, "group metadata"); err != nil {
+		return nil, err
+	}
+
+	if err := k.doUpdateGroup(ctx, req.GroupId, req.Admin, action, "metadata updated"); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupMetadataResponse{}, nil
+}
+
+// CreateGroupWithPolicy creates a new group with a group policy, optionally
+// setting the group policy account as the admin of both the group and the
+// group policy.
+func (k Keeper) CreateGroupWithPolicy(goCtx context.Context, req *group.MsgCreateGroupWithPolicy) (*group.MsgCreateGroupWithPolicyResponse, error) {
+	groupRes, err := k.CreateGroup(goCtx, &group.MsgCreateGroup{
+		Admin:    req.Admin,
+		Members:  req.Members,
+		Metadata: req.GroupMetadata,
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "group response")
+	}
+	groupID := groupRes.GroupId
+
+	groupPolicyRes, err := k.CreateGroupPolicy(goCtx, &group.MsgCreateGroupPolicy{
+		Admin:          req.Admin,
+		GroupId:        groupID,
+		Metadata:       req.GroupPolicyMetadata,
+		DecisionPolicy: req.DecisionPolicy,
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "group policy response")
+	}
+	groupPolicyAddress := groupPolicyRes.Address
+
+	if req.GroupPolicyAsAdmin {
+		updateAdminReq := &group.MsgUpdateGroupAdmin{
+			GroupId:  groupID,
+			Admin:    req.Admin,
+			NewAdmin: groupPolicyAddress,
+		}
+		_, err = k.UpdateGroupAdmin(goCtx, updateAdminReq)
+		if err != nil {
+			return nil, err
+		}
+
+		updatePolicyAddressReq := &group.MsgUpdateGroupPolicyAdmin{
+			Admin:              req.Admin,
+			GroupPolicyAddress: groupPolicyAddress,
+			NewAdmin:           groupPolicyAddress,
+		}
+		_, err = k.UpdateGroupPolicyAdmin(goCtx, updatePolicyAddressReq)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &group.MsgCreateGroupWithPolicyResponse{GroupId: groupID, GroupPolicyAddress: groupPolicyAddress}, nil
+}
+
+// CreateGroupPolicy creates a new group policy account for a group, with a
+// decision policy and some optional metadata.
+func (k Keeper) CreateGroupPolicy(goCtx context.Context, req *group.MsgCreateGroupPolicy) (*group.MsgCreateGroupPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "request admin")
+	}
+	policy, err := req.GetDecisionPolicy()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "request decision policy")
+	}
+
+	if err := k.assertMetadataLength(req.Metadata, "group policy metadata"); err != nil {
+		return nil, err
+	}
+
+	g, err := k.GetGroupInfo(ctx, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	// Only the group admin can create a group policy.
+	if g.Admin != req.Admin {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not group admin")
+	}
+
+	if err := policy.Validate(g, k.config); err != nil {
+		return nil, err
+	}
+
+	// Generate account address of group policy.
+	var accountAddr sdk.AccAddress
+	// loop here in the rare case where a ADR-028-derived address creates a
+	// collision with an existing address.
+	for {
+		nextAccVal := k.nextSeq(ctx, GroupPolicySeqKey)
+		derivationKey := concat(GroupPolicyTablePrefix, uint64ToBytes(nextAccVal))
+		accountAddr = address.Module(group.ModuleName, derivationKey)
+
+		if k.accKeeper.GetAccount(ctx, accountAddr) != nil {
+			// handle a rare collision, in which case we just go on to the
+			// next sequence value and derive a new address.
+			continue
+		}
+
+		// group policy accounts are unclaimable base accounts
+		acc := k.accKeeper.NewAccount(ctx, authtypes.NewBaseAccountWithAddress(accountAddr))
+		k.accKeeper.SetAccount(ctx, acc)
+
+		break
+	}
+
+	groupPolicy, err := group.NewGroupPolicyInfo(accountAddr, req.GroupId, admin, req.Metadata, 1, policy, ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.setGroupPolicyInfo(ctx, groupPolicy); err != nil {
+		return nil, sdkerrors.Wrap(err, "could not create group policy")
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventCreateGroupPolicy{Address: accountAddr.String()}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgCreateGroupPolicyResponse{Address: accountAddr.String()}, nil
+}
+
+// UpdateGroupPolicyAdmin updates the admin of a group policy.
+func (k Keeper) UpdateGroupPolicyAdmin(goCtx context.Context, req *group.MsgUpdateGroupPolicyAdmin) (*group.MsgUpdateGroupPolicyAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	action := func(groupPolicy *group.GroupPolicyInfo) error {
+		groupPolicy.Admin = req.NewAdmin
+		groupPolicy.Version++
+		return k.setGroupPolicyInfo(ctx, *groupPolicy)
+	}
+
+	if err := k.doUpdateGroupPolicy(ctx, req.GroupPolicyAddress, req.Admin, action, "group policy admin updated"); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupPolicyAdminResponse{}, nil
+}
+
+// UpdateGroupPolicyDecisionPolicy updates the decision policy of a group
+// policy.
+func (k Keeper) UpdateGroupPolicyDecisionPolicy(goCtx context.Context, req *group.MsgUpdateGroupPolicyDecisionPolicy) (*group.MsgUpdateGroupPolicyDecisionPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	policy, err := req.GetDecisionPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	action := func(groupPolicy *group.GroupPolicyInfo) error {
+		g, err := k.GetGroupInfo(ctx, groupPolicy.GroupId)
+		if err != nil {
+			return err
+		}
+
+		if err := policy.Validate(g, k.config); err != nil {
+			return err
+		}
+
+		if err := groupPolicy.SetDecisionPolicy(policy); err != nil {
+			return err
+		}
+
+		groupPolicy.Version++
+		return k.setGroupPolicyInfo(ctx, *groupPolicy)
+	}
+
+	if err = k.doUpdateGroupPolicy(ctx, req.GroupPolicyAddress, req.Admin, action, "group policy's decision policy updated"); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupPolicyDecisionPolicyResponse{}, nil
+}
+
+// UpdateGroupPolicyMetadata updates the metadata of a group policy.
+func (k Keeper) UpdateGroupPolicyMetadata(goCtx context.Context, req *group.MsgUpdateGroupPolicyMetadata) (*group.MsgUpdateGroupPolicyMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	metadata := req.Metadata
+
+	action := func(groupPolicy *group.GroupPolicyInfo) error {
+		groupPolicy.Metadata = metadata
+		groupPolicy.Version++
+		return k.setGroupPolicyInfo(ctx, *groupPolicy)
+	}
+
+	if err := k.assertMetadataLength(metadata, "group policy metadata"); err != nil {
+		return nil, err
+	}
+
+	err := k.doUpdateGroupPolicy(ctx, req.GroupPolicyAddress, req.Admin, action, "group policy metadata updated")
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupPolicyMetadataResponse{}, nil
+}
+
+// SubmitProposal submits a new proposal to a group policy. Only members of
+// the group can submit proposals.
+func (k Keeper) SubmitProposal(goCtx context.Context, req *group.MsgSubmitProposal) (*group.MsgSubmitProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	groupPolicyAddr, err := sdk.AccAddressFromBech32(req.GroupPolicyAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "request account address of group policy")
+	}
+
+	if err := k.assertMetadataLength(req.Metadata, "metadata"); err != nil {
+		return nil, err
+	}
+
+	policyAcc, err := k.GetGroupPolicyInfo(ctx, req.GroupPolicyAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "load group policy: %s", req.GroupPolicyAddress)
+	}
+
+	g, err := k.GetGroupInfo(ctx, policyAcc.GroupId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "get group by groupId of group policy")
+	}
+
+	// Only members of the group can submit a new proposal.
+	for _, proposer := range req.Proposers {
+		proposerAddr, err := sdk.AccAddressFromBech32(proposer)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "proposer address")
+		}
+		if _, err := k.getGroupMember(ctx, g.Id, proposerAddr); err != nil {
+			return nil, sdkerrors.Wrapf(err, "address is not a group member")
+		}
+	}
+
+	msgs, err := req.GetMsgs()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "request msgs")
+	}
+
+	// Check that if the messages require signers, they are all equal to the given account address of group policy.
+	if err := ensureMsgAuthZ(msgs, groupPolicyAddr); err != nil {
+		return nil, err
+	}
+
+	policy, err := policyAcc.GetDecisionPolicy()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "proposal group policy decision policy")
+	}
+
+	proposal := group.Proposal{
+		Id:                 k.nextSeq(ctx, ProposalSeqKey),
+		GroupPolicyAddress: req.GroupPolicyAddress,
+		Metadata:           req.Metadata,
+		Proposers:          req.Proposers,
+		SubmitTime:         ctx.BlockTime(),
+		GroupVersion:       g.Version,
+		GroupPolicyVersion: policyAcc.Version,
+		Status:             group.PROPOSAL_STATUS_SUBMITTED,
+		ExecutorResult:     group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN,
+		VotingPeriodEnd:    ctx.BlockTime().Add(policy.GetVotingPeriod()),
+		FinalTallyResult:   group.DefaultTallyResult(),
+	}
+
+	if err := proposal.SetMsgs(msgs); err != nil {
+		return nil, sdkerrors.Wrap(err, "create proposal")
+	}
+
+	if err := k.setProposal(ctx, proposal); err != nil {
+		return nil, sdkerrors.Wrap(err, "create proposal")
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventSubmitProposal{ProposalId: proposal.Id}); err != nil {
+		return nil, err
+	}
+
+	// Try to execute proposal immediately
+	if req.Exec == group.Exec_EXEC_TRY {
+		// Consider proposers as Yes votes
+		for _, proposer := range req.Proposers {
+			_, err = k.Vote(sdk.WrapSDKContext(ctx), &group.MsgVote{
+				ProposalId: proposal.Id,
+				Voter:      proposer,
+				Option:     group.VOTE_OPTION_YES,
+			})
+			if err != nil {
+				return &group.MsgSubmitProposalResponse{ProposalId: proposal.Id}, sdkerrors.Wrapf(err, "the proposal was created but failed on vote for voter %s", proposer)
+			}
+		}
+
+		// Then try to execute the proposal
+		_, err = k.Exec(sdk.WrapSDKContext(ctx), &group.MsgExec{
+			ProposalId: proposal.Id,
+			// We consider the first proposer as the MsgExecRequest signer
+			// but that could be revisited (eg using the group policy)
+			Executor: req.Proposers[0],
+		})
+		if err != nil {
+			return &group.MsgSubmitProposalResponse{ProposalId: proposal.Id}, sdkerrors.Wrap(err, "the proposal was created but failed on exec")
+		}
+	}
+
+	return &group.MsgSubmitProposalResponse{ProposalId: proposal.Id}, nil
+}
+
+// WithdrawProposal withdraws a proposal. Only one of the proposers or the
+// group policy admin can withdraw a proposal.
+func (k Keeper) WithdrawProposal(goCtx context.Context, req *group.MsgWithdrawProposal) (*group.MsgWithdrawProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id := req.ProposalId
+	address := req.Address
+
+	proposal, err := k.getProposal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the proposal can be withdrawn.
+	if proposal.Status != group.PROPOSAL_STATUS_SUBMITTED {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot withdraw a proposal with the status of %s", proposal.Status.String())
+	}
+
+	var policyInfo group.GroupPolicyInfo
+	if policyInfo, err = k.GetGroupPolicyInfo(ctx, proposal.GroupPolicyAddress); err != nil {
+		return nil, sdkerrors.Wrap(err, "load group policy")
+	}
+
+	// check address is the group policy admin he is in proposers list..
+	if address != policyInfo.Admin && !isProposer(proposal, address) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "given address is neither group policy admin nor in proposers: %s", address)
+	}
+
+	proposal.Status = group.PROPOSAL_STATUS_WITHDRAWN
+	if err := k.setProposal(ctx, proposal); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventWithdrawProposal{ProposalId: id}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgWithdrawProposalResponse{}, nil
+}
+
+// Vote casts the vote of a group member on a proposal.
+func (k Keeper) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// verify vote options
+	if req.Option == group.VOTE_OPTION_UNSPECIFIED {
+		return nil, sdkerrors.Wrap(group.ErrEmpty, "vote option")
+	}
+	if _, ok := group.VoteOption_name[int32(req.Option)]; !ok {
+		return nil, sdkerrors.Wrap(group.ErrInvalid, "vote option")
+	}
+
+	if err := k.assertMetadataLength(req.Metadata, "metadata"); err != nil {
+		return nil, err
+	}
+
+	proposal, err := k.getProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we can still accept votes for this proposal.
+	if proposal.Status != group.PROPOSAL_STATUS_SUBMITTED {
+		return nil, sdkerrors.Wrap(group.ErrInvalid, "proposal not open for voting")
+	}
+
+	if !ctx.BlockTime().Before(proposal.VotingPeriodEnd) {
+		return nil, sdkerrors.Wrap(group.ErrExpired, "voting period has ended already")
+	}
+
+	policyInfo, err := k.GetGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "load group policy")
+	}
+
+	electorate, err := k.GetGroupInfo(ctx, policyInfo.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	voterAddr, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "voter address")
+	}
+
+	// Count and store votes.
+	if _, err := k.getGroupMember(ctx, electorate.Id, voterAddr); err != nil {
+		return nil, sdkerrors.Wrapf(err, "voter address: %s", req.Voter)
+	}
+
+	if _, err := k.getVote(ctx, req.ProposalId, voterAddr); err == nil {
+		return nil, sdkerrors.Wrapf(group.ErrDuplicate, "voter %s has already voted on proposal %d", req.Voter, req.ProposalId)
+	}
+
+	newVote := group.Vote{
+		ProposalId: req.ProposalId,
+		Voter:      req.Voter,
+		Option:     req.Option,
+		Metadata:   req.Metadata,
+		SubmitTime: ctx.BlockTime(),
+	}
+
+	if err := k.setVote(ctx, newVote); err != nil {
+		return nil, sdkerrors.Wrap(err, "store vote")
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventVote{ProposalId: req.ProposalId}); err != nil {
+		return nil, err
+	}
+
+	// Try to execute proposal immediately
+	if req.Exec == group.Exec_EXEC_TRY {
+		_, err = k.Exec(sdk.WrapSDKContext(ctx), &group.MsgExec{ProposalId: req.ProposalId, Executor: req.Voter})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &group.MsgVoteResponse{}, nil
+}
+
+// Exec executes the messages from a proposal. The proposal is tallied first
+// if its voting period hasn't ended yet.
+func (k Keeper) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposal, err := k.getProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.Status != group.PROPOSAL_STATUS_SUBMITTED && proposal.Status != group.PROPOSAL_STATUS_ACCEPTED {
+		return nil, sdkerrors.Wrapf(group.ErrInvalid, "not possible to exec with proposal status %s", proposal.Status.String())
+	}
+
+	policyInfo, err := k.GetGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "load group policy")
+	}
+
+	// If proposal is still in SUBMITTED phase, it means that the voting period
+	// didn't end yet, and tallying hasn't been done. In this case, we need to
+	// tally first.
+	if proposal.Status == group.PROPOSAL_STATUS_SUBMITTED {
+		electorate, err := k.GetGroupInfo(ctx, policyInfo.GroupId)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "load group")
+		}
+
+		if err := k.doTallyAndUpdate(ctx, &proposal, electorate, policyInfo); err != nil {
+			return nil, err
+		}
+	}
+
+	// Execute proposal payload.
+	var logs string
+	if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+		// Caching context so that we don't update the store in case of failure.
+		cacheCtx, flush := ctx.CacheContext()
+
+		addr, err := sdk.AccAddressFromBech32(policyInfo.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		decisionPolicy, err := policyInfo.GetDecisionPolicy()
+		if err != nil {
+			return nil, err
+		}
+
+		if results, err := k.doExecuteMsgs(cacheCtx, k.router, proposal, addr, decisionPolicy); err != nil {
+			proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_FAILURE
+			logs = fmt.Sprintf("proposal execution failed on proposal %d, because of error %s", proposal.Id, err.Error())
+			k.Logger(ctx).Info("proposal execution failed", "cause", err, "proposalID", proposal.Id)
+		} else {
+			proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_SUCCESS
+			flush()
+
+			for _, res := range results {
+				// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
+				for _, event := range res.GetEvents() {
+					ctx.EventManager().EmitEvent(sdk.Event(event))
+				}
+			}
+		}
+	}
+
+	// Update proposal in proposalTable
+	// If proposal has successfully run, delete it from state.
+	if proposal.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+		if err := k.pruneProposal(ctx, proposal); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := k.setProposal(ctx, proposal); err != nil {
+			return nil, err
+		}
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&group.EventExec{
+		ProposalId: proposal.Id,
+		Logs:       logs,
+		Result:     proposal.ExecutorResult,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgExecResponse{
+		Result: proposal.ExecutorResult,
+	}, nil
+}
+
+// LeaveGroup implements the MsgServer/LeaveGroup method.
+func (k Keeper) LeaveGroup(goCtx context.Context, req *group.MsgLeaveGroup) (*group.MsgLeaveGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	memberAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	groupInfo, err := k.GetGroupInfo(ctx, req.GroupId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "group")
+	}
+
+	groupWeight, err := math.NewNonNegativeDecFromString(groupInfo.TotalWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	gm, err := k.getGroupMember(ctx, req.GroupId, memberAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	memberWeight, err := math.NewPositiveDecFromString(gm.Member.Weight)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedWeight, err := math.SubNonNegative(groupWeight, memberWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// delete group member in the groupMemberTable.
+	k.deleteGroupMember(ctx, req.GroupId, memberAddr)
+
+	// update group weight
+	groupInfo.TotalWeight = updatedWeight.String()
+	groupInfo.Version++
+
+	if err := k.validateDecisionPolicies(ctx, groupInfo); err != nil {
+		return nil, err
+	}
+
+	if err := k.setGroupInfo(ctx, groupInfo); err != nil {
+		return nil, err
+	}
+
+	// The group has changed, so the proposals of its group policies can't be
+	// voted on anymore.
+	if err := k.abortGroupProposals(ctx, groupInfo.Id); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventLeaveGroup{
+		GroupId: req.GroupId,
+		Address: req.Address,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgLeaveGroupResponse{}, nil
+}
+
+type (
+	actionFn            func(m *group.GroupInfo) error
+	groupPolicyActionFn func(m *group.GroupPolicyInfo) error
+)
+
+// doUpdateGroupPolicy first makes sure that the group policy admin initiated the group policy update,
+// before performing the group policy update and emitting an event.
+func (k Keeper) doUpdateGroupPolicy(ctx sdk.Context, groupPolicy string, admin string, action groupPolicyActionFn, note string) error {
+	groupPolicyAddr, err := sdk.AccAddressFromBech32(groupPolicy)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group policy address")
+	}
+
+	groupPolicyInfo, err := k.GetGroupPolicyInfo(ctx, groupPolicy)
+	if err != nil {
+		return sdkerrors.Wrap(err, "load group policy")
+	}
+
+	// Only current group policy admin is authorized to update a group policy.
+	if admin != groupPolicyInfo.Admin {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not group policy admin")
+	}
+
+	if err := action(&groupPolicyInfo); err != nil {
+		return sdkerrors.Wrap(err, note)
+	}
+
+	if err = k.abortProposals(ctx, groupPolicyAddr); err != nil {
+		return err
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(&group.EventUpdateGroupPolicy{Address: groupPolicyInfo.Address}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// doUpdateGroup first makes sure that the group admin initiated the group update,
+// before performing the group update and emitting an event.
+func (k Keeper) doUpdateGroup(ctx sdk.Context, groupID uint64, reqGroupAdmin string, action actionFn, errNote string) error {
+	g, err := k.GetGroupInfo(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	if g.Admin != reqGroupAdmin {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "not group admin; got %s, expected %s", reqGroupAdmin, g.Admin)
+	}
+
+	if err := action(&g); err != nil {
+		return sdkerrors.Wrap(err, errNote)
+	}
+
+	// The group has changed, so the proposals of its group policies can't be
+	// voted on anymore.
+	if err := k.abortGroupProposals(ctx, groupID); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventUpdateGroup{GroupId: groupID}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// abortGroupProposals aborts the submitted proposals of all the group
+// policies of a group.
+func (k Keeper) abortGroupProposals(ctx sdk.Context, groupID uint64) error {
+	for _, policyAddr := range k.getGroupPolicyAddressesByGroup(ctx, groupID) {
+		if err := k.abortProposals(ctx, policyAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateDecisionPolicies loops through all decision policies from the group,
+// and calls each of their Validate() method.
+func (k Keeper) validateDecisionPolicies(ctx sdk.Context, g group.GroupInfo) error {
+	for _, policyAddr := range k.getGroupPolicyAddressesByGroup(ctx, g.Id) {
+		groupPolicy, err := k.GetGroupPolicyInfo(ctx, policyAddr.String())
+		if err != nil {
+			return err
+		}
+
+		policy, err := groupPolicy.GetDecisionPolicy()
+		if err != nil {
+			return err
+		}
+
+		if err := policy.Validate(g, k.config); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isProposer checks that an address is a proposer of a given proposal.
+func isProposer(proposal group.Proposal, address string) bool {
+	for _, proposer := range proposal.Proposers {
+		if proposer == address {
+			return true
+		}
+	}
+
+	return false
+}
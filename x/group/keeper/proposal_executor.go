@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// doExecuteMsgs routes the messages to the registered handlers. Messages are limited to those that require no authZ or
+// by the account of group policy only. Otherwise this gives access to other peoples accounts as the sdk middlewares are bypassed
+func (k Keeper) doExecuteMsgs(ctx sdk.Context, router *baseapp.MsgServiceRouter, proposal group.Proposal, groupPolicyAcc sdk.AccAddress, decisionPolicy group.DecisionPolicy) ([]sdk.Result, error) {
+	// Ensure it's not too early to execute the messages.
+	minExecutionDate := proposal.SubmitTime.Add(decisionPolicy.GetMinExecutionPeriod())
+	if ctx.BlockTime().Before(minExecutionDate) {
+		return nil, sdkerrors.Wrapf(group.ErrInvalid, "must wait until %s to execute proposal %d", minExecutionDate, proposal.Id)
+	}
+
+	// Ensure it's not too late to execute the messages. Expired proposals are
+	// pruned in EndBlocker, so this is only a cheap sanity check.
+	expiryDate := proposal.VotingPeriodEnd.Add(k.config.MaxExecutionPeriod)
+	if expiryDate.Before(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(group.ErrExpired, "proposal expired on %s", expiryDate)
+	}
+
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]sdk.Result, len(msgs))
+	if err := ensureMsgAuthZ(msgs, groupPolicyAcc); err != nil {
+		return nil, err
+	}
+	for i, msg := range msgs {
+		handler := router.Handler(msg)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no message handler found for %q", sdk.MsgTypeURL(msg))
+		}
+		r, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "message %s at position %d", sdk.MsgTypeURL(msg), i)
+		}
+		// Handler should always return non-nil sdk.Result.
+		if r == nil {
+			return nil, fmt.Errorf("got nil sdk.Result for message %q at position %d", msg, i)
+		}
+
+		results[i] = *r
+	}
+	return results, nil
+}
+
+// ensureMsgAuthZ checks that if a message requires signers that all of them are equal to the given account address of group policy.
+func ensureMsgAuthZ(msgs []sdk.Msg, groupPolicyAcc sdk.AccAddress) error {
+	for i := range msgs {
+		// In practice, GetSigners() should return a non-empty array without
+		// duplicates, so the code below is equivalent to:
+		// `msgs[i].GetSigners()[0] == groupPolicyAcc`
+		// but we prefer to loop through all GetSigners just to be sure.
+		for _, acct := range msgs[i].GetSigners() {
+			if !groupPolicyAcc.Equals(acct) {
+				return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg does not have group policy authorization")
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// Tally is a function that tallies a proposal by iterating through its votes,
+// and returns the tally result without modifying the proposal or any state.
+func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.TallyResult, error) {
+	// If proposal has already been tallied and updated, then its status is
+	// accepted/rejected, in which case we just return the previously stored result.
+	//
+	// In all other cases (including withdrawn, aborted...) we do the tally
+	// again.
+	if p.Status == group.PROPOSAL_STATUS_ACCEPTED || p.Status == group.PROPOSAL_STATUS_REJECTED {
+		return p.FinalTallyResult, nil
+	}
+
+	tallyResult := group.DefaultTallyResult()
+	var err error
+	k.iterateVotesByProposal(ctx, p.Id, func(vote group.Vote) bool {
+		voter := sdk.MustAccAddressFromBech32(vote.Voter)
+
+		// Only the votes of the current members of the group are taken into
+		// account, with their current weight.
+		member, memberErr := k.getGroupMember(ctx, groupID, voter)
+		if sdkerrors.ErrNotFound.Is(memberErr) {
+			return false
+		}
+		if memberErr != nil {
+			err = sdkerrors.Wrapf(memberErr, "failed to get group member %s of group %d", vote.Voter, groupID)
+			return true
+		}
+
+		if addErr := tallyResult.Add(vote.Option, member.Member.Weight); addErr != nil {
+			err = sdkerrors.Wrap(addErr, "add new vote")
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return group.TallyResult{}, err
+	}
+
+	return tallyResult, nil
+}
+
+// doTallyAndUpdate performs a tally, and, if the tally result is final, then:
+// - updates the proposal's `Status` and `FinalTallyResult` fields,
+// - prune all the votes.
+func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, electorate group.GroupInfo, policyInfo group.GroupPolicyInfo) error {
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return err
+	}
+
+	tallyResult, err := k.Tally(ctx, *p, policyInfo.GroupId)
+	if err != nil {
+		return err
+	}
+
+	result, err := policy.Allow(tallyResult, electorate.TotalWeight)
+	if err != nil {
+		return sdkerrors.Wrap(err, "policy allow")
+	}
+
+	// If the result was final (i.e. enough votes to pass) or if the voting
+	// period ended, then we consider the proposal as final.
+	if isFinal := result.Final || !ctx.BlockTime().Before(p.VotingPeriodEnd); isFinal {
+		k.pruneVotes(ctx, p.Id)
+		p.FinalTallyResult = tallyResult
+		if result.Allow {
+			p.Status = group.PROPOSAL_STATUS_ACCEPTED
+		} else {
+			p.Status = group.PROPOSAL_STATUS_REJECTED
+		}
+	}
+
+	return nil
+}
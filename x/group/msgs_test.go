@@ -0,0 +1,189 @@
+package group_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+var (
+	admin   = sdk.AccAddress("_______admin________")
+	member1 = sdk.AccAddress("_______member1______")
+	member2 = sdk.AccAddress("_______member2______")
+	policy  = sdk.AccAddress("_______policy_______")
+)
+
+func TestMsgCreateGroup(t *testing.T) {
+	tests := []struct {
+		title      string
+		msg        *group.MsgCreateGroup
+		expectPass bool
+	}{
+		{
+			"invalid admin",
+			&group.MsgCreateGroup{Admin: "invalid"},
+			false,
+		},
+		{
+			"no members",
+			&group.MsgCreateGroup{Admin: admin.String()},
+			true,
+		},
+		{
+			"valid members",
+			&group.MsgCreateGroup{
+				Admin: admin.String(),
+				Members: []group.MemberRequest{
+					{Address: member1.String(), Weight: "1"},
+					{Address: member2.String(), Weight: "2.5"},
+				},
+			},
+			true,
+		},
+		{
+			"duplicate members",
+			&group.MsgCreateGroup{
+				Admin: admin.String(),
+				Members: []group.MemberRequest{
+					{Address: member1.String(), Weight: "1"},
+					{Address: member1.String(), Weight: "2"},
+				},
+			},
+			false,
+		},
+		{
+			"zero weight",
+			&group.MsgCreateGroup{
+				Admin:   admin.String(),
+				Members: []group.MemberRequest{{Address: member1.String(), Weight: "0"}},
+			},
+			false,
+		},
+		{
+			"invalid member address",
+			&group.MsgCreateGroup{
+				Admin:   admin.String(),
+				Members: []group.MemberRequest{{Address: "invalid", Weight: "1"}},
+			},
+			false,
+		},
+	}
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.title)
+			require.Equal(t, []sdk.AccAddress{admin}, tc.msg.GetSigners(), tc.title)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.title)
+		}
+	}
+}
+
+func TestMsgUpdateGroupMembers(t *testing.T) {
+	tests := []struct {
+		title      string
+		msg        *group.MsgUpdateGroupMembers
+		expectPass bool
+	}{
+		{
+			"zero group id",
+			&group.MsgUpdateGroupMembers{
+				Admin:         admin.String(),
+				MemberUpdates: []group.MemberRequest{{Address: member1.String(), Weight: "1"}},
+			},
+			false,
+		},
+		{
+			"no member updates",
+			&group.MsgUpdateGroupMembers{Admin: admin.String(), GroupId: 1},
+			false,
+		},
+		{
+			"member removal",
+			&group.MsgUpdateGroupMembers{
+				Admin:         admin.String(),
+				GroupId:       1,
+				MemberUpdates: []group.MemberRequest{{Address: member1.String(), Weight: "0"}},
+			},
+			true,
+		},
+	}
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.title)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.title)
+		}
+	}
+}
+
+func TestMsgCreateGroupPolicy(t *testing.T) {
+	msg, err := group.NewMsgCreateGroupPolicy(admin, 1, "", group.NewThresholdDecisionPolicy("1", time.Hour, 0))
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+
+	decisionPolicy, err := msg.GetDecisionPolicy()
+	require.NoError(t, err)
+	require.Equal(t, "1", decisionPolicy.(*group.ThresholdDecisionPolicy).Threshold)
+
+	msg, err = group.NewMsgCreateGroupPolicy(admin, 0, "", group.NewThresholdDecisionPolicy("1", time.Hour, 0))
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+
+	msg, err = group.NewMsgCreateGroupPolicy(admin, 1, "", group.NewPercentageDecisionPolicy("2", time.Hour, 0))
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgSubmitProposal(t *testing.T) {
+	send := banktypes.NewMsgSend(policy, member1, sdk.NewCoins(sdk.NewInt64Coin("steak", 10)))
+
+	tests := []struct {
+		title      string
+		proposers  []string
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{"no proposers", nil, []sdk.Msg{send}, false},
+		{"duplicate proposers", []string{member1.String(), member1.String()}, []sdk.Msg{send}, false},
+		{"invalid msg", []string{member1.String()}, []sdk.Msg{&banktypes.MsgSend{}}, false},
+		{"no msgs", []string{member1.String()}, nil, true},
+		{"valid", []string{member1.String(), member2.String()}, []sdk.Msg{send}, true},
+	}
+	for _, tc := range tests {
+		msg, err := group.NewMsgSubmitProposal(policy.String(), tc.proposers, tc.msgs, "", group.Exec_EXEC_UNSPECIFIED)
+		require.NoError(t, err, tc.title)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), tc.title)
+			require.Len(t, msg.GetSigners(), len(tc.proposers), tc.title)
+
+			msgs, err := msg.GetMsgs()
+			require.NoError(t, err, tc.title)
+			require.Len(t, msgs, len(tc.msgs), tc.title)
+		} else {
+			require.Error(t, msg.ValidateBasic(), tc.title)
+		}
+	}
+}
+
+func TestMsgVote(t *testing.T) {
+	msg := &group.MsgVote{ProposalId: 1, Voter: member1.String(), Option: group.VOTE_OPTION_YES}
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{member1}, msg.GetSigners())
+
+	msg = &group.MsgVote{ProposalId: 1, Voter: member1.String()}
+	require.Error(t, msg.ValidateBasic())
+
+	msg = &group.MsgVote{Voter: member1.String(), Option: group.VOTE_OPTION_YES}
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgSignBytes(t *testing.T) {
+	msg := &group.MsgLeaveGroup{Address: member1.String(), GroupId: 1}
+	expected := `{"type":"cosmos-sdk/group/MsgLeaveGroup","value":{"address":"` + member1.String() + `","group_id":"1"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
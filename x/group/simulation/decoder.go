@@ -0,0 +1,67 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding group type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], keeper.GroupTablePrefix):
+			var groupA, groupB group.GroupInfo
+			cdc.MustUnmarshal(kvA.Value, &groupA)
+			cdc.MustUnmarshal(kvB.Value, &groupB)
+			return fmt.Sprintf("%v\n%v", groupA, groupB)
+
+		case bytes.Equal(kvA.Key[:1], keeper.GroupMemberTablePrefix):
+			var memberA, memberB group.GroupMember
+			cdc.MustUnmarshal(kvA.Value, &memberA)
+			cdc.MustUnmarshal(kvB.Value, &memberB)
+			return fmt.Sprintf("%v\n%v", memberA, memberB)
+
+		case bytes.Equal(kvA.Key[:1], keeper.GroupPolicyTablePrefix):
+			var policyA, policyB group.GroupPolicyInfo
+			cdc.MustUnmarshal(kvA.Value, &policyA)
+			cdc.MustUnmarshal(kvB.Value, &policyB)
+			return fmt.Sprintf("%v\n%v", policyA, policyB)
+
+		case bytes.Equal(kvA.Key[:1], keeper.ProposalTablePrefix):
+			var proposalA, proposalB group.Proposal
+			cdc.MustUnmarshal(kvA.Value, &proposalA)
+			cdc.MustUnmarshal(kvB.Value, &proposalB)
+			return fmt.Sprintf("%v\n%v", proposalA, proposalB)
+
+		case bytes.Equal(kvA.Key[:1], keeper.VoteTablePrefix):
+			var voteA, voteB group.Vote
+			cdc.MustUnmarshal(kvA.Value, &voteA)
+			cdc.MustUnmarshal(kvB.Value, &voteB)
+			return fmt.Sprintf("%v\n%v", voteA, voteB)
+
+		case bytes.Equal(kvA.Key[:1], keeper.GroupSeqKey),
+			bytes.Equal(kvA.Key[:1], keeper.GroupPolicySeqKey),
+			bytes.Equal(kvA.Key[:1], keeper.ProposalSeqKey):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], keeper.GroupMemberByMemberIndexPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.GroupByAdminIndexPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.GroupPolicyByGroupIndexPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.GroupPolicyByAdminIndexPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.ProposalByGroupPolicyIndexPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.ProposalsByVotingPeriodEndPrefix),
+			bytes.Equal(kvA.Key[:1], keeper.VoteByVoterIndexPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid group key %X", kvA.Key))
+		}
+	}
+}
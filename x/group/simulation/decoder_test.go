@@ -0,0 +1,67 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/group/simulation"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	addr := sdk.AccAddress("_______member_______")
+	groupInfo := group.GroupInfo{Id: 1, Admin: addr.String(), Version: 1, TotalWeight: "1", CreatedAt: time.Now().UTC()}
+	groupBz, err := cdc.Marshal(&groupInfo)
+	require.NoError(t, err)
+
+	member := group.GroupMember{GroupId: 1, Member: &group.Member{Address: addr.String(), Weight: "1"}}
+	memberBz, err := cdc.Marshal(&member)
+	require.NoError(t, err)
+
+	vote := group.Vote{ProposalId: 1, Voter: addr.String(), Option: group.VOTE_OPTION_YES, SubmitTime: time.Now().UTC()}
+	voteBz, err := cdc.Marshal(&vote)
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: keeper.GroupTablePrefix, Value: groupBz},
+			{Key: keeper.GroupMemberTablePrefix, Value: memberBz},
+			{Key: keeper.VoteTablePrefix, Value: voteBz},
+			{Key: keeper.GroupSeqKey, Value: sdk.Uint64ToBigEndian(2)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectErr   bool
+		expectedLog string
+	}{
+		{"GroupInfo", false, fmt.Sprintf("%v\n%v", groupInfo, groupInfo)},
+		{"GroupMember", false, fmt.Sprintf("%v\n%v", member, member)},
+		{"Vote", false, fmt.Sprintf("%v\n%v", vote, vote)},
+		{"GroupSeq", false, "2\n2"},
+		{"other", true, ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectErr {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
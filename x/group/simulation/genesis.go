@@ -0,0 +1,75 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+const (
+	GroupInfo    = "group-info"
+	GroupMembers = "group-members"
+)
+
+// genGroups returns one group per account, administered by that account and
+// whose single member is the next account.
+func genGroups(r *rand.Rand, accounts []simtypes.Account, members []*group.GroupMember) []*group.GroupInfo {
+	groups := make([]*group.GroupInfo, len(members))
+	for i, m := range members {
+		groups[i] = &group.GroupInfo{
+			Id:          m.GroupId,
+			Admin:       accounts[i].Address.String(),
+			Metadata:    simtypes.RandStringOfLength(r, 10),
+			Version:     1,
+			TotalWeight: m.Member.Weight,
+		}
+	}
+
+	return groups
+}
+
+func genGroupMembers(r *rand.Rand, accounts []simtypes.Account) []*group.GroupMember {
+	if len(accounts) < 2 {
+		return nil
+	}
+
+	members := make([]*group.GroupMember, len(accounts)-1)
+	for i := 0; i < len(accounts)-1; i++ {
+		members[i] = &group.GroupMember{
+			GroupId: uint64(i + 1),
+			Member: &group.Member{
+				Address:  accounts[i+1].Address.String(),
+				Weight:   fmt.Sprintf("%d", simtypes.RandIntBetween(r, 1, 10)),
+				Metadata: simtypes.RandStringOfLength(r, 10),
+			},
+		}
+	}
+
+	return members
+}
+
+// RandomizedGenState generates a random GenesisState for group.
+func RandomizedGenState(simState *module.SimulationState) {
+	var groupMembers []*group.GroupMember
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, GroupMembers, &groupMembers, simState.Rand,
+		func(r *rand.Rand) { groupMembers = genGroupMembers(r, simState.Accounts) },
+	)
+
+	var groups []*group.GroupInfo
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, GroupInfo, &groups, simState.Rand,
+		func(r *rand.Rand) { groups = genGroups(r, simState.Accounts, groupMembers) },
+	)
+
+	groupGenesis := group.GenesisState{
+		GroupSeq:     uint64(len(groups)),
+		Groups:       groups,
+		GroupMembers: groupMembers,
+	}
+
+	simState.GenState[group.ModuleName] = simState.Cdc.MustMarshalJSON(&groupGenesis)
+}
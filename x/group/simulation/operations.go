@@ -0,0 +1,476 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// group message types
+var (
+	TypeMsgCreateGroup           = sdk.MsgTypeURL(&group.MsgCreateGroup{})
+	TypeMsgUpdateGroupMetadata   = sdk.MsgTypeURL(&group.MsgUpdateGroupMetadata{})
+	TypeMsgCreateGroupPolicy     = sdk.MsgTypeURL(&group.MsgCreateGroupPolicy{})
+	TypeMsgCreateGroupWithPolicy = sdk.MsgTypeURL(&group.MsgCreateGroupWithPolicy{})
+	TypeMsgSubmitProposal        = sdk.MsgTypeURL(&group.MsgSubmitProposal{})
+	TypeMsgVote                  = sdk.MsgTypeURL(&group.MsgVote{})
+	TypeMsgExec                  = sdk.MsgTypeURL(&group.MsgExec{})
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec
+const (
+	OpMsgCreateGroup           = "op_weight_msg_create_group"
+	OpMsgUpdateGroupMetadata   = "op_weight_msg_update_group_metadata"
+	OpMsgCreateGroupPolicy     = "op_weight_msg_create_group_policy"
+	OpMsgCreateGroupWithPolicy = "op_weight_msg_create_group_with_policy"
+	OpMsgSubmitProposal        = "op_weight_msg_submit_proposal"
+	OpMsgVote                  = "op_weight_msg_vote"
+	OpMsgExec                  = "op_weight_msg_exec"
+)
+
+// group operations weights
+const (
+	WeightMsgCreateGroup           = 100
+	WeightMsgUpdateGroupMetadata   = 40
+	WeightMsgCreateGroupPolicy     = 50
+	WeightMsgCreateGroupWithPolicy = 50
+	WeightMsgSubmitProposal        = 90
+	WeightMsgVote                  = 90
+	WeightMsgExec                  = 90
+)
+
+// maxGroupMembers bounds the size of simulated groups so that their creation
+// fits in the default simulated gas limit.
+const maxGroupMembers = 10
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak group.AccountKeeper, bk group.BankKeeper, k keeper.Keeper, appCdc cdctypes.AnyUnpacker,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateGroup           int
+		weightMsgUpdateGroupMetadata   int
+		weightMsgCreateGroupPolicy     int
+		weightMsgCreateGroupWithPolicy int
+		weightMsgSubmitProposal        int
+		weightMsgVote                  int
+		weightMsgExec                  int
+	)
+
+	appParams.GetOrGenerate(cdc, OpMsgCreateGroup, &weightMsgCreateGroup, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateGroup = WeightMsgCreateGroup
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpMsgUpdateGroupMetadata, &weightMsgUpdateGroupMetadata, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateGroupMetadata = WeightMsgUpdateGroupMetadata
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpMsgCreateGroupPolicy, &weightMsgCreateGroupPolicy, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateGroupPolicy = WeightMsgCreateGroupPolicy
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpMsgCreateGroupWithPolicy, &weightMsgCreateGroupWithPolicy, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateGroupWithPolicy = WeightMsgCreateGroupWithPolicy
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpMsgSubmitProposal, &weightMsgSubmitProposal, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitProposal = WeightMsgSubmitProposal
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpMsgVote, &weightMsgVote, nil,
+		func(_ *rand.Rand) {
+			weightMsgVote = WeightMsgVote
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpMsgExec, &weightMsgExec, nil,
+		func(_ *rand.Rand) {
+			weightMsgExec = WeightMsgExec
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateGroup,
+			SimulateMsgCreateGroup(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateGroupMetadata,
+			SimulateMsgUpdateGroupMetadata(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateGroupPolicy,
+			SimulateMsgCreateGroupPolicy(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateGroupWithPolicy,
+			SimulateMsgCreateGroupWithPolicy(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSubmitProposal,
+			SimulateMsgSubmitProposal(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgVote,
+			SimulateMsgVote(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgExec,
+			SimulateMsgExec(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateGroup generates a MsgCreateGroup with random values.
+func SimulateMsgCreateGroup(ak group.AccountKeeper, bk group.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		admin, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &group.MsgCreateGroup{
+			Admin:    admin.Address.String(),
+			Members:  genMemberRequests(r, accs),
+			Metadata: simtypes.RandStringOfLength(r, 10),
+		}
+
+		return deliverMsg(r, app, ctx, ak, bk, chainID, admin, msg, TypeMsgCreateGroup)
+	}
+}
+
+// SimulateMsgUpdateGroupMetadata generates a MsgUpdateGroupMetadata with random values.
+func SimulateMsgUpdateGroupMetadata(ak group.AccountKeeper, bk group.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		admin, _ := simtypes.RandomAcc(r, accs)
+
+		groupInfo, err := randomGroupByAdmin(r, ctx, k, admin)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgUpdateGroupMetadata, err.Error()), nil, err
+		}
+		if groupInfo == nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgUpdateGroupMetadata, "no group found"), nil, nil
+		}
+
+		msg := &group.MsgUpdateGroupMetadata{
+			Admin:    admin.Address.String(),
+			GroupId:  groupInfo.Id,
+			Metadata: simtypes.RandStringOfLength(r, 10),
+		}
+
+		return deliverMsg(r, app, ctx, ak, bk, chainID, admin, msg, TypeMsgUpdateGroupMetadata)
+	}
+}
+
+// SimulateMsgCreateGroupPolicy generates a MsgCreateGroupPolicy with random values.
+func SimulateMsgCreateGroupPolicy(ak group.AccountKeeper, bk group.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		admin, _ := simtypes.RandomAcc(r, accs)
+
+		groupInfo, err := randomGroupByAdmin(r, ctx, k, admin)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateGroupPolicy, err.Error()), nil, err
+		}
+		if groupInfo == nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateGroupPolicy, "no group found"), nil, nil
+		}
+
+		msg, err := group.NewMsgCreateGroupPolicy(
+			admin.Address,
+			groupInfo.Id,
+			simtypes.RandStringOfLength(r, 10),
+			genDecisionPolicy(r),
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateGroupPolicy, err.Error()), nil, err
+		}
+
+		return deliverMsg(r, app, ctx, ak, bk, chainID, admin, msg, TypeMsgCreateGroupPolicy)
+	}
+}
+
+// SimulateMsgCreateGroupWithPolicy generates a MsgCreateGroupWithPolicy with random values.
+func SimulateMsgCreateGroupWithPolicy(ak group.AccountKeeper, bk group.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		admin, _ := simtypes.RandomAcc(r, accs)
+
+		msg, err := group.NewMsgCreateGroupWithPolicy(
+			admin.Address.String(),
+			genMemberRequests(r, accs),
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+			r.Intn(2) == 0,
+			genDecisionPolicy(r),
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateGroupWithPolicy, err.Error()), nil, err
+		}
+
+		return deliverMsg(r, app, ctx, ak, bk, chainID, admin, msg, TypeMsgCreateGroupWithPolicy)
+	}
+}
+
+// SimulateMsgSubmitProposal generates a MsgSubmitProposal with random values.
+// The proposal does not contain any messages, as the group policy account
+// has no balance it could spend.
+func SimulateMsgSubmitProposal(ak group.AccountKeeper, bk group.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		proposer, _ := simtypes.RandomAcc(r, accs)
+
+		policies, err := groupPoliciesByMember(ctx, k, proposer)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgSubmitProposal, err.Error()), nil, err
+		}
+		if len(policies) == 0 {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgSubmitProposal, "no group policy found"), nil, nil
+		}
+		policy := policies[r.Intn(len(policies))]
+
+		msg, err := group.NewMsgSubmitProposal(
+			policy.Address,
+			[]string{proposer.Address.String()},
+			nil,
+			simtypes.RandStringOfLength(r, 10),
+			group.Exec_EXEC_UNSPECIFIED,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgSubmitProposal, err.Error()), nil, err
+		}
+
+		return deliverMsg(r, app, ctx, ak, bk, chainID, proposer, msg, TypeMsgSubmitProposal)
+	}
+}
+
+// SimulateMsgVote generates a MsgVote with random values.
+func SimulateMsgVote(ak group.AccountKeeper, bk group.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		voter, _ := simtypes.RandomAcc(r, accs)
+
+		proposals, err := proposalsByMember(ctx, k, voter, func(p *group.Proposal) bool {
+			if p.Status != group.PROPOSAL_STATUS_SUBMITTED || !ctx.BlockTime().Before(p.VotingPeriodEnd) {
+				return false
+			}
+
+			// skip proposals the voter already voted on
+			_, err := k.VoteByProposalVoter(sdk.WrapSDKContext(ctx), &group.QueryVoteByProposalVoterRequest{
+				ProposalId: p.Id,
+				Voter:      voter.Address.String(),
+			})
+			return err != nil
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgVote, err.Error()), nil, err
+		}
+		if len(proposals) == 0 {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgVote, "no proposal to vote on found"), nil, nil
+		}
+		proposal := proposals[r.Intn(len(proposals))]
+
+		msg := &group.MsgVote{
+			ProposalId: proposal.Id,
+			Voter:      voter.Address.String(),
+			Option:     group.VoteOption(simtypes.RandIntBetween(r, 1, 5)),
+			Metadata:   simtypes.RandStringOfLength(r, 10),
+		}
+
+		return deliverMsg(r, app, ctx, ak, bk, chainID, voter, msg, TypeMsgVote)
+	}
+}
+
+// SimulateMsgExec generates a MsgExec with random values.
+func SimulateMsgExec(ak group.AccountKeeper, bk group.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		executor, _ := simtypes.RandomAcc(r, accs)
+
+		proposals, err := proposalsByMember(ctx, k, executor, func(p *group.Proposal) bool {
+			return p.Status == group.PROPOSAL_STATUS_ACCEPTED &&
+				p.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgExec, err.Error()), nil, err
+		}
+		if len(proposals) == 0 {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgExec, "no proposal to execute found"), nil, nil
+		}
+		proposal := proposals[r.Intn(len(proposals))]
+
+		msg := &group.MsgExec{
+			ProposalId: proposal.Id,
+			Executor:   executor.Address.String(),
+		}
+
+		return deliverMsg(r, app, ctx, ak, bk, chainID, executor, msg, TypeMsgExec)
+	}
+}
+
+// genMemberRequests returns a random, non-empty subset of at most
+// maxGroupMembers accounts as group members with random weights.
+func genMemberRequests(r *rand.Rand, accs []simtypes.Account) []group.MemberRequest {
+	maxMembers := maxGroupMembers
+	if len(accs) < maxMembers {
+		maxMembers = len(accs)
+	}
+
+	n := simtypes.RandIntBetween(r, 1, maxMembers+1)
+	members := make([]group.MemberRequest, n)
+	for i, j := range r.Perm(len(accs))[:n] {
+		members[i] = group.MemberRequest{
+			Address:  accs[j].Address.String(),
+			Weight:   fmt.Sprintf("%d", simtypes.RandIntBetween(r, 1, 10)),
+			Metadata: simtypes.RandStringOfLength(r, 10),
+		}
+	}
+
+	return members
+}
+
+// genDecisionPolicy returns a random decision policy with a short voting
+// period so that proposals get tallied during the simulation.
+func genDecisionPolicy(r *rand.Rand) group.DecisionPolicy {
+	votingPeriod := time.Duration(simtypes.RandIntBetween(r, 1, 60)) * time.Second
+	if r.Intn(2) == 0 {
+		return group.NewThresholdDecisionPolicy(
+			fmt.Sprintf("%d", simtypes.RandIntBetween(r, 1, 10)),
+			votingPeriod,
+			0,
+		)
+	}
+
+	return group.NewPercentageDecisionPolicy(
+		fmt.Sprintf("0.%d", simtypes.RandIntBetween(r, 1, 10)),
+		votingPeriod,
+		0,
+	)
+}
+
+// randomGroupByAdmin returns a random group administered by the given
+// account, or nil if there is none.
+func randomGroupByAdmin(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, admin simtypes.Account) (*group.GroupInfo, error) {
+	res, err := k.GroupsByAdmin(sdk.WrapSDKContext(ctx), &group.QueryGroupsByAdminRequest{
+		Admin: admin.Address.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Groups) == 0 {
+		return nil, nil
+	}
+
+	return res.Groups[r.Intn(len(res.Groups))], nil
+}
+
+// groupPoliciesByMember returns the group policies of all groups the given
+// account is a member of.
+func groupPoliciesByMember(ctx sdk.Context, k keeper.Keeper, member simtypes.Account) ([]*group.GroupPolicyInfo, error) {
+	goCtx := sdk.WrapSDKContext(ctx)
+	groupsRes, err := k.GroupsByMember(goCtx, &group.QueryGroupsByMemberRequest{
+		Address: member.Address.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []*group.GroupPolicyInfo
+	for _, g := range groupsRes.Groups {
+		policiesRes, err := k.GroupPoliciesByGroup(goCtx, &group.QueryGroupPoliciesByGroupRequest{
+			GroupId: g.Id,
+		})
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, policiesRes.GroupPolicies...)
+	}
+
+	return policies, nil
+}
+
+// proposalsByMember returns the proposals of the group policies of all groups
+// the given account is a member of, filtered by keep.
+func proposalsByMember(ctx sdk.Context, k keeper.Keeper, member simtypes.Account, keep func(*group.Proposal) bool) ([]*group.Proposal, error) {
+	policies, err := groupPoliciesByMember(ctx, k, member)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposals []*group.Proposal
+	for _, policy := range policies {
+		res, err := k.ProposalsByGroupPolicy(sdk.WrapSDKContext(ctx), &group.QueryProposalsByGroupPolicyRequest{
+			Address: policy.Address,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range res.Proposals {
+			if keep(p) {
+				proposals = append(proposals, p)
+			}
+		}
+	}
+
+	return proposals, nil
+}
+
+// deliverMsg signs the given message with the signer's key, paying random
+// fees, and delivers it.
+func deliverMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak group.AccountKeeper, bk group.BankKeeper,
+	chainID string, signer simtypes.Account, msg sdk.Msg, msgType string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	account := ak.GetAccount(ctx, signer.Address)
+	spendableCoins := bk.SpendableCoins(ctx, signer.Address)
+	fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+	if err != nil {
+		return simtypes.NoOpMsg(group.ModuleName, msgType, "fee error"), nil, err
+	}
+
+	txCfg := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenSignedMockTx(
+		r,
+		txCfg,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		signer.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(group.ModuleName, msgType, "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = app.Deliver(txCfg.TxEncoder(), tx)
+	if err != nil {
+		return simtypes.NoOpMsg(group.ModuleName, msgType, "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+}
@@ -0,0 +1,593 @@
+package group
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
+)
+
+// DecisionPolicyResult is the result of whether a proposal passes or not a
+// decision policy.
+type DecisionPolicyResult struct {
+	// Allow determines if the proposal is allowed to pass.
+	Allow bool
+	// Final determines if the tally result is final or not. If final, then
+	// votes are pruned, and the tally result is saved in the proposal's
+	// `FinalTallyResult` field.
+	Final bool
+}
+
+// DecisionPolicy is the persistent set of rules to determine the result of election on a proposal.
+type DecisionPolicy interface {
+	codec.ProtoMarshaler
+
+	// GetVotingPeriod returns the duration after proposal submission where
+	// votes are accepted.
+	GetVotingPeriod() time.Duration
+	// GetMinExecutionPeriod returns the minimum duration after submission
+	// where a proposal can be executed. It can be set to 0 or to a value
+	// lesser than VotingPeriod to allow TRY_EXEC.
+	GetMinExecutionPeriod() time.Duration
+	// Allow defines policy-specific logic to allow a proposal to pass or not,
+	// based on its tally result, the group's total power and the time since
+	// the proposal was submitted.
+	Allow(tallyResult TallyResult, totalPower string) (DecisionPolicyResult, error)
+
+	ValidateBasic() error
+	Validate(g GroupInfo, config Config) error
+}
+
+var _ DecisionPolicy = &ThresholdDecisionPolicy{}
+
+// NewThresholdDecisionPolicy creates a threshold DecisionPolicy
+func NewThresholdDecisionPolicy(threshold string, votingPeriod time.Duration, minExecutionPeriod time.Duration) DecisionPolicy {
+	return &ThresholdDecisionPolicy{threshold, &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
+}
+
+// GetVotingPeriod returns the voting period of ThresholdDecisionPolicy
+func (p ThresholdDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+// GetMinExecutionPeriod returns the minimum execution period of ThresholdDecisionPolicy
+func (p ThresholdDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+// ValidateBasic does basic validation on ThresholdDecisionPolicy
+func (p ThresholdDecisionPolicy) ValidateBasic() error {
+	if _, err := math.NewPositiveDecFromString(p.Threshold); err != nil {
+		return sdkerrors.Wrap(err, "threshold")
+	}
+
+	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "voting period cannot be zero")
+	}
+
+	return nil
+}
+
+// Allow allows a proposal to pass when the tally of yes votes equals or exceeds the threshold before the timeout.
+func (p ThresholdDecisionPolicy) Allow(tallyResult TallyResult, totalPower string) (DecisionPolicyResult, error) {
+	threshold, err := math.NewPositiveDecFromString(p.Threshold)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "threshold")
+	}
+	yesCount, err := math.NewNonNegativeDecFromString(tallyResult.YesCount)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "yes count")
+	}
+	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "total power")
+	}
+
+	// nobody can vote in a group without any weight.
+	if totalPowerDec.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	// the real threshold of the policy is `min(threshold,total_weight)`. If
+	// the group member weights changes (member leaving, member weight update)
+	// and the threshold doesn't, we can end up with threshold > total_weight.
+	// In this case, as long as everyone votes yes (in which case
+	// `yesCount`==`realThreshold`), then the proposal still passes.
+	realThreshold := sdk.MinDec(threshold, totalPowerDec)
+	if yesCount.GTE(realThreshold) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	totalCounts, err := tallyResult.TotalCounts()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	undecided, err := math.SubNonNegative(totalPowerDec, totalCounts)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	// maxYesCount is the max potential number of yes count, i.e the current yes count
+	// plus all undecided count (supposing they all vote yes).
+	maxYesCount := yesCount.Add(undecided)
+	if maxYesCount.LT(realThreshold) {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// Validate validates the policy against the group. Note that the threshold
+// can actually be greater than the group's total weight: in the Allow method
+// we check the tally weight against `min(threshold,total_weight)`.
+func (p *ThresholdDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	if _, err := math.NewPositiveDecFromString(p.Threshold); err != nil {
+		return sdkerrors.Wrap(err, "threshold")
+	}
+	if _, err := math.NewNonNegativeDecFromString(g.TotalWeight); err != nil {
+		return sdkerrors.Wrap(err, "group total weight")
+	}
+
+	return validateWindows(p.Windows, config)
+}
+
+var _ DecisionPolicy = &PercentageDecisionPolicy{}
+
+// NewPercentageDecisionPolicy creates a new percentage DecisionPolicy
+func NewPercentageDecisionPolicy(percentage string, votingPeriod time.Duration, executionPeriod time.Duration) DecisionPolicy {
+	return &PercentageDecisionPolicy{percentage, &DecisionPolicyWindows{votingPeriod, executionPeriod}}
+}
+
+// GetVotingPeriod returns the voting period of PercentageDecisionPolicy
+func (p PercentageDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+// GetMinExecutionPeriod returns the minimum execution period of PercentageDecisionPolicy
+func (p PercentageDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+// ValidateBasic does basic validation on PercentageDecisionPolicy
+func (p PercentageDecisionPolicy) ValidateBasic() error {
+	percentage, err := math.NewPositiveDecFromString(p.Percentage)
+	if err != nil {
+		return sdkerrors.Wrap(err, "percentage threshold")
+	}
+	if percentage.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalid, "percentage must be > 0 and <= 1")
+	}
+
+	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "voting period cannot be 0")
+	}
+
+	return nil
+}
+
+// Validate validates the policy against the group.
+func (p *PercentageDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	return validateWindows(p.Windows, config)
+}
+
+// Allow allows a proposal to pass when the tally of yes votes equals or exceeds the percentage threshold before the timeout.
+func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string) (DecisionPolicyResult, error) {
+	percentage, err := math.NewPositiveDecFromString(p.Percentage)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "percentage")
+	}
+	yesCount, err := math.NewNonNegativeDecFromString(tally.YesCount)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "yes count")
+	}
+	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "total power")
+	}
+
+	// nobody can vote in a group without any weight.
+	if totalPowerDec.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	yesPercentage := yesCount.Quo(totalPowerDec)
+	if yesPercentage.GTE(percentage) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	totalCounts, err := tally.TotalCounts()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	undecided, err := math.SubNonNegative(totalPowerDec, totalCounts)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	// maxYesPercentage is the max potential percentage of yes votes, i.e the
+	// current yes count plus all undecided count (supposing they all vote
+	// yes), divided by the total power.
+	maxYesPercentage := yesCount.Add(undecided).Quo(totalPowerDec)
+	if maxYesPercentage.LT(percentage) {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// validateWindows checks that the execution window of a proposal, i.e.
+// `[submission + min_execution_period ; submission + voting_period + max_execution_period]`,
+// is not empty.
+func validateWindows(windows *DecisionPolicyWindows, config Config) error {
+	if windows == nil {
+		return sdkerrors.Wrap(ErrEmpty, "decision policy windows")
+	}
+	if windows.MinExecutionPeriod > windows.VotingPeriod+config.MaxExecutionPeriod {
+		return sdkerrors.Wrap(ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
+	}
+
+	return nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = GroupPolicyInfo{}
+
+// NewGroupPolicyInfo creates a new GroupPolicyInfo instance
+//
+//nolint:interfacer
+func NewGroupPolicyInfo(address sdk.AccAddress, group uint64, admin sdk.AccAddress, metadata string,
+	version uint64, decisionPolicy DecisionPolicy, createdAt time.Time,
+) (GroupPolicyInfo, error) {
+	p := GroupPolicyInfo{
+		Address:   address.String(),
+		GroupId:   group,
+		Admin:     admin.String(),
+		Metadata:  metadata,
+		Version:   version,
+		CreatedAt: createdAt,
+	}
+
+	err := p.SetDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return GroupPolicyInfo{}, err
+	}
+
+	return p, nil
+}
+
+// SetDecisionPolicy sets the decision policy for GroupPolicyInfo.
+func (g *GroupPolicyInfo) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	any, err := codectypes.NewAnyWithValue(decisionPolicy)
+	if err != nil {
+		return err
+	}
+	g.DecisionPolicy = any
+	return nil
+}
+
+// GetDecisionPolicy gets the decision policy of GroupPolicyInfo
+func (g GroupPolicyInfo) GetDecisionPolicy() (DecisionPolicy, error) {
+	decisionPolicy, ok := g.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (DecisionPolicy)(nil), g.DecisionPolicy.GetCachedValue())
+	}
+
+	return decisionPolicy, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g GroupPolicyInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(g.DecisionPolicy, &decisionPolicy)
+}
+
+// ValidateBasic does basic validation on GroupPolicyInfo.
+func (g GroupPolicyInfo) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(g.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(g.Address); err != nil {
+		return sdkerrors.Wrap(err, "group policy")
+	}
+
+	if g.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group policy's group id")
+	}
+	if g.Version == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group policy version")
+	}
+
+	policy, err := g.GetDecisionPolicy()
+	if err != nil {
+		return sdkerrors.Wrap(err, "group policy decision policy")
+	}
+
+	if err := policy.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "group policy's decision policy")
+	}
+	return nil
+}
+
+// ValidateBasic does basic validation on GroupInfo.
+func (g GroupInfo) ValidateBasic() error {
+	if g.Id == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group's GroupId")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(g.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+
+	if _, err := math.NewNonNegativeDecFromString(g.TotalWeight); err != nil {
+		return sdkerrors.Wrap(err, "total weight")
+	}
+	if g.Version == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "version")
+	}
+	return nil
+}
+
+// ValidateBasic does basic validation on GroupMember.
+func (g GroupMember) ValidateBasic() error {
+	if g.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group member's group id")
+	}
+
+	if g.Member == nil {
+		return sdkerrors.Wrap(ErrEmpty, "group member")
+	}
+
+	if err := MemberToMemberRequest(g.Member).ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "group member")
+	}
+
+	return nil
+}
+
+// MemberToMemberRequest converts a `Member` (used for storage)
+// to a `MemberRequest` (used in requests). The only difference
+// between the two is that `MemberRequest` doesn't have any `AddedAt` field
+// since it cannot be set as part of requests.
+func MemberToMemberRequest(m *Member) MemberRequest {
+	return MemberRequest{
+		Address:  m.Address,
+		Weight:   m.Weight,
+		Metadata: m.Metadata,
+	}
+}
+
+// ValidateBasic performs stateless validation on a member request. The
+// weight can be zero, which is used to remove a member from a group.
+func (m MemberRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrap(err, "address")
+	}
+
+	if _, err := math.NewNonNegativeDecFromString(m.Weight); err != nil {
+		return sdkerrors.Wrap(err, "weight")
+	}
+
+	return nil
+}
+
+// MemberRequests defines a repeated slice of MemberRequest objects.
+type MemberRequests struct {
+	Members []MemberRequest
+}
+
+// ValidateBasic performs stateless validation on an array of members. On top
+// of validating each member individually, it also makes sure there are no
+// duplicate addresses.
+func (ms MemberRequests) ValidateBasic() error {
+	index := make(map[string]struct{}, len(ms.Members))
+	for i := range ms.Members {
+		member := ms.Members[i]
+		if err := member.ValidateBasic(); err != nil {
+			return err
+		}
+		addr := member.Address
+		if _, exists := index[addr]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "address: %s", addr)
+		}
+		index[addr] = struct{}{}
+	}
+	return nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = Proposal{}
+
+// GetMsgs unpacks p.Messages Any's into sdk.Msg's
+func (p *Proposal) GetMsgs() ([]sdk.Msg, error) {
+	return getMsgs(p.Messages)
+}
+
+// SetMsgs packs msgs into Any's
+func (p *Proposal) SetMsgs(msgs []sdk.Msg) error {
+	anys, err := setMsgs(msgs)
+	if err != nil {
+		return err
+	}
+	p.Messages = anys
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p Proposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackMsgs(p.Messages, unpacker)
+}
+
+// ValidateBasic does basic validation on Proposal.
+func (p Proposal) ValidateBasic() error {
+	if p.Id == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.GroupPolicyAddress); err != nil {
+		return sdkerrors.Wrap(err, "proposal group policy address")
+	}
+	if p.GroupVersion == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal group version")
+	}
+	if p.GroupPolicyVersion == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal group policy version")
+	}
+	if _, err := p.FinalTallyResult.GetYesCount(); err != nil {
+		return sdkerrors.Wrap(err, "proposal FinalTallyResult yes count")
+	}
+	if _, err := p.FinalTallyResult.GetNoCount(); err != nil {
+		return sdkerrors.Wrap(err, "proposal FinalTallyResult no count")
+	}
+	if _, err := p.FinalTallyResult.GetAbstainCount(); err != nil {
+		return sdkerrors.Wrap(err, "proposal FinalTallyResult abstain count")
+	}
+	if _, err := p.FinalTallyResult.GetNoWithVetoCount(); err != nil {
+		return sdkerrors.Wrap(err, "proposal FinalTallyResult veto count")
+	}
+	return nil
+}
+
+// ValidateBasic does basic validation on Vote.
+func (v Vote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(v.Voter); err != nil {
+		return sdkerrors.Wrap(err, "voter")
+	}
+	if v.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "voter ProposalId")
+	}
+	if v.Option == VOTE_OPTION_UNSPECIFIED {
+		return sdkerrors.Wrap(ErrEmpty, "voter vote option")
+	}
+	if _, ok := VoteOption_name[int32(v.Option)]; !ok {
+		return sdkerrors.Wrap(ErrInvalid, "vote option")
+	}
+	return nil
+}
+
+// VoteOptionFromString returns a VoteOption from a string. It returns an error
+// if the string is invalid.
+func VoteOptionFromString(str string) (VoteOption, error) {
+	vo, ok := VoteOption_value[str]
+	if !ok || vo == int32(VOTE_OPTION_UNSPECIFIED) {
+		return VOTE_OPTION_UNSPECIFIED, fmt.Errorf("'%s' is not a valid vote option", str)
+	}
+	return VoteOption(vo), nil
+}
+
+// DefaultTallyResult returns a TallyResult with all counts set to 0.
+func DefaultTallyResult() TallyResult {
+	return TallyResult{
+		YesCount:        "0",
+		NoCount:         "0",
+		NoWithVetoCount: "0",
+		AbstainCount:    "0",
+	}
+}
+
+// GetYesCount returns the yes count of the tally as a decimal.
+func (t TallyResult) GetYesCount() (sdk.Dec, error) {
+	return math.NewNonNegativeDecFromString(t.YesCount)
+}
+
+// GetNoCount returns the no count of the tally as a decimal.
+func (t TallyResult) GetNoCount() (sdk.Dec, error) {
+	return math.NewNonNegativeDecFromString(t.NoCount)
+}
+
+// GetAbstainCount returns the abstain count of the tally as a decimal.
+func (t TallyResult) GetAbstainCount() (sdk.Dec, error) {
+	return math.NewNonNegativeDecFromString(t.AbstainCount)
+}
+
+// GetNoWithVetoCount returns the no with veto count of the tally as a decimal.
+func (t TallyResult) GetNoWithVetoCount() (sdk.Dec, error) {
+	return math.NewNonNegativeDecFromString(t.NoWithVetoCount)
+}
+
+// Add adds the weight of a vote to the corresponding count of the tally.
+func (t *TallyResult) Add(option VoteOption, weight string) error {
+	w, err := math.NewNonNegativeDecFromString(weight)
+	if err != nil {
+		return err
+	}
+
+	var count *string
+	switch option {
+	case VOTE_OPTION_YES:
+		count = &t.YesCount
+	case VOTE_OPTION_NO:
+		count = &t.NoCount
+	case VOTE_OPTION_ABSTAIN:
+		count = &t.AbstainCount
+	case VOTE_OPTION_NO_WITH_VETO:
+		count = &t.NoWithVetoCount
+	default:
+		return sdkerrors.Wrapf(ErrInvalid, "unknown vote option %s", option.String())
+	}
+
+	c, err := math.NewNonNegativeDecFromString(*count)
+	if err != nil {
+		return err
+	}
+	*count = c.Add(w).String()
+
+	return nil
+}
+
+// TotalCounts is the sum of all weights.
+func (t TallyResult) TotalCounts() (sdk.Dec, error) {
+	total := sdk.ZeroDec()
+	for _, s := range []string{t.YesCount, t.NoCount, t.AbstainCount, t.NoWithVetoCount} {
+		c, err := math.NewNonNegativeDecFromString(s)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		total = total.Add(c)
+	}
+
+	return total, nil
+}
+
+func getMsgs(anys []*codectypes.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(anys))
+	for i, any := range anys {
+		cached := any.GetCachedValue()
+		if cached == nil {
+			return nil, fmt.Errorf("any cached value is nil, proposal messages must be correctly packed any values")
+		}
+		msgs[i] = cached.(sdk.Msg)
+	}
+	return msgs, nil
+}
+
+func setMsgs(msgs []sdk.Msg) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		var err error
+		anys[i], err = codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return anys, nil
+}
+
+func unpackMsgs(anys []*codectypes.Any, unpacker codectypes.AnyUnpacker) error {
+	for _, any := range anys {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func packDecisionPolicy(decisionPolicy DecisionPolicy) (*codectypes.Any, error) {
+	msg, ok := decisionPolicy.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "can't proto marshal %T", decisionPolicy)
+	}
+	return codectypes.NewAnyWithValue(msg)
+}
@@ -0,0 +1,106 @@
+package group_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+func TestThresholdDecisionPolicy(t *testing.T) {
+	testCases := map[string]struct {
+		threshold  string
+		tally      group.TallyResult
+		totalPower string
+		exp        group.DecisionPolicyResult
+	}{
+		"yes votes reach the threshold": {
+			threshold:  "2",
+			tally:      group.TallyResult{YesCount: "2", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			totalPower: "3",
+			exp:        group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"threshold can still be reached": {
+			threshold:  "2",
+			tally:      group.TallyResult{YesCount: "1", NoCount: "1", AbstainCount: "0", NoWithVetoCount: "0"},
+			totalPower: "3",
+			exp:        group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"threshold can't be reached anymore": {
+			threshold:  "2",
+			tally:      group.TallyResult{YesCount: "0", NoCount: "1", AbstainCount: "1", NoWithVetoCount: "0"},
+			totalPower: "3",
+			exp:        group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"threshold greater than the total power": {
+			threshold:  "10",
+			tally:      group.TallyResult{YesCount: "3", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			totalPower: "3",
+			exp:        group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"zero total power": {
+			threshold:  "1",
+			tally:      group.DefaultTallyResult(),
+			totalPower: "0",
+			exp:        group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			policy := group.NewThresholdDecisionPolicy(tc.threshold, time.Hour, 0)
+			res, err := policy.Allow(tc.tally, tc.totalPower)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, res)
+		})
+	}
+}
+
+func TestPercentageDecisionPolicy(t *testing.T) {
+	testCases := map[string]struct {
+		percentage string
+		tally      group.TallyResult
+		totalPower string
+		exp        group.DecisionPolicyResult
+	}{
+		"yes votes reach the percentage": {
+			percentage: "0.5",
+			tally:      group.TallyResult{YesCount: "2", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			totalPower: "4",
+			exp:        group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"percentage can still be reached": {
+			percentage: "0.5",
+			tally:      group.TallyResult{YesCount: "1", NoCount: "1", AbstainCount: "0", NoWithVetoCount: "0"},
+			totalPower: "4",
+			exp:        group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"percentage can't be reached anymore": {
+			percentage: "0.5",
+			tally:      group.TallyResult{YesCount: "1", NoCount: "2", AbstainCount: "1", NoWithVetoCount: "0"},
+			totalPower: "5",
+			exp:        group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			policy := group.NewPercentageDecisionPolicy(tc.percentage, time.Hour, 0)
+			res, err := policy.Allow(tc.tally, tc.totalPower)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, res)
+		})
+	}
+}
+
+func TestDecisionPolicyValidateBasic(t *testing.T) {
+	require.NoError(t, group.NewThresholdDecisionPolicy("1", time.Hour, 0).ValidateBasic())
+	require.Error(t, group.NewThresholdDecisionPolicy("0", time.Hour, 0).ValidateBasic())
+	require.Error(t, group.NewThresholdDecisionPolicy("1", 0, 0).ValidateBasic())
+
+	require.NoError(t, group.NewPercentageDecisionPolicy("1", time.Hour, 0).ValidateBasic())
+	require.Error(t, group.NewPercentageDecisionPolicy("1.1", time.Hour, 0).ValidateBasic())
+	require.Error(t, group.NewPercentageDecisionPolicy("-0.5", time.Hour, 0).ValidateBasic())
+}
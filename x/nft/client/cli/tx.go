@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	nftTxCmd := &cobra.Command{
+		Use:                        nft.ModuleName,
+		Short:                      "nft transactions subcommands",
+		Long:                       "Provides the most common nft logic for upper-level applications, compatible with Ethereum's erc721 contract",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	nftTxCmd.AddCommand(
+		NewCmdSend(),
+	)
+
+	return nftTxCmd
+}
+
+// NewCmdSend creates a CLI command for MsgSend.
+func NewCmdSend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send [class-id] [nft-id] [receiver] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "transfer ownership of nft",
+		Long: fmt.Sprintf(`transfer ownership of nft.
+Example:
+$ %s tx %s send <class-id> <nft-id> <receiver> --from <sender> --chain-id <chain-id>`,
+			version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			receiver, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := nft.NewMsgSend(args[0], args[1], clientCtx.GetFromAddress(), receiver)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
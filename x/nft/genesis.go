@@ -0,0 +1,48 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateGenesis check the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	classes := make(map[string]bool, len(data.Classes))
+	for _, class := range data.Classes {
+		if err := ValidateClassID(class.GetId()); err != nil {
+			return err
+		}
+		if classes[class.Id] {
+			return sdkerrors.Wrapf(ErrClassExists, "duplicate class %s", class.Id)
+		}
+		classes[class.Id] = true
+	}
+
+	nfts := make(map[string]bool)
+	for _, entry := range data.Entries {
+		if _, err := sdk.AccAddressFromBech32(entry.Owner); err != nil {
+			return err
+		}
+
+		for _, nft := range entry.Nfts {
+			if !classes[nft.ClassId] {
+				return sdkerrors.Wrap(ErrClassNotExists, nft.ClassId)
+			}
+			if err := ValidateNFTID(nft.GetId()); err != nil {
+				return err
+			}
+
+			key := nft.ClassId + "/" + nft.Id
+			if nfts[key] {
+				return sdkerrors.Wrapf(ErrNFTExists, "duplicate nft %s", key)
+			}
+			nfts[key] = true
+		}
+	}
+	return nil
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
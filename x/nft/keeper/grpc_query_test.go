@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+func (s *TestSuite) setupQueryState() (nft.Class, nft.NFT) {
+	class := nft.Class{
+		Id:          testClassID,
+		Name:        testClassName,
+		Symbol:      testClassSymbol,
+		Description: testClassDescription,
+		Uri:         testClassURI,
+		UriHash:     testClassURIHash,
+	}
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, class))
+
+	token := nft.NFT{
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     testURI,
+		UriHash: testURIHash,
+	}
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, token, s.addrs[0]))
+	return class, token
+}
+
+func (s *TestSuite) TestGRPCQueries() {
+	class, token := s.setupQueryState()
+	ctx := gocontext.Background()
+
+	balance, err := s.queryClient.Balance(ctx, &nft.QueryBalanceRequest{ClassId: testClassID, Owner: s.addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), balance.Amount)
+
+	balance, err = s.queryClient.Balance(ctx, &nft.QueryBalanceRequest{ClassId: testClassID, Owner: s.addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), balance.Amount)
+
+	_, err = s.queryClient.Balance(ctx, &nft.QueryBalanceRequest{ClassId: testClassID, Owner: "invalid"})
+	s.Require().Error(err)
+
+	owner, err := s.queryClient.Owner(ctx, &nft.QueryOwnerRequest{ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[0].String(), owner.Owner)
+
+	owner, err = s.queryClient.Owner(ctx, &nft.QueryOwnerRequest{ClassId: testClassID, Id: "kitty2"})
+	s.Require().NoError(err)
+	s.Require().Empty(owner.Owner)
+
+	supply, err := s.queryClient.Supply(ctx, &nft.QuerySupplyRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), supply.Amount)
+
+	nftRes, err := s.queryClient.NFT(ctx, &nft.QueryNFTRequest{ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().Equal(token, *nftRes.Nft)
+
+	_, err = s.queryClient.NFT(ctx, &nft.QueryNFTRequest{ClassId: testClassID, Id: "kitty2"})
+	s.Require().Error(err)
+
+	classRes, err := s.queryClient.Class(ctx, &nft.QueryClassRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(class, *classRes.Class)
+
+	_, err = s.queryClient.Class(ctx, &nft.QueryClassRequest{ClassId: "kitty2"})
+	s.Require().Error(err)
+
+	classesRes, err := s.queryClient.Classes(ctx, &nft.QueryClassesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]*nft.Class{&class}, classesRes.Classes)
+}
+
+func (s *TestSuite) TestNFTs() {
+	_, token := s.setupQueryState()
+	ctx := gocontext.Background()
+
+	testCases := []struct {
+		msg      string
+		req      *nft.QueryNFTsRequest
+		expPass  bool
+		expected []*nft.NFT
+	}{
+		{
+			"fail empty ClassId and Owner",
+			&nft.QueryNFTsRequest{},
+			false,
+			nil,
+		},
+		{
+			"fail invalid Owner",
+			&nft.QueryNFTsRequest{Owner: "owner"},
+			false,
+			nil,
+		},
+		{
+			"fail invalid ClassId",
+			&nft.QueryNFTsRequest{ClassId: "1"},
+			false,
+			nil,
+		},
+		{
+			"success by class",
+			&nft.QueryNFTsRequest{ClassId: testClassID},
+			true,
+			[]*nft.NFT{&token},
+		},
+		{
+			"success by owner",
+			&nft.QueryNFTsRequest{Owner: s.addrs[0].String()},
+			true,
+			[]*nft.NFT{&token},
+		},
+		{
+			"success by class and owner",
+			&nft.QueryNFTsRequest{ClassId: testClassID, Owner: s.addrs[0].String()},
+			true,
+			[]*nft.NFT{&token},
+		},
+		{
+			"success by class and owner without nfts",
+			&nft.QueryNFTsRequest{ClassId: testClassID, Owner: s.addrs[1].String()},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			res, err := s.queryClient.NFTs(ctx, tc.req)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expected, res.Nfts)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// Keeper of the nft store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey sdk.StoreKey
+	bk       nft.BankKeeper
+}
+
+// NewKeeper creates a new nft Keeper instance
+func NewKeeper(key sdk.StoreKey,
+	cdc codec.BinaryCodec, ak nft.AccountKeeper, bk nft.BankKeeper,
+) Keeper {
+	// ensure nft module account is set
+	if addr := ak.GetModuleAddress(nft.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the %s module account has not been set", nft.ModuleName))
+	}
+
+	return Keeper{
+		cdc:      cdc,
+		storeKey: key,
+		bk:       bk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", nft.ModuleName))
+}
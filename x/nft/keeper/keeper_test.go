@@ -0,0 +1,349 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+const (
+	testClassID          = "kitty"
+	testClassName        = "Crypto Kitty"
+	testClassSymbol      = "kitty"
+	testClassDescription = "Crypto Kitty"
+	testClassURI         = "class uri"
+	testClassURIHash     = "ae702cefd6b6a65fe2f991ad6d9969ed"
+	testID               = "kitty1"
+	testURI              = "kitty uri"
+	testURIHash          = "229bfd3c1b431c14a526497873897108"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient nft.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now()})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	nft.RegisterQueryServer(queryHelper, app.NFTKeeper)
+	queryClient := nft.NewQueryClient(queryHelper)
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = queryClient
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) TestSaveClass() {
+	except := nft.Class{
+		Id:          testClassID,
+		Name:        testClassName,
+		Symbol:      testClassSymbol,
+		Description: testClassDescription,
+		Uri:         testClassURI,
+		UriHash:     testClassURIHash,
+	}
+	err := s.app.NFTKeeper.SaveClass(s.ctx, except)
+	s.Require().NoError(err)
+
+	actual, has := s.app.NFTKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().EqualValues(except, actual)
+
+	classes := s.app.NFTKeeper.GetClasses(s.ctx)
+	s.Require().EqualValues([]*nft.Class{&except}, classes)
+
+	s.Require().ErrorIs(s.app.NFTKeeper.SaveClass(s.ctx, except), nft.ErrClassExists)
+}
+
+func (s *TestSuite) TestUpdateClass() {
+	class := nft.Class{
+		Id:          testClassID,
+		Name:        testClassName,
+		Symbol:      testClassSymbol,
+		Description: testClassDescription,
+		Uri:         testClassURI,
+		UriHash:     testClassURIHash,
+	}
+	err := s.app.NFTKeeper.UpdateClass(s.ctx, class)
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, class))
+
+	class.Name = "My Crypto Kitty"
+	s.Require().NoError(s.app.NFTKeeper.UpdateClass(s.ctx, class))
+
+	actual, has := s.app.NFTKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().EqualValues(class, actual)
+}
+
+func (s *TestSuite) TestMint() {
+	class := nft.Class{
+		Id:          testClassID,
+		Name:        testClassName,
+		Symbol:      testClassSymbol,
+		Description: testClassDescription,
+		Uri:         testClassURI,
+		UriHash:     testClassURIHash,
+	}
+	expNFT := nft.NFT{
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     testURI,
+	}
+
+	err := s.app.NFTKeeper.Mint(s.ctx, expNFT, s.addrs[0])
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, class))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, expNFT, s.addrs[0]))
+
+	// test GetNFT
+	actNFT, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().EqualValues(expNFT, actNFT)
+
+	// test GetOwner
+	owner := s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID)
+	s.Require().True(s.addrs[0].Equals(owner))
+
+	// test GetNFTsOfClass
+	actNFTs := s.app.NFTKeeper.GetNFTsOfClass(s.ctx, testClassID)
+	s.Require().EqualValues([]nft.NFT{expNFT}, actNFTs)
+
+	// test GetNFTsOfClassByOwner
+	actNFTs = s.app.NFTKeeper.GetNFTsOfClassByOwner(s.ctx, testClassID, s.addrs[0])
+	s.Require().EqualValues([]nft.NFT{expNFT}, actNFTs)
+
+	// test GetBalance
+	balance := s.app.NFTKeeper.GetBalance(s.ctx, testClassID, s.addrs[0])
+	s.Require().EqualValues(uint64(1), balance)
+
+	// test GetTotalSupply
+	supply := s.app.NFTKeeper.GetTotalSupply(s.ctx, testClassID)
+	s.Require().EqualValues(uint64(1), supply)
+
+	expNFT2 := nft.NFT{
+		ClassId: testClassID,
+		Id:      testID + "2",
+		Uri:     testURI + "2",
+	}
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, expNFT2, s.addrs[0]))
+
+	// test GetNFTsOfClassByOwner
+	actNFTs = s.app.NFTKeeper.GetNFTsOfClassByOwner(s.ctx, testClassID, s.addrs[0])
+	s.Require().EqualValues([]nft.NFT{expNFT, expNFT2}, actNFTs)
+
+	// test GetBalance
+	balance = s.app.NFTKeeper.GetBalance(s.ctx, testClassID, s.addrs[0])
+	s.Require().EqualValues(uint64(2), balance)
+
+	// minting the same nft twice fails
+	err = s.app.NFTKeeper.Mint(s.ctx, expNFT, s.addrs[1])
+	s.Require().ErrorIs(err, nft.ErrNFTExists)
+}
+
+func (s *TestSuite) TestBurn() {
+	class := nft.Class{
+		Id:   testClassID,
+		Name: testClassName,
+	}
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, class))
+
+	expNFT := nft.NFT{
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     testURI,
+	}
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, expNFT, s.addrs[0]))
+
+	s.Require().NoError(s.app.NFTKeeper.Burn(s.ctx, testClassID, testID))
+
+	// test GetNFT
+	_, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().False(has)
+
+	// test GetOwner
+	owner := s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID)
+	s.Require().Nil(owner)
+
+	// test GetNFTsOfClass
+	actNFTs := s.app.NFTKeeper.GetNFTsOfClass(s.ctx, testClassID)
+	s.Require().Empty(actNFTs)
+
+	// test GetNFTsOfClassByOwner
+	actNFTs = s.app.NFTKeeper.GetNFTsOfClassByOwner(s.ctx, testClassID, s.addrs[0])
+	s.Require().Empty(actNFTs)
+
+	// test GetBalance
+	balance := s.app.NFTKeeper.GetBalance(s.ctx, testClassID, s.addrs[0])
+	s.Require().EqualValues(uint64(0), balance)
+
+	// test GetTotalSupply
+	supply := s.app.NFTKeeper.GetTotalSupply(s.ctx, testClassID)
+	s.Require().EqualValues(uint64(0), supply)
+
+	err := s.app.NFTKeeper.Burn(s.ctx, testClassID, testID)
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+}
+
+func (s *TestSuite) TestUpdate() {
+	class := nft.Class{
+		Id:   testClassID,
+		Name: testClassName,
+	}
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, class))
+
+	myNFT := nft.NFT{
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     testURI,
+	}
+	s.Require().ErrorIs(s.app.NFTKeeper.Update(s.ctx, myNFT), nft.ErrNFTNotExists)
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, myNFT, s.addrs[0]))
+
+	expNFT := nft.NFT{
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     "updated",
+	}
+	s.Require().NoError(s.app.NFTKeeper.Update(s.ctx, expNFT))
+
+	// test GetNFT
+	actNFT, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().EqualValues(expNFT, actNFT)
+}
+
+func (s *TestSuite) TestTransfer() {
+	class := nft.Class{
+		Id:   testClassID,
+		Name: testClassName,
+	}
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, class))
+
+	expNFT := nft.NFT{
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     testURI,
+	}
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, expNFT, s.addrs[0]))
+
+	// valid owner
+	s.Require().NoError(s.app.NFTKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[1]))
+
+	// test GetOwner
+	owner := s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID)
+	s.Require().Equal(s.addrs[1], owner)
+
+	balanceAddr0 := s.app.NFTKeeper.GetBalance(s.ctx, testClassID, s.addrs[0])
+	s.Require().EqualValues(uint64(0), balanceAddr0)
+
+	balanceAddr1 := s.app.NFTKeeper.GetBalance(s.ctx, testClassID, s.addrs[1])
+	s.Require().EqualValues(uint64(1), balanceAddr1)
+
+	// test GetNFTsOfClassByOwner
+	actNFTs := s.app.NFTKeeper.GetNFTsOfClassByOwner(s.ctx, testClassID, s.addrs[1])
+	s.Require().EqualValues([]nft.NFT{expNFT}, actNFTs)
+
+	err := s.app.NFTKeeper.Transfer(s.ctx, testClassID, "unknown", s.addrs[1])
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+}
+
+func (s *TestSuite) TestSend() {
+	class := nft.Class{
+		Id:   testClassID,
+		Name: testClassName,
+	}
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, class))
+
+	expNFT := nft.NFT{
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     testURI,
+	}
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, expNFT, s.addrs[0]))
+
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	// sender is not the owner
+	_, err := s.app.NFTKeeper.Send(goCtx, nft.NewMsgSend(testClassID, testID, s.addrs[1], s.addrs[2]))
+	s.Require().Error(err)
+
+	_, err = s.app.NFTKeeper.Send(goCtx, nft.NewMsgSend(testClassID, testID, s.addrs[0], s.addrs[1]))
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[1], s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID))
+}
+
+func (s *TestSuite) TestExportGenesis() {
+	class := nft.Class{
+		Id:   testClassID,
+		Name: testClassName,
+	}
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, class))
+
+	expNFT := nft.NFT{
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     testURI,
+	}
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, expNFT, s.addrs[0]))
+
+	expGenesis := &nft.GenesisState{
+		Classes: []*nft.Class{&class},
+		Entries: []*nft.Entry{{
+			Owner: s.addrs[0].String(),
+			Nfts:  []*nft.NFT{&expNFT},
+		}},
+	}
+	genesis := s.app.NFTKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal(expGenesis, genesis)
+}
+
+func (s *TestSuite) TestInitGenesis() {
+	expClass := nft.Class{
+		Id:   testClassID,
+		Name: testClassName,
+	}
+	expNFT := nft.NFT{
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     testURI,
+	}
+	expGenesis := &nft.GenesisState{
+		Classes: []*nft.Class{&expClass},
+		Entries: []*nft.Entry{{
+			Owner: s.addrs[0].String(),
+			Nfts:  []*nft.NFT{&expNFT},
+		}},
+	}
+	s.app.NFTKeeper.InitGenesis(s.ctx, expGenesis)
+
+	actual, has := s.app.NFTKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().EqualValues(expClass, actual)
+
+	// test GetNFT
+	actNFT, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().EqualValues(expNFT, actNFT)
+}
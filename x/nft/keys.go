@@ -0,0 +1,12 @@
+package nft
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "nft"
+
+	// StoreKey is the store key string for nft
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for nft
+	RouterKey = ModuleName
+)
@@ -0,0 +1,71 @@
+package nft
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+)
+
+var (
+	_ sdk.Msg = &MsgSend{}
+
+	// For amino support.
+	_ legacytx.LegacyMsg = &MsgSend{}
+)
+
+// NewMsgSend creates a new MsgSend
+//
+//nolint:interfacer
+func NewMsgSend(classID, id string, sender, receiver sdk.AccAddress) *MsgSend {
+	return &MsgSend{
+		ClassId:  classID,
+		Id:       id,
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+	}
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgSend) ValidateBasic() error {
+	if err := ValidateClassID(msg.ClassId); err != nil {
+		return err
+	}
+
+	if err := ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", msg.Sender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", msg.Receiver)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (msg MsgSend) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgSend) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgSend) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgSend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
@@ -0,0 +1,107 @@
+package nft_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+var (
+	sender   = sdk.AccAddress("_______sender_______")
+	receiver = sdk.AccAddress("______receiver______")
+)
+
+func TestMsgSendValidateBasic(t *testing.T) {
+	testCases := []struct {
+		msg     string
+		req     *nft.MsgSend
+		expPass bool
+	}{
+		{"valid msg", nft.NewMsgSend("kitty", "kitty1", sender, receiver), true},
+		{"invalid class id", nft.NewMsgSend("1kitty", "kitty1", sender, receiver), false},
+		{"invalid nft id", nft.NewMsgSend("kitty", "k", sender, receiver), false},
+		{"empty sender", nft.NewMsgSend("kitty", "kitty1", nil, receiver), false},
+		{"empty receiver", nft.NewMsgSend("kitty", "kitty1", sender, nil), false},
+	}
+	for _, tc := range testCases {
+		err := tc.req.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
+
+func TestMsgSendGetSigners(t *testing.T) {
+	msg := nft.NewMsgSend("kitty", "kitty1", sender, receiver)
+	require.Equal(t, []sdk.AccAddress{sender}, msg.GetSigners())
+}
+
+func TestValidateGenesis(t *testing.T) {
+	class := &nft.Class{Id: "kitty"}
+	token := &nft.NFT{ClassId: "kitty", Id: "kitty1"}
+
+	testCases := []struct {
+		msg     string
+		genesis nft.GenesisState
+		expPass bool
+	}{
+		{"default genesis", *nft.DefaultGenesisState(), true},
+		{
+			"valid genesis",
+			nft.GenesisState{
+				Classes: []*nft.Class{class},
+				Entries: []*nft.Entry{{Owner: sender.String(), Nfts: []*nft.NFT{token}}},
+			},
+			true,
+		},
+		{
+			"invalid class id",
+			nft.GenesisState{Classes: []*nft.Class{{Id: "k"}}},
+			false,
+		},
+		{
+			"duplicate class",
+			nft.GenesisState{Classes: []*nft.Class{class, class}},
+			false,
+		},
+		{
+			"nft of unknown class",
+			nft.GenesisState{
+				Entries: []*nft.Entry{{Owner: sender.String(), Nfts: []*nft.NFT{token}}},
+			},
+			false,
+		},
+		{
+			"invalid owner",
+			nft.GenesisState{
+				Classes: []*nft.Class{class},
+				Entries: []*nft.Entry{{Owner: "owner", Nfts: []*nft.NFT{token}}},
+			},
+			false,
+		},
+		{
+			"duplicate nft",
+			nft.GenesisState{
+				Classes: []*nft.Class{class},
+				Entries: []*nft.Entry{
+					{Owner: sender.String(), Nfts: []*nft.NFT{token}},
+					{Owner: receiver.String(), Nfts: []*nft.NFT{token}},
+				},
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		err := nft.ValidateGenesis(tc.genesis)
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
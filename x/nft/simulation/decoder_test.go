@@ -0,0 +1,75 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	"github.com/cosmos/cosmos-sdk/x/nft/simulation"
+)
+
+var ownerAddr = sdk.AccAddress("_______owner________")
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	class := nft.Class{
+		Id:          "ClassID",
+		Name:        "ClassName",
+		Symbol:      "ClassSymbol",
+		Description: "ClassDescription",
+		Uri:         "ClassURI",
+	}
+	classBz, err := cdc.Marshal(&class)
+	require.NoError(t, err)
+
+	token := nft.NFT{
+		ClassId: "ClassID",
+		Id:      "NFTID",
+		Uri:     "NFTURI",
+	}
+	nftBz, err := cdc.Marshal(&token)
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: keeper.ClassKey, Value: classBz},
+			{Key: keeper.NFTKey, Value: nftBz},
+			{Key: keeper.NFTOfClassByOwnerKey, Value: keeper.Placeholder},
+			{Key: keeper.OwnerKey, Value: ownerAddr},
+			{Key: keeper.ClassTotalSupply, Value: sdk.Uint64ToBigEndian(1)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectErr   bool
+		expectedLog string
+	}{
+		{"Class", false, fmt.Sprintf("%v\n%v", class, class)},
+		{"NFT", false, fmt.Sprintf("%v\n%v", token, token)},
+		{"OwnerToNFTs", false, fmt.Sprintf("%v\n%v", keeper.Placeholder, keeper.Placeholder)},
+		{"NFTToOwner", false, fmt.Sprintf("%v\n%v", ownerAddr, ownerAddr)},
+		{"TotalSupply", false, fmt.Sprintf("%v\n%v", 1, 1)},
+		{"other", true, ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectErr {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
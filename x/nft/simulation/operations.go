@@ -0,0 +1,131 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// nft message types
+var TypeMsgSend = sdk.MsgTypeURL(&nft.MsgSend{})
+
+// Simulation operation weights constants
+//
+//nolint:gosec
+const (
+	OpWeightMsgSend = "op_weight_msg_nft_send"
+)
+
+// nft operations weights
+const (
+	WeightSend = 100
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	registry cdctypes.InterfaceRegistry,
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgSend int
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSend, &weightMsgSend, nil,
+		func(_ *rand.Rand) {
+			weightMsgSend = WeightSend
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgSend,
+			SimulateMsgSend(codec.NewProtoCodec(registry), ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgSend generates a MsgSend with random values.
+func SimulateMsgSend(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		sender, _ := simtypes.RandomAcc(r, accs)
+		receiver, _ := simtypes.RandomAcc(r, accs)
+
+		if sender.Address.Equals(receiver.Address) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSend, "sender and receiver are same"), nil, nil
+		}
+
+		senderAcc := ak.GetAccount(ctx, sender.Address)
+		spendableCoins := bk.SpendableCoins(ctx, sender.Address)
+		fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSend, err.Error()), nil, err
+		}
+
+		token, err := randNFT(r, ctx, k, sender.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSend, err.Error()), nil, err
+		}
+		if token == nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSend, "sender does not own any nft"), nil, nil
+		}
+
+		msg := nft.NewMsgSend(token.ClassId, token.Id, sender.Address, receiver.Address)
+
+		txCfg := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenSignedMockTx(
+			r,
+			txCfg,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{senderAcc.GetAccountNumber()},
+			[]uint64{senderAcc.GetSequence()},
+			sender.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSend, "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(txCfg.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, sdk.MsgTypeURL(msg), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, "", cdc), nil, nil
+	}
+}
+
+// randNFT returns a random nft owned by the given account, or nil if the
+// account does not own any.
+func randNFT(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, owner sdk.AccAddress) (*nft.NFT, error) {
+	res, err := k.NFTs(sdk.WrapSDKContext(ctx), &nft.QueryNFTsRequest{
+		Owner: owner.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Nfts) == 0 {
+		return nil, nil
+	}
+	return res.Nfts[r.Intn(len(res.Nfts))], nil
+}
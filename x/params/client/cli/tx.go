@@ -36,7 +36,7 @@ Proper vetting of a parameter change proposal should prevent this from happening
 regardless.
 
 Example:
-$ %s tx gov submit-proposal param-change <path/to/proposal.json> --from=<key_or_address>
+$ %s tx gov submit-legacy-proposal param-change <path/to/proposal.json> --from=<key_or_address>
 
 Where proposal.json contains:
 
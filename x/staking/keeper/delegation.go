@@ -977,6 +977,207 @@ func (k Keeper) CompleteRedelegation(
 	return balances, nil
 }
 
+// TransferUnbonding changes the ownership of UnbondingDelegation entries from
+// the given delegator to the recipient until at most wantAmt tokens have
+// changed hands. It returns the number of tokens actually transferred. No
+// tokens move between pools since the entries keep their completion time.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt sdk.Int,
+) sdk.Int {
+	transferred := sdk.ZeroInt()
+
+	ubdFrom, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	modified := false
+
+	for i := 0; i < len(ubdFrom.Entries) && wantAmt.IsPositive(); i++ {
+		entry := ubdFrom.Entries[i]
+
+		toXfer := sdk.MinInt(entry.Balance, wantAmt)
+		if !toXfer.IsPositive() {
+			continue
+		}
+
+		if k.HasMaxUnbondingDelegationEntries(ctx, toAddr, valAddr) {
+			break
+		}
+
+		ubdTo := k.SetUnbondingDelegationEntry(ctx, toAddr, valAddr, entry.CreationHeight, entry.CompletionTime, toXfer)
+		k.InsertUBDQueue(ctx, ubdTo, entry.CompletionTime)
+
+		transferred = transferred.Add(toXfer)
+		wantAmt = wantAmt.Sub(toXfer)
+		modified = true
+
+		// the stale queue entry of a fully transferred entry is skipped once
+		// it matures, as CompleteUnbonding finds nothing left to complete
+		remaining := entry.Balance.Sub(toXfer)
+		if remaining.IsZero() {
+			ubdFrom.RemoveEntry(int64(i))
+			i--
+
+			continue
+		}
+
+		entry.InitialBalance = entry.InitialBalance.Sub(sdk.MinInt(entry.InitialBalance, toXfer))
+		entry.Balance = remaining
+		ubdFrom.Entries[i] = entry
+	}
+
+	if modified {
+		if len(ubdFrom.Entries) == 0 {
+			k.RemoveUnbondingDelegation(ctx, ubdFrom)
+		} else {
+			k.SetUnbondingDelegation(ctx, ubdFrom)
+		}
+	}
+
+	return transferred
+}
+
+// TransferDelegation changes the ownership of at most wantShares delegation
+// shares to the given validator from one delegator to another, and returns the
+// number of shares actually transferred. Redelegation entries to the validator
+// are transferred along with the shares so that the shares kept by the source
+// delegator still cover its remaining redelegations, keeping them slashable.
+// No tokens move between pools since the validator's bond is unchanged.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantShares sdk.Dec,
+) sdk.Dec {
+	transferred := sdk.ZeroDec()
+
+	if !wantShares.IsPositive() || fromAddr.Equals(toAddr) {
+		return transferred
+	}
+
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return transferred
+	}
+
+	delFrom, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	// Assume the worst case, in which every redelegation entry must move, and
+	// bail out while nothing has been written yet if the recipient could end
+	// up with too many entries.
+	reds := k.getRedelegationsToValidator(ctx, fromAddr, valAddr)
+	for _, red := range reds {
+		valSrcAddr, err := sdk.ValAddressFromBech32(red.ValidatorSrcAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		toRed, found := k.GetRedelegation(ctx, toAddr, valSrcAddr, valAddr)
+		if found && len(toRed.Entries)+len(red.Entries) > int(k.MaxEntries(ctx)) {
+			return transferred
+		}
+	}
+
+	transferred = sdk.MinDec(delFrom.Shares, wantShares)
+	remaining := delFrom.Shares.Sub(transferred)
+
+	// update the source delegation
+	k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr)
+
+	if remaining.IsZero() {
+		k.RemoveDelegation(ctx, delFrom)
+	} else {
+		delFrom.Shares = remaining
+		k.SetDelegation(ctx, delFrom)
+		k.AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+
+	// update or create the recipient delegation
+	delTo, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		delTo = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+
+	delTo.Shares = delTo.Shares.Add(transferred)
+	k.SetDelegation(ctx, delTo)
+	k.AfterDelegationModified(ctx, toAddr, valAddr)
+
+	// The shares kept by the source delegator cover its redelegation entries
+	// first, and whatever they cannot cover is moved to the recipient. An entry
+	// straddling the boundary is split proportionally.
+	for _, red := range reds {
+		valSrcAddr, err := sdk.ValAddressFromBech32(red.ValidatorSrcAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		modified := false
+
+		for i := 0; i < len(red.Entries); i++ {
+			entry := red.Entries[i]
+
+			sharesToKeep := sdk.MinDec(entry.SharesDst, remaining)
+			sharesToSend := entry.SharesDst.Sub(sharesToKeep)
+			remaining = remaining.Sub(sharesToKeep)
+
+			if sharesToSend.IsZero() {
+				continue
+			}
+
+			balanceToSend := entry.InitialBalance
+			if sharesToKeep.IsPositive() {
+				balanceToSend = sharesToSend.Quo(entry.SharesDst).MulInt(entry.InitialBalance).TruncateInt()
+			}
+
+			toRed := k.SetRedelegationEntry(
+				ctx, toAddr, valSrcAddr, valAddr,
+				entry.CreationHeight, entry.CompletionTime, balanceToSend, sdk.ZeroDec(), sharesToSend,
+			)
+			k.InsertRedelegationQueue(ctx, toRed, entry.CompletionTime)
+			modified = true
+
+			if sharesToKeep.IsZero() {
+				red.RemoveEntry(int64(i))
+				i--
+
+				continue
+			}
+
+			entry.InitialBalance = entry.InitialBalance.Sub(balanceToSend)
+			entry.SharesDst = sharesToKeep
+			red.Entries[i] = entry
+		}
+
+		if modified {
+			if len(red.Entries) == 0 {
+				k.RemoveRedelegation(ctx, red)
+			} else {
+				k.SetRedelegation(ctx, red)
+			}
+		}
+	}
+
+	return transferred
+}
+
+// getRedelegationsToValidator returns all redelegations of a delegator whose
+// destination is the given validator.
+func (k Keeper) getRedelegationsToValidator(
+	ctx sdk.Context, delAddr sdk.AccAddress, valDstAddr sdk.ValAddress,
+) (reds []types.Redelegation) {
+	k.IterateDelegatorRedelegations(ctx, delAddr, func(red types.Redelegation) bool {
+		if red.ValidatorDstAddress == valDstAddr.String() {
+			reds = append(reds, red)
+		}
+		return false
+	})
+
+	return reds
+}
+
 // ValidateUnbondAmount validates that a given unbond or redelegation amount is
 // valied based on upon the converted shares. If the amount is valid, the total
 // amount of respective shares is returned, otherwise an error is returned.
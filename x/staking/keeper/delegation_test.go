@@ -990,3 +990,120 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	completionTime := time.Unix(100, 0).UTC()
+	ubd := types.NewUnbondingDelegation(delAddrs[0], valAddrs[0], 0, completionTime, sdk.NewInt(5))
+	ubd.AddEntry(1, completionTime.Add(time.Hour), sdk.NewInt(10))
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+
+	// nothing to transfer from an unknown unbonding delegation
+	transferred := app.StakingKeeper.TransferUnbonding(ctx, delAddrs[1], delAddrs[0], valAddrs[0], sdk.NewInt(5))
+	require.True(t, transferred.IsZero())
+
+	// transfer the first entry and part of the second
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(8))
+	require.Equal(t, sdk.NewInt(8), transferred)
+
+	resUbd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, resUbd.Entries, 1)
+	require.Equal(t, sdk.NewInt(7), resUbd.Entries[0].Balance)
+
+	resUbd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, resUbd.Entries, 2)
+	require.Equal(t, sdk.NewInt(5), resUbd.Entries[0].Balance)
+	require.Equal(t, completionTime, resUbd.Entries[0].CompletionTime)
+	require.Equal(t, sdk.NewInt(3), resUbd.Entries[1].Balance)
+	require.Equal(t, completionTime.Add(time.Hour), resUbd.Entries[1].CompletionTime)
+
+	// the recipient entries are queued for completion
+	dvPairs := app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime)
+	require.Contains(t, dvPairs, types.DVPair{DelegatorAddress: delAddrs[1].String(), ValidatorAddress: valAddrs[0].String()})
+
+	// asking for more than is left transfers everything
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(100))
+	require.Equal(t, sdk.NewInt(7), transferred)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.False(t, found)
+	require.Equal(t, sdk.NewInt(15), app.StakingKeeper.GetDelegatorUnbonding(ctx, delAddrs[1]))
+}
+
+func TestTransferDelegation(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+
+	// create two validators
+	var validators [2]types.Validator
+	for i := range validators {
+		validators[i] = teststaking.NewValidator(t, valAddrs[i], PKs[i])
+		validators[i], _ = validators[i].AddTokensFromDel(sdk.NewInt(100))
+		validators[i] = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validators[i], true)
+	}
+
+	// addrDels[2] holds 100 shares of validators[1], 40 of which were
+	// redelegated from validators[0]
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrDels[2], valAddrs[1], sdk.NewDec(100)))
+	app.StakingKeeper.SetRedelegation(ctx, types.NewRedelegation(addrDels[2], valAddrs[0], valAddrs[1], 0,
+		time.Unix(100, 0).UTC(), sdk.NewInt(40), sdk.NewDec(40)))
+
+	// nothing is transferred to the same delegator or from an unknown delegation
+	transferred := app.StakingKeeper.TransferDelegation(ctx, addrDels[2], addrDels[2], valAddrs[1], sdk.NewDec(10))
+	require.True(t, transferred.IsZero())
+	transferred = app.StakingKeeper.TransferDelegation(ctx, addrDels[1], addrDels[2], valAddrs[1], sdk.NewDec(10))
+	require.True(t, transferred.IsZero())
+
+	// transferring 50 shares leaves 50 behind, which still cover the redelegation
+	transferred = app.StakingKeeper.TransferDelegation(ctx, addrDels[2], addrDels[1], valAddrs[1], sdk.NewDec(50))
+	require.Equal(t, sdk.NewDec(50), transferred)
+
+	delFrom, found := app.StakingKeeper.GetDelegation(ctx, addrDels[2], valAddrs[1])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(50), delFrom.Shares)
+	delTo, found := app.StakingKeeper.GetDelegation(ctx, addrDels[1], valAddrs[1])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(50), delTo.Shares)
+	_, found = app.StakingKeeper.GetRedelegation(ctx, addrDels[1], valAddrs[0], valAddrs[1])
+	require.False(t, found)
+
+	// transferring another 20 leaves only 30 shares for the 40 share redelegation
+	transferred = app.StakingKeeper.TransferDelegation(ctx, addrDels[2], addrDels[1], valAddrs[1], sdk.NewDec(20))
+	require.Equal(t, sdk.NewDec(20), transferred)
+
+	redFrom, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[2], valAddrs[0], valAddrs[1])
+	require.True(t, found)
+	require.Len(t, redFrom.Entries, 1)
+	require.Equal(t, sdk.NewDec(30), redFrom.Entries[0].SharesDst)
+	require.Equal(t, sdk.NewInt(30), redFrom.Entries[0].InitialBalance)
+
+	redTo, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[1], valAddrs[0], valAddrs[1])
+	require.True(t, found)
+	require.Len(t, redTo.Entries, 1)
+	require.Equal(t, sdk.NewDec(10), redTo.Entries[0].SharesDst)
+	require.Equal(t, sdk.NewInt(10), redTo.Entries[0].InitialBalance)
+
+	// transferring everything removes the source delegation and redelegation
+	transferred = app.StakingKeeper.TransferDelegation(ctx, addrDels[2], addrDels[1], valAddrs[1], sdk.NewDec(1000))
+	require.Equal(t, sdk.NewDec(30), transferred)
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, addrDels[2], valAddrs[1])
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetRedelegation(ctx, addrDels[2], valAddrs[0], valAddrs[1])
+	require.False(t, found)
+
+	delTo, found = app.StakingKeeper.GetDelegation(ctx, addrDels[1], valAddrs[1])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(100), delTo.Shares)
+	redTo, found = app.StakingKeeper.GetRedelegation(ctx, addrDels[1], valAddrs[0], valAddrs[1])
+	require.True(t, found)
+	require.Len(t, redTo.Entries, 2)
+}
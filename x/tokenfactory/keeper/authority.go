@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// GetAuthorityMetadata returns the authority metadata of a denom. It returns
+// ErrDenomDoesNotExist if the denom was not created by the token factory.
+func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (types.DenomAuthorityMetadata, error) {
+	bz := k.getAuthorityMetadataPrefixStore(ctx).Get([]byte(denom))
+	if bz == nil {
+		return types.DenomAuthorityMetadata{}, sdkerrors.Wrapf(types.ErrDenomDoesNotExist, "denom: %s", denom)
+	}
+
+	var metadata types.DenomAuthorityMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+	return metadata, nil
+}
+
+// IterateAuthorityMetadata iterates over the authority metadata of all the
+// denoms, ordered by denom, until cb returns true.
+func (k Keeper) IterateAuthorityMetadata(ctx sdk.Context, cb func(denom string, metadata types.DenomAuthorityMetadata) (stop bool)) {
+	iterator := k.getAuthorityMetadataPrefixStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.DenomAuthorityMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &metadata)
+
+		if cb(string(iterator.Key()), metadata) {
+			break
+		}
+	}
+}
+
+// setAuthorityMetadata stores the authority metadata of a denom.
+func (k Keeper) setAuthorityMetadata(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata) error {
+	if err := metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidAuthorityMetadata, err.Error())
+	}
+
+	k.getAuthorityMetadataPrefixStore(ctx).Set([]byte(denom), k.cdc.MustMarshal(&metadata))
+	return nil
+}
+
+// setAdmin changes the admin of a denom.
+func (k Keeper) setAdmin(ctx sdk.Context, denom, admin string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	metadata.Admin = admin
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// checkAdmin returns ErrUnauthorized if the given account is not the admin of
+// a denom.
+func (k Keeper) checkAdmin(ctx sdk.Context, denom, sender string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if metadata.Admin == "" || sender != metadata.Admin {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", sender, denom)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// CreateDenom creates the denom factory/{creator}/{subdenom}, administered by
+// the creator, and charges the denom creation fee to the creator. The denom
+// must not have any supply or bank metadata yet.
+func (k Keeper) CreateDenom(ctx sdk.Context, creator, subdenom string) (string, error) {
+	denom, err := types.GetTokenDenom(creator, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found || k.bankKeeper.HasSupply(ctx, denom) {
+		return "", sdkerrors.Wrapf(types.ErrDenomExists, "denom: %s", denom)
+	}
+
+	creatorAddr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return "", err
+	}
+
+	if fee := k.GetParams(ctx).DenomCreationFee; !fee.Empty() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, fee, creatorAddr); err != nil {
+			return "", err
+		}
+	}
+
+	if err := k.createDenomAfterValidation(ctx, creatorAddr, denom); err != nil {
+		return "", err
+	}
+
+	return denom, nil
+}
+
+// createDenomAfterValidation stores a denom created by the given account, with
+// the account as admin. It sets default bank metadata for the denom if it has
+// none.
+func (k Keeper) createDenomAfterValidation(ctx sdk.Context, creator sdk.AccAddress, denom string) error {
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); !found {
+		k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+			DenomUnits: []*banktypes.DenomUnit{{
+				Denom:    denom,
+				Exponent: 0,
+			}},
+			Base:    denom,
+			Display: denom,
+			Name:    denom,
+			Symbol:  denom,
+		})
+	}
+
+	if err := k.setAuthorityMetadata(ctx, denom, types.DenomAuthorityMetadata{Admin: creator.String()}); err != nil {
+		return err
+	}
+
+	k.getCreatorDenomPrefixStore(ctx, creator).Set([]byte(denom), []byte{})
+	return nil
+}
+
+// GetDenomsFromCreator returns the denoms created by an account.
+func (k Keeper) GetDenomsFromCreator(ctx sdk.Context, creator sdk.AccAddress) []string {
+	iterator := k.getCreatorDenomPrefixStore(ctx, creator).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var denoms []string
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+	return denoms
+}
+
+// mintTo mints coins of a token factory denom to an account.
+func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, toAddr sdk.AccAddress) error {
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, toAddr, coins)
+}
+
+// burnFrom burns coins of a token factory denom from an account.
+func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, fromAddr sdk.AccAddress) error {
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, fromAddr, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
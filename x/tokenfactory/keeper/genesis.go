@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// InitGenesis initializes the tokenfactory module's state from a given genesis
+// state. No denom creation fee is charged for the genesis denoms.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	for _, genDenom := range genState.FactoryDenoms {
+		creator, _, err := types.DeconstructDenom(genDenom.Denom)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.createDenomAfterValidation(ctx, sdk.MustAccAddressFromBech32(creator), genDenom.Denom); err != nil {
+			panic(fmt.Errorf("error on creating denom %s: %w", genDenom.Denom, err))
+		}
+		if err := k.setAuthorityMetadata(ctx, genDenom.Denom, genDenom.AuthorityMetadata); err != nil {
+			panic(fmt.Errorf("error on setting authority metadata of %s: %w", genDenom.Denom, err))
+		}
+	}
+}
+
+// ExportGenesis returns the tokenfactory module's genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	genDenoms := []types.GenesisDenom{}
+	k.IterateAuthorityMetadata(ctx, func(denom string, metadata types.DenomAuthorityMetadata) bool {
+		genDenoms = append(genDenoms, types.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: metadata,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParams(ctx), genDenoms)
+}
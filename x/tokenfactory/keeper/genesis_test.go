@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	app, ctx := suite.app, suite.ctx
+	bitcoin := "factory/" + addr1.String() + "/bitcoin"
+	litecoin := "factory/" + addr2.String() + "/litecoin"
+
+	// bank metadata set before the token factory genesis is kept
+	bitcoinMetadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: bitcoin, Exponent: 0}},
+		Base:       bitcoin,
+		Display:    bitcoin,
+		Name:       "Bitcoin",
+		Symbol:     "BTC",
+	}
+	app.BankKeeper.SetDenomMetaData(ctx, bitcoinMetadata)
+
+	genState := types.NewGenesisState(
+		types.NewParams(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))),
+		[]types.GenesisDenom{
+			{Denom: bitcoin, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: addr2.String()}},
+			{Denom: litecoin, AuthorityMetadata: types.DenomAuthorityMetadata{}},
+		},
+	)
+	app.TokenFactoryKeeper.InitGenesis(ctx, genState)
+
+	suite.Require().Equal(genState.Params, app.TokenFactoryKeeper.GetParams(ctx))
+	suite.Require().Equal([]string{bitcoin}, app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, addr1))
+	suite.Require().Equal([]string{litecoin}, app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, addr2))
+
+	metadata, err := app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, bitcoin)
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr2.String(), metadata.Admin)
+
+	bankMetadata, found := app.BankKeeper.GetDenomMetaData(ctx, bitcoin)
+	suite.Require().True(found)
+	suite.Require().Equal(bitcoinMetadata, bankMetadata)
+	_, found = app.BankKeeper.GetDenomMetaData(ctx, litecoin)
+	suite.Require().True(found)
+
+	suite.Require().Equal(genState, app.TokenFactoryKeeper.ExportGenesis(ctx))
+}
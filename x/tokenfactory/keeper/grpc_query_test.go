@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	res, err := suite.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.app.TokenFactoryKeeper.GetParams(suite.ctx), res.Params)
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomAuthorityMetadata() {
+	suite.createDenom(addr1, "bitcoin")
+
+	res, err := suite.queryClient.DenomAuthorityMetadata(gocontext.Background(), &types.QueryDenomAuthorityMetadataRequest{
+		Creator:  addr1.String(),
+		Subdenom: "bitcoin",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DenomAuthorityMetadata{Admin: addr1.String()}, res.AuthorityMetadata)
+
+	_, err = suite.queryClient.DenomAuthorityMetadata(gocontext.Background(), &types.QueryDenomAuthorityMetadataRequest{
+		Creator:  addr2.String(),
+		Subdenom: "bitcoin",
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.DenomAuthorityMetadata(gocontext.Background(), &types.QueryDenomAuthorityMetadataRequest{
+		Creator: addr1.String(),
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomsFromCreator() {
+	bitcoin := suite.createDenom(addr1, "bitcoin")
+	litecoin := suite.createDenom(addr1, "litecoin")
+	suite.createDenom(addr2, "dogecoin")
+
+	res, err := suite.queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{
+		Creator: addr1.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{bitcoin, litecoin}, res.Denoms)
+
+	res, err = suite.queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{
+		Creator:    addr1.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{bitcoin}, res.Denoms)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = suite.queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{
+		Creator: addr3.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Denoms)
+
+	_, err = suite.queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{
+		Creator: "invalid",
+	})
+	suite.Require().Error(err)
+}
@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+var (
+	addr1 = sdk.AccAddress("addr1_______________")
+	addr2 = sdk.AccAddress("addr2_______________")
+	addr3 = sdk.AccAddress("addr3_______________")
+
+	initBalance = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+	msgServer   types.MsgServer
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.TokenFactoryKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.queryClient = types.NewQueryClient(queryHelper)
+	suite.msgServer = keeper.NewMsgServerImpl(app.TokenFactoryKeeper)
+
+	for _, addr := range []sdk.AccAddress{addr1, addr2} {
+		suite.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, addr, initBalance))
+	}
+}
+
+// createDenom creates the denom factory/{creator}/{subdenom} and returns it.
+func (suite *KeeperTestSuite) createDenom(creator sdk.AccAddress, subdenom string) string {
+	res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.ctx), types.NewMsgCreateDenom(creator.String(), subdenom))
+	suite.Require().NoError(err)
+	return res.NewTokenDenom
+}
+
+func (suite *KeeperTestSuite) TestCreateDenom() {
+	app, ctx := suite.app, suite.ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+	fee := app.TokenFactoryKeeper.GetParams(ctx).DenomCreationFee
+	suite.Require().False(fee.Empty())
+
+	res, err := suite.msgServer.CreateDenom(goCtx, types.NewMsgCreateDenom(addr1.String(), "bitcoin"))
+	suite.Require().NoError(err)
+	denom := res.NewTokenDenom
+	suite.Require().Equal("factory/"+addr1.String()+"/bitcoin", denom)
+
+	// the fee is sent to the community pool
+	suite.Require().Equal(initBalance.Sub(fee), app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().Equal(sdk.NewDecCoinsFromCoins(fee...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	metadata, err := app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr1.String(), metadata.Admin)
+	suite.Require().Equal([]string{denom}, app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, addr1))
+
+	bankMetadata, found := app.BankKeeper.GetDenomMetaData(ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(denom, bankMetadata.Base)
+	suite.Require().NoError(bankMetadata.Validate())
+
+	// a denom cannot be created twice
+	_, err = suite.msgServer.CreateDenom(goCtx, types.NewMsgCreateDenom(addr1.String(), "bitcoin"))
+	suite.Require().ErrorIs(err, types.ErrDenomExists)
+
+	// the same subdenom can be used by another creator
+	_, err = suite.msgServer.CreateDenom(goCtx, types.NewMsgCreateDenom(addr2.String(), "bitcoin"))
+	suite.Require().NoError(err)
+
+	// a creator must be able to pay the fee
+	_, err = suite.msgServer.CreateDenom(goCtx, types.NewMsgCreateDenom(addr3.String(), "bitcoin"))
+	suite.Require().Error(err)
+
+	_, err = suite.msgServer.CreateDenom(goCtx, types.NewMsgCreateDenom(addr1.String(), ""))
+	suite.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+	// no fee is charged when the denom creation fee is empty
+	app.TokenFactoryKeeper.SetParams(ctx, types.NewParams(sdk.NewCoins()))
+	_, err = suite.msgServer.CreateDenom(goCtx, types.NewMsgCreateDenom(addr3.String(), "bitcoin"))
+	suite.Require().NoError(err)
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr3).Empty())
+}
+
+func (suite *KeeperTestSuite) TestCreateDenomExistingBankDenom() {
+	app, ctx := suite.app, suite.ctx
+	denom := "factory/" + addr1.String() + "/bitcoin"
+
+	suite.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, addr2, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+
+	_, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(ctx), types.NewMsgCreateDenom(addr1.String(), "bitcoin"))
+	suite.Require().ErrorIs(err, types.ErrDenomExists)
+}
+
+func (suite *KeeperTestSuite) TestMintBurn() {
+	app, ctx := suite.app, suite.ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+	denom := suite.createDenom(addr1, "bitcoin")
+
+	_, err := suite.msgServer.Mint(goCtx, types.NewMsgMint(addr1.String(), sdk.NewInt64Coin(denom, 100)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 100), app.BankKeeper.GetBalance(ctx, addr1, denom))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 100), app.BankKeeper.GetSupply(ctx, denom))
+
+	_, err = suite.msgServer.Burn(goCtx, types.NewMsgBurn(addr1.String(), sdk.NewInt64Coin(denom, 40)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 60), app.BankKeeper.GetBalance(ctx, addr1, denom))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 60), app.BankKeeper.GetSupply(ctx, denom))
+
+	// the admin can only burn from its balance
+	_, err = suite.msgServer.Burn(goCtx, types.NewMsgBurn(addr1.String(), sdk.NewInt64Coin(denom, 100)))
+	suite.Require().Error(err)
+
+	// only the admin can mint and burn
+	_, err = suite.msgServer.Mint(goCtx, types.NewMsgMint(addr2.String(), sdk.NewInt64Coin(denom, 100)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.Burn(goCtx, types.NewMsgBurn(addr2.String(), sdk.NewInt64Coin(denom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	unknownDenom := "factory/" + addr1.String() + "/litecoin"
+	_, err = suite.msgServer.Mint(goCtx, types.NewMsgMint(addr1.String(), sdk.NewInt64Coin(unknownDenom, 100)))
+	suite.Require().ErrorIs(err, types.ErrDenomDoesNotExist)
+}
+
+func (suite *KeeperTestSuite) TestChangeAdmin() {
+	app, ctx := suite.app, suite.ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+	denom := suite.createDenom(addr1, "bitcoin")
+	amount := sdk.NewInt64Coin(denom, 100)
+
+	_, err := suite.msgServer.ChangeAdmin(goCtx, types.NewMsgChangeAdmin(addr2.String(), denom, addr2.String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.ChangeAdmin(goCtx, types.NewMsgChangeAdmin(addr1.String(), denom, addr2.String()))
+	suite.Require().NoError(err)
+	metadata, err := app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr2.String(), metadata.Admin)
+
+	_, err = suite.msgServer.Mint(goCtx, types.NewMsgMint(addr1.String(), amount))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.Mint(goCtx, types.NewMsgMint(addr2.String(), amount))
+	suite.Require().NoError(err)
+
+	// the denom stays indexed under its creator
+	suite.Require().Equal([]string{denom}, app.TokenFactoryKeeper.GetDenomsFromCreator(ctx, addr1))
+
+	// without admin, nobody can mint
+	_, err = suite.msgServer.ChangeAdmin(goCtx, types.NewMsgChangeAdmin(addr2.String(), denom, ""))
+	suite.Require().NoError(err)
+	for _, addr := range []sdk.AccAddress{addr1, addr2} {
+		_, err = suite.msgServer.Mint(goCtx, types.NewMsgMint(addr.String(), amount))
+		suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetDenomMetadata() {
+	app, ctx := suite.app, suite.ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+	denom := suite.createDenom(addr1, "bitcoin")
+
+	metadata := banktypes.Metadata{
+		Description: "wrapped bitcoin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "wbtc", Exponent: 8},
+		},
+		Base:    denom,
+		Display: "wbtc",
+		Name:    "Wrapped Bitcoin",
+		Symbol:  "WBTC",
+	}
+
+	_, err := suite.msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(addr2.String(), metadata))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(addr1.String(), metadata))
+	suite.Require().NoError(err)
+	bankMetadata, found := app.BankKeeper.GetDenomMetaData(ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(metadata, bankMetadata)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,213 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec
+const (
+	OpWeightMsgCreateDenom = "op_weight_msg_create_denom"
+	OpWeightMsgMint        = "op_weight_msg_tf_mint"
+	OpWeightMsgBurn        = "op_weight_msg_tf_burn"
+)
+
+// tokenfactory operations weights
+const (
+	WeightCreateDenom = 20
+	WeightMint        = 100
+	WeightBurn        = 50
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak authkeeper.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgCreateDenom, weightMsgMint, weightMsgBurn int
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateDenom, &weightMsgCreateDenom, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateDenom = WeightCreateDenom
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMint, &weightMsgMint, nil,
+		func(_ *rand.Rand) {
+			weightMsgMint = WeightMint
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurn, &weightMsgBurn, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurn = WeightBurn
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateDenom,
+			SimulateMsgCreateDenom(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMint,
+			SimulateMsgMint(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurn,
+			SimulateMsgBurn(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateDenom generates a MsgCreateDenom with random values.
+func SimulateMsgCreateDenom(ak authkeeper.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		creator, _ := simtypes.RandomAcc(r, accs)
+
+		spendable := bk.SpendableCoins(ctx, creator.Address)
+		remaining, hasNeg := spendable.SafeSub(k.GetParams(ctx).DenomCreationFee)
+		if hasNeg {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateDenom, "unable to pay the denom creation fee"), nil, nil
+		}
+
+		subdenom := simtypes.RandStringOfLength(r, 10)
+		denom, err := types.GetTokenDenom(creator.Address.String(), subdenom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateDenom, err.Error()), nil, nil
+		}
+		if _, err := k.GetAuthorityMetadata(ctx, denom); err == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateDenom, "denom already exists"), nil, nil
+		}
+
+		msg := types.NewMsgCreateDenom(creator.Address.String(), subdenom)
+		return deliver(r, app, ctx, ak, msg, creator, remaining, chainID)
+	}
+}
+
+// SimulateMsgMint generates a MsgMint of a random amount of a denom
+// administered by a random account.
+func SimulateMsgMint(ak authkeeper.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		admin, denom := randAdminDenom(r, ctx, k, accs, func(simtypes.Account, string) bool { return true })
+		if denom == "" {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMint, "no account administers a denom"), nil, nil
+		}
+
+		amount := sdk.NewCoin(denom, simtypes.RandomAmount(r, sdk.NewInt(1_000_000)))
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMint, "zero amount"), nil, nil
+		}
+
+		msg := types.NewMsgMint(admin.Address.String(), amount)
+		return deliver(r, app, ctx, ak, msg, admin, bk.SpendableCoins(ctx, admin.Address), chainID)
+	}
+}
+
+// SimulateMsgBurn generates a MsgBurn of a random amount of a denom
+// administered by a random account holding it, out of its balance.
+func SimulateMsgBurn(ak authkeeper.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		admin, denom := randAdminDenom(r, ctx, k, accs, func(acc simtypes.Account, denom string) bool {
+			return bk.SpendableCoins(ctx, acc.Address).AmountOf(denom).IsPositive()
+		})
+		if denom == "" {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBurn, "no account administers a denom it holds"), nil, nil
+		}
+
+		spendable := bk.SpendableCoins(ctx, admin.Address)
+		amount := sdk.NewCoin(denom, simtypes.RandomAmount(r, spendable.AmountOf(denom)))
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBurn, "zero amount"), nil, nil
+		}
+
+		msg := types.NewMsgBurn(admin.Address.String(), amount)
+		return deliver(r, app, ctx, ak, msg, admin, spendable.Sub(sdk.NewCoins(amount)), chainID)
+	}
+}
+
+// randAdminDenom returns a random account among the given ones and a random
+// denom it administers, for which keep returns true. It returns an empty denom
+// if there is none.
+func randAdminDenom(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account,
+	keep func(acc simtypes.Account, denom string) bool,
+) (simtypes.Account, string) {
+	type adminDenom struct {
+		acc   simtypes.Account
+		denom string
+	}
+
+	var candidates []adminDenom
+	for _, acc := range accs {
+		for _, denom := range k.GetDenomsFromCreator(ctx, acc.Address) {
+			metadata, err := k.GetAuthorityMetadata(ctx, denom)
+			if err == nil && metadata.Admin == acc.Address.String() && keep(acc, denom) {
+				candidates = append(candidates, adminDenom{acc, denom})
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		return simtypes.Account{}, ""
+	}
+	c := candidates[r.Intn(len(candidates))]
+	return c.acc, c.denom
+}
+
+// deliver signs and delivers a tx made of msg, paying random fees out of the
+// given spendable coins.
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak authkeeper.AccountKeeper,
+	msg legacytx.LegacyMsg, signer simtypes.Account, spendable sdk.Coins, chainID string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msgType := msg.Type()
+
+	fees, err := simtypes.RandomFees(r, ctx, spendable)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate fees"), nil, err
+	}
+
+	account := ak.GetAccount(ctx, signer.Address)
+	txCfg := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenSignedMockTx(
+		r,
+		txCfg,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		signer.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = app.Deliver(txCfg.TxEncoder(), tx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, fmt.Sprintf("unable to deliver tx: %s", err)), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+}
@@ -0,0 +1,143 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/simulation"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{})
+}
+
+func (suite *SimTestSuite) TestWeightedOperations() {
+	weightedOps := simulation.WeightedOperations(
+		make(simtypes.AppParams),
+		suite.app.AppCodec(),
+		suite.app.AccountKeeper,
+		suite.app.BankKeeper,
+		suite.app.TokenFactoryKeeper,
+	)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accs := suite.getTestingAccounts(r, 3)
+
+	expected := []struct {
+		weight     int
+		opMsgRoute string
+		opMsgName  string
+	}{
+		{simulation.WeightCreateDenom, types.ModuleName, types.TypeMsgCreateDenom},
+		{simulation.WeightMint, types.ModuleName, types.TypeMsgMint},
+		{simulation.WeightBurn, types.ModuleName, types.TypeMsgBurn},
+	}
+
+	for i, w := range weightedOps {
+		operationMsg, _, _ := w.Op()(r, suite.app.BaseApp, suite.ctx, accs, "")
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		suite.Require().Equal(expected[i].weight, w.Weight(), "weight should be the same")
+		suite.Require().Equal(expected[i].opMsgRoute, operationMsg.Route, "route should be the same")
+		suite.Require().Equal(expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+	}
+}
+
+func (suite *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := suite.app.StakingKeeper.TokensFromConsensusPower(suite.ctx, 200000)
+	initCoins := sdk.NewCoins(sdk.NewCoin("stake", initAmt))
+
+	// add coins to the accounts
+	for _, account := range accounts {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, account.Address)
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		suite.Require().NoError(simapp.FundAccount(suite.app.BankKeeper, suite.ctx, account.Address, initCoins))
+	}
+
+	return accounts
+}
+
+func (suite *SimTestSuite) beginBlock() {
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+}
+
+func (suite *SimTestSuite) TestSimulateMsgCreateDenom() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+	suite.beginBlock()
+
+	op := simulation.SimulateMsgCreateDenom(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.TokenFactoryKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg types.MsgCreateDenom
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(types.TypeMsgCreateDenom, operationMsg.Name)
+	suite.Require().Len(futureOperations, 0)
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	suite.Require().Len(suite.app.TokenFactoryKeeper.GetDenomsFromCreator(suite.ctx, sender), 1)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgMintBurn() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+	suite.beginBlock()
+
+	// without any denom, nothing can be minted or burnt
+	mintOp := simulation.SimulateMsgMint(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.TokenFactoryKeeper)
+	operationMsg, _, err := mintOp(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+	suite.Require().False(operationMsg.OK)
+
+	denom, err := suite.app.TokenFactoryKeeper.CreateDenom(suite.ctx, accounts[0].Address.String(), "bitcoin")
+	suite.Require().NoError(err)
+
+	operationMsg, _, err = mintOp(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(types.TypeMsgMint, operationMsg.Name)
+	minted := suite.app.BankKeeper.GetBalance(suite.ctx, accounts[0].Address, denom)
+	suite.Require().True(minted.IsPositive())
+
+	burnOp := simulation.SimulateMsgBurn(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.TokenFactoryKeeper)
+	operationMsg, _, err = burnOp(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(types.TypeMsgBurn, operationMsg.Name)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, accounts[0].Address, denom).IsLT(minted))
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
@@ -0,0 +1,28 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyDenomCreationFee),
+			func(r *rand.Rand) string {
+				bz, err := json.Marshal(GenDenomCreationFee(r))
+				if err != nil {
+					panic(err)
+				}
+				return string(bz)
+			},
+		),
+	}
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ModuleDenomPrefix is the first part of the token factory denoms, which
+	// are of the form factory/{creator}/{subdenom}.
+	ModuleDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of a subdenom.
+	MaxSubdenomLength = 44
+	// MaxHrpLength is the maximum length of the bech32 human readable part of
+	// a creator address.
+	MaxHrpLength = 16
+	// MaxCreatorLength is the maximum length of a creator address, allowing
+	// 32 bytes addresses with the longest human readable part.
+	MaxCreatorLength = 59 + MaxHrpLength
+)
+
+// GetTokenDenom returns the token factory denom factory/{creator}/{subdenom}.
+// The subdenom must not be empty, and the resulting denom must be a valid sdk
+// denom.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) == 0 {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, "subdenom cannot be empty")
+	}
+	if len(subdenom) > MaxSubdenomLength {
+		return "", ErrSubdenomTooLong
+	}
+	if len(creator) > MaxCreatorLength {
+		return "", ErrCreatorTooLong
+	}
+	if strings.Contains(creator, "/") {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "creator %s contains a '/'", creator)
+	}
+
+	denom := strings.Join([]string{ModuleDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom returns the creator address and the subdenom of a token
+// factory denom. It fails if the denom is not of the form
+// factory/{creator}/{subdenom} with a valid creator address.
+func DeconstructDenom(denom string) (creator string, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) < 3 {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "not enough parts in denom %s", denom)
+	}
+	if parts[0] != ModuleDenomPrefix {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "denom %s does not start with %q", denom, ModuleDenomPrefix+"/")
+	}
+
+	creator = parts[1]
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "invalid creator address (%s)", err)
+	}
+
+	subdenom = parts[2]
+	if len(subdenom) == 0 {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "empty subdenom in denom %s", denom)
+	}
+
+	return creator, subdenom, nil
+}
@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestGetTokenDenom(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+
+	testCases := []struct {
+		name     string
+		creator  string
+		subdenom string
+		expDenom string
+		expErr   bool
+	}{
+		{"valid", creator, "bitcoin", "factory/" + creator + "/bitcoin", false},
+		{"subdenom with slashes", creator, "bitcoin/1", "factory/" + creator + "/bitcoin/1", false},
+		{"empty subdenom", creator, "", "", true},
+		{"subdenom too long", creator, "adsfadsfadsfadsfadsfadsfadsfadsfadsfadsfadsfadsf", "", true},
+		{"creator too long", creator + creator, "bitcoin", "", true},
+		{"creator with a slash", "cosmos1/abc", "bitcoin", "", true},
+		{"invalid characters", creator, "bit^coin", "", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			denom, err := types.GetTokenDenom(tc.creator, tc.subdenom)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expDenom, denom)
+		})
+	}
+}
+
+func TestDeconstructDenom(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+
+	testCases := []struct {
+		name        string
+		denom       string
+		expSubdenom string
+		expErr      bool
+	}{
+		{"valid", "factory/" + creator + "/bitcoin", "bitcoin", false},
+		{"subdenom with slashes", "factory/" + creator + "/bitcoin/1", "bitcoin/1", false},
+		{"empty subdenom", "factory/" + creator + "/", "", true},
+		{"missing subdenom", "factory/" + creator, "", true},
+		{"wrong prefix", "fake/" + creator + "/bitcoin", "", true},
+		{"invalid creator", "factory/cosmos1abc/bitcoin", "", true},
+		{"not a factory denom", "stake", "", true},
+		{"invalid denom", "", "", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			denomCreator, subdenom, err := types.DeconstructDenom(tc.denom)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, creator, denomCreator)
+			require.Equal(t, tc.expSubdenom, subdenom)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/tokenfactory module sentinel errors
+var (
+	ErrDenomExists              = sdkerrors.Register(ModuleName, 2, "attempting to create a denom that already exists")
+	ErrDenomDoesNotExist        = sdkerrors.Register(ModuleName, 3, "denom does not exist")
+	ErrUnauthorized             = sdkerrors.Register(ModuleName, 4, "unauthorized account")
+	ErrInvalidDenom             = sdkerrors.Register(ModuleName, 5, "invalid denom")
+	ErrSubdenomTooLong          = sdkerrors.Register(ModuleName, 6, "subdenom too long")
+	ErrCreatorTooLong           = sdkerrors.Register(ModuleName, 7, "creator too long")
+	ErrInvalidAuthorityMetadata = sdkerrors.Register(ModuleName, 8, "invalid authority metadata")
+	ErrInvalidGenesis           = sdkerrors.Register(ModuleName, 9, "invalid genesis")
+)
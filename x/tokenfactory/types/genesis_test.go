@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expErr   bool
+	}{
+		{"default", types.DefaultGenesisState(), false},
+		{
+			"valid denoms",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+				{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: addr1}},
+				{Denom: "factory/" + addr1 + "/litecoin", AuthorityMetadata: types.DenomAuthorityMetadata{}},
+			}),
+			false,
+		},
+		{
+			"invalid denom creation fee",
+			types.NewGenesisState(types.NewParams(sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.ZeroInt()}}), []types.GenesisDenom{}),
+			true,
+		},
+		{
+			"duplicate denoms",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+				{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: addr1}},
+				{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: addr2}},
+			}),
+			true,
+		},
+		{
+			"not a factory denom",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+				{Denom: "stake", AuthorityMetadata: types.DenomAuthorityMetadata{Admin: addr1}},
+			}),
+			true,
+		},
+		{
+			"invalid admin",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+				{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: "invalid"}},
+			}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "tokenfactory"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore keys
+var (
+	// DenomAuthorityMetadataPrefix is the prefix of the authority metadata of
+	// the denoms, keyed by denom.
+	DenomAuthorityMetadataPrefix = []byte{0x01}
+	// CreatorDenomPrefix is the prefix of the index of the denoms by creator.
+	CreatorDenomPrefix = []byte{0x02}
+)
+
+// DenomAuthorityMetadataKey returns the key of the authority metadata of a
+// denom.
+func DenomAuthorityMetadataKey(denom string) []byte {
+	key := make([]byte, len(DenomAuthorityMetadataPrefix)+len(denom))
+	copy(key, DenomAuthorityMetadataPrefix)
+	copy(key[len(DenomAuthorityMetadataPrefix):], denom)
+	return key
+}
+
+// CreateCreatorDenomPrefix returns the prefix of the denoms created by an
+// account.
+func CreateCreatorDenomPrefix(creator []byte) []byte {
+	return append(CreatorDenomPrefix, address.MustLengthPrefix(creator)...)
+}
+
+// CreatorDenomKey returns the key indexing a denom under its creator.
+func CreatorDenomKey(creator []byte, denom string) []byte {
+	return append(CreateCreatorDenomPrefix(creator), denom...)
+}
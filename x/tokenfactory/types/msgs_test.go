@@ -0,0 +1,102 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+var (
+	addr1 = sdk.AccAddress("addr1_______________").String()
+	addr2 = sdk.AccAddress("addr2_______________").String()
+	denom = "factory/" + addr1 + "/bitcoin"
+)
+
+func TestMsgCreateDenomValidateBasic(t *testing.T) {
+	require.NoError(t, types.NewMsgCreateDenom(addr1, "bitcoin").ValidateBasic())
+	require.Error(t, types.NewMsgCreateDenom("invalid", "bitcoin").ValidateBasic())
+	require.Error(t, types.NewMsgCreateDenom(addr1, "").ValidateBasic())
+	require.Error(t, types.NewMsgCreateDenom(addr1, "bit^coin").ValidateBasic())
+}
+
+func TestMsgMintBurnValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		sender string
+		amount sdk.Coin
+		expErr bool
+	}{
+		{"valid", addr1, sdk.NewInt64Coin(denom, 10), false},
+		{"invalid sender", "invalid", sdk.NewInt64Coin(denom, 10), true},
+		{"zero amount", addr1, sdk.NewInt64Coin(denom, 0), true},
+		{"negative amount", addr1, sdk.Coin{Denom: denom, Amount: sdk.NewInt(-10)}, true},
+		{"not a factory denom", addr1, sdk.NewInt64Coin("stake", 10), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			for _, msg := range []sdk.Msg{types.NewMsgMint(tc.sender, tc.amount), types.NewMsgBurn(tc.sender, tc.amount)} {
+				if tc.expErr {
+					require.Error(t, msg.ValidateBasic())
+				} else {
+					require.NoError(t, msg.ValidateBasic())
+				}
+			}
+		})
+	}
+}
+
+func TestMsgChangeAdminValidateBasic(t *testing.T) {
+	require.NoError(t, types.NewMsgChangeAdmin(addr1, denom, addr2).ValidateBasic())
+	require.NoError(t, types.NewMsgChangeAdmin(addr1, denom, "").ValidateBasic())
+	require.Error(t, types.NewMsgChangeAdmin("invalid", denom, addr2).ValidateBasic())
+	require.Error(t, types.NewMsgChangeAdmin(addr1, denom, "invalid").ValidateBasic())
+	require.Error(t, types.NewMsgChangeAdmin(addr1, "stake", addr2).ValidateBasic())
+}
+
+func TestMsgSetDenomMetadataValidateBasic(t *testing.T) {
+	metadata := banktypes.Metadata{
+		Description: "bitcoin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "bitcoin", Exponent: 8},
+		},
+		Base:    denom,
+		Display: "bitcoin",
+		Name:    "Bitcoin",
+		Symbol:  "BTC",
+	}
+	require.NoError(t, types.NewMsgSetDenomMetadata(addr1, metadata).ValidateBasic())
+	require.Error(t, types.NewMsgSetDenomMetadata("invalid", metadata).ValidateBasic())
+
+	invalidMetadata := metadata
+	invalidMetadata.Symbol = ""
+	require.Error(t, types.NewMsgSetDenomMetadata(addr1, invalidMetadata).ValidateBasic())
+
+	notFactoryMetadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "stake", Exponent: 0}},
+		Base:       "stake",
+		Display:    "stake",
+		Name:       "Stake",
+		Symbol:     "STAKE",
+	}
+	require.Error(t, types.NewMsgSetDenomMetadata(addr1, notFactoryMetadata).ValidateBasic())
+}
+
+func TestMsgGetSigners(t *testing.T) {
+	msgs := []sdk.Msg{
+		types.NewMsgCreateDenom(addr1, "bitcoin"),
+		types.NewMsgMint(addr1, sdk.NewInt64Coin(denom, 10)),
+		types.NewMsgBurn(addr1, sdk.NewInt64Coin(denom, 10)),
+		types.NewMsgChangeAdmin(addr1, denom, addr2),
+		types.NewMsgSetDenomMetadata(addr1, banktypes.Metadata{}),
+	}
+	for _, msg := range msgs {
+		require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(addr1)}, msg.GetSigners())
+	}
+}